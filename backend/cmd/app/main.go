@@ -2,6 +2,7 @@ package main
 
 import (
 	"log"
+	"os"
 
 	"kompass/config"
 	"kompass/internal/app"
@@ -14,6 +15,15 @@ func main() {
 		log.Fatalf("Config error: %s", err)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate-attachments" {
+		app.MigrateAttachments(cfg, os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "rotate-keys" {
+		app.RotateKeys(cfg, os.Args[2:])
+		return
+	}
+
 	// Run
 	app.Run(cfg)
 }
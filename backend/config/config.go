@@ -2,24 +2,40 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 )
 
 type (
 	Config struct {
-		HTTP    HTTP
-		Auth    Auth
-		Log     Log
-		PG      PG
-		Metrics Metrics
-		Swagger Swagger
-		WebApi  WebApi
+		App         App
+		HTTP        HTTP
+		Auth        Auth
+		Log         Log
+		PG          PG
+		Metrics     Metrics
+		Swagger     Swagger
+		WebApi      WebApi
+		Attachments Attachments
+		Encryption  Encryption
+		Trash       Trash
+		Checks      Checks
+		Emissions   Emissions
+	}
+
+	App struct {
+		URL string `env:"APP_URL" envDefault:"http://localhost:3000"`
 	}
 
 	HTTP struct {
 		Port           string `env:"HTTP_PORT" envDefault:"8080"`
 		UsePreforkMode bool   `env:"HTTP_USE_PREFORK_MODE" envDefault:"false"`
+		BodyLimit      int    `env:"HTTP_BODY_LIMIT" envDefault:"4194304"`
+		// UploadBodyLimit only applies to the routes uploading attachments and photos
+		UploadBodyLimit int `env:"HTTP_UPLOAD_BODY_LIMIT" envDefault:"104857600"`
+		// TransferTimeout replaces the read and write timeouts of uploading and downloading attachments and photos
+		TransferTimeout time.Duration `env:"HTTP_TRANSFER_TIMEOUT" envDefault:"10m"`
 	}
 
 	Auth struct {
@@ -52,6 +68,48 @@ type (
 		OpenTravelDataBaseURL   string `env:"OPTD_URL" envDefault:"https://raw.githubusercontent.com/opentraveldata/opentraveldata/refs/heads/master/opentraveldata"`
 		OpenRouteServiceBaseURL string `env:"ORS_URL" envDefault:"https://api.openrouteservice.org"`
 		OpenRouteServiceApiKey  string `env:"ORS_APIKEY"`
+		EcbBaseURL              string `env:"ECB_URL" envDefault:"https://www.ecb.europa.eu/stats/eurofxref"`
+		ExchangeRatesFile       string `env:"EXCHANGE_RATES_FILE"`
+	}
+
+	Attachments struct {
+		MaxSize int64 `env:"ATTACHMENT_MAX_SIZE" envDefault:"26214400"`
+		// Storage is one of postgres, filesystem or s3
+		Storage     string `env:"ATTACHMENT_STORAGE" envDefault:"postgres"`
+		StoragePath string `env:"ATTACHMENT_STORAGE_PATH" envDefault:"attachments"`
+		S3Endpoint  string `env:"ATTACHMENT_S3_ENDPOINT"`
+		S3Region    string `env:"ATTACHMENT_S3_REGION"`
+		S3Bucket    string `env:"ATTACHMENT_S3_BUCKET" envDefault:"kompass"`
+		S3AccessKey string `env:"ATTACHMENT_S3_ACCESS_KEY"`
+		S3SecretKey string `env:"ATTACHMENT_S3_SECRET_KEY"`
+		S3UseSSL    bool   `env:"ATTACHMENT_S3_USE_SSL" envDefault:"true"`
+		// PresignExpiry is how long pre-signed download URLs are valid, zero disables them
+		PresignExpiry time.Duration `env:"ATTACHMENT_PRESIGN_EXPIRY" envDefault:"0"`
+	}
+
+	Encryption struct {
+		// MasterKey is a base64-encoded 256 bit key, encryption is disabled without it
+		MasterKey string `env:"ENCRYPTION_MASTER_KEY"`
+		// PreviousMasterKeys are only used to decrypt data keys until they are re-wrapped with rotate-keys
+		PreviousMasterKeys []string `env:"ENCRYPTION_PREVIOUS_MASTER_KEYS"`
+	}
+
+	Trash struct {
+		// Retention is how long deleted trips and entities are kept in the trash, zero keeps them until restored
+		Retention time.Duration `env:"TRASH_RETENTION" envDefault:"720h"`
+	}
+
+	Checks struct {
+		// MinFlightConnection and MinTrainTransfer are the shortest times to change between flights and trains
+		MinFlightConnection time.Duration `env:"CHECK_MIN_FLIGHT_CONNECTION" envDefault:"45m"`
+		MinTrainTransfer    time.Duration `env:"CHECK_MIN_TRAIN_TRANSFER" envDefault:"5m"`
+		// MaxAccommodationDistance is how far in meters accommodation may be from where the day's last transport arrives
+		MaxAccommodationDistance float64 `env:"CHECK_MAX_ACCOMMODATION_DISTANCE" envDefault:"50000"`
+	}
+
+	Emissions struct {
+		// FactorsFile replaces the emission factors shipped in internal/repo/emissions/factors.json
+		FactorsFile string `env:"EMISSION_FACTORS_FILE"`
 	}
 )
 
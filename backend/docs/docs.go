@@ -6,10 +6,10 @@ import "github.com/swaggo/swag/v2"
 
 const docTemplate = `{
     "schemes": {{ marshal .Schemes }},
-    "components": {"schemas":{"entity.Accommodation":{"properties":{"address":{"nullable":true,"type":"string"},"arrivalDate":{"type":"string"},"checkInTime":{"nullable":true,"type":"string"},"checkOutTime":{"nullable":true,"type":"string"},"departureDate":{"type":"string"},"description":{"nullable":true,"type":"string"},"id":{"type":"integer"},"location":{"$ref":"#/components/schemas/entity.Location"},"name":{"type":"string"},"price":{"nullable":true,"type":"integer"},"tripId":{"type":"integer"}},"required":["address","arrivalDate","checkInTime","checkOutTime","departureDate","description","id","location","name","price","tripId"],"type":"object"},"entity.Activity":{"properties":{"address":{"nullable":true,"type":"string"},"date":{"type":"string"},"description":{"nullable":true,"type":"string"},"id":{"type":"integer"},"location":{"$ref":"#/components/schemas/entity.Location"},"name":{"type":"string"},"price":{"nullable":true,"type":"integer"},"time":{"nullable":true,"type":"string"},"tripId":{"type":"integer"}},"required":["address","date","description","id","location","name","price","time","tripId"],"type":"object"},"entity.Airport":{"properties":{"iata":{"type":"string"},"location":{"$ref":"#/components/schemas/entity.Location"},"municipality":{"type":"string"},"name":{"type":"string"}},"required":["iata","location","municipality","name"],"type":"object"},"entity.AmbiguousFlightChoice":{"properties":{"departureDateTime":{"type":"string"},"destinationIata":{"type":"string"},"originIata":{"type":"string"}},"required":["departureDateTime","destinationIata","originIata"],"type":"object"},"entity.Attachment":{"properties":{"blob":{"items":{"type":"integer"},"type":"array","uniqueItems":false},"id":{"type":"integer"},"name":{"type":"string"},"tripId":{"type":"integer"}},"required":["blob","id","name","tripId"],"type":"object"},"entity.ErrAmbiguousFlightRequest":{"additionalProperties":{"items":{"$ref":"#/components/schemas/entity.AmbiguousFlightChoice"},"type":"array"},"type":"object"},"entity.FlightDetail":{"nullable":true,"properties":{"legs":{"items":{"$ref":"#/components/schemas/entity.FlightLeg"},"type":"array","uniqueItems":false},"pnrs":{"items":{"$ref":"#/components/schemas/entity.PNR"},"type":"array","uniqueItems":false}},"required":["legs","pnrs"],"type":"object"},"entity.FlightLeg":{"properties":{"aircraft":{"nullable":true,"type":"string"},"airline":{"type":"string"},"amadeusFlightDate":{"nullable":true,"type":"string"},"arrivalDateTime":{"type":"string"},"departureDateTime":{"type":"string"},"destination":{"$ref":"#/components/schemas/entity.Airport"},"durationInMinutes":{"type":"integer"},"flightNumber":{"type":"string"},"id":{"type":"integer"},"origin":{"$ref":"#/components/schemas/entity.Airport"}},"required":["aircraft","airline","amadeusFlightDate","arrivalDateTime","departureDateTime","destination","durationInMinutes","flightNumber","id","origin"],"type":"object"},"entity.GenericDetail":{"nullable":true,"properties":{"destinationAddress":{"nullable":true,"type":"string"},"name":{"type":"string"},"originAddress":{"nullable":true,"type":"string"}},"required":["destinationAddress","name","originAddress"],"type":"object"},"entity.Location":{"nullable":true,"properties":{"id":{"type":"integer"},"latitude":{"type":"number"},"longitude":{"type":"number"}},"required":["id","latitude","longitude"],"type":"object"},"entity.PNR":{"properties":{"airline":{"example":"LH","type":"string"},"id":{"type":"integer"},"pnr":{"example":"123456","type":"string"}},"required":["airline","id","pnr"],"type":"object"},"entity.TrainDetail":{"nullable":true,"properties":{"legs":{"items":{"$ref":"#/components/schemas/entity.TrainLeg"},"type":"array","uniqueItems":false},"refreshToken":{"type":"string"}},"required":["legs","refreshToken"],"type":"object"},"entity.TrainLeg":{"properties":{"arrivalDateTime":{"type":"string"},"departureDateTime":{"type":"string"},"destination":{"$ref":"#/components/schemas/entity.TrainStation"},"durationInMinutes":{"type":"integer"},"id":{"type":"integer"},"lineName":{"type":"string"},"operatorName":{"type":"string"},"origin":{"$ref":"#/components/schemas/entity.TrainStation"}},"required":["arrivalDateTime","departureDateTime","destination","durationInMinutes","id","lineName","operatorName","origin"],"type":"object"},"entity.TrainStation":{"properties":{"id":{"type":"string"},"location":{"$ref":"#/components/schemas/entity.Location"},"name":{"type":"string"}},"required":["id","location","name"],"type":"object"},"entity.Transportation":{"properties":{"arrivalDateTime":{"type":"string"},"departureDateTime":{"type":"string"},"destination":{"$ref":"#/components/schemas/entity.Location"},"flightDetail":{"$ref":"#/components/schemas/entity.FlightDetail"},"genericDetail":{"$ref":"#/components/schemas/entity.GenericDetail"},"id":{"type":"integer"},"origin":{"$ref":"#/components/schemas/entity.Location"},"price":{"nullable":true,"type":"integer"},"trainDetail":{"$ref":"#/components/schemas/entity.TrainDetail"},"tripId":{"type":"integer"},"type":{"$ref":"#/components/schemas/entity.TransportationType"}},"required":["arrivalDateTime","departureDateTime","destination","id","origin","price","tripId","type"],"type":"object"},"entity.TransportationType":{"type":"string","x-enum-varnames":["FLIGHT","TRAIN","BUS","CAR","FERRY","BOAT","BIKE","HIKE","OTHER"]},"entity.Trip":{"properties":{"description":{"nullable":true,"type":"string"},"endDate":{"type":"string"},"id":{"type":"integer"},"imageUrl":{"nullable":true,"type":"string"},"name":{"type":"string"},"owner_id":{"type":"integer"},"startDate":{"type":"string"}},"required":["description","endDate","id","imageUrl","name","owner_id","startDate"],"type":"object"},"entity.User":{"properties":{"id":{"type":"integer"},"jwtSub":{"type":"string"},"name":{"type":"string"}},"required":["id","jwtSub","name"],"type":"object"},"request.Accommodation":{"properties":{"address":{"nullable":true,"type":"string"},"arrivalDate":{"type":"string"},"checkInTime":{"nullable":true,"type":"string"},"checkOutTime":{"nullable":true,"type":"string"},"departureDate":{"type":"string"},"description":{"nullable":true,"type":"string"},"location":{"$ref":"#/components/schemas/entity.Location"},"name":{"type":"string"},"price":{"nullable":true,"type":"integer"}},"required":["address","arrivalDate","checkInTime","checkOutTime","departureDate","description","location","name","price"],"type":"object"},"request.Activity":{"properties":{"address":{"nullable":true,"type":"string"},"date":{"type":"string"},"description":{"nullable":true,"type":"string"},"location":{"$ref":"#/components/schemas/entity.Location"},"name":{"type":"string"},"price":{"nullable":true,"type":"integer"},"time":{"nullable":true,"type":"string"}},"required":["address","date","description","location","name","price","time"],"type":"object"},"request.Flight":{"properties":{"legs":{"items":{"$ref":"#/components/schemas/request.FlightLeg"},"type":"array","uniqueItems":false},"pnrs":{"items":{"$ref":"#/components/schemas/entity.PNR"},"type":"array","uniqueItems":false},"price":{"nullable":true,"type":"integer"}},"required":["legs","pnrs","price"],"type":"object"},"request.FlightLeg":{"properties":{"date":{"example":"2026-01-30","type":"string"},"flightNumber":{"example":"EK412","type":"string"},"originAirport":{"example":"SYD","nullable":true,"type":"string"}},"required":["date","flightNumber","originAirport"],"type":"object"},"request.TrainJourney":{"properties":{"departureDate":{"example":"2025-09-20","type":"string"},"fromStationId":{"example":"8011113","type":"string"},"price":{"nullable":true,"type":"integer"},"toStationId":{"example":"8000261","type":"string"},"trainNumbers":{"example":["ICE707"],"items":{"type":"string"},"type":"array","uniqueItems":false},"viaStationId":{"example":"8596008","nullable":true,"type":"string"}},"required":["departureDate","fromStationId","price","toStationId","trainNumbers","viaStationId"],"type":"object"},"request.Transportation":{"properties":{"arrivalDateTime":{"type":"string"},"departureDateTime":{"type":"string"},"destination":{"$ref":"#/components/schemas/entity.Location"},"destinationAddress":{"nullable":true,"type":"string"},"name":{"type":"string"},"origin":{"$ref":"#/components/schemas/entity.Location"},"originAddress":{"nullable":true,"type":"string"},"price":{"nullable":true,"type":"integer"},"type":{"type":"string","x-enum-varnames":["FLIGHT","TRAIN","BUS","CAR","FERRY","BOAT","BIKE","HIKE","OTHER"]}},"required":["arrivalDateTime","departureDateTime","destination","destinationAddress","name","origin","originAddress","price","type"],"type":"object"},"request.Trip":{"properties":{"description":{"nullable":true,"type":"string"},"endDate":{"type":"string"},"imageUrl":{"nullable":true,"type":"string"},"name":{"type":"string"},"startDate":{"type":"string"}},"required":["description","endDate","imageUrl","name","startDate"],"type":"object"},"response.Error":{"properties":{"detail":{"nullable":true,"type":"string"},"error":{"type":"string"}},"required":["error"],"type":"object"},"v1.AttachmentsParam":{"properties":{"attachments":{"items":{"format":"binary","type":"string"},"type":"array","uniqueItems":false}},"required":["attachments"],"type":"object"}},"securitySchemes":{"bearerauth":{"bearerFormat":"JWT","scheme":"bearer","type":"http"}}},
+    "components": {"schemas":{"entity.Accommodation":{"description":"Accommodation is where we sleep in the night following Date.","properties":{"address":{"nullable":true,"type":"string"},"arrivalDate":{"type":"string"},"attachments":{"items":{"$ref":"#/components/schemas/entity.AttachmentMetadata"},"type":"array","uniqueItems":false},"budgetAlert":{"$ref":"#/components/schemas/entity.BudgetAlert"},"checkInTime":{"nullable":true,"type":"string"},"checkInUtcOffset":{"nullable":true,"type":"string"},"checkOutTime":{"nullable":true,"type":"string"},"checkOutUtcOffset":{"nullable":true,"type":"string"},"currency":{"example":"EUR","type":"string"},"departureDate":{"type":"string"},"description":{"nullable":true,"type":"string"},"id":{"type":"integer"},"location":{"$ref":"#/components/schemas/entity.Location"},"name":{"type":"string"},"price":{"nullable":true,"type":"integer"},"revision":{"type":"integer"},"sensitive":{"type":"boolean"},"tripId":{"type":"integer"}},"required":["address","arrivalDate","attachments","checkInTime","checkInUtcOffset","checkOutTime","checkOutUtcOffset","currency","departureDate","description","id","location","name","price","revision","sensitive","tripId"],"type":"object"},"entity.Activity":{"description":"Proposal is an activity that has not been saved yet.","properties":{"address":{"nullable":true,"type":"string"},"attachments":{"items":{"$ref":"#/components/schemas/entity.AttachmentMetadata"},"type":"array","uniqueItems":false},"budgetAlert":{"$ref":"#/components/schemas/entity.BudgetAlert"},"currency":{"example":"EUR","type":"string"},"date":{"type":"string"},"description":{"nullable":true,"type":"string"},"id":{"type":"integer"},"location":{"$ref":"#/components/schemas/entity.Location"},"name":{"type":"string"},"price":{"nullable":true,"type":"integer"},"revision":{"type":"integer"},"sensitive":{"type":"boolean"},"time":{"nullable":true,"type":"string"},"tripId":{"type":"integer"},"utcOffset":{"nullable":true,"type":"string"}},"required":["address","attachments","currency","date","description","id","location","name","price","revision","sensitive","time","tripId","utcOffset"],"type":"object"},"entity.Airport":{"properties":{"iata":{"type":"string"},"location":{"$ref":"#/components/schemas/entity.Location"},"municipality":{"type":"string"},"name":{"type":"string"}},"required":["iata","location","municipality","name"],"type":"object"},"entity.AmbiguousFlightChoice":{"properties":{"departureDateTime":{"type":"string"},"destinationIata":{"type":"string"},"originIata":{"type":"string"}},"required":["departureDateTime","destinationIata","originIata"],"type":"object"},"entity.Attachment":{"properties":{"contentType":{"example":"application/pdf","type":"string"},"createdAt":{"type":"string"},"id":{"type":"integer"},"name":{"type":"string"},"revision":{"type":"integer"},"sha256":{"type":"string"},"size":{"type":"integer"},"tripId":{"type":"integer"},"uploadedBy":{"nullable":true,"type":"integer"}},"required":["contentType","createdAt","id","name","revision","sha256","size","tripId","uploadedBy"],"type":"object"},"entity.AttachmentMetadata":{"properties":{"contentType":{"example":"application/pdf","type":"string"},"id":{"type":"integer"},"name":{"type":"string"},"size":{"type":"integer"}},"required":["contentType","id","name","size"],"type":"object"},"entity.AttachmentURL":{"properties":{"expiresAt":{"type":"string"},"url":{"type":"string"}},"required":["expiresAt","url"],"type":"object"},"entity.Budget":{"properties":{"categories":{"items":{"$ref":"#/components/schemas/entity.CategoryBudget"},"type":"array","uniqueItems":false},"total":{"nullable":true,"type":"number"}},"required":["categories","total"],"type":"object"},"entity.BudgetAlert":{"nullable":true,"properties":{"category":{"$ref":"#/components/schemas/entity.CostCategory"},"planned":{"type":"number"},"spent":{"type":"number"}},"required":["category","planned","spent"],"type":"object"},"entity.BudgetReport":{"properties":{"categories":{"items":{"$ref":"#/components/schemas/entity.CategoryBudgetReport"},"type":"array","uniqueItems":false},"dailyBurnRate":{"type":"number"},"homeCurrency":{"type":"string"},"planned":{"nullable":true,"type":"number"},"remaining":{"nullable":true,"type":"number"},"spent":{"type":"number"}},"required":["categories","dailyBurnRate","homeCurrency","planned","remaining","spent"],"type":"object"},"entity.CabinClass":{"nullable":true,"type":"string"},"entity.CategoryBudget":{"properties":{"amount":{"type":"number"},"category":{"type":"string","x-enum-varnames":["TRANSPORTATION_COSTS","ACCOMMODATION_COSTS","ACTIVITY_COSTS","FOOD_COSTS","OTHER_COSTS"]}},"required":["amount","category"],"type":"object"},"entity.CategoryBudgetReport":{"properties":{"category":{"type":"string","x-enum-varnames":["TRANSPORTATION_COSTS","ACCOMMODATION_COSTS","ACTIVITY_COSTS","FOOD_COSTS","OTHER_COSTS"]},"exceeded":{"type":"boolean"},"planned":{"nullable":true,"type":"number"},"remaining":{"nullable":true,"type":"number"},"spent":{"type":"number"}},"required":["category","exceeded","planned","remaining","spent"],"type":"object"},"entity.CategoryCosts":{"properties":{"category":{"type":"string","x-enum-varnames":["TRANSPORTATION_COSTS","ACCOMMODATION_COSTS","ACTIVITY_COSTS","FOOD_COSTS","OTHER_COSTS"]},"total":{"type":"number"}},"required":["category","total"],"type":"object"},"entity.ChangeResult":{"properties":{"error":{"type":"string"},"id":{"nullable":true,"type":"integer"},"kind":{"type":"string","x-enum-varnames":["TRIP_KIND","TRANSPORTATION_KIND","ACTIVITY_KIND","ACCOMMODATION_KIND","ATTACHMENT_KIND","EXPENSE_KIND","BUDGET_KIND"]},"revision":{"nullable":true,"type":"integer"},"status":{"$ref":"#/components/schemas/entity.ChangeStatus"}},"required":["id","kind","revision","status"],"type":"object"},"entity.ChangeStatus":{"type":"string","x-enum-varnames":["CHANGE_APPLIED","CHANGE_CONFLICT","CHANGE_REJECTED"]},"entity.ChangeType":{"type":"string","x-enum-varnames":["CREATED","UPDATED","DELETED"]},"entity.Changes":{"properties":{"accommodation":{"items":{"$ref":"#/components/schemas/entity.Accommodation"},"type":"array","uniqueItems":false},"activities":{"items":{"$ref":"#/components/schemas/entity.Activity"},"type":"array","uniqueItems":false},"attachments":{"items":{"$ref":"#/components/schemas/entity.Attachment"},"type":"array","uniqueItems":false},"cursor":{"type":"integer"},"deleted":{"items":{"$ref":"#/components/schemas/entity.Tombstone"},"type":"array","uniqueItems":false},"expenses":{"items":{"$ref":"#/components/schemas/entity.Expense"},"type":"array","uniqueItems":false},"transportation":{"items":{"$ref":"#/components/schemas/entity.Transportation"},"type":"array","uniqueItems":false},"trip":{"$ref":"#/components/schemas/entity.Trip"}},"required":["accommodation","activities","attachments","cursor","deleted","expenses","transportation"],"type":"object"},"entity.CheckType":{"type":"string","x-enum-varnames":["OVERLAPPING_TRANSPORTATION","ACTIVITY_IN_TRANSIT","MISSING_ACCOMMODATION","DISTANT_ACCOMMODATION","SHORT_FLIGHT_CONNECTION","SHORT_TRAIN_TRANSFER"]},"entity.CheckedEntity":{"properties":{"id":{"type":"integer"},"kind":{"type":"string","x-enum-varnames":["TRIP_KIND","TRANSPORTATION_KIND","ACTIVITY_KIND","ACCOMMODATION_KIND","ATTACHMENT_KIND","EXPENSE_KIND","BUDGET_KIND"]},"name":{"type":"string"}},"required":["id","kind","name"],"type":"object"},"entity.CostCategory":{"type":"string","x-enum-varnames":["TRANSPORTATION_COSTS","ACCOMMODATION_COSTS","ACTIVITY_COSTS","FOOD_COSTS","OTHER_COSTS"]},"entity.Costs":{"properties":{"categories":{"items":{"$ref":"#/components/schemas/entity.CategoryCosts"},"type":"array","uniqueItems":false},"currencies":{"items":{"$ref":"#/components/schemas/entity.CurrencyCosts"},"type":"array","uniqueItems":false},"days":{"items":{"$ref":"#/components/schemas/entity.DayCosts"},"type":"array","uniqueItems":false},"homeCurrency":{"type":"string"},"total":{"type":"number"}},"required":["categories","currencies","days","homeCurrency","total"],"type":"object"},"entity.CurrencyCosts":{"properties":{"amount":{"type":"number"},"currency":{"type":"string"},"total":{"nullable":true,"type":"number"}},"required":["amount","currency","total"],"type":"object"},"entity.DateChange":{"type":"string","x-enum-varnames":["VALIDATE_DATES","SHIFT_DATES"]},"entity.DayCosts":{"properties":{"date":{"type":"string"},"total":{"type":"number"}},"required":["date","total"],"type":"object"},"entity.EntityKind":{"type":"string","x-enum-varnames":["TRIP_KIND","TRANSPORTATION_KIND","ACTIVITY_KIND","ACCOMMODATION_KIND","ATTACHMENT_KIND","EXPENSE_KIND","BUDGET_KIND"]},"entity.ErrAmbiguousFlightRequest":{"additionalProperties":{"items":{"$ref":"#/components/schemas/entity.AmbiguousFlightChoice"},"type":"array"},"type":"object"},"entity.Expense":{"properties":{"accommodationId":{"nullable":true,"type":"integer"},"activityId":{"nullable":true,"type":"integer"},"amount":{"type":"number"},"attachmentId":{"nullable":true,"type":"integer"},"category":{"type":"string","x-enum-varnames":["TRANSPORTATION_COSTS","ACCOMMODATION_COSTS","ACTIVITY_COSTS","FOOD_COSTS","OTHER_COSTS"]},"currency":{"example":"EUR","type":"string"},"date":{"type":"string"},"id":{"type":"integer"},"name":{"type":"string"},"participants":{"items":{"$ref":"#/components/schemas/entity.ExpenseParticipant"},"type":"array","uniqueItems":false},"payerId":{"type":"integer"},"revision":{"type":"integer"},"splitMode":{"$ref":"#/components/schemas/entity.SplitMode"},"transportationId":{"nullable":true,"type":"integer"},"tripId":{"type":"integer"}},"required":["accommodationId","activityId","amount","attachmentId","category","currency","date","id","name","participants","payerId","revision","splitMode","transportationId","tripId"],"type":"object"},"entity.ExpenseParticipant":{"properties":{"amount":{"type":"number"},"share":{"nullable":true,"type":"number"},"userId":{"type":"integer"}},"required":["amount","share","userId"],"type":"object"},"entity.FlightDetail":{"nullable":true,"properties":{"legs":{"items":{"$ref":"#/components/schemas/entity.FlightLeg"},"type":"array","uniqueItems":false},"pnrs":{"items":{"$ref":"#/components/schemas/entity.PNR"},"type":"array","uniqueItems":false}},"required":["legs","pnrs"],"type":"object"},"entity.FlightLeg":{"properties":{"aircraft":{"nullable":true,"type":"string"},"airline":{"type":"string"},"amadeusFlightDate":{"nullable":true,"type":"string"},"arrivalDateTime":{"type":"string"},"arrivalUtcOffset":{"nullable":true,"type":"string"},"cabinClass":{"$ref":"#/components/schemas/entity.CabinClass"},"departureDateTime":{"type":"string"},"departureUtcOffset":{"nullable":true,"type":"string"},"destination":{"$ref":"#/components/schemas/entity.Airport"},"durationInMinutes":{"type":"integer"},"flightNumber":{"type":"string"},"id":{"type":"integer"},"origin":{"$ref":"#/components/schemas/entity.Airport"}},"required":["aircraft","airline","amadeusFlightDate","arrivalDateTime","arrivalUtcOffset","cabinClass","departureDateTime","departureUtcOffset","destination","durationInMinutes","flightNumber","id","origin"],"type":"object"},"entity.GenericDetail":{"nullable":true,"properties":{"destinationAddress":{"nullable":true,"type":"string"},"name":{"type":"string"},"originAddress":{"nullable":true,"type":"string"}},"required":["destinationAddress","name","originAddress"],"type":"object"},"entity.HistoryEntry":{"properties":{"after":{"type":"object"},"before":{"type":"object"},"createdAt":{"type":"string"},"entityId":{"type":"integer"},"id":{"type":"integer"},"kind":{"type":"string","x-enum-varnames":["TRIP_KIND","TRANSPORTATION_KIND","ACTIVITY_KIND","ACCOMMODATION_KIND","ATTACHMENT_KIND","EXPENSE_KIND","BUDGET_KIND"]},"tripId":{"type":"integer"},"type":{"type":"string","x-enum-varnames":["CREATED","UPDATED","DELETED"]},"userId":{"nullable":true,"type":"integer"}},"required":["createdAt","entityId","id","kind","tripId","type","userId"],"type":"object"},"entity.ItineraryCheck":{"properties":{"date":{"type":"string"},"entities":{"items":{"$ref":"#/components/schemas/entity.CheckedEntity"},"type":"array","uniqueItems":false},"message":{"type":"string"},"type":{"$ref":"#/components/schemas/entity.CheckType"}},"required":["date","entities","message","type"],"type":"object"},"entity.ItineraryDay":{"properties":{"accommodation":{"$ref":"#/components/schemas/entity.Accommodation"},"date":{"type":"string"},"events":{"items":{"$ref":"#/components/schemas/entity.ItineraryEvent"},"type":"array","uniqueItems":false},"missingAccommodation":{"description":"MissingAccommodation is set if the night is neither covered by accommodation nor spent travelling.\nIt is never set for the last day.","type":"boolean"},"overnightTransportation":{"description":"OvernightTransportation is still underway at midnight following Date.","items":{"$ref":"#/components/schemas/entity.Transportation"},"type":"array","uniqueItems":false}},"required":["date","events","missingAccommodation","overnightTransportation"],"type":"object"},"entity.ItineraryEvent":{"properties":{"accommodation":{"$ref":"#/components/schemas/entity.Accommodation"},"activity":{"$ref":"#/components/schemas/entity.Activity"},"time":{"nullable":true,"type":"string"},"transportation":{"$ref":"#/components/schemas/entity.Transportation"},"type":{"$ref":"#/components/schemas/entity.ItineraryEventType"},"utcOffset":{"nullable":true,"type":"string"}},"required":["time","type","utcOffset"],"type":"object"},"entity.ItineraryEventType":{"type":"string","x-enum-varnames":["DEPARTURE","ARRIVAL","ACTIVITY","CHECK_IN","CHECK_OUT"]},"entity.Location":{"nullable":true,"properties":{"id":{"type":"integer"},"latitude":{"type":"number"},"longitude":{"type":"number"},"timezone":{"description":"Timezone is the IANA name of the timezone at the location, e.g. \"Europe/Berlin\".","type":"string"}},"required":["id","latitude","longitude"],"type":"object"},"entity.MemberBalance":{"properties":{"balance":{"type":"number"},"owed":{"type":"number"},"paid":{"type":"number"},"userId":{"type":"integer"}},"required":["balance","owed","paid","userId"],"type":"object"},"entity.OutOfRangeEntity":{"properties":{"endDate":{"type":"string"},"id":{"type":"integer"},"kind":{"type":"string","x-enum-varnames":["TRIP_KIND","TRANSPORTATION_KIND","ACTIVITY_KIND","ACCOMMODATION_KIND","ATTACHMENT_KIND","EXPENSE_KIND","BUDGET_KIND"]},"name":{"type":"string"},"startDate":{"type":"string"}},"required":["endDate","id","kind","name","startDate"],"type":"object"},"entity.PNR":{"properties":{"airline":{"example":"LH","type":"string"},"id":{"type":"integer"},"pnr":{"example":"123456","type":"string"}},"required":["airline","id","pnr"],"type":"object"},"entity.PhotoCluster":{"properties":{"activityId":{"description":"ActivityID is the existing activity the photos were linked to.","nullable":true,"type":"integer"},"date":{"type":"string"},"end":{"type":"string"},"location":{"$ref":"#/components/schemas/entity.Location"},"photos":{"items":{"$ref":"#/components/schemas/entity.AttachmentMetadata"},"type":"array","uniqueItems":false},"proposal":{"$ref":"#/components/schemas/entity.Activity"},"start":{"type":"string"}},"required":["activityId","date","end","location","photos","start"],"type":"object"},"entity.PhotoImport":{"properties":{"clusters":{"items":{"$ref":"#/components/schemas/entity.PhotoCluster"},"type":"array","uniqueItems":false},"unplaced":{"description":"Unplaced are photos without a timestamp, which cannot be assigned to a day.","items":{"$ref":"#/components/schemas/entity.AttachmentMetadata"},"type":"array","uniqueItems":false}},"required":["clusters","unplaced"],"type":"object"},"entity.Settlement":{"properties":{"balances":{"items":{"$ref":"#/components/schemas/entity.MemberBalance"},"type":"array","uniqueItems":false},"homeCurrency":{"type":"string"},"transfers":{"items":{"$ref":"#/components/schemas/entity.SettlementTransfer"},"type":"array","uniqueItems":false}},"required":["balances","homeCurrency","transfers"],"type":"object"},"entity.SettlementTransfer":{"properties":{"amount":{"type":"number"},"fromUserId":{"type":"integer"},"toUserId":{"type":"integer"}},"required":["amount","fromUserId","toUserId"],"type":"object"},"entity.SplitMode":{"type":"string","x-enum-varnames":["EQUAL_SPLIT","SHARES_SPLIT","EXACT_SPLIT"]},"entity.StatsCount":{"properties":{"count":{"type":"integer"},"name":{"type":"string"}},"required":["count","name"],"type":"object"},"entity.Tombstone":{"properties":{"id":{"type":"integer"},"kind":{"$ref":"#/components/schemas/entity.EntityKind"},"revision":{"type":"integer"}},"required":["id","kind","revision"],"type":"object"},"entity.Track":{"nullable":true,"properties":{"display":{"$ref":"#/components/schemas/entity.TrackDisplay"},"distanceInMeters":{"type":"number"},"elevationGainInMeters":{"nullable":true,"type":"number"},"endDateTime":{"nullable":true,"type":"string"},"startDateTime":{"nullable":true,"type":"string"}},"required":["display","distanceInMeters","elevationGainInMeters","endDateTime","startDateTime"],"type":"object"},"entity.TrackDisplay":{"type":"string","x-enum-varnames":["PLANNED","ACTUAL","BOTH"]},"entity.TrainDetail":{"nullable":true,"properties":{"legs":{"items":{"$ref":"#/components/schemas/entity.TrainLeg"},"type":"array","uniqueItems":false},"refreshToken":{"type":"string"}},"required":["legs","refreshToken"],"type":"object"},"entity.TrainLeg":{"properties":{"arrivalDateTime":{"type":"string"},"arrivalUtcOffset":{"nullable":true,"type":"string"},"departureDateTime":{"type":"string"},"departureUtcOffset":{"nullable":true,"type":"string"},"destination":{"$ref":"#/components/schemas/entity.TrainStation"},"durationInMinutes":{"type":"integer"},"id":{"type":"integer"},"lineName":{"type":"string"},"operatorName":{"type":"string"},"origin":{"$ref":"#/components/schemas/entity.TrainStation"}},"required":["arrivalDateTime","arrivalUtcOffset","departureDateTime","departureUtcOffset","destination","durationInMinutes","id","lineName","operatorName","origin"],"type":"object"},"entity.TrainStation":{"properties":{"id":{"type":"string"},"location":{"$ref":"#/components/schemas/entity.Location"},"name":{"type":"string"}},"required":["id","location","name"],"type":"object"},"entity.Transportation":{"properties":{"arrivalDateTime":{"type":"string"},"arrivalUtcOffset":{"nullable":true,"type":"string"},"attachments":{"items":{"$ref":"#/components/schemas/entity.AttachmentMetadata"},"type":"array","uniqueItems":false},"budgetAlert":{"$ref":"#/components/schemas/entity.BudgetAlert"},"co2e":{"description":"Co2e is the estimated emission in kg CO2e per passenger, nil if there is no emission factor for the type.","nullable":true,"type":"number"},"currency":{"example":"EUR","type":"string"},"departureDateTime":{"type":"string"},"departureUtcOffset":{"nullable":true,"type":"string"},"destination":{"$ref":"#/components/schemas/entity.Location"},"flightDetail":{"$ref":"#/components/schemas/entity.FlightDetail"},"genericDetail":{"$ref":"#/components/schemas/entity.GenericDetail"},"id":{"type":"integer"},"origin":{"$ref":"#/components/schemas/entity.Location"},"price":{"nullable":true,"type":"integer"},"revision":{"type":"integer"},"track":{"$ref":"#/components/schemas/entity.Track"},"trainDetail":{"$ref":"#/components/schemas/entity.TrainDetail"},"tripId":{"type":"integer"},"type":{"$ref":"#/components/schemas/entity.TransportationType"}},"required":["arrivalDateTime","arrivalUtcOffset","attachments","currency","departureDateTime","departureUtcOffset","destination","id","origin","price","revision","tripId","type"],"type":"object"},"entity.TransportationDistance":{"properties":{"kilometers":{"type":"number"},"type":{"type":"string","x-enum-varnames":["FLIGHT","TRAIN","BUS","CAR","FERRY","BOAT","BIKE","HIKE","OTHER"]}},"required":["kilometers","type"],"type":"object"},"entity.TransportationEmissions":{"properties":{"co2e":{"type":"number"},"type":{"type":"string","x-enum-varnames":["FLIGHT","TRAIN","BUS","CAR","FERRY","BOAT","BIKE","HIKE","OTHER"]}},"required":["co2e","type"],"type":"object"},"entity.TransportationType":{"type":"string","x-enum-varnames":["FLIGHT","TRAIN","BUS","CAR","FERRY","BOAT","BIKE","HIKE","OTHER"]},"entity.TrashItem":{"properties":{"deletedAt":{"type":"string"},"id":{"type":"integer"},"kind":{"type":"string","x-enum-varnames":["TRIP_KIND","TRANSPORTATION_KIND","ACTIVITY_KIND","ACCOMMODATION_KIND","ATTACHMENT_KIND","EXPENSE_KIND","BUDGET_KIND"]},"name":{"type":"string"},"purgeAt":{"nullable":true,"type":"string"},"tripId":{"type":"integer"}},"required":["deletedAt","id","kind","name","purgeAt","tripId"],"type":"object"},"entity.TravelStats":{"properties":{"distances":{"items":{"$ref":"#/components/schemas/entity.TransportationDistance"},"type":"array","uniqueItems":false},"flightHours":{"type":"number"},"flights":{"type":"integer"},"topAircraft":{"items":{"$ref":"#/components/schemas/entity.StatsCount"},"type":"array","uniqueItems":false},"topAirlines":{"items":{"$ref":"#/components/schemas/entity.StatsCount"},"type":"array","uniqueItems":false},"topAirports":{"items":{"$ref":"#/components/schemas/entity.StatsCount"},"type":"array","uniqueItems":false},"topTrainOperators":{"items":{"$ref":"#/components/schemas/entity.StatsCount"},"type":"array","uniqueItems":false},"trainHours":{"type":"number"},"trips":{"type":"integer"},"year":{"nullable":true,"type":"integer"}},"required":["distances","flightHours","flights","topAircraft","topAirlines","topAirports","topTrainOperators","trainHours","trips","year"],"type":"object"},"entity.Trip":{"properties":{"budget":{"nullable":true,"type":"number"},"description":{"nullable":true,"type":"string"},"endDate":{"type":"string"},"homeCurrency":{"example":"EUR","type":"string"},"id":{"type":"integer"},"imageUrl":{"nullable":true,"type":"string"},"name":{"type":"string"},"owner_id":{"type":"integer"},"revision":{"type":"integer"},"startDate":{"type":"string"},"template":{"type":"boolean"}},"required":["budget","description","endDate","homeCurrency","id","imageUrl","name","owner_id","revision","startDate","template"],"type":"object"},"entity.TripEmissions":{"properties":{"total":{"type":"number"},"types":{"items":{"$ref":"#/components/schemas/entity.TransportationEmissions"},"type":"array","uniqueItems":false},"unestimated":{"type":"integer"}},"required":["total","types","unestimated"],"type":"object"},"entity.User":{"properties":{"id":{"type":"integer"},"jwtSub":{"type":"string"},"name":{"type":"string"}},"required":["id","jwtSub","name"],"type":"object"},"request.Accommodation":{"properties":{"address":{"nullable":true,"type":"string"},"arrivalDate":{"type":"string"},"checkInTime":{"nullable":true,"type":"string"},"checkOutTime":{"nullable":true,"type":"string"},"currency":{"example":"EUR","type":"string"},"departureDate":{"type":"string"},"description":{"nullable":true,"type":"string"},"location":{"$ref":"#/components/schemas/entity.Location"},"name":{"type":"string"},"price":{"nullable":true,"type":"integer"},"sensitive":{"type":"boolean"}},"required":["address","arrivalDate","checkInTime","checkOutTime","departureDate","description","location","name","price"],"type":"object"},"request.Activity":{"properties":{"address":{"nullable":true,"type":"string"},"currency":{"example":"EUR","type":"string"},"date":{"type":"string"},"description":{"nullable":true,"type":"string"},"location":{"$ref":"#/components/schemas/entity.Location"},"name":{"type":"string"},"price":{"nullable":true,"type":"integer"},"sensitive":{"type":"boolean"},"time":{"nullable":true,"type":"string"}},"required":["address","date","description","location","name","price","time"],"type":"object"},"request.Budget":{"properties":{"categories":{"items":{"$ref":"#/components/schemas/entity.CategoryBudget"},"type":"array","uniqueItems":false},"total":{"nullable":true,"type":"number"}},"required":["categories","total"],"type":"object"},"request.Change":{"properties":{"accommodation":{"$ref":"#/components/schemas/request.Accommodation"},"activity":{"$ref":"#/components/schemas/request.Activity"},"baseRevision":{"type":"integer"},"clientId":{"maxLength":100,"type":"string"},"expense":{"$ref":"#/components/schemas/request.Expense"},"id":{"type":"integer"},"kind":{"type":"string","x-enum-varnames":["TRIP_KIND","TRANSPORTATION_KIND","ACTIVITY_KIND","ACCOMMODATION_KIND","ATTACHMENT_KIND","EXPENSE_KIND","BUDGET_KIND"]},"transportation":{"$ref":"#/components/schemas/request.Transportation"},"trip":{"$ref":"#/components/schemas/request.Trip"},"type":{"$ref":"#/components/schemas/entity.ChangeType"}},"required":["kind","type"],"type":"object"},"request.ChangeBatch":{"properties":{"changes":{"items":{"$ref":"#/components/schemas/request.Change"},"type":"array","uniqueItems":false}},"required":["changes"],"type":"object"},"request.Expense":{"properties":{"accommodationId":{"nullable":true,"type":"integer"},"activityId":{"nullable":true,"type":"integer"},"amount":{"type":"number"},"attachmentId":{"nullable":true,"type":"integer"},"category":{"type":"string","x-enum-varnames":["TRANSPORTATION_COSTS","ACCOMMODATION_COSTS","ACTIVITY_COSTS","FOOD_COSTS","OTHER_COSTS"]},"currency":{"example":"EUR","type":"string"},"date":{"type":"string"},"name":{"type":"string"},"participants":{"items":{"$ref":"#/components/schemas/request.ExpenseParticipant"},"type":"array","uniqueItems":false},"payerId":{"type":"integer"},"splitMode":{"type":"string","x-enum-varnames":["EQUAL_SPLIT","SHARES_SPLIT","EXACT_SPLIT"]},"transportationId":{"nullable":true,"type":"integer"}},"required":["accommodationId","activityId","amount","attachmentId","date","name","participants","payerId","splitMode","transportationId"],"type":"object"},"request.ExpenseParticipant":{"properties":{"share":{"type":"number"},"userId":{"type":"integer"}},"required":["userId"],"type":"object"},"request.Flight":{"properties":{"currency":{"example":"EUR","type":"string"},"legs":{"items":{"$ref":"#/components/schemas/request.FlightLeg"},"type":"array","uniqueItems":false},"pnrs":{"items":{"$ref":"#/components/schemas/entity.PNR"},"type":"array","uniqueItems":false},"price":{"nullable":true,"type":"integer"}},"required":["legs","pnrs","price"],"type":"object"},"request.FlightLeg":{"properties":{"cabinClass":{"description":"CabinClass is only used to estimate emissions, economy is assumed if it is not set.","nullable":true,"type":"string"},"date":{"example":"2026-01-30","type":"string"},"flightNumber":{"example":"EK412","type":"string"},"originAirport":{"example":"SYD","nullable":true,"type":"string"}},"required":["date","flightNumber","originAirport"],"type":"object"},"request.Track":{"properties":{"display":{"type":"string","x-enum-varnames":["PLANNED","ACTUAL","BOTH"]}},"required":["display"],"type":"object"},"request.TrainJourney":{"properties":{"currency":{"example":"EUR","type":"string"},"departureDate":{"example":"2025-09-20","type":"string"},"fromStationId":{"example":"8011113","type":"string"},"price":{"nullable":true,"type":"integer"},"toStationId":{"example":"8000261","type":"string"},"trainNumbers":{"example":["ICE707"],"items":{"type":"string"},"type":"array","uniqueItems":false},"viaStationId":{"example":"8596008","nullable":true,"type":"string"}},"required":["departureDate","fromStationId","price","toStationId","trainNumbers","viaStationId"],"type":"object"},"request.Transportation":{"properties":{"arrivalDateTime":{"type":"string"},"currency":{"example":"EUR","type":"string"},"departureDateTime":{"type":"string"},"destination":{"$ref":"#/components/schemas/entity.Location"},"destinationAddress":{"nullable":true,"type":"string"},"name":{"type":"string"},"origin":{"$ref":"#/components/schemas/entity.Location"},"originAddress":{"nullable":true,"type":"string"},"price":{"nullable":true,"type":"integer"},"type":{"type":"string","x-enum-varnames":["FLIGHT","TRAIN","BUS","CAR","FERRY","BOAT","BIKE","HIKE","OTHER"]}},"required":["arrivalDateTime","departureDateTime","destination","destinationAddress","name","origin","originAddress","price","type"],"type":"object"},"request.Trip":{"properties":{"dateChange":{"$ref":"#/components/schemas/entity.DateChange"},"description":{"nullable":true,"type":"string"},"endDate":{"type":"string"},"homeCurrency":{"example":"EUR","type":"string"},"imageUrl":{"nullable":true,"type":"string"},"name":{"type":"string"},"startDate":{"type":"string"},"template":{"type":"boolean"}},"required":["description","endDate","imageUrl","name","startDate"],"type":"object"},"request.TripCopy":{"properties":{"attachments":{"type":"boolean"},"name":{"type":"string"},"resolveBookings":{"type":"boolean"},"startDate":{"type":"string"}},"required":["startDate"],"type":"object"},"response.Error":{"properties":{"detail":{"nullable":true,"type":"string"},"error":{"type":"string"}},"required":["error"],"type":"object"},"v1.AttachmentsParam":{"properties":{"attachments":{"items":{"format":"binary","type":"string"},"type":"array","uniqueItems":false}},"required":["attachments"],"type":"object"},"v1.PhotosParam":{"properties":{"photos":{"items":{"format":"binary","type":"string"},"type":"array","uniqueItems":false}},"required":["photos"],"type":"object"},"v1.TrackParam":{"properties":{"display":{"type":"string","x-enum-varnames":["PLANNED","ACTUAL","BOTH"]},"track":{"format":"binary","type":"string"}},"required":["track"],"type":"object"}},"securitySchemes":{"bearerauth":{"bearerFormat":"JWT","scheme":"bearer","type":"http"}}},
     "info": {"description":"{{escape .Description}}","title":"{{.Title}}","version":"{{.Version}}"},
     "externalDocs": {"description":"","url":""},
-    "paths": {"/geocoding/location":{"get":{"operationId":"getLocation","parameters":[{"description":"location query","in":"query","name":"query","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Location"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Lookup location","tags":["geocoding"]}},"/geocoding/station":{"get":{"operationId":"getTrainStation","parameters":[{"description":"station query","in":"query","name":"query","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.TrainStation"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Lookup train station","tags":["geocoding"]}},"/trips":{"get":{"operationId":"getTrips","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.Trip"},"type":"array"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get all trips","tags":["trips"]},"post":{"operationId":"postTrip","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Trip"}}},"description":"trip","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Trip"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add trip","tags":["trips"]}},"/trips/{trip_id}":{"delete":{"operationId":"deleteTrip","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Delete trip","tags":["trips"]},"get":{"operationId":"getTrip","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Trip"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get trip by ID","tags":["trips"]},"put":{"operationId":"putTrip","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Trip"}}},"description":"trip","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Update trip","tags":["trips"]}},"/trips/{trip_id}/accommodation":{"get":{"operationId":"getAllAccommodation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.Accommodation"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get all accommodation","tags":["accommodation"]},"post":{"operationId":"postAccommodation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Accommodation"}}},"description":"accommodation","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Accommodation"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add accommodation","tags":["accommodation"]}},"/trips/{trip_id}/accommodation/{accommodation_id}":{"delete":{"operationId":"deleteAccommodation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Accommodation ID","in":"path","name":"accommodation_id","required":true,"schema":{"type":"integer"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Delete accommodation","tags":["accommodation"]},"get":{"operationId":"getAccommodationByID","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Accommodation ID","in":"path","name":"accommodation_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Accommodation"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get accommodation by ID","tags":["accommodation"]},"put":{"operationId":"putAccommodation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Accommodation ID","in":"path","name":"accommodation_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Accommodation"}}},"description":"accommodation","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Update accommodation","tags":["accommodation"]}},"/trips/{trip_id}/activities":{"get":{"operationId":"getActivities","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.Activity"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get all activities","tags":["activities"]},"post":{"operationId":"postActivity","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Activity"}}},"description":"activity","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Activity"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add activity","tags":["activities"]}},"/trips/{trip_id}/activities/{activity_id}":{"delete":{"operationId":"deleteActivity","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Activity ID","in":"path","name":"activity_id","required":true,"schema":{"type":"integer"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Delete activity","tags":["activities"]},"get":{"operationId":"getActivity","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Activity ID","in":"path","name":"activity_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Activity"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get activity by ID","tags":["activities"]},"put":{"operationId":"putActivity","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Activity ID","in":"path","name":"activity_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Activity"}}},"description":"activity","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Update activity","tags":["activities"]}},"/trips/{trip_id}/attachments":{"get":{"operationId":"getAttachments","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.Attachment"},"type":"array"}}},"description":"OK"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get all attachments","tags":["attachments"]},"post":{"operationId":"postAttachment","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"multipart/form-data":{"schema":{"$ref":"#/components/schemas/v1.AttachmentsParam"}}},"description":"attachment","required":true},"responses":{"204":{"description":"No Content"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add attachment","tags":["attachments"]}},"/trips/{trip_id}/attachments/{attachment_id}":{"delete":{"operationId":"deleteAttachment","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Attachment ID","in":"path","name":"attachment_id","required":true,"schema":{"type":"integer"}}],"responses":{"204":{"description":"No Content"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Delete attachment","tags":["attachments"]},"get":{"operationId":"downloadAttachment","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Attachment ID","in":"path","name":"attachment_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Attachment"}}},"description":"OK"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Download attachment by ID","tags":["attachments"]}},"/trips/{trip_id}/flights":{"post":{"operationId":"postFlight","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Flight"}}},"description":"flight","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Transportation"}}},"description":"OK"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"422":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.ErrAmbiguousFlightRequest"}}},"description":"Unprocessable Entity"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add flight","tags":["flights"]}},"/trips/{trip_id}/flights/{flight_id}":{"put":{"operationId":"putFlight","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Flight ID","in":"path","name":"flight_id","required":true,"schema":{"type":"integer"}}],"responses":{"204":{"description":"No Content"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Update flight","tags":["flights"]}},"/trips/{trip_id}/trains":{"post":{"operationId":"postTrainJourney","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.TrainJourney"}}},"description":"train journey","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Transportation"}}},"description":"OK"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add train journey","tags":["trains"]}},"/trips/{trip_id}/transportation":{"get":{"operationId":"getAllTransportation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.Transportation"},"type":"array"}}},"description":"OK"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get all Transportation","tags":["transportation"]},"post":{"operationId":"postTransportation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Transportation"}}},"description":"transportation","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Transportation"}}},"description":"OK"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add transportation","tags":["transportation"]}},"/trips/{trip_id}/transportation/geojson":{"get":{"operationId":"getGeoJson","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"type":"string"},"type":"array"}}},"description":"OK"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get GeoJson","tags":["transportation"]}},"/trips/{trip_id}/transportation/{transportation_id}":{"delete":{"operationId":"deleteTransportation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Transportation ID","in":"path","name":"transportation_id","required":true,"schema":{"type":"integer"}}],"responses":{"204":{"description":"No Content"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Delete Transportation","tags":["transportation"]},"get":{"operationId":"getTransportation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Transportation ID","in":"path","name":"transportation_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Transportation"}}},"description":"OK"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get Transportation by ID","tags":["transportation"]},"put":{"operationId":"putTransportation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Transportation ID","in":"path","name":"transportation_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Transportation"}}},"description":"transportation","required":true},"responses":{"204":{"description":"No Content"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Update transportation","tags":["transportation"]}},"/users":{"get":{"operationId":"getUsers","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.User"},"type":"array"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get all users","tags":["users"]}},"/users/{user_id}":{"get":{"operationId":"getUser","parameters":[{"description":"User ID","in":"path","name":"user_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.User"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get user by ID","tags":["users"]}}},
+    "paths": {"/geocoding/location":{"get":{"operationId":"getLocation","parameters":[{"description":"location query","in":"query","name":"query","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Location"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Lookup location","tags":["geocoding"]}},"/geocoding/station":{"get":{"operationId":"getTrainStation","parameters":[{"description":"station query","in":"query","name":"query","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.TrainStation"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Lookup train station","tags":["geocoding"]}},"/templates":{"get":{"description":"Templates are trips that any user can copy.","operationId":"getTemplates","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.Trip"},"type":"array"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get trip templates","tags":["templates"]}},"/trash":{"get":{"description":"Items are purged once purgeAt has passed. Entities of deleted trips are restored with the trip.","operationId":"getTrash","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.TrashItem"},"type":"array"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get deleted trips and entities of all trips","tags":["trash"]}},"/trash/trips/{trip_id}/restore":{"post":{"operationId":"restoreTrip","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Restore deleted trip","tags":["trash"]}},"/trips":{"get":{"operationId":"getTrips","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.Trip"},"type":"array"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get all trips","tags":["trips"]},"post":{"operationId":"postTrip","requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Trip"}}},"description":"trip","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Trip"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add trip","tags":["trips"]}},"/trips/{trip_id}":{"delete":{"description":"The trip is moved to the trash with all of its entities and can be restored until it is purged.","operationId":"deleteTrip","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"ETag the request is conditional on","in":"header","name":"If-Match","schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"412":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Precondition Failed"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Delete trip","tags":["trips"]},"get":{"operationId":"getTrip","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"ETag of a cached copy","in":"header","name":"If-None-Match","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Trip"}}},"description":"OK"},"304":{"description":"Not Modified"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get trip by ID","tags":["trips"]},"put":{"description":"If the dates change, entities of the trip are either validated against the new dates or shifted by the\ndays the start date moved, see dateChange. Entities outside of the new dates are returned with 409.","operationId":"putTrip","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"ETag the request is conditional on","in":"header","name":"If-Match","schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Trip"}}},"description":"trip","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"409":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.OutOfRangeEntity"},"type":"array"}}},"description":"Conflict"},"412":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Precondition Failed"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Update trip","tags":["trips"]}},"/trips/{trip_id}/accommodation":{"get":{"operationId":"getAllAccommodation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.Accommodation"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get all accommodation","tags":["accommodation"]},"post":{"operationId":"postAccommodation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Accommodation"}}},"description":"accommodation","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Accommodation"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add accommodation","tags":["accommodation"]}},"/trips/{trip_id}/accommodation/{accommodation_id}":{"delete":{"description":"The accommodation is moved to the trash and can be restored until it is purged.","operationId":"deleteAccommodation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Accommodation ID","in":"path","name":"accommodation_id","required":true,"schema":{"type":"integer"}},{"description":"ETag the request is conditional on","in":"header","name":"If-Match","schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"412":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Precondition Failed"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Delete accommodation","tags":["accommodation"]},"get":{"operationId":"getAccommodationByID","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Accommodation ID","in":"path","name":"accommodation_id","required":true,"schema":{"type":"integer"}},{"description":"ETag of a cached copy","in":"header","name":"If-None-Match","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Accommodation"}}},"description":"OK"},"304":{"description":"Not Modified"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get accommodation by ID","tags":["accommodation"]},"put":{"operationId":"putAccommodation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Accommodation ID","in":"path","name":"accommodation_id","required":true,"schema":{"type":"integer"}},{"description":"ETag the request is conditional on","in":"header","name":"If-Match","schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Accommodation"}}},"description":"accommodation","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"412":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Precondition Failed"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Update accommodation","tags":["accommodation"]}},"/trips/{trip_id}/accommodation/{accommodation_id}/restore/{entry_id}":{"post":{"description":"Restores the version recorded by an entry of the history. Deleted accommodation is created again with a new ID.","operationId":"restoreAccommodation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Accommodation ID","in":"path","name":"accommodation_id","required":true,"schema":{"type":"integer"}},{"description":"History entry ID","in":"path","name":"entry_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Accommodation"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Restore accommodation","tags":["accommodation"]}},"/trips/{trip_id}/activities":{"get":{"operationId":"getActivities","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.Activity"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get all activities","tags":["activities"]},"post":{"operationId":"postActivity","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Activity"}}},"description":"activity","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Activity"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add activity","tags":["activities"]}},"/trips/{trip_id}/activities/{activity_id}":{"delete":{"description":"The activity is moved to the trash and can be restored until it is purged.","operationId":"deleteActivity","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Activity ID","in":"path","name":"activity_id","required":true,"schema":{"type":"integer"}},{"description":"ETag the request is conditional on","in":"header","name":"If-Match","schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"412":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Precondition Failed"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Delete activity","tags":["activities"]},"get":{"operationId":"getActivity","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Activity ID","in":"path","name":"activity_id","required":true,"schema":{"type":"integer"}},{"description":"ETag of a cached copy","in":"header","name":"If-None-Match","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Activity"}}},"description":"OK"},"304":{"description":"Not Modified"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get activity by ID","tags":["activities"]},"put":{"operationId":"putActivity","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Activity ID","in":"path","name":"activity_id","required":true,"schema":{"type":"integer"}},{"description":"ETag the request is conditional on","in":"header","name":"If-Match","schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Activity"}}},"description":"activity","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"412":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Precondition Failed"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Update activity","tags":["activities"]}},"/trips/{trip_id}/activities/{activity_id}/restore/{entry_id}":{"post":{"description":"Restores the version recorded by an entry of the history. Deleted activities are created again with a new ID.","operationId":"restoreActivity","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Activity ID","in":"path","name":"activity_id","required":true,"schema":{"type":"integer"}},{"description":"History entry ID","in":"path","name":"entry_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Activity"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Restore activity","tags":["activities"]}},"/trips/{trip_id}/attachments":{"get":{"operationId":"getAttachments","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.Attachment"},"type":"array"}}},"description":"OK"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get all attachments","tags":["attachments"]},"post":{"operationId":"postAttachment","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"multipart/form-data":{"schema":{"$ref":"#/components/schemas/v1.AttachmentsParam"}}},"description":"attachment","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"411":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Length Required"},"413":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Request Entity Too Large"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add attachment","tags":["attachments"]}},"/trips/{trip_id}/attachments/{attachment_id}":{"delete":{"description":"The attachment is moved to the trash and can be restored until it is purged.","operationId":"deleteAttachment","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Attachment ID","in":"path","name":"attachment_id","required":true,"schema":{"type":"integer"}},{"description":"Revision of the attachment as ETag the request is conditional on","in":"header","name":"If-Match","schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"412":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Precondition Failed"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Delete attachment","tags":["attachments"]},"get":{"description":"Supports single byte ranges. The ETag is the SHA-256 hash of the content.","operationId":"downloadAttachment","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Attachment ID","in":"path","name":"attachment_id","required":true,"schema":{"type":"integer"}},{"description":"Byte range, e.g. bytes=0-1023","in":"header","name":"Range","schema":{"type":"string"}},{"description":"ETag the range request is conditional on","in":"header","name":"If-Range","schema":{"type":"string"}},{"description":"ETag of a cached copy","in":"header","name":"If-None-Match","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"type":"string"}},"application/octet-stream":{"schema":{"format":"binary","type":"string"}}},"description":"OK"},"206":{"content":{"application/json":{"schema":{"type":"string"}},"application/octet-stream":{"schema":{"format":"binary","type":"string"}}},"description":"Partial Content"},"304":{"description":"Not Modified"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"416":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Requested Range Not Satisfiable"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Download attachment by ID","tags":["attachments"]}},"/trips/{trip_id}/attachments/{attachment_id}/links/{target}/{target_id}":{"delete":{"operationId":"unlinkAttachment","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Attachment ID","in":"path","name":"attachment_id","required":true,"schema":{"type":"integer"}},{"description":"Booking type","in":"path","name":"target","required":true,"schema":{"enum":["transportation","activities","accommodation"],"type":"string"}},{"description":"Booking ID","in":"path","name":"target_id","required":true,"schema":{"type":"integer"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Unlink attachment from a booking","tags":["attachments"]},"put":{"operationId":"linkAttachment","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Attachment ID","in":"path","name":"attachment_id","required":true,"schema":{"type":"integer"}},{"description":"Booking type","in":"path","name":"target","required":true,"schema":{"enum":["transportation","activities","accommodation"],"type":"string"}},{"description":"Booking ID","in":"path","name":"target_id","required":true,"schema":{"type":"integer"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Link attachment to a booking","tags":["attachments"]}},"/trips/{trip_id}/attachments/{attachment_id}/thumbnail":{"get":{"description":"Available for JPEG, PNG and WebP images. Thumbnails are JPEG images that fit into a square of 160 (small),\n480 (medium) or 1280 (large) pixels.","operationId":"getAttachmentThumbnail","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Attachment ID","in":"path","name":"attachment_id","required":true,"schema":{"type":"integer"}},{"description":"Thumbnail size","in":"query","name":"size","schema":{"default":"medium","enum":["small","medium","large"],"type":"string"}},{"description":"ETag of a cached copy","in":"header","name":"If-None-Match","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"type":"string"}},"image/jpeg":{"schema":{"format":"binary","type":"string"}}},"description":"OK"},"304":{"description":"Not Modified"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"422":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Unprocessable Entity"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get thumbnail of attachment","tags":["attachments"]}},"/trips/{trip_id}/attachments/{attachment_id}/url":{"get":{"description":"Only available if the storage backend supports pre-signed URLs and they are enabled.","operationId":"getAttachmentURL","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Attachment ID","in":"path","name":"attachment_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.AttachmentURL"}}},"description":"OK"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"},"501":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Implemented"}},"security":[{"bearerauth":[]}],"summary":"Get pre-signed download URL of attachment","tags":["attachments"]}},"/trips/{trip_id}/budget":{"get":{"operationId":"getBudget","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Budget"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get budget","tags":["budget"]},"put":{"operationId":"putBudget","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Budget"}}},"description":"budget","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Update budget","tags":["budget"]}},"/trips/{trip_id}/budget/report":{"get":{"operationId":"getBudgetReport","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.BudgetReport"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Compare costs to budget","tags":["budget"]}},"/trips/{trip_id}/changes":{"get":{"description":"Omitting the cursor returns the whole trip. The returned cursor is passed to the next request.","operationId":"getChanges","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Cursor of the previous request","in":"query","name":"since","schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Changes"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get everything of a trip that changed since a cursor","tags":["changes"]},"post":{"description":"Changes are applied in order. Updates and deletions based on an outdated revision are not applied and\nreported as conflicts. Creations with a clientId are applied once, pushing them again reports the entity\ncreated the first time.","operationId":"postChanges","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.ChangeBatch"}}},"description":"changes","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.ChangeResult"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Apply changes made offline","tags":["changes"]}},"/trips/{trip_id}/checks":{"get":{"description":"Reports overlapping transportation, activities during transit, nights without accommodation,\naccommodation far from the day's last arrival and flight connections or train transfers that are too short.","operationId":"getChecks","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.ItineraryCheck"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Check the itinerary for problems","tags":["itinerary"]}},"/trips/{trip_id}/copy":{"post":{"description":"Copies activities, accommodation, transportation and optionally attachments into a new trip, shifting\nall dates by the days between the start dates. Expenses and members are not copied. Any user can copy\ntemplates.","operationId":"copyTrip","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.TripCopy"}}},"description":"copy","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Trip"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Copy trip","tags":["templates"]}},"/trips/{trip_id}/costs":{"get":{"operationId":"getCosts","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Costs"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get costs converted to the home currency","tags":["costs"]}},"/trips/{trip_id}/emissions":{"get":{"description":"Sums up the estimated kg CO2e per passenger of all transportation, in total and by transportation type.\nTransportation without emission factor is counted as unestimated.","operationId":"getEmissions","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.TripEmissions"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get estimated emissions of trip","tags":["transportation"]}},"/trips/{trip_id}/events":{"get":{"description":"Server-Sent Events stream of the changes to the trip made by all users. Each event is named\n\"\u003ckind\u003e.\u003ctype\u003e\", e.g. \"activity.created\", and its data is an entity.TripEvent as JSON.","operationId":"getTripEvents","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"type":"string"}},"text/event-stream":{"schema":{"type":"string"}}},"description":"OK"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Stream trip changes","tags":["trips"]}},"/trips/{trip_id}/expenses":{"get":{"operationId":"getExpenses","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.Expense"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get all expenses","tags":["expenses"]},"post":{"operationId":"postExpense","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Expense"}}},"description":"expense","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Expense"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add expense","tags":["expenses"]}},"/trips/{trip_id}/expenses/{expense_id}":{"delete":{"description":"The expense is moved to the trash and can be restored until it is purged.","operationId":"deleteExpense","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Expense ID","in":"path","name":"expense_id","required":true,"schema":{"type":"integer"}},{"description":"ETag the request is conditional on","in":"header","name":"If-Match","schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"412":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Precondition Failed"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Delete expense","tags":["expenses"]},"get":{"operationId":"getExpense","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Expense ID","in":"path","name":"expense_id","required":true,"schema":{"type":"integer"}},{"description":"ETag of a cached copy","in":"header","name":"If-None-Match","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Expense"}}},"description":"OK"},"304":{"description":"Not Modified"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get expense by ID","tags":["expenses"]},"put":{"operationId":"putExpense","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Expense ID","in":"path","name":"expense_id","required":true,"schema":{"type":"integer"}},{"description":"ETag the request is conditional on","in":"header","name":"If-Match","schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Expense"}}},"description":"expense","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"412":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Precondition Failed"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Update expense","tags":["expenses"]}},"/trips/{trip_id}/export/gpx":{"get":{"operationId":"getGpx","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/gpx+xml":{"schema":{"type":"string"}},"application/json":{"schema":{"type":"string"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Export trip as GPX","tags":["export"]}},"/trips/{trip_id}/export/kml":{"get":{"operationId":"getKml","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"type":"string"}},"application/vnd.google-earth.kml+xml":{"schema":{"type":"string"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Export trip as KML","tags":["export"]}},"/trips/{trip_id}/flights":{"post":{"operationId":"postFlight","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Flight"}}},"description":"flight","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Transportation"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"422":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.ErrAmbiguousFlightRequest"}}},"description":"Unprocessable Entity"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add flight","tags":["flights"]}},"/trips/{trip_id}/flights/{flight_id}":{"put":{"operationId":"putFlight","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Flight ID","in":"path","name":"flight_id","required":true,"schema":{"type":"integer"}},{"description":"ETag the request is conditional on","in":"header","name":"If-Match","schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"412":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Precondition Failed"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Update flight","tags":["flights"]}},"/trips/{trip_id}/history":{"get":{"description":"Entries are returned newest first. Older entries are paged by passing the ID of the oldest entry received so far as before.","operationId":"getHistory","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Kind of entity","in":"query","name":"kind","schema":{"type":"string"}},{"description":"ID of entity, in combination with kind","in":"query","name":"entityId","schema":{"type":"integer"}},{"description":"ID of the user who made the changes","in":"query","name":"userId","schema":{"type":"integer"}},{"description":"Only entries older than this entry","in":"query","name":"before","schema":{"type":"integer"}},{"description":"Maximum number of entries","in":"query","name":"limit","schema":{"default":50,"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.HistoryEntry"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get history of changes","tags":["history"]}},"/trips/{trip_id}/itinerary":{"get":{"operationId":"getItinerary","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.ItineraryDay"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get day-by-day itinerary","tags":["itinerary"]}},"/trips/{trip_id}/itinerary.pdf":{"get":{"operationId":"getItineraryPdf","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"type":"string"}},"application/pdf":{"schema":{"format":"binary","type":"string"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Export printable itinerary as PDF","tags":["export"]}},"/trips/{trip_id}/photos":{"post":{"description":"Stores the photos as attachments and groups them by the time and place in their Exif data. Groups are\nlinked to a nearby activity on the same day, otherwise an activity is proposed but not created.","operationId":"importPhotos","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"multipart/form-data":{"schema":{"$ref":"#/components/schemas/v1.PhotosParam"}}},"description":"JPEG or HEIC photos","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.PhotoImport"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"411":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Length Required"},"413":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Request Entity Too Large"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Import photos","tags":["attachments"]}},"/trips/{trip_id}/settlement":{"get":{"operationId":"getSettlement","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Settlement"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"409":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Conflict"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get transfers that settle all expenses","tags":["expenses"]}},"/trips/{trip_id}/trains":{"post":{"operationId":"postTrainJourney","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.TrainJourney"}}},"description":"train journey","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Transportation"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add train journey","tags":["trains"]}},"/trips/{trip_id}/transportation":{"get":{"operationId":"getAllTransportation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.Transportation"},"type":"array"}}},"description":"OK"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get all Transportation","tags":["transportation"]},"post":{"operationId":"postTransportation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Transportation"}}},"description":"transportation","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Transportation"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Add transportation","tags":["transportation"]}},"/trips/{trip_id}/transportation/geojson":{"get":{"operationId":"getGeoJson","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"type":"string"},"type":"array"}}},"description":"OK"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get GeoJson","tags":["transportation"]}},"/trips/{trip_id}/transportation/{transportation_id}":{"delete":{"description":"The transportation is moved to the trash and can be restored until it is purged.","operationId":"deleteTransportation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Transportation ID","in":"path","name":"transportation_id","required":true,"schema":{"type":"integer"}},{"description":"ETag the request is conditional on","in":"header","name":"If-Match","schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"412":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Precondition Failed"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Delete Transportation","tags":["transportation"]},"get":{"operationId":"getTransportation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Transportation ID","in":"path","name":"transportation_id","required":true,"schema":{"type":"integer"}},{"description":"ETag of a cached copy","in":"header","name":"If-None-Match","schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Transportation"}}},"description":"OK"},"304":{"description":"Not Modified"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get Transportation by ID","tags":["transportation"]},"put":{"operationId":"putTransportation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Transportation ID","in":"path","name":"transportation_id","required":true,"schema":{"type":"integer"}},{"description":"ETag the request is conditional on","in":"header","name":"If-Match","schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Transportation"}}},"description":"transportation","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"412":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Precondition Failed"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Update transportation","tags":["transportation"]}},"/trips/{trip_id}/transportation/{transportation_id}/restore/{entry_id}":{"post":{"description":"Restores the version recorded by an entry of the history. Deleted transportation is created again with a new ID.\nFlights and train journeys are restored as generic transportation named after their legs, replacing them\nwith a new ID if they still exist.","operationId":"restoreTransportation","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Transportation ID","in":"path","name":"transportation_id","required":true,"schema":{"type":"integer"}},{"description":"History entry ID","in":"path","name":"entry_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Transportation"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Restore transportation","tags":["transportation"]}},"/trips/{trip_id}/transportation/{transportation_id}/track":{"delete":{"operationId":"deleteTrack","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Transportation ID","in":"path","name":"transportation_id","required":true,"schema":{"type":"integer"}},{"description":"ETag of the transportation the request is conditional on","in":"header","name":"If-Match","schema":{"type":"string"}}],"responses":{"204":{"description":"No Content"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"412":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Precondition Failed"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Delete recorded track","tags":["transportation"]},"post":{"operationId":"postTrack","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Transportation ID","in":"path","name":"transportation_id","required":true,"schema":{"type":"integer"}}],"requestBody":{"content":{"multipart/form-data":{"schema":{"$ref":"#/components/schemas/v1.TrackParam"}}},"description":"track","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.Transportation"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Import recorded track","tags":["transportation"]},"put":{"operationId":"putTrack","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Transportation ID","in":"path","name":"transportation_id","required":true,"schema":{"type":"integer"}},{"description":"ETag of the transportation the request is conditional on","in":"header","name":"If-Match","schema":{"type":"string"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/request.Track"}}},"description":"track","required":true},"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"412":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Precondition Failed"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Update track display","tags":["transportation"]}},"/trips/{trip_id}/trash":{"get":{"operationId":"getTripTrash","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.TrashItem"},"type":"array"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get deleted entities of trip","tags":["trash"]}},"/trips/{trip_id}/trash/{kind}/{entity_id}/restore":{"post":{"description":"Entities outside of the trip dates can not be restored, the trip dates may have changed since.","operationId":"restoreFromTrash","parameters":[{"description":"Trip ID","in":"path","name":"trip_id","required":true,"schema":{"type":"integer"}},{"description":"Kind of entity","in":"path","name":"kind","required":true,"schema":{"enum":["transportation","activity","accommodation","expense","attachment"],"type":"string"}},{"description":"Entity ID","in":"path","name":"entity_id","required":true,"schema":{"type":"integer"}}],"responses":{"204":{"description":"No Content"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"403":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Forbidden"},"404":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Not Found"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Restore deleted entity of trip","tags":["trash"]}},"/users":{"get":{"operationId":"getUsers","responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/entity.User"},"type":"array"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get all users","tags":["users"]}},"/users/me/stats":{"get":{"description":"Aggregates distances, hours travelled, flights and the most frequent airports, airlines, aircraft and\ntrain operators over all trips the user owns or is a member of.","operationId":"getStats","parameters":[{"description":"Only count trips and legs of this year","in":"query","name":"year","schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.TravelStats"}}},"description":"OK"},"400":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Bad Request"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get travel statistics","tags":["users"]}},"/users/{user_id}":{"get":{"operationId":"getUser","parameters":[{"description":"User ID","in":"path","name":"user_id","required":true,"schema":{"type":"integer"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/entity.User"}}},"description":"OK"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/response.Error"}}},"description":"Internal Server Error"}},"security":[{"bearerauth":[]}],"summary":"Get user by ID","tags":["users"]}}},
     "openapi": "3.1.0",
     "servers": [
         {"url":"http://127.0.0.1:8080/api/v1"}
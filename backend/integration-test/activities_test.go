@@ -1,6 +1,7 @@
 package integration_test
 
 import (
+	"fmt"
 	"kompass/integration-test/client/api"
 )
 
@@ -62,6 +63,67 @@ func (suite *IntegrationTestSuite) TestCreateActivityOutsideOfTripDates() {
 	suite.Empty(*activities)
 }
 
+func (suite *IntegrationTestSuite) TestSensitiveActivityDescription() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+
+	// when
+	response, err := suite.api.PostActivity(suite.T().Context(), &api.RequestActivity{
+		Name:        "Embassy Appointment",
+		Date:        "2025-02-01",
+		Description: api.NewNilString("Passport number C01X00T47"),
+		Sensitive:   api.NewOptBool(true),
+		Time:        api.NilString{Null: true},
+		Address:     api.NilString{Null: true},
+		Location:    api.NilEntityLocation{Null: true},
+		Price:       api.NilInt{Null: true},
+	}, api.PostActivityParams{TripID: tripID})
+	suite.NoError(err)
+
+	// then
+	created, ok := response.(*api.EntityActivity)
+	suite.Require().True(ok)
+	activity := suite.getActivity(tripID, created.ID)
+	suite.True(activity.Sensitive)
+	suite.Equal("Passport number C01X00T47", activity.Description.Value)
+}
+
+func (suite *IntegrationTestSuite) TestConditionalActivityRequests() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	activity := suite.postAndRetrieveActivity(tripID)
+	etag := fmt.Sprintf(`"%d"`, activity.Revision)
+
+	// when (not modified)
+	getRes, err := suite.api.GetActivity(suite.T().Context(), api.GetActivityParams{TripID: tripID, ActivityID: activity.ID, IfNoneMatch: api.NewOptString(etag)})
+
+	// then (not modified)
+	suite.NoError(err)
+	suite.IsType(&api.GetActivityNotModified{}, getRes)
+
+	// when (modified in the meantime)
+	suite.putAndRetrieveActivity(tripID, activity.ID)
+	putRes, err := suite.api.PutActivity(suite.T().Context(), &api.RequestActivity{
+		Name:        "Outdated Activity",
+		Date:        "2025-02-01",
+		Description: api.NilString{Null: true},
+		Time:        api.NilString{Null: true},
+		Address:     api.NilString{Null: true},
+		Location:    api.NilEntityLocation{Null: true},
+		Price:       api.NilInt{Null: true},
+	}, api.PutActivityParams{TripID: tripID, ActivityID: activity.ID, IfMatch: api.NewOptString(etag)})
+	suite.NoError(err)
+	deleteRes, err := suite.api.DeleteActivity(suite.T().Context(), api.DeleteActivityParams{TripID: tripID, ActivityID: activity.ID, IfMatch: api.NewOptString(etag)})
+	suite.NoError(err)
+
+	// then (modified in the meantime)
+	suite.IsType(&api.PutActivityPreconditionFailed{}, putRes)
+	suite.IsType(&api.DeleteActivityPreconditionFailed{}, deleteRes)
+	suite.Equal("Updated Activity", suite.getActivity(tripID, activity.ID).Name)
+}
+
 func (suite *IntegrationTestSuite) postAndRetrieveActivity(tripID int) api.EntityActivity {
 	_, err := suite.api.PostActivity(suite.T().Context(), &api.RequestActivity{
 		Name:        "My Activity",
@@ -0,0 +1,227 @@
+package integration_test
+
+import (
+	"bytes"
+	"image"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"kompass/integration-test/client/api"
+	"strings"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+func (suite *IntegrationTestSuite) TestLinkAttachmentToActivity() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	activity := suite.postAndRetrieveActivity(tripID)
+	suite.Empty(activity.Attachments)
+	attachmentID := suite.postAndRetrieveAttachment(tripID, "voucher.pdf", "some voucher")
+
+	// when (link)
+	linkRes, err := suite.api.LinkAttachment(suite.T().Context(), api.LinkAttachmentParams{
+		TripID:       tripID,
+		AttachmentID: attachmentID,
+		Target:       api.LinkAttachmentTargetActivities,
+		TargetID:     activity.ID,
+	})
+
+	// then (link)
+	suite.NoError(err)
+	suite.IsType(&api.LinkAttachmentNoContent{}, linkRes)
+
+	linked := suite.getActivity(tripID, activity.ID)
+	suite.Len(linked.Attachments, 1)
+	suite.Equal(attachmentID, linked.Attachments[0].ID)
+	suite.Equal("voucher.pdf", linked.Attachments[0].Name)
+	suite.Equal(len("some voucher"), linked.Attachments[0].Size)
+	suite.Equal("application/pdf", linked.Attachments[0].ContentType)
+
+	// when (unlink)
+	unlinkRes, err := suite.api.UnlinkAttachment(suite.T().Context(), api.UnlinkAttachmentParams{
+		TripID:       tripID,
+		AttachmentID: attachmentID,
+		Target:       api.UnlinkAttachmentTargetActivities,
+		TargetID:     activity.ID,
+	})
+
+	// then (unlink)
+	suite.NoError(err)
+	suite.IsType(&api.UnlinkAttachmentNoContent{}, unlinkRes)
+	suite.Empty(suite.getActivity(tripID, activity.ID).Attachments)
+}
+
+func (suite *IntegrationTestSuite) TestLinkAttachmentNotFound() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	attachmentID := suite.postAndRetrieveAttachment(tripID, "boarding-pass.png", "some image")
+
+	// when
+	linkRes, err := suite.api.LinkAttachment(suite.T().Context(), api.LinkAttachmentParams{
+		TripID:       tripID,
+		AttachmentID: attachmentID,
+		Target:       api.LinkAttachmentTargetTransportation,
+		TargetID:     999999,
+	})
+
+	// then
+	suite.NoError(err)
+	suite.IsType(&api.LinkAttachmentNotFound{}, linkRes)
+}
+
+func (suite *IntegrationTestSuite) postAndRetrieveAttachment(tripID int, name string, content string) int {
+	_, err := suite.api.PostAttachment(suite.T().Context(), &api.V1AttachmentsParamMultipart{
+		Attachments: []ht.MultipartFile{{Name: name, File: strings.NewReader(content), Size: int64(len(content))}},
+	}, api.PostAttachmentParams{TripID: tripID})
+	suite.NoError(err)
+
+	getAll, err := suite.api.GetAttachments(suite.T().Context(), api.GetAttachmentsParams{TripID: tripID})
+	suite.NoError(err)
+
+	attachments := getAll.(*api.GetAttachmentsOKApplicationJSON)
+	suite.NotEmpty(*attachments)
+
+	return (*attachments)[len(*attachments)-1].ID
+}
+
+func (suite *IntegrationTestSuite) getActivity(tripID int, activityID int) api.EntityActivity {
+	getRes, err := suite.api.GetActivity(suite.T().Context(), api.GetActivityParams{TripID: tripID, ActivityID: activityID})
+	suite.NoError(err)
+	activity, ok := getRes.(*api.EntityActivity)
+	suite.True(ok)
+
+	return *activity
+}
+
+func (suite *IntegrationTestSuite) TestAttachmentMetadata() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+
+	// when
+	suite.postAndRetrieveAttachment(tripID, "notes.json", `{"a":1}`)
+	getAll, err := suite.api.GetAttachments(suite.T().Context(), api.GetAttachmentsParams{TripID: tripID})
+
+	// then
+	suite.NoError(err)
+	attachments := *getAll.(*api.GetAttachmentsOKApplicationJSON)
+	suite.Len(attachments, 1)
+	suite.Equal("notes.json", attachments[0].Name)
+	suite.Equal(7, attachments[0].Size)
+	suite.Equal("application/json", attachments[0].ContentType)
+	suite.Equal("015abd7f5cc57a2dd94b7590f04ad8084273905ee33ec5cebeae62276a97f862", attachments[0].SHA256)
+	suite.Equal(suite.getOwnerID(tripID), attachments[0].UploadedBy.Value)
+	suite.NotEmpty(attachments[0].CreatedAt)
+}
+
+func (suite *IntegrationTestSuite) TestDownloadAttachmentRange() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	attachmentID := suite.postAndRetrieveAttachment(tripID, "voucher.txt", "0123456789")
+
+	// when
+	res, err := suite.api.DownloadAttachment(suite.T().Context(), api.DownloadAttachmentParams{
+		TripID:       tripID,
+		AttachmentID: attachmentID,
+		Range:        api.NewOptString("bytes=2-5"),
+	})
+
+	// then
+	suite.NoError(err)
+	partial, ok := res.(*api.DownloadAttachmentPartialContentApplicationOctetStream)
+	suite.Require().True(ok)
+	content, err := io.ReadAll(partial.Data)
+	suite.NoError(err)
+	suite.Equal("2345", string(content))
+
+	// when (unsatisfiable)
+	res, err = suite.api.DownloadAttachment(suite.T().Context(), api.DownloadAttachmentParams{
+		TripID:       tripID,
+		AttachmentID: attachmentID,
+		Range:        api.NewOptString("bytes=20-30"),
+	})
+
+	// then (unsatisfiable)
+	suite.NoError(err)
+	suite.IsType(&api.DownloadAttachmentRequestedRangeNotSatisfiable{}, res)
+}
+
+func (suite *IntegrationTestSuite) TestDeleteDeduplicatedAttachment() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	firstID := suite.postAndRetrieveAttachment(tripID, "ticket.txt", "same content")
+	secondID := suite.postAndRetrieveAttachment(tripID, "ticket-copy.txt", "same content")
+
+	// when
+	deleteRes, err := suite.api.DeleteAttachment(suite.T().Context(), api.DeleteAttachmentParams{TripID: tripID, AttachmentID: firstID})
+	suite.NoError(err)
+	suite.IsType(&api.DeleteAttachmentNoContent{}, deleteRes)
+
+	// then
+	res, err := suite.api.DownloadAttachment(suite.T().Context(), api.DownloadAttachmentParams{TripID: tripID, AttachmentID: secondID})
+	suite.NoError(err)
+	download, ok := res.(*api.DownloadAttachmentOKApplicationOctetStream)
+	suite.Require().True(ok)
+	content, err := io.ReadAll(download.Data)
+	suite.NoError(err)
+	suite.Equal("same content", string(content))
+}
+
+func (suite *IntegrationTestSuite) TestAttachmentURLNotSupported() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	attachmentID := suite.postAndRetrieveAttachment(tripID, "voucher.pdf", "some voucher")
+
+	// when
+	res, err := suite.api.GetAttachmentURL(suite.T().Context(), api.GetAttachmentURLParams{TripID: tripID, AttachmentID: attachmentID})
+
+	// then
+	suite.NoError(err)
+	suite.IsType(&api.GetAttachmentURLNotImplemented{}, res)
+}
+
+func (suite *IntegrationTestSuite) TestAttachmentThumbnail() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	var photo bytes.Buffer
+	suite.Require().NoError(png.Encode(&photo, image.NewRGBA(image.Rect(0, 0, 800, 400))))
+	attachmentID := suite.postAndRetrieveAttachment(tripID, "photo.png", photo.String())
+
+	// when
+	res, err := suite.api.GetAttachmentThumbnail(suite.T().Context(), api.GetAttachmentThumbnailParams{
+		TripID:       tripID,
+		AttachmentID: attachmentID,
+		Size:         api.NewOptGetAttachmentThumbnailSize(api.GetAttachmentThumbnailSizeSmall),
+	})
+
+	// then
+	suite.NoError(err)
+	thumbnail, ok := res.(*api.GetAttachmentThumbnailOKImageJpeg)
+	suite.Require().True(ok)
+	config, format, err := image.DecodeConfig(thumbnail.Data)
+	suite.NoError(err)
+	suite.Equal("jpeg", format)
+	suite.Equal(160, config.Width)
+	suite.Equal(80, config.Height)
+}
+
+func (suite *IntegrationTestSuite) TestAttachmentThumbnailNotAvailable() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	attachmentID := suite.postAndRetrieveAttachment(tripID, "notes.json", `{"a":1}`)
+
+	// when
+	res, err := suite.api.GetAttachmentThumbnail(suite.T().Context(), api.GetAttachmentThumbnailParams{TripID: tripID, AttachmentID: attachmentID})
+
+	// then
+	suite.NoError(err)
+	suite.IsType(&api.GetAttachmentThumbnailNotFound{}, res)
+}
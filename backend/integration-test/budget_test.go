@@ -0,0 +1,54 @@
+package integration_test
+
+import (
+	"kompass/integration-test/client/api"
+)
+
+func (suite *IntegrationTestSuite) TestBudget() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+
+	putRes, err := suite.api.PutBudget(suite.T().Context(), &api.RequestBudget{
+		Total:      api.NewNilFloat64(732),
+		Categories: []api.EntityCategoryBudget{{Category: "ACTIVITY", Amount: 50}},
+	}, api.PutBudgetParams{TripID: tripID})
+	suite.NoError(err)
+	suite.IsType(&api.PutBudgetNoContent{}, putRes)
+
+	// when (exceeding activity)
+	res, err := suite.api.PostActivity(suite.T().Context(), &api.RequestActivity{
+		Name:        "Whale Watching",
+		Date:        "2025-02-01",
+		Description: api.NilString{Null: true},
+		Time:        api.NilString{Null: true},
+		Address:     api.NilString{Null: true},
+		Location:    api.NilEntityLocation{Null: true},
+		Price:       api.NewNilInt(100),
+	}, api.PostActivityParams{TripID: tripID})
+
+	// then (exceeding activity)
+	suite.NoError(err)
+	activity := res.(*api.EntityActivity)
+	alert, ok := activity.BudgetAlert.Get()
+	suite.True(ok)
+	suite.Equal(api.EntityCostCategory("ACTIVITY"), alert.Category)
+	suite.Equal(50.0, alert.Planned)
+	suite.Equal(100.0, alert.Spent)
+
+	// when (report)
+	reportRes, err := suite.api.GetBudgetReport(suite.T().Context(), api.GetBudgetReportParams{TripID: tripID})
+
+	// then (report)
+	suite.NoError(err)
+	report := reportRes.(*api.EntityBudgetReport)
+	suite.Equal(732.0, report.Planned.Value)
+	suite.Equal(100.0, report.Spent)
+	suite.Equal(632.0, report.Remaining.Value)
+	suite.Equal(0.27, report.DailyBurnRate)
+	suite.Len(report.Categories, 5)
+	suite.Equal(api.EntityCostCategory("ACTIVITY"), report.Categories[2].Category)
+	suite.Equal(-50.0, report.Categories[2].Remaining.Value)
+	suite.True(report.Categories[2].Exceeded)
+	suite.True(report.Categories[0].Planned.Null)
+}
@@ -0,0 +1,136 @@
+package integration_test
+
+import (
+	"kompass/integration-test/client/api"
+)
+
+func (suite *IntegrationTestSuite) TestGetChangesSinceCursor() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	cursor := suite.getChanges(tripID, 0).Cursor
+
+	// when (create)
+	activity := suite.postAndRetrieveActivity(tripID)
+	changes := suite.getChanges(tripID, cursor)
+
+	// then (create)
+	suite.Greater(changes.Cursor, cursor)
+	suite.False(changes.Trip.IsSet())
+	suite.Len(changes.Activities, 1)
+	suite.Equal(activity.ID, changes.Activities[0].ID)
+	suite.Empty(changes.Transportation)
+	suite.Empty(changes.Deleted)
+
+	// when (delete)
+	_, err := suite.api.DeleteActivity(suite.T().Context(), api.DeleteActivityParams{TripID: tripID, ActivityID: activity.ID})
+	suite.NoError(err)
+	changes = suite.getChanges(tripID, changes.Cursor)
+
+	// then (delete)
+	suite.Empty(changes.Activities)
+	suite.Len(changes.Deleted, 1)
+	suite.Equal(api.EntityEntityKind("activity"), changes.Deleted[0].Kind)
+	suite.Equal(activity.ID, changes.Deleted[0].ID)
+	suite.Equal(changes.Cursor, changes.Deleted[0].Revision)
+}
+
+func (suite *IntegrationTestSuite) TestPushChangesWithConflict() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	activity := suite.postAndRetrieveActivity(tripID)
+	outdated := activity.Revision
+	updated := suite.putAndRetrieveActivity(tripID, activity.ID)
+
+	// when
+	res, err := suite.api.PostChanges(suite.T().Context(), &api.RequestChangeBatch{
+		Changes: []api.RequestChange{
+			{
+				Type:         "updated",
+				Kind:         "activity",
+				ID:           api.NewOptInt(activity.ID),
+				BaseRevision: api.NewOptInt(outdated),
+				Activity:     api.NewOptRequestActivity(offlineActivity("Offline Activity")),
+			},
+			{
+				Type:     "created",
+				Kind:     "activity",
+				Activity: api.NewOptRequestActivity(offlineActivity("New Offline Activity")),
+			},
+			{
+				Type:         "deleted",
+				Kind:         "attachment",
+				ID:           api.NewOptInt(1),
+				BaseRevision: api.NewOptInt(1),
+			},
+		},
+	}, api.PostChangesParams{TripID: tripID})
+	suite.NoError(err)
+
+	// then
+	results := *res.(*api.PostChangesOKApplicationJSON)
+	suite.Len(results, 3)
+	suite.Equal(api.EntityChangeStatus("conflict"), results[0].Status)
+	suite.Equal(updated.Revision, results[0].Revision.Value)
+	suite.Equal(api.EntityChangeStatus("applied"), results[1].Status)
+	suite.Greater(results[1].Revision.Value, updated.Revision)
+	suite.Equal(api.EntityChangeStatus("rejected"), results[2].Status)
+	suite.True(results[2].Error.IsSet())
+
+	getByID, err := suite.api.GetActivity(suite.T().Context(), api.GetActivityParams{TripID: tripID, ActivityID: activity.ID})
+	suite.NoError(err)
+	suite.Equal("Updated Activity", getByID.(*api.EntityActivity).Name)
+}
+
+func (suite *IntegrationTestSuite) TestPushCreationTwice() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	batch := &api.RequestChangeBatch{
+		Changes: []api.RequestChange{
+			{
+				Type:     "created",
+				Kind:     "activity",
+				ClientId: api.NewOptString("0b5a5f5e-8c1a-4f0e-9d61-3f3c0f6f1b2a"),
+				Activity: api.NewOptRequestActivity(offlineActivity("New Offline Activity")),
+			},
+		},
+	}
+
+	// when
+	first, err := suite.api.PostChanges(suite.T().Context(), batch, api.PostChangesParams{TripID: tripID})
+	suite.NoError(err)
+	replayed, err := suite.api.PostChanges(suite.T().Context(), batch, api.PostChangesParams{TripID: tripID})
+	suite.NoError(err)
+
+	// then
+	firstResults := *first.(*api.PostChangesOKApplicationJSON)
+	replayedResults := *replayed.(*api.PostChangesOKApplicationJSON)
+	suite.Equal(api.EntityChangeStatus("applied"), firstResults[0].Status)
+	suite.Equal(api.EntityChangeStatus("applied"), replayedResults[0].Status)
+	suite.Equal(firstResults[0].ID, replayedResults[0].ID)
+
+	activities, err := suite.api.GetActivities(suite.T().Context(), api.GetActivitiesParams{TripID: tripID})
+	suite.NoError(err)
+	suite.Len(*activities.(*api.GetActivitiesOKApplicationJSON), 1)
+}
+
+func (suite *IntegrationTestSuite) getChanges(tripID int, since int) *api.EntityChanges {
+	res, err := suite.api.GetChanges(suite.T().Context(), api.GetChangesParams{TripID: tripID, Since: api.NewOptInt(since)})
+	suite.NoError(err)
+
+	return res.(*api.EntityChanges)
+}
+
+func offlineActivity(name string) api.RequestActivity {
+	return api.RequestActivity{
+		Name:        name,
+		Date:        "2025-02-01",
+		Description: api.NilString{Null: true},
+		Time:        api.NilString{Null: true},
+		Address:     api.NilString{Null: true},
+		Location:    api.NilEntityLocation{Null: true},
+		Price:       api.NilInt{Null: true},
+	}
+}
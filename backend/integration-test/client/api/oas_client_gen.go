@@ -22,39 +22,61 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// CopyTrip invokes copyTrip operation.
+	//
+	// Copies activities, accommodation, transportation and optionally attachments into a new trip,
+	// shifting
+	// all dates by the days between the start dates. Expenses and members are not copied. Any user can
+	// copy
+	// templates.
+	//
+	// POST /trips/{trip_id}/copy
+	CopyTrip(ctx context.Context, request *RequestTripCopy, params CopyTripParams) (CopyTripRes, error)
 	// DeleteAccommodation invokes deleteAccommodation operation.
 	//
-	// Delete accommodation.
+	// The accommodation is moved to the trash and can be restored until it is purged.
 	//
 	// DELETE /trips/{trip_id}/accommodation/{accommodation_id}
 	DeleteAccommodation(ctx context.Context, params DeleteAccommodationParams) (DeleteAccommodationRes, error)
 	// DeleteActivity invokes deleteActivity operation.
 	//
-	// Delete activity.
+	// The activity is moved to the trash and can be restored until it is purged.
 	//
 	// DELETE /trips/{trip_id}/activities/{activity_id}
 	DeleteActivity(ctx context.Context, params DeleteActivityParams) (DeleteActivityRes, error)
 	// DeleteAttachment invokes deleteAttachment operation.
 	//
-	// Delete attachment.
+	// The attachment is moved to the trash and can be restored until it is purged.
 	//
 	// DELETE /trips/{trip_id}/attachments/{attachment_id}
 	DeleteAttachment(ctx context.Context, params DeleteAttachmentParams) (DeleteAttachmentRes, error)
+	// DeleteExpense invokes deleteExpense operation.
+	//
+	// The expense is moved to the trash and can be restored until it is purged.
+	//
+	// DELETE /trips/{trip_id}/expenses/{expense_id}
+	DeleteExpense(ctx context.Context, params DeleteExpenseParams) (DeleteExpenseRes, error)
+	// DeleteTrack invokes deleteTrack operation.
+	//
+	// Delete recorded track.
+	//
+	// DELETE /trips/{trip_id}/transportation/{transportation_id}/track
+	DeleteTrack(ctx context.Context, params DeleteTrackParams) (DeleteTrackRes, error)
 	// DeleteTransportation invokes deleteTransportation operation.
 	//
-	// Delete Transportation.
+	// The transportation is moved to the trash and can be restored until it is purged.
 	//
 	// DELETE /trips/{trip_id}/transportation/{transportation_id}
 	DeleteTransportation(ctx context.Context, params DeleteTransportationParams) (DeleteTransportationRes, error)
 	// DeleteTrip invokes deleteTrip operation.
 	//
-	// Delete trip.
+	// The trip is moved to the trash with all of its entities and can be restored until it is purged.
 	//
 	// DELETE /trips/{trip_id}
 	DeleteTrip(ctx context.Context, params DeleteTripParams) (DeleteTripRes, error)
 	// DownloadAttachment invokes downloadAttachment operation.
 	//
-	// Download attachment by ID.
+	// Supports single byte ranges. The ETag is the SHA-256 hash of the content.
 	//
 	// GET /trips/{trip_id}/attachments/{attachment_id}
 	DownloadAttachment(ctx context.Context, params DownloadAttachmentParams) (DownloadAttachmentRes, error)
@@ -88,24 +110,141 @@ type Invoker interface {
 	//
 	// GET /trips/{trip_id}/transportation
 	GetAllTransportation(ctx context.Context, params GetAllTransportationParams) (GetAllTransportationRes, error)
+	// GetAttachmentThumbnail invokes getAttachmentThumbnail operation.
+	//
+	// Available for JPEG, PNG and WebP images. Thumbnails are JPEG images that fit into a square of 160
+	// (small),
+	// 480 (medium) or 1280 (large) pixels.
+	//
+	// GET /trips/{trip_id}/attachments/{attachment_id}/thumbnail
+	GetAttachmentThumbnail(ctx context.Context, params GetAttachmentThumbnailParams) (GetAttachmentThumbnailRes, error)
+	// GetAttachmentURL invokes getAttachmentURL operation.
+	//
+	// Only available if the storage backend supports pre-signed URLs and they are enabled.
+	//
+	// GET /trips/{trip_id}/attachments/{attachment_id}/url
+	GetAttachmentURL(ctx context.Context, params GetAttachmentURLParams) (GetAttachmentURLRes, error)
 	// GetAttachments invokes getAttachments operation.
 	//
 	// Get all attachments.
 	//
 	// GET /trips/{trip_id}/attachments
 	GetAttachments(ctx context.Context, params GetAttachmentsParams) (GetAttachmentsRes, error)
+	// GetBudget invokes getBudget operation.
+	//
+	// Get budget.
+	//
+	// GET /trips/{trip_id}/budget
+	GetBudget(ctx context.Context, params GetBudgetParams) (GetBudgetRes, error)
+	// GetBudgetReport invokes getBudgetReport operation.
+	//
+	// Compare costs to budget.
+	//
+	// GET /trips/{trip_id}/budget/report
+	GetBudgetReport(ctx context.Context, params GetBudgetReportParams) (GetBudgetReportRes, error)
+	// GetChanges invokes getChanges operation.
+	//
+	// Omitting the cursor returns the whole trip. The returned cursor is passed to the next request.
+	//
+	// GET /trips/{trip_id}/changes
+	GetChanges(ctx context.Context, params GetChangesParams) (GetChangesRes, error)
+	// GetChecks invokes getChecks operation.
+	//
+	// Reports overlapping transportation, activities during transit, nights without accommodation,
+	// accommodation far from the day's last arrival and flight connections or train transfers that are
+	// too short.
+	//
+	// GET /trips/{trip_id}/checks
+	GetChecks(ctx context.Context, params GetChecksParams) (GetChecksRes, error)
+	// GetCosts invokes getCosts operation.
+	//
+	// Get costs converted to the home currency.
+	//
+	// GET /trips/{trip_id}/costs
+	GetCosts(ctx context.Context, params GetCostsParams) (GetCostsRes, error)
+	// GetEmissions invokes getEmissions operation.
+	//
+	// Sums up the estimated kg CO2e per passenger of all transportation, in total and by transportation
+	// type.
+	// Transportation without emission factor is counted as unestimated.
+	//
+	// GET /trips/{trip_id}/emissions
+	GetEmissions(ctx context.Context, params GetEmissionsParams) (GetEmissionsRes, error)
+	// GetExpense invokes getExpense operation.
+	//
+	// Get expense by ID.
+	//
+	// GET /trips/{trip_id}/expenses/{expense_id}
+	GetExpense(ctx context.Context, params GetExpenseParams) (GetExpenseRes, error)
+	// GetExpenses invokes getExpenses operation.
+	//
+	// Get all expenses.
+	//
+	// GET /trips/{trip_id}/expenses
+	GetExpenses(ctx context.Context, params GetExpensesParams) (GetExpensesRes, error)
 	// GetGeoJson invokes getGeoJson operation.
 	//
 	// Get GeoJson.
 	//
 	// GET /trips/{trip_id}/transportation/geojson
 	GetGeoJson(ctx context.Context, params GetGeoJsonParams) (GetGeoJsonRes, error)
+	// GetGpx invokes getGpx operation.
+	//
+	// Export trip as GPX.
+	//
+	// GET /trips/{trip_id}/export/gpx
+	GetGpx(ctx context.Context, params GetGpxParams) (GetGpxRes, error)
+	// GetHistory invokes getHistory operation.
+	//
+	// Entries are returned newest first. Older entries are paged by passing the ID of the oldest entry
+	// received so far as before.
+	//
+	// GET /trips/{trip_id}/history
+	GetHistory(ctx context.Context, params GetHistoryParams) (GetHistoryRes, error)
+	// GetItinerary invokes getItinerary operation.
+	//
+	// Get day-by-day itinerary.
+	//
+	// GET /trips/{trip_id}/itinerary
+	GetItinerary(ctx context.Context, params GetItineraryParams) (GetItineraryRes, error)
+	// GetItineraryPdf invokes getItineraryPdf operation.
+	//
+	// Export printable itinerary as PDF.
+	//
+	// GET /trips/{trip_id}/itinerary.pdf
+	GetItineraryPdf(ctx context.Context, params GetItineraryPdfParams) (GetItineraryPdfRes, error)
+	// GetKml invokes getKml operation.
+	//
+	// Export trip as KML.
+	//
+	// GET /trips/{trip_id}/export/kml
+	GetKml(ctx context.Context, params GetKmlParams) (GetKmlRes, error)
 	// GetLocation invokes getLocation operation.
 	//
 	// Lookup location.
 	//
 	// GET /geocoding/location
 	GetLocation(ctx context.Context, params GetLocationParams) (GetLocationRes, error)
+	// GetSettlement invokes getSettlement operation.
+	//
+	// Get transfers that settle all expenses.
+	//
+	// GET /trips/{trip_id}/settlement
+	GetSettlement(ctx context.Context, params GetSettlementParams) (GetSettlementRes, error)
+	// GetStats invokes getStats operation.
+	//
+	// Aggregates distances, hours travelled, flights and the most frequent airports, airlines, aircraft
+	// and
+	// train operators over all trips the user owns or is a member of.
+	//
+	// GET /users/me/stats
+	GetStats(ctx context.Context, params GetStatsParams) (GetStatsRes, error)
+	// GetTemplates invokes getTemplates operation.
+	//
+	// Templates are trips that any user can copy.
+	//
+	// GET /templates
+	GetTemplates(ctx context.Context) (GetTemplatesRes, error)
 	// GetTrainStation invokes getTrainStation operation.
 	//
 	// Lookup train station.
@@ -118,12 +257,31 @@ type Invoker interface {
 	//
 	// GET /trips/{trip_id}/transportation/{transportation_id}
 	GetTransportation(ctx context.Context, params GetTransportationParams) (GetTransportationRes, error)
+	// GetTrash invokes getTrash operation.
+	//
+	// Items are purged once purgeAt has passed. Entities of deleted trips are restored with the trip.
+	//
+	// GET /trash
+	GetTrash(ctx context.Context) (GetTrashRes, error)
 	// GetTrip invokes getTrip operation.
 	//
 	// Get trip by ID.
 	//
 	// GET /trips/{trip_id}
 	GetTrip(ctx context.Context, params GetTripParams) (GetTripRes, error)
+	// GetTripEvents invokes getTripEvents operation.
+	//
+	// Server-Sent Events stream of the changes to the trip made by all users. Each event is named
+	// "<kind>.<type>", e.g. "activity.created", and its data is an entity.TripEvent as JSON.
+	//
+	// GET /trips/{trip_id}/events
+	GetTripEvents(ctx context.Context, params GetTripEventsParams) (GetTripEventsRes, error)
+	// GetTripTrash invokes getTripTrash operation.
+	//
+	// Get deleted entities of trip.
+	//
+	// GET /trips/{trip_id}/trash
+	GetTripTrash(ctx context.Context, params GetTripTrashParams) (GetTripTrashRes, error)
 	// GetTrips invokes getTrips operation.
 	//
 	// Get all trips.
@@ -142,6 +300,20 @@ type Invoker interface {
 	//
 	// GET /users
 	GetUsers(ctx context.Context) (GetUsersRes, error)
+	// ImportPhotos invokes importPhotos operation.
+	//
+	// Stores the photos as attachments and groups them by the time and place in their Exif data. Groups
+	// are
+	// linked to a nearby activity on the same day, otherwise an activity is proposed but not created.
+	//
+	// POST /trips/{trip_id}/photos
+	ImportPhotos(ctx context.Context, request *V1PhotosParamMultipart, params ImportPhotosParams) (ImportPhotosRes, error)
+	// LinkAttachment invokes linkAttachment operation.
+	//
+	// Link attachment to a booking.
+	//
+	// PUT /trips/{trip_id}/attachments/{attachment_id}/links/{target}/{target_id}
+	LinkAttachment(ctx context.Context, params LinkAttachmentParams) (LinkAttachmentRes, error)
 	// PostAccommodation invokes postAccommodation operation.
 	//
 	// Add accommodation.
@@ -160,12 +332,34 @@ type Invoker interface {
 	//
 	// POST /trips/{trip_id}/attachments
 	PostAttachment(ctx context.Context, request *V1AttachmentsParamMultipart, params PostAttachmentParams) (PostAttachmentRes, error)
+	// PostChanges invokes postChanges operation.
+	//
+	// Changes are applied in order. Updates and deletions based on an outdated revision are not applied
+	// and
+	// reported as conflicts. Creations with a clientId are applied once, pushing them again reports the
+	// entity
+	// created the first time.
+	//
+	// POST /trips/{trip_id}/changes
+	PostChanges(ctx context.Context, request *RequestChangeBatch, params PostChangesParams) (PostChangesRes, error)
+	// PostExpense invokes postExpense operation.
+	//
+	// Add expense.
+	//
+	// POST /trips/{trip_id}/expenses
+	PostExpense(ctx context.Context, request *RequestExpense, params PostExpenseParams) (PostExpenseRes, error)
 	// PostFlight invokes postFlight operation.
 	//
 	// Add flight.
 	//
 	// POST /trips/{trip_id}/flights
 	PostFlight(ctx context.Context, request *RequestFlight, params PostFlightParams) (PostFlightRes, error)
+	// PostTrack invokes postTrack operation.
+	//
+	// Import recorded track.
+	//
+	// POST /trips/{trip_id}/transportation/{transportation_id}/track
+	PostTrack(ctx context.Context, request *V1TrackParamMultipart, params PostTrackParams) (PostTrackRes, error)
 	// PostTrainJourney invokes postTrainJourney operation.
 	//
 	// Add train journey.
@@ -196,12 +390,30 @@ type Invoker interface {
 	//
 	// PUT /trips/{trip_id}/activities/{activity_id}
 	PutActivity(ctx context.Context, request *RequestActivity, params PutActivityParams) (PutActivityRes, error)
+	// PutBudget invokes putBudget operation.
+	//
+	// Update budget.
+	//
+	// PUT /trips/{trip_id}/budget
+	PutBudget(ctx context.Context, request *RequestBudget, params PutBudgetParams) (PutBudgetRes, error)
+	// PutExpense invokes putExpense operation.
+	//
+	// Update expense.
+	//
+	// PUT /trips/{trip_id}/expenses/{expense_id}
+	PutExpense(ctx context.Context, request *RequestExpense, params PutExpenseParams) (PutExpenseRes, error)
 	// PutFlight invokes putFlight operation.
 	//
 	// Update flight.
 	//
 	// PUT /trips/{trip_id}/flights/{flight_id}
 	PutFlight(ctx context.Context, params PutFlightParams) (PutFlightRes, error)
+	// PutTrack invokes putTrack operation.
+	//
+	// Update track display.
+	//
+	// PUT /trips/{trip_id}/transportation/{transportation_id}/track
+	PutTrack(ctx context.Context, request *RequestTrack, params PutTrackParams) (PutTrackRes, error)
 	// PutTransportation invokes putTransportation operation.
 	//
 	// Update transportation.
@@ -210,10 +422,54 @@ type Invoker interface {
 	PutTransportation(ctx context.Context, request *RequestTransportation, params PutTransportationParams) (PutTransportationRes, error)
 	// PutTrip invokes putTrip operation.
 	//
-	// Update trip.
+	// If the dates change, entities of the trip are either validated against the new dates or shifted by
+	// the
+	// days the start date moved, see dateChange. Entities outside of the new dates are returned with 409.
 	//
 	// PUT /trips/{trip_id}
 	PutTrip(ctx context.Context, request *RequestTrip, params PutTripParams) (PutTripRes, error)
+	// RestoreAccommodation invokes restoreAccommodation operation.
+	//
+	// Restores the version recorded by an entry of the history. Deleted accommodation is created again
+	// with a new ID.
+	//
+	// POST /trips/{trip_id}/accommodation/{accommodation_id}/restore/{entry_id}
+	RestoreAccommodation(ctx context.Context, params RestoreAccommodationParams) (RestoreAccommodationRes, error)
+	// RestoreActivity invokes restoreActivity operation.
+	//
+	// Restores the version recorded by an entry of the history. Deleted activities are created again
+	// with a new ID.
+	//
+	// POST /trips/{trip_id}/activities/{activity_id}/restore/{entry_id}
+	RestoreActivity(ctx context.Context, params RestoreActivityParams) (RestoreActivityRes, error)
+	// RestoreFromTrash invokes restoreFromTrash operation.
+	//
+	// Entities outside of the trip dates can not be restored, the trip dates may have changed since.
+	//
+	// POST /trips/{trip_id}/trash/{kind}/{entity_id}/restore
+	RestoreFromTrash(ctx context.Context, params RestoreFromTrashParams) (RestoreFromTrashRes, error)
+	// RestoreTransportation invokes restoreTransportation operation.
+	//
+	// Restores the version recorded by an entry of the history. Deleted transportation is created again
+	// with a new ID.
+	// Flights and train journeys are restored as generic transportation named after their legs,
+	// replacing them
+	// with a new ID if they still exist.
+	//
+	// POST /trips/{trip_id}/transportation/{transportation_id}/restore/{entry_id}
+	RestoreTransportation(ctx context.Context, params RestoreTransportationParams) (RestoreTransportationRes, error)
+	// RestoreTrip invokes restoreTrip operation.
+	//
+	// Restore deleted trip.
+	//
+	// POST /trash/trips/{trip_id}/restore
+	RestoreTrip(ctx context.Context, params RestoreTripParams) (RestoreTripRes, error)
+	// UnlinkAttachment invokes unlinkAttachment operation.
+	//
+	// Unlink attachment from a booking.
+	//
+	// DELETE /trips/{trip_id}/attachments/{attachment_id}/links/{target}/{target_id}
+	UnlinkAttachment(ctx context.Context, params UnlinkAttachmentParams) (UnlinkAttachmentRes, error)
 }
 
 // Client implements OAS client.
@@ -257,9 +513,104 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
+// CopyTrip invokes copyTrip operation.
+//
+// Copies activities, accommodation, transportation and optionally attachments into a new trip,
+// shifting
+// all dates by the days between the start dates. Expenses and members are not copied. Any user can
+// copy
+// templates.
+//
+// POST /trips/{trip_id}/copy
+func (c *Client) CopyTrip(ctx context.Context, request *RequestTripCopy, params CopyTripParams) (CopyTripRes, error) {
+	res, err := c.sendCopyTrip(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendCopyTrip(ctx context.Context, request *RequestTripCopy, params CopyTripParams) (res CopyTripRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/copy"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCopyTripRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, CopyTripOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeCopyTripResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // DeleteAccommodation invokes deleteAccommodation operation.
 //
-// Delete accommodation.
+// The accommodation is moved to the trash and can be restored until it is purged.
 //
 // DELETE /trips/{trip_id}/accommodation/{accommodation_id}
 func (c *Client) DeleteAccommodation(ctx context.Context, params DeleteAccommodationParams) (DeleteAccommodationRes, error) {
@@ -316,6 +667,22 @@ func (c *Client) sendDeleteAccommodation(ctx context.Context, params DeleteAccom
 		return res, errors.Wrap(err, "create request")
 	}
 
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
@@ -365,7 +732,7 @@ func (c *Client) sendDeleteAccommodation(ctx context.Context, params DeleteAccom
 
 // DeleteActivity invokes deleteActivity operation.
 //
-// Delete activity.
+// The activity is moved to the trash and can be restored until it is purged.
 //
 // DELETE /trips/{trip_id}/activities/{activity_id}
 func (c *Client) DeleteActivity(ctx context.Context, params DeleteActivityParams) (DeleteActivityRes, error) {
@@ -422,6 +789,22 @@ func (c *Client) sendDeleteActivity(ctx context.Context, params DeleteActivityPa
 		return res, errors.Wrap(err, "create request")
 	}
 
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
@@ -471,7 +854,7 @@ func (c *Client) sendDeleteActivity(ctx context.Context, params DeleteActivityPa
 
 // DeleteAttachment invokes deleteAttachment operation.
 //
-// Delete attachment.
+// The attachment is moved to the trash and can be restored until it is purged.
 //
 // DELETE /trips/{trip_id}/attachments/{attachment_id}
 func (c *Client) DeleteAttachment(ctx context.Context, params DeleteAttachmentParams) (DeleteAttachmentRes, error) {
@@ -528,6 +911,22 @@ func (c *Client) sendDeleteAttachment(ctx context.Context, params DeleteAttachme
 		return res, errors.Wrap(err, "create request")
 	}
 
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
@@ -575,17 +974,17 @@ func (c *Client) sendDeleteAttachment(ctx context.Context, params DeleteAttachme
 	return result, nil
 }
 
-// DeleteTransportation invokes deleteTransportation operation.
+// DeleteExpense invokes deleteExpense operation.
 //
-// Delete Transportation.
+// The expense is moved to the trash and can be restored until it is purged.
 //
-// DELETE /trips/{trip_id}/transportation/{transportation_id}
-func (c *Client) DeleteTransportation(ctx context.Context, params DeleteTransportationParams) (DeleteTransportationRes, error) {
-	res, err := c.sendDeleteTransportation(ctx, params)
+// DELETE /trips/{trip_id}/expenses/{expense_id}
+func (c *Client) DeleteExpense(ctx context.Context, params DeleteExpenseParams) (DeleteExpenseRes, error) {
+	res, err := c.sendDeleteExpense(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteTransportation(ctx context.Context, params DeleteTransportationParams) (res DeleteTransportationRes, err error) {
+func (c *Client) sendDeleteExpense(ctx context.Context, params DeleteExpenseParams) (res DeleteExpenseRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [4]string
@@ -608,16 +1007,16 @@ func (c *Client) sendDeleteTransportation(ctx context.Context, params DeleteTran
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/transportation/"
+	pathParts[2] = "/expenses/"
 	{
-		// Encode "transportation_id" parameter.
+		// Encode "expense_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "transportation_id",
+			Param:   "expense_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.TransportationID))
+			return e.EncodeValue(conv.IntToString(params.ExpenseID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -634,12 +1033,28 @@ func (c *Client) sendDeleteTransportation(ctx context.Context, params DeleteTran
 		return res, errors.Wrap(err, "create request")
 	}
 
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, DeleteTransportationOperation, r); {
+			switch err := c.securityBearerauth(ctx, DeleteExpenseOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -673,7 +1088,7 @@ func (c *Client) sendDeleteTransportation(ctx context.Context, params DeleteTran
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDeleteTransportationResponse(resp)
+	result, err := decodeDeleteExpenseResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -681,20 +1096,20 @@ func (c *Client) sendDeleteTransportation(ctx context.Context, params DeleteTran
 	return result, nil
 }
 
-// DeleteTrip invokes deleteTrip operation.
+// DeleteTrack invokes deleteTrack operation.
 //
-// Delete trip.
+// Delete recorded track.
 //
-// DELETE /trips/{trip_id}
-func (c *Client) DeleteTrip(ctx context.Context, params DeleteTripParams) (DeleteTripRes, error) {
-	res, err := c.sendDeleteTrip(ctx, params)
+// DELETE /trips/{trip_id}/transportation/{transportation_id}/track
+func (c *Client) DeleteTrack(ctx context.Context, params DeleteTrackParams) (DeleteTrackRes, error) {
+	res, err := c.sendDeleteTrack(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteTrip(ctx context.Context, params DeleteTripParams) (res DeleteTripRes, err error) {
+func (c *Client) sendDeleteTrack(ctx context.Context, params DeleteTrackParams) (res DeleteTrackRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [5]string
 	pathParts[0] = "/trips/"
 	{
 		// Encode "trip_id" parameter.
@@ -714,6 +1129,26 @@ func (c *Client) sendDeleteTrip(ctx context.Context, params DeleteTripParams) (r
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/transportation/"
+	{
+		// Encode "transportation_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "transportation_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TransportationID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/track"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "DELETE", u)
@@ -721,12 +1156,28 @@ func (c *Client) sendDeleteTrip(ctx context.Context, params DeleteTripParams) (r
 		return res, errors.Wrap(err, "create request")
 	}
 
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, DeleteTripOperation, r); {
+			switch err := c.securityBearerauth(ctx, DeleteTrackOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -760,7 +1211,7 @@ func (c *Client) sendDeleteTrip(ctx context.Context, params DeleteTripParams) (r
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDeleteTripResponse(resp)
+	result, err := decodeDeleteTrackResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -768,17 +1219,17 @@ func (c *Client) sendDeleteTrip(ctx context.Context, params DeleteTripParams) (r
 	return result, nil
 }
 
-// DownloadAttachment invokes downloadAttachment operation.
+// DeleteTransportation invokes deleteTransportation operation.
 //
-// Download attachment by ID.
+// The transportation is moved to the trash and can be restored until it is purged.
 //
-// GET /trips/{trip_id}/attachments/{attachment_id}
-func (c *Client) DownloadAttachment(ctx context.Context, params DownloadAttachmentParams) (DownloadAttachmentRes, error) {
-	res, err := c.sendDownloadAttachment(ctx, params)
+// DELETE /trips/{trip_id}/transportation/{transportation_id}
+func (c *Client) DeleteTransportation(ctx context.Context, params DeleteTransportationParams) (DeleteTransportationRes, error) {
+	res, err := c.sendDeleteTransportation(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDownloadAttachment(ctx context.Context, params DownloadAttachmentParams) (res DownloadAttachmentRes, err error) {
+func (c *Client) sendDeleteTransportation(ctx context.Context, params DeleteTransportationParams) (res DeleteTransportationRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [4]string
@@ -801,16 +1252,16 @@ func (c *Client) sendDownloadAttachment(ctx context.Context, params DownloadAtta
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/attachments/"
+	pathParts[2] = "/transportation/"
 	{
-		// Encode "attachment_id" parameter.
+		// Encode "transportation_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "attachment_id",
+			Param:   "transportation_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.AttachmentID))
+			return e.EncodeValue(conv.IntToString(params.TransportationID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -822,17 +1273,33 @@ func (c *Client) sendDownloadAttachment(ctx context.Context, params DownloadAtta
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
+	h := uri.NewHeaderEncoder(r.Header)
 	{
-		type bitset = [1]uint8
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, DownloadAttachmentOperation, r); {
+			switch err := c.securityBearerauth(ctx, DeleteTransportationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -866,7 +1333,7 @@ func (c *Client) sendDownloadAttachment(ctx context.Context, params DownloadAtta
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDownloadAttachmentResponse(resp)
+	result, err := decodeDeleteTransportationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -874,20 +1341,20 @@ func (c *Client) sendDownloadAttachment(ctx context.Context, params DownloadAtta
 	return result, nil
 }
 
-// GetAccommodationByID invokes getAccommodationByID operation.
+// DeleteTrip invokes deleteTrip operation.
 //
-// Get accommodation by ID.
+// The trip is moved to the trash with all of its entities and can be restored until it is purged.
 //
-// GET /trips/{trip_id}/accommodation/{accommodation_id}
-func (c *Client) GetAccommodationByID(ctx context.Context, params GetAccommodationByIDParams) (GetAccommodationByIDRes, error) {
-	res, err := c.sendGetAccommodationByID(ctx, params)
+// DELETE /trips/{trip_id}
+func (c *Client) DeleteTrip(ctx context.Context, params DeleteTripParams) (DeleteTripRes, error) {
+	res, err := c.sendDeleteTrip(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetAccommodationByID(ctx context.Context, params GetAccommodationByIDParams) (res GetAccommodationByIDRes, err error) {
+func (c *Client) sendDeleteTrip(ctx context.Context, params DeleteTripParams) (res DeleteTripRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
+	var pathParts [2]string
 	pathParts[0] = "/trips/"
 	{
 		// Encode "trip_id" parameter.
@@ -907,38 +1374,35 @@ func (c *Client) sendGetAccommodationByID(ctx context.Context, params GetAccommo
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/accommodation/"
-	{
-		// Encode "accommodation_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "accommodation_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.AccommodationID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, GetAccommodationByIDOperation, r); {
+			switch err := c.securityBearerauth(ctx, DeleteTripOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -972,7 +1436,7 @@ func (c *Client) sendGetAccommodationByID(ctx context.Context, params GetAccommo
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetAccommodationByIDResponse(resp)
+	result, err := decodeDeleteTripResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -980,20 +1444,20 @@ func (c *Client) sendGetAccommodationByID(ctx context.Context, params GetAccommo
 	return result, nil
 }
 
-// GetActivities invokes getActivities operation.
+// DownloadAttachment invokes downloadAttachment operation.
 //
-// Get all activities.
+// Supports single byte ranges. The ETag is the SHA-256 hash of the content.
 //
-// GET /trips/{trip_id}/activities
-func (c *Client) GetActivities(ctx context.Context, params GetActivitiesParams) (GetActivitiesRes, error) {
-	res, err := c.sendGetActivities(ctx, params)
+// GET /trips/{trip_id}/attachments/{attachment_id}
+func (c *Client) DownloadAttachment(ctx context.Context, params DownloadAttachmentParams) (DownloadAttachmentRes, error) {
+	res, err := c.sendDownloadAttachment(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetActivities(ctx context.Context, params GetActivitiesParams) (res GetActivitiesRes, err error) {
+func (c *Client) sendDownloadAttachment(ctx context.Context, params DownloadAttachmentParams) (res DownloadAttachmentRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
+	var pathParts [4]string
 	pathParts[0] = "/trips/"
 	{
 		// Encode "trip_id" parameter.
@@ -1013,7 +1477,25 @@ func (c *Client) sendGetActivities(ctx context.Context, params GetActivitiesPara
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/activities"
+	pathParts[2] = "/attachments/"
+	{
+		// Encode "attachment_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "attachment_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.AttachmentID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -1021,12 +1503,56 @@ func (c *Client) sendGetActivities(ctx context.Context, params GetActivitiesPara
 		return res, errors.Wrap(err, "create request")
 	}
 
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Range",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Range.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Range",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfRange.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfNoneMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, GetActivitiesOperation, r); {
+			switch err := c.securityBearerauth(ctx, DownloadAttachmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1060,7 +1586,7 @@ func (c *Client) sendGetActivities(ctx context.Context, params GetActivitiesPara
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetActivitiesResponse(resp)
+	result, err := decodeDownloadAttachmentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1068,17 +1594,17 @@ func (c *Client) sendGetActivities(ctx context.Context, params GetActivitiesPara
 	return result, nil
 }
 
-// GetActivity invokes getActivity operation.
+// GetAccommodationByID invokes getAccommodationByID operation.
 //
-// Get activity by ID.
+// Get accommodation by ID.
 //
-// GET /trips/{trip_id}/activities/{activity_id}
-func (c *Client) GetActivity(ctx context.Context, params GetActivityParams) (GetActivityRes, error) {
-	res, err := c.sendGetActivity(ctx, params)
+// GET /trips/{trip_id}/accommodation/{accommodation_id}
+func (c *Client) GetAccommodationByID(ctx context.Context, params GetAccommodationByIDParams) (GetAccommodationByIDRes, error) {
+	res, err := c.sendGetAccommodationByID(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetActivity(ctx context.Context, params GetActivityParams) (res GetActivityRes, err error) {
+func (c *Client) sendGetAccommodationByID(ctx context.Context, params GetAccommodationByIDParams) (res GetAccommodationByIDRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [4]string
@@ -1101,16 +1627,16 @@ func (c *Client) sendGetActivity(ctx context.Context, params GetActivityParams)
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/activities/"
+	pathParts[2] = "/accommodation/"
 	{
-		// Encode "activity_id" parameter.
+		// Encode "accommodation_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "activity_id",
+			Param:   "accommodation_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ActivityID))
+			return e.EncodeValue(conv.IntToString(params.AccommodationID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1127,12 +1653,28 @@ func (c *Client) sendGetActivity(ctx context.Context, params GetActivityParams)
 		return res, errors.Wrap(err, "create request")
 	}
 
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfNoneMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, GetActivityOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetAccommodationByIDOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1166,7 +1708,7 @@ func (c *Client) sendGetActivity(ctx context.Context, params GetActivityParams)
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetActivityResponse(resp)
+	result, err := decodeGetAccommodationByIDResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1174,17 +1716,17 @@ func (c *Client) sendGetActivity(ctx context.Context, params GetActivityParams)
 	return result, nil
 }
 
-// GetAllAccommodation invokes getAllAccommodation operation.
+// GetActivities invokes getActivities operation.
 //
-// Get all accommodation.
+// Get all activities.
 //
-// GET /trips/{trip_id}/accommodation
-func (c *Client) GetAllAccommodation(ctx context.Context, params GetAllAccommodationParams) (GetAllAccommodationRes, error) {
-	res, err := c.sendGetAllAccommodation(ctx, params)
+// GET /trips/{trip_id}/activities
+func (c *Client) GetActivities(ctx context.Context, params GetActivitiesParams) (GetActivitiesRes, error) {
+	res, err := c.sendGetActivities(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetAllAccommodation(ctx context.Context, params GetAllAccommodationParams) (res GetAllAccommodationRes, err error) {
+func (c *Client) sendGetActivities(ctx context.Context, params GetActivitiesParams) (res GetActivitiesRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
@@ -1207,7 +1749,7 @@ func (c *Client) sendGetAllAccommodation(ctx context.Context, params GetAllAccom
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/accommodation"
+	pathParts[2] = "/activities"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -1220,7 +1762,7 @@ func (c *Client) sendGetAllAccommodation(ctx context.Context, params GetAllAccom
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, GetAllAccommodationOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetActivitiesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1254,7 +1796,7 @@ func (c *Client) sendGetAllAccommodation(ctx context.Context, params GetAllAccom
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetAllAccommodationResponse(resp)
+	result, err := decodeGetActivitiesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1262,20 +1804,20 @@ func (c *Client) sendGetAllAccommodation(ctx context.Context, params GetAllAccom
 	return result, nil
 }
 
-// GetAllTransportation invokes getAllTransportation operation.
+// GetActivity invokes getActivity operation.
 //
-// Get all Transportation.
+// Get activity by ID.
 //
-// GET /trips/{trip_id}/transportation
-func (c *Client) GetAllTransportation(ctx context.Context, params GetAllTransportationParams) (GetAllTransportationRes, error) {
-	res, err := c.sendGetAllTransportation(ctx, params)
+// GET /trips/{trip_id}/activities/{activity_id}
+func (c *Client) GetActivity(ctx context.Context, params GetActivityParams) (GetActivityRes, error) {
+	res, err := c.sendGetActivity(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetAllTransportation(ctx context.Context, params GetAllTransportationParams) (res GetAllTransportationRes, err error) {
+func (c *Client) sendGetActivity(ctx context.Context, params GetActivityParams) (res GetActivityRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
+	var pathParts [4]string
 	pathParts[0] = "/trips/"
 	{
 		// Encode "trip_id" parameter.
@@ -1295,7 +1837,25 @@ func (c *Client) sendGetAllTransportation(ctx context.Context, params GetAllTran
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/transportation"
+	pathParts[2] = "/activities/"
+	{
+		// Encode "activity_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "activity_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ActivityID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -1303,12 +1863,28 @@ func (c *Client) sendGetAllTransportation(ctx context.Context, params GetAllTran
 		return res, errors.Wrap(err, "create request")
 	}
 
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfNoneMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, GetAllTransportationOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetActivityOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1342,7 +1918,7 @@ func (c *Client) sendGetAllTransportation(ctx context.Context, params GetAllTran
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetAllTransportationResponse(resp)
+	result, err := decodeGetActivityResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1350,17 +1926,17 @@ func (c *Client) sendGetAllTransportation(ctx context.Context, params GetAllTran
 	return result, nil
 }
 
-// GetAttachments invokes getAttachments operation.
+// GetAllAccommodation invokes getAllAccommodation operation.
 //
-// Get all attachments.
+// Get all accommodation.
 //
-// GET /trips/{trip_id}/attachments
-func (c *Client) GetAttachments(ctx context.Context, params GetAttachmentsParams) (GetAttachmentsRes, error) {
-	res, err := c.sendGetAttachments(ctx, params)
+// GET /trips/{trip_id}/accommodation
+func (c *Client) GetAllAccommodation(ctx context.Context, params GetAllAccommodationParams) (GetAllAccommodationRes, error) {
+	res, err := c.sendGetAllAccommodation(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetAttachments(ctx context.Context, params GetAttachmentsParams) (res GetAttachmentsRes, err error) {
+func (c *Client) sendGetAllAccommodation(ctx context.Context, params GetAllAccommodationParams) (res GetAllAccommodationRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
@@ -1383,7 +1959,7 @@ func (c *Client) sendGetAttachments(ctx context.Context, params GetAttachmentsPa
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/attachments"
+	pathParts[2] = "/accommodation"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -1396,7 +1972,7 @@ func (c *Client) sendGetAttachments(ctx context.Context, params GetAttachmentsPa
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, GetAttachmentsOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetAllAccommodationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1430,7 +2006,7 @@ func (c *Client) sendGetAttachments(ctx context.Context, params GetAttachmentsPa
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetAttachmentsResponse(resp)
+	result, err := decodeGetAllAccommodationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1438,17 +2014,17 @@ func (c *Client) sendGetAttachments(ctx context.Context, params GetAttachmentsPa
 	return result, nil
 }
 
-// GetGeoJson invokes getGeoJson operation.
+// GetAllTransportation invokes getAllTransportation operation.
 //
-// Get GeoJson.
+// Get all Transportation.
 //
-// GET /trips/{trip_id}/transportation/geojson
-func (c *Client) GetGeoJson(ctx context.Context, params GetGeoJsonParams) (GetGeoJsonRes, error) {
-	res, err := c.sendGetGeoJson(ctx, params)
+// GET /trips/{trip_id}/transportation
+func (c *Client) GetAllTransportation(ctx context.Context, params GetAllTransportationParams) (GetAllTransportationRes, error) {
+	res, err := c.sendGetAllTransportation(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetGeoJson(ctx context.Context, params GetGeoJsonParams) (res GetGeoJsonRes, err error) {
+func (c *Client) sendGetAllTransportation(ctx context.Context, params GetAllTransportationParams) (res GetAllTransportationRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
@@ -1471,7 +2047,7 @@ func (c *Client) sendGetGeoJson(ctx context.Context, params GetGeoJsonParams) (r
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/transportation/geojson"
+	pathParts[2] = "/transportation"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -1484,7 +2060,7 @@ func (c *Client) sendGetGeoJson(ctx context.Context, params GetGeoJsonParams) (r
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, GetGeoJsonOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetAllTransportationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1518,7 +2094,7 @@ func (c *Client) sendGetGeoJson(ctx context.Context, params GetGeoJsonParams) (r
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetGeoJsonResponse(resp)
+	result, err := decodeGetAllTransportationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1526,34 +2102,77 @@ func (c *Client) sendGetGeoJson(ctx context.Context, params GetGeoJsonParams) (r
 	return result, nil
 }
 
-// GetLocation invokes getLocation operation.
+// GetAttachmentThumbnail invokes getAttachmentThumbnail operation.
 //
-// Lookup location.
+// Available for JPEG, PNG and WebP images. Thumbnails are JPEG images that fit into a square of 160
+// (small),
+// 480 (medium) or 1280 (large) pixels.
 //
-// GET /geocoding/location
-func (c *Client) GetLocation(ctx context.Context, params GetLocationParams) (GetLocationRes, error) {
-	res, err := c.sendGetLocation(ctx, params)
+// GET /trips/{trip_id}/attachments/{attachment_id}/thumbnail
+func (c *Client) GetAttachmentThumbnail(ctx context.Context, params GetAttachmentThumbnailParams) (GetAttachmentThumbnailRes, error) {
+	res, err := c.sendGetAttachmentThumbnail(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetLocation(ctx context.Context, params GetLocationParams) (res GetLocationRes, err error) {
+func (c *Client) sendGetAttachmentThumbnail(ctx context.Context, params GetAttachmentThumbnailParams) (res GetAttachmentThumbnailRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/geocoding/location"
+	var pathParts [5]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/attachments/"
+	{
+		// Encode "attachment_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "attachment_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.AttachmentID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/thumbnail"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "query" parameter.
+		// Encode "size" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "query",
+			Name:    "size",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Query))
+			if val, ok := params.Size.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
@@ -1565,12 +2184,28 @@ func (c *Client) sendGetLocation(ctx context.Context, params GetLocationParams)
 		return res, errors.Wrap(err, "create request")
 	}
 
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfNoneMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, GetLocationOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetAttachmentThumbnailOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1604,7 +2239,7 @@ func (c *Client) sendGetLocation(ctx context.Context, params GetLocationParams)
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetLocationResponse(resp)
+	result, err := decodeGetAttachmentThumbnailResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1612,39 +2247,60 @@ func (c *Client) sendGetLocation(ctx context.Context, params GetLocationParams)
 	return result, nil
 }
 
-// GetTrainStation invokes getTrainStation operation.
+// GetAttachmentURL invokes getAttachmentURL operation.
 //
-// Lookup train station.
+// Only available if the storage backend supports pre-signed URLs and they are enabled.
 //
-// GET /geocoding/station
-func (c *Client) GetTrainStation(ctx context.Context, params GetTrainStationParams) (GetTrainStationRes, error) {
-	res, err := c.sendGetTrainStation(ctx, params)
+// GET /trips/{trip_id}/attachments/{attachment_id}/url
+func (c *Client) GetAttachmentURL(ctx context.Context, params GetAttachmentURLParams) (GetAttachmentURLRes, error) {
+	res, err := c.sendGetAttachmentURL(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetTrainStation(ctx context.Context, params GetTrainStationParams) (res GetTrainStationRes, err error) {
+func (c *Client) sendGetAttachmentURL(ctx context.Context, params GetAttachmentURLParams) (res GetAttachmentURLRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/geocoding/station"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	q := uri.NewQueryEncoder()
+	var pathParts [5]string
+	pathParts[0] = "/trips/"
 	{
-		// Encode "query" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "query",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Query))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/attachments/"
+	{
+		// Encode "attachment_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "attachment_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.AttachmentID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/url"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -1656,7 +2312,7 @@ func (c *Client) sendGetTrainStation(ctx context.Context, params GetTrainStation
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, GetTrainStationOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetAttachmentURLOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1690,7 +2346,7 @@ func (c *Client) sendGetTrainStation(ctx context.Context, params GetTrainStation
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetTrainStationResponse(resp)
+	result, err := decodeGetAttachmentURLResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1698,20 +2354,20 @@ func (c *Client) sendGetTrainStation(ctx context.Context, params GetTrainStation
 	return result, nil
 }
 
-// GetTransportation invokes getTransportation operation.
+// GetAttachments invokes getAttachments operation.
 //
-// Get Transportation by ID.
+// Get all attachments.
 //
-// GET /trips/{trip_id}/transportation/{transportation_id}
-func (c *Client) GetTransportation(ctx context.Context, params GetTransportationParams) (GetTransportationRes, error) {
-	res, err := c.sendGetTransportation(ctx, params)
+// GET /trips/{trip_id}/attachments
+func (c *Client) GetAttachments(ctx context.Context, params GetAttachmentsParams) (GetAttachmentsRes, error) {
+	res, err := c.sendGetAttachments(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetTransportation(ctx context.Context, params GetTransportationParams) (res GetTransportationRes, err error) {
+func (c *Client) sendGetAttachments(ctx context.Context, params GetAttachmentsParams) (res GetAttachmentsRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
+	var pathParts [3]string
 	pathParts[0] = "/trips/"
 	{
 		// Encode "trip_id" parameter.
@@ -1731,25 +2387,7 @@ func (c *Client) sendGetTransportation(ctx context.Context, params GetTransporta
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/transportation/"
-	{
-		// Encode "transportation_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "transportation_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.TransportationID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
+	pathParts[2] = "/attachments"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -1762,7 +2400,7 @@ func (c *Client) sendGetTransportation(ctx context.Context, params GetTransporta
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, GetTransportationOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetAttachmentsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1796,7 +2434,7 @@ func (c *Client) sendGetTransportation(ctx context.Context, params GetTransporta
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetTransportationResponse(resp)
+	result, err := decodeGetAttachmentsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1804,20 +2442,20 @@ func (c *Client) sendGetTransportation(ctx context.Context, params GetTransporta
 	return result, nil
 }
 
-// GetTrip invokes getTrip operation.
+// GetBudget invokes getBudget operation.
 //
-// Get trip by ID.
+// Get budget.
 //
-// GET /trips/{trip_id}
-func (c *Client) GetTrip(ctx context.Context, params GetTripParams) (GetTripRes, error) {
-	res, err := c.sendGetTrip(ctx, params)
+// GET /trips/{trip_id}/budget
+func (c *Client) GetBudget(ctx context.Context, params GetBudgetParams) (GetBudgetRes, error) {
+	res, err := c.sendGetBudget(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetTrip(ctx context.Context, params GetTripParams) (res GetTripRes, err error) {
+func (c *Client) sendGetBudget(ctx context.Context, params GetBudgetParams) (res GetBudgetRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [3]string
 	pathParts[0] = "/trips/"
 	{
 		// Encode "trip_id" parameter.
@@ -1837,6 +2475,7 @@ func (c *Client) sendGetTrip(ctx context.Context, params GetTripParams) (res Get
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/budget"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -1849,7 +2488,7 @@ func (c *Client) sendGetTrip(ctx context.Context, params GetTripParams) (res Get
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, GetTripOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetBudgetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1883,7 +2522,7 @@ func (c *Client) sendGetTrip(ctx context.Context, params GetTripParams) (res Get
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetTripResponse(resp)
+	result, err := decodeGetBudgetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1891,21 +2530,40 @@ func (c *Client) sendGetTrip(ctx context.Context, params GetTripParams) (res Get
 	return result, nil
 }
 
-// GetTrips invokes getTrips operation.
+// GetBudgetReport invokes getBudgetReport operation.
 //
-// Get all trips.
+// Compare costs to budget.
 //
-// GET /trips
-func (c *Client) GetTrips(ctx context.Context) (GetTripsRes, error) {
-	res, err := c.sendGetTrips(ctx)
+// GET /trips/{trip_id}/budget/report
+func (c *Client) GetBudgetReport(ctx context.Context, params GetBudgetReportParams) (GetBudgetReportRes, error) {
+	res, err := c.sendGetBudgetReport(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetTrips(ctx context.Context) (res GetTripsRes, err error) {
+func (c *Client) sendGetBudgetReport(ctx context.Context, params GetBudgetReportParams) (res GetBudgetReportRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/trips"
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/budget/report"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -1918,7 +2576,7 @@ func (c *Client) sendGetTrips(ctx context.Context) (res GetTripsRes, err error)
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, GetTripsOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetBudgetReportOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1952,7 +2610,7 @@ func (c *Client) sendGetTrips(ctx context.Context) (res GetTripsRes, err error)
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetTripsResponse(resp)
+	result, err := decodeGetBudgetReportResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1960,30 +2618,30 @@ func (c *Client) sendGetTrips(ctx context.Context) (res GetTripsRes, err error)
 	return result, nil
 }
 
-// GetUser invokes getUser operation.
+// GetChanges invokes getChanges operation.
 //
-// Get user by ID.
+// Omitting the cursor returns the whole trip. The returned cursor is passed to the next request.
 //
-// GET /users/{user_id}
-func (c *Client) GetUser(ctx context.Context, params GetUserParams) (GetUserRes, error) {
-	res, err := c.sendGetUser(ctx, params)
+// GET /trips/{trip_id}/changes
+func (c *Client) GetChanges(ctx context.Context, params GetChangesParams) (GetChangesRes, error) {
+	res, err := c.sendGetChanges(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res GetUserRes, err error) {
+func (c *Client) sendGetChanges(ctx context.Context, params GetChangesParams) (res GetChangesRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/users/"
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
 	{
-		// Encode "user_id" parameter.
+		// Encode "trip_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "user_id",
+			Param:   "trip_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.UserID))
+			return e.EncodeValue(conv.IntToString(params.TripID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1993,8 +2651,29 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res Get
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/changes"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
@@ -2005,7 +2684,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res Get
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, GetUserOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetChangesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2039,7 +2718,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res Get
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetUserResponse(resp)
+	result, err := decodeGetChangesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2047,21 +2726,42 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res Get
 	return result, nil
 }
 
-// GetUsers invokes getUsers operation.
+// GetChecks invokes getChecks operation.
 //
-// Get all users.
+// Reports overlapping transportation, activities during transit, nights without accommodation,
+// accommodation far from the day's last arrival and flight connections or train transfers that are
+// too short.
 //
-// GET /users
-func (c *Client) GetUsers(ctx context.Context) (GetUsersRes, error) {
-	res, err := c.sendGetUsers(ctx)
+// GET /trips/{trip_id}/checks
+func (c *Client) GetChecks(ctx context.Context, params GetChecksParams) (GetChecksRes, error) {
+	res, err := c.sendGetChecks(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetUsers(ctx context.Context) (res GetUsersRes, err error) {
+func (c *Client) sendGetChecks(ctx context.Context, params GetChecksParams) (res GetChecksRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/users"
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/checks"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -2074,7 +2774,7 @@ func (c *Client) sendGetUsers(ctx context.Context) (res GetUsersRes, err error)
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, GetUsersOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetChecksOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2108,7 +2808,7 @@ func (c *Client) sendGetUsers(ctx context.Context) (res GetUsersRes, err error)
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetUsersResponse(resp)
+	result, err := decodeGetChecksResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2116,17 +2816,17 @@ func (c *Client) sendGetUsers(ctx context.Context) (res GetUsersRes, err error)
 	return result, nil
 }
 
-// PostAccommodation invokes postAccommodation operation.
+// GetCosts invokes getCosts operation.
 //
-// Add accommodation.
+// Get costs converted to the home currency.
 //
-// POST /trips/{trip_id}/accommodation
-func (c *Client) PostAccommodation(ctx context.Context, request *RequestAccommodation, params PostAccommodationParams) (PostAccommodationRes, error) {
-	res, err := c.sendPostAccommodation(ctx, request, params)
+// GET /trips/{trip_id}/costs
+func (c *Client) GetCosts(ctx context.Context, params GetCostsParams) (GetCostsRes, error) {
+	res, err := c.sendGetCosts(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPostAccommodation(ctx context.Context, request *RequestAccommodation, params PostAccommodationParams) (res PostAccommodationRes, err error) {
+func (c *Client) sendGetCosts(ctx context.Context, params GetCostsParams) (res GetCostsRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
@@ -2149,23 +2849,20 @@ func (c *Client) sendPostAccommodation(ctx context.Context, request *RequestAcco
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/accommodation"
+	pathParts[2] = "/costs"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePostAccommodationRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, PostAccommodationOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetCostsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2199,7 +2896,7 @@ func (c *Client) sendPostAccommodation(ctx context.Context, request *RequestAcco
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePostAccommodationResponse(resp)
+	result, err := decodeGetCostsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2207,17 +2904,19 @@ func (c *Client) sendPostAccommodation(ctx context.Context, request *RequestAcco
 	return result, nil
 }
 
-// PostActivity invokes postActivity operation.
+// GetEmissions invokes getEmissions operation.
 //
-// Add activity.
+// Sums up the estimated kg CO2e per passenger of all transportation, in total and by transportation
+// type.
+// Transportation without emission factor is counted as unestimated.
 //
-// POST /trips/{trip_id}/activities
-func (c *Client) PostActivity(ctx context.Context, request *RequestActivity, params PostActivityParams) (PostActivityRes, error) {
-	res, err := c.sendPostActivity(ctx, request, params)
+// GET /trips/{trip_id}/emissions
+func (c *Client) GetEmissions(ctx context.Context, params GetEmissionsParams) (GetEmissionsRes, error) {
+	res, err := c.sendGetEmissions(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPostActivity(ctx context.Context, request *RequestActivity, params PostActivityParams) (res PostActivityRes, err error) {
+func (c *Client) sendGetEmissions(ctx context.Context, params GetEmissionsParams) (res GetEmissionsRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
@@ -2240,23 +2939,20 @@ func (c *Client) sendPostActivity(ctx context.Context, request *RequestActivity,
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/activities"
+	pathParts[2] = "/emissions"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePostActivityRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, PostActivityOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetEmissionsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2290,7 +2986,7 @@ func (c *Client) sendPostActivity(ctx context.Context, request *RequestActivity,
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePostActivityResponse(resp)
+	result, err := decodeGetEmissionsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2298,20 +2994,20 @@ func (c *Client) sendPostActivity(ctx context.Context, request *RequestActivity,
 	return result, nil
 }
 
-// PostAttachment invokes postAttachment operation.
+// GetExpense invokes getExpense operation.
 //
-// Add attachment.
+// Get expense by ID.
 //
-// POST /trips/{trip_id}/attachments
-func (c *Client) PostAttachment(ctx context.Context, request *V1AttachmentsParamMultipart, params PostAttachmentParams) (PostAttachmentRes, error) {
-	res, err := c.sendPostAttachment(ctx, request, params)
+// GET /trips/{trip_id}/expenses/{expense_id}
+func (c *Client) GetExpense(ctx context.Context, params GetExpenseParams) (GetExpenseRes, error) {
+	res, err := c.sendGetExpense(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPostAttachment(ctx context.Context, request *V1AttachmentsParamMultipart, params PostAttachmentParams) (res PostAttachmentRes, err error) {
+func (c *Client) sendGetExpense(ctx context.Context, params GetExpenseParams) (res GetExpenseRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
+	var pathParts [4]string
 	pathParts[0] = "/trips/"
 	{
 		// Encode "trip_id" parameter.
@@ -2331,15 +3027,46 @@ func (c *Client) sendPostAttachment(ctx context.Context, request *V1AttachmentsP
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/attachments"
+	pathParts[2] = "/expenses/"
+	{
+		// Encode "expense_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "expense_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ExpenseID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePostAttachmentRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfNoneMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
 	}
 
 	{
@@ -2347,7 +3074,7 @@ func (c *Client) sendPostAttachment(ctx context.Context, request *V1AttachmentsP
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, PostAttachmentOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetExpenseOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2381,7 +3108,7 @@ func (c *Client) sendPostAttachment(ctx context.Context, request *V1AttachmentsP
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePostAttachmentResponse(resp)
+	result, err := decodeGetExpenseResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2389,17 +3116,17 @@ func (c *Client) sendPostAttachment(ctx context.Context, request *V1AttachmentsP
 	return result, nil
 }
 
-// PostFlight invokes postFlight operation.
+// GetExpenses invokes getExpenses operation.
 //
-// Add flight.
+// Get all expenses.
 //
-// POST /trips/{trip_id}/flights
-func (c *Client) PostFlight(ctx context.Context, request *RequestFlight, params PostFlightParams) (PostFlightRes, error) {
-	res, err := c.sendPostFlight(ctx, request, params)
+// GET /trips/{trip_id}/expenses
+func (c *Client) GetExpenses(ctx context.Context, params GetExpensesParams) (GetExpensesRes, error) {
+	res, err := c.sendGetExpenses(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPostFlight(ctx context.Context, request *RequestFlight, params PostFlightParams) (res PostFlightRes, err error) {
+func (c *Client) sendGetExpenses(ctx context.Context, params GetExpensesParams) (res GetExpensesRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
@@ -2422,23 +3149,20 @@ func (c *Client) sendPostFlight(ctx context.Context, request *RequestFlight, par
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/flights"
+	pathParts[2] = "/expenses"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePostFlightRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, PostFlightOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetExpensesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2472,7 +3196,7 @@ func (c *Client) sendPostFlight(ctx context.Context, request *RequestFlight, par
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePostFlightResponse(resp)
+	result, err := decodeGetExpensesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2480,17 +3204,17 @@ func (c *Client) sendPostFlight(ctx context.Context, request *RequestFlight, par
 	return result, nil
 }
 
-// PostTrainJourney invokes postTrainJourney operation.
+// GetGeoJson invokes getGeoJson operation.
 //
-// Add train journey.
+// Get GeoJson.
 //
-// POST /trips/{trip_id}/trains
-func (c *Client) PostTrainJourney(ctx context.Context, request *RequestTrainJourney, params PostTrainJourneyParams) (PostTrainJourneyRes, error) {
-	res, err := c.sendPostTrainJourney(ctx, request, params)
+// GET /trips/{trip_id}/transportation/geojson
+func (c *Client) GetGeoJson(ctx context.Context, params GetGeoJsonParams) (GetGeoJsonRes, error) {
+	res, err := c.sendGetGeoJson(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPostTrainJourney(ctx context.Context, request *RequestTrainJourney, params PostTrainJourneyParams) (res PostTrainJourneyRes, err error) {
+func (c *Client) sendGetGeoJson(ctx context.Context, params GetGeoJsonParams) (res GetGeoJsonRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
@@ -2513,23 +3237,20 @@ func (c *Client) sendPostTrainJourney(ctx context.Context, request *RequestTrain
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/trains"
+	pathParts[2] = "/transportation/geojson"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePostTrainJourneyRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, PostTrainJourneyOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetGeoJsonOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2563,7 +3284,7 @@ func (c *Client) sendPostTrainJourney(ctx context.Context, request *RequestTrain
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePostTrainJourneyResponse(resp)
+	result, err := decodeGetGeoJsonResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2571,17 +3292,17 @@ func (c *Client) sendPostTrainJourney(ctx context.Context, request *RequestTrain
 	return result, nil
 }
 
-// PostTransportation invokes postTransportation operation.
+// GetGpx invokes getGpx operation.
 //
-// Add transportation.
+// Export trip as GPX.
 //
-// POST /trips/{trip_id}/transportation
-func (c *Client) PostTransportation(ctx context.Context, request *RequestTransportation, params PostTransportationParams) (PostTransportationRes, error) {
-	res, err := c.sendPostTransportation(ctx, request, params)
+// GET /trips/{trip_id}/export/gpx
+func (c *Client) GetGpx(ctx context.Context, params GetGpxParams) (GetGpxRes, error) {
+	res, err := c.sendGetGpx(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPostTransportation(ctx context.Context, request *RequestTransportation, params PostTransportationParams) (res PostTransportationRes, err error) {
+func (c *Client) sendGetGpx(ctx context.Context, params GetGpxParams) (res GetGpxRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
@@ -2604,23 +3325,20 @@ func (c *Client) sendPostTransportation(ctx context.Context, request *RequestTra
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/transportation"
+	pathParts[2] = "/export/gpx"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePostTransportationRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, PostTransportationOperation, r); {
+			switch err := c.securityBearerauth(ctx, GetGpxOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2654,7 +3372,7 @@ func (c *Client) sendPostTransportation(ctx context.Context, request *RequestTra
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePostTransportationResponse(resp)
+	result, err := decodeGetGpxResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2662,29 +3380,3612 @@ func (c *Client) sendPostTransportation(ctx context.Context, request *RequestTra
 	return result, nil
 }
 
-// PostTrip invokes postTrip operation.
+// GetHistory invokes getHistory operation.
 //
-// Add trip.
+// Entries are returned newest first. Older entries are paged by passing the ID of the oldest entry
+// received so far as before.
 //
-// POST /trips
-func (c *Client) PostTrip(ctx context.Context, request *RequestTrip) (PostTripRes, error) {
-	res, err := c.sendPostTrip(ctx, request)
+// GET /trips/{trip_id}/history
+func (c *Client) GetHistory(ctx context.Context, params GetHistoryParams) (GetHistoryRes, error) {
+	res, err := c.sendGetHistory(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPostTrip(ctx context.Context, request *RequestTrip) (res PostTripRes, err error) {
+func (c *Client) sendGetHistory(ctx context.Context, params GetHistoryParams) (res GetHistoryRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/trips"
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/history"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "kind" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Kind.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "entityId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "entityId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.EntityId.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "userId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "userId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.UserId.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePostTripRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetHistoryOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetHistoryResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetItinerary invokes getItinerary operation.
+//
+// Get day-by-day itinerary.
+//
+// GET /trips/{trip_id}/itinerary
+func (c *Client) GetItinerary(ctx context.Context, params GetItineraryParams) (GetItineraryRes, error) {
+	res, err := c.sendGetItinerary(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetItinerary(ctx context.Context, params GetItineraryParams) (res GetItineraryRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/itinerary"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetItineraryOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetItineraryResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetItineraryPdf invokes getItineraryPdf operation.
+//
+// Export printable itinerary as PDF.
+//
+// GET /trips/{trip_id}/itinerary.pdf
+func (c *Client) GetItineraryPdf(ctx context.Context, params GetItineraryPdfParams) (GetItineraryPdfRes, error) {
+	res, err := c.sendGetItineraryPdf(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetItineraryPdf(ctx context.Context, params GetItineraryPdfParams) (res GetItineraryPdfRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/itinerary.pdf"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetItineraryPdfOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetItineraryPdfResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetKml invokes getKml operation.
+//
+// Export trip as KML.
+//
+// GET /trips/{trip_id}/export/kml
+func (c *Client) GetKml(ctx context.Context, params GetKmlParams) (GetKmlRes, error) {
+	res, err := c.sendGetKml(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetKml(ctx context.Context, params GetKmlParams) (res GetKmlRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/export/kml"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetKmlOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetKmlResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetLocation invokes getLocation operation.
+//
+// Lookup location.
+//
+// GET /geocoding/location
+func (c *Client) GetLocation(ctx context.Context, params GetLocationParams) (GetLocationRes, error) {
+	res, err := c.sendGetLocation(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetLocation(ctx context.Context, params GetLocationParams) (res GetLocationRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/geocoding/location"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "query" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "query",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Query))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetLocationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetLocationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetSettlement invokes getSettlement operation.
+//
+// Get transfers that settle all expenses.
+//
+// GET /trips/{trip_id}/settlement
+func (c *Client) GetSettlement(ctx context.Context, params GetSettlementParams) (GetSettlementRes, error) {
+	res, err := c.sendGetSettlement(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetSettlement(ctx context.Context, params GetSettlementParams) (res GetSettlementRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/settlement"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetSettlementOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetSettlementResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetStats invokes getStats operation.
+//
+// Aggregates distances, hours travelled, flights and the most frequent airports, airlines, aircraft
+// and
+// train operators over all trips the user owns or is a member of.
+//
+// GET /users/me/stats
+func (c *Client) GetStats(ctx context.Context, params GetStatsParams) (GetStatsRes, error) {
+	res, err := c.sendGetStats(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetStats(ctx context.Context, params GetStatsParams) (res GetStatsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/users/me/stats"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "year" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "year",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Year.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetStatsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetStatsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTemplates invokes getTemplates operation.
+//
+// Templates are trips that any user can copy.
+//
+// GET /templates
+func (c *Client) GetTemplates(ctx context.Context) (GetTemplatesRes, error) {
+	res, err := c.sendGetTemplates(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetTemplates(ctx context.Context) (res GetTemplatesRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/templates"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetTemplatesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetTemplatesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTrainStation invokes getTrainStation operation.
+//
+// Lookup train station.
+//
+// GET /geocoding/station
+func (c *Client) GetTrainStation(ctx context.Context, params GetTrainStationParams) (GetTrainStationRes, error) {
+	res, err := c.sendGetTrainStation(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetTrainStation(ctx context.Context, params GetTrainStationParams) (res GetTrainStationRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/geocoding/station"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "query" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "query",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Query))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetTrainStationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetTrainStationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTransportation invokes getTransportation operation.
+//
+// Get Transportation by ID.
+//
+// GET /trips/{trip_id}/transportation/{transportation_id}
+func (c *Client) GetTransportation(ctx context.Context, params GetTransportationParams) (GetTransportationRes, error) {
+	res, err := c.sendGetTransportation(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetTransportation(ctx context.Context, params GetTransportationParams) (res GetTransportationRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/transportation/"
+	{
+		// Encode "transportation_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "transportation_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TransportationID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfNoneMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetTransportationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetTransportationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTrash invokes getTrash operation.
+//
+// Items are purged once purgeAt has passed. Entities of deleted trips are restored with the trip.
+//
+// GET /trash
+func (c *Client) GetTrash(ctx context.Context) (GetTrashRes, error) {
+	res, err := c.sendGetTrash(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetTrash(ctx context.Context) (res GetTrashRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/trash"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetTrashOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetTrashResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTrip invokes getTrip operation.
+//
+// Get trip by ID.
+//
+// GET /trips/{trip_id}
+func (c *Client) GetTrip(ctx context.Context, params GetTripParams) (GetTripRes, error) {
+	res, err := c.sendGetTrip(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetTrip(ctx context.Context, params GetTripParams) (res GetTripRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfNoneMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetTripOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetTripResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTripEvents invokes getTripEvents operation.
+//
+// Server-Sent Events stream of the changes to the trip made by all users. Each event is named
+// "<kind>.<type>", e.g. "activity.created", and its data is an entity.TripEvent as JSON.
+//
+// GET /trips/{trip_id}/events
+func (c *Client) GetTripEvents(ctx context.Context, params GetTripEventsParams) (GetTripEventsRes, error) {
+	res, err := c.sendGetTripEvents(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetTripEvents(ctx context.Context, params GetTripEventsParams) (res GetTripEventsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/events"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetTripEventsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetTripEventsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTripTrash invokes getTripTrash operation.
+//
+// Get deleted entities of trip.
+//
+// GET /trips/{trip_id}/trash
+func (c *Client) GetTripTrash(ctx context.Context, params GetTripTrashParams) (GetTripTrashRes, error) {
+	res, err := c.sendGetTripTrash(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetTripTrash(ctx context.Context, params GetTripTrashParams) (res GetTripTrashRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/trash"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetTripTrashOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetTripTrashResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTrips invokes getTrips operation.
+//
+// Get all trips.
+//
+// GET /trips
+func (c *Client) GetTrips(ctx context.Context) (GetTripsRes, error) {
+	res, err := c.sendGetTrips(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetTrips(ctx context.Context) (res GetTripsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/trips"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetTripsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetTripsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetUser invokes getUser operation.
+//
+// Get user by ID.
+//
+// GET /users/{user_id}
+func (c *Client) GetUser(ctx context.Context, params GetUserParams) (GetUserRes, error) {
+	res, err := c.sendGetUser(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res GetUserRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/users/"
+	{
+		// Encode "user_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "user_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.UserID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetUserOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetUserResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetUsers invokes getUsers operation.
+//
+// Get all users.
+//
+// GET /users
+func (c *Client) GetUsers(ctx context.Context) (GetUsersRes, error) {
+	res, err := c.sendGetUsers(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetUsers(ctx context.Context) (res GetUsersRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/users"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, GetUsersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetUsersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ImportPhotos invokes importPhotos operation.
+//
+// Stores the photos as attachments and groups them by the time and place in their Exif data. Groups
+// are
+// linked to a nearby activity on the same day, otherwise an activity is proposed but not created.
+//
+// POST /trips/{trip_id}/photos
+func (c *Client) ImportPhotos(ctx context.Context, request *V1PhotosParamMultipart, params ImportPhotosParams) (ImportPhotosRes, error) {
+	res, err := c.sendImportPhotos(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendImportPhotos(ctx context.Context, request *V1PhotosParamMultipart, params ImportPhotosParams) (res ImportPhotosRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/photos"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeImportPhotosRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, ImportPhotosOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeImportPhotosResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// LinkAttachment invokes linkAttachment operation.
+//
+// Link attachment to a booking.
+//
+// PUT /trips/{trip_id}/attachments/{attachment_id}/links/{target}/{target_id}
+func (c *Client) LinkAttachment(ctx context.Context, params LinkAttachmentParams) (LinkAttachmentRes, error) {
+	res, err := c.sendLinkAttachment(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendLinkAttachment(ctx context.Context, params LinkAttachmentParams) (res LinkAttachmentRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [8]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/attachments/"
+	{
+		// Encode "attachment_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "attachment_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.AttachmentID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/links/"
+	{
+		// Encode "target" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "target",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(string(params.Target)))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	pathParts[6] = "/"
+	{
+		// Encode "target_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "target_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TargetID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[7] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, LinkAttachmentOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeLinkAttachmentResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostAccommodation invokes postAccommodation operation.
+//
+// Add accommodation.
+//
+// POST /trips/{trip_id}/accommodation
+func (c *Client) PostAccommodation(ctx context.Context, request *RequestAccommodation, params PostAccommodationParams) (PostAccommodationRes, error) {
+	res, err := c.sendPostAccommodation(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPostAccommodation(ctx context.Context, request *RequestAccommodation, params PostAccommodationParams) (res PostAccommodationRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/accommodation"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostAccommodationRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PostAccommodationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePostAccommodationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostActivity invokes postActivity operation.
+//
+// Add activity.
+//
+// POST /trips/{trip_id}/activities
+func (c *Client) PostActivity(ctx context.Context, request *RequestActivity, params PostActivityParams) (PostActivityRes, error) {
+	res, err := c.sendPostActivity(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPostActivity(ctx context.Context, request *RequestActivity, params PostActivityParams) (res PostActivityRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/activities"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostActivityRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PostActivityOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePostActivityResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostAttachment invokes postAttachment operation.
+//
+// Add attachment.
+//
+// POST /trips/{trip_id}/attachments
+func (c *Client) PostAttachment(ctx context.Context, request *V1AttachmentsParamMultipart, params PostAttachmentParams) (PostAttachmentRes, error) {
+	res, err := c.sendPostAttachment(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPostAttachment(ctx context.Context, request *V1AttachmentsParamMultipart, params PostAttachmentParams) (res PostAttachmentRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/attachments"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostAttachmentRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PostAttachmentOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePostAttachmentResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostChanges invokes postChanges operation.
+//
+// Changes are applied in order. Updates and deletions based on an outdated revision are not applied
+// and
+// reported as conflicts. Creations with a clientId are applied once, pushing them again reports the
+// entity
+// created the first time.
+//
+// POST /trips/{trip_id}/changes
+func (c *Client) PostChanges(ctx context.Context, request *RequestChangeBatch, params PostChangesParams) (PostChangesRes, error) {
+	res, err := c.sendPostChanges(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPostChanges(ctx context.Context, request *RequestChangeBatch, params PostChangesParams) (res PostChangesRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/changes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostChangesRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PostChangesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePostChangesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostExpense invokes postExpense operation.
+//
+// Add expense.
+//
+// POST /trips/{trip_id}/expenses
+func (c *Client) PostExpense(ctx context.Context, request *RequestExpense, params PostExpenseParams) (PostExpenseRes, error) {
+	res, err := c.sendPostExpense(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPostExpense(ctx context.Context, request *RequestExpense, params PostExpenseParams) (res PostExpenseRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/expenses"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostExpenseRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PostExpenseOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePostExpenseResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostFlight invokes postFlight operation.
+//
+// Add flight.
+//
+// POST /trips/{trip_id}/flights
+func (c *Client) PostFlight(ctx context.Context, request *RequestFlight, params PostFlightParams) (PostFlightRes, error) {
+	res, err := c.sendPostFlight(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPostFlight(ctx context.Context, request *RequestFlight, params PostFlightParams) (res PostFlightRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/flights"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostFlightRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PostFlightOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePostFlightResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostTrack invokes postTrack operation.
+//
+// Import recorded track.
+//
+// POST /trips/{trip_id}/transportation/{transportation_id}/track
+func (c *Client) PostTrack(ctx context.Context, request *V1TrackParamMultipart, params PostTrackParams) (PostTrackRes, error) {
+	res, err := c.sendPostTrack(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPostTrack(ctx context.Context, request *V1TrackParamMultipart, params PostTrackParams) (res PostTrackRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/transportation/"
+	{
+		// Encode "transportation_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "transportation_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TransportationID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/track"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostTrackRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PostTrackOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePostTrackResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostTrainJourney invokes postTrainJourney operation.
+//
+// Add train journey.
+//
+// POST /trips/{trip_id}/trains
+func (c *Client) PostTrainJourney(ctx context.Context, request *RequestTrainJourney, params PostTrainJourneyParams) (PostTrainJourneyRes, error) {
+	res, err := c.sendPostTrainJourney(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPostTrainJourney(ctx context.Context, request *RequestTrainJourney, params PostTrainJourneyParams) (res PostTrainJourneyRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/trains"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostTrainJourneyRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PostTrainJourneyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePostTrainJourneyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostTransportation invokes postTransportation operation.
+//
+// Add transportation.
+//
+// POST /trips/{trip_id}/transportation
+func (c *Client) PostTransportation(ctx context.Context, request *RequestTransportation, params PostTransportationParams) (PostTransportationRes, error) {
+	res, err := c.sendPostTransportation(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPostTransportation(ctx context.Context, request *RequestTransportation, params PostTransportationParams) (res PostTransportationRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/transportation"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostTransportationRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PostTransportationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePostTransportationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostTrip invokes postTrip operation.
+//
+// Add trip.
+//
+// POST /trips
+func (c *Client) PostTrip(ctx context.Context, request *RequestTrip) (PostTripRes, error) {
+	res, err := c.sendPostTrip(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendPostTrip(ctx context.Context, request *RequestTrip) (res PostTripRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/trips"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostTripRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PostTripOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePostTripResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PutAccommodation invokes putAccommodation operation.
+//
+// Update accommodation.
+//
+// PUT /trips/{trip_id}/accommodation/{accommodation_id}
+func (c *Client) PutAccommodation(ctx context.Context, request *RequestAccommodation, params PutAccommodationParams) (PutAccommodationRes, error) {
+	res, err := c.sendPutAccommodation(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPutAccommodation(ctx context.Context, request *RequestAccommodation, params PutAccommodationParams) (res PutAccommodationRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/accommodation/"
+	{
+		// Encode "accommodation_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "accommodation_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.AccommodationID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePutAccommodationRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PutAccommodationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePutAccommodationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PutActivity invokes putActivity operation.
+//
+// Update activity.
+//
+// PUT /trips/{trip_id}/activities/{activity_id}
+func (c *Client) PutActivity(ctx context.Context, request *RequestActivity, params PutActivityParams) (PutActivityRes, error) {
+	res, err := c.sendPutActivity(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPutActivity(ctx context.Context, request *RequestActivity, params PutActivityParams) (res PutActivityRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/activities/"
+	{
+		// Encode "activity_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "activity_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ActivityID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePutActivityRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PutActivityOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePutActivityResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PutBudget invokes putBudget operation.
+//
+// Update budget.
+//
+// PUT /trips/{trip_id}/budget
+func (c *Client) PutBudget(ctx context.Context, request *RequestBudget, params PutBudgetParams) (PutBudgetRes, error) {
+	res, err := c.sendPutBudget(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPutBudget(ctx context.Context, request *RequestBudget, params PutBudgetParams) (res PutBudgetRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/budget"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePutBudgetRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PutBudgetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePutBudgetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PutExpense invokes putExpense operation.
+//
+// Update expense.
+//
+// PUT /trips/{trip_id}/expenses/{expense_id}
+func (c *Client) PutExpense(ctx context.Context, request *RequestExpense, params PutExpenseParams) (PutExpenseRes, error) {
+	res, err := c.sendPutExpense(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPutExpense(ctx context.Context, request *RequestExpense, params PutExpenseParams) (res PutExpenseRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/expenses/"
+	{
+		// Encode "expense_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "expense_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ExpenseID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePutExpenseRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PutExpenseOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePutExpenseResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PutFlight invokes putFlight operation.
+//
+// Update flight.
+//
+// PUT /trips/{trip_id}/flights/{flight_id}
+func (c *Client) PutFlight(ctx context.Context, params PutFlightParams) (PutFlightRes, error) {
+	res, err := c.sendPutFlight(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendPutFlight(ctx context.Context, params PutFlightParams) (res PutFlightRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/flights/"
+	{
+		// Encode "flight_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "flight_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.FlightID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PutFlightOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePutFlightResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PutTrack invokes putTrack operation.
+//
+// Update track display.
+//
+// PUT /trips/{trip_id}/transportation/{transportation_id}/track
+func (c *Client) PutTrack(ctx context.Context, request *RequestTrack, params PutTrackParams) (PutTrackRes, error) {
+	res, err := c.sendPutTrack(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPutTrack(ctx context.Context, request *RequestTrack, params PutTrackParams) (res PutTrackRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/transportation/"
+	{
+		// Encode "transportation_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "transportation_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TransportationID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/track"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePutTrackRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PutTrackOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePutTrackResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PutTransportation invokes putTransportation operation.
+//
+// Update transportation.
+//
+// PUT /trips/{trip_id}/transportation/{transportation_id}
+func (c *Client) PutTransportation(ctx context.Context, request *RequestTransportation, params PutTransportationParams) (PutTransportationRes, error) {
+	res, err := c.sendPutTransportation(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPutTransportation(ctx context.Context, request *RequestTransportation, params PutTransportationParams) (res PutTransportationRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/transportation/"
+	{
+		// Encode "transportation_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "transportation_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TransportationID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePutTransportationRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, PutTransportationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePutTransportationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PutTrip invokes putTrip operation.
+//
+// If the dates change, entities of the trip are either validated against the new dates or shifted by
+// the
+// days the start date moved, see dateChange. Entities outside of the new dates are returned with 409.
+//
+// PUT /trips/{trip_id}
+func (c *Client) PutTrip(ctx context.Context, request *RequestTrip, params PutTripParams) (PutTripRes, error) {
+	res, err := c.sendPutTrip(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPutTrip(ctx context.Context, request *RequestTrip, params PutTripParams) (res PutTripRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePutTripRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
 	}
 
 	{
@@ -2692,7 +6993,7 @@ func (c *Client) sendPostTrip(ctx context.Context, request *RequestTrip) (res Po
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, PostTripOperation, r); {
+			switch err := c.securityBearerauth(ctx, PutTripOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2726,7 +7027,7 @@ func (c *Client) sendPostTrip(ctx context.Context, request *RequestTrip) (res Po
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePostTripResponse(resp)
+	result, err := decodePutTripResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2734,20 +7035,21 @@ func (c *Client) sendPostTrip(ctx context.Context, request *RequestTrip) (res Po
 	return result, nil
 }
 
-// PutAccommodation invokes putAccommodation operation.
+// RestoreAccommodation invokes restoreAccommodation operation.
 //
-// Update accommodation.
+// Restores the version recorded by an entry of the history. Deleted accommodation is created again
+// with a new ID.
 //
-// PUT /trips/{trip_id}/accommodation/{accommodation_id}
-func (c *Client) PutAccommodation(ctx context.Context, request *RequestAccommodation, params PutAccommodationParams) (PutAccommodationRes, error) {
-	res, err := c.sendPutAccommodation(ctx, request, params)
+// POST /trips/{trip_id}/accommodation/{accommodation_id}/restore/{entry_id}
+func (c *Client) RestoreAccommodation(ctx context.Context, params RestoreAccommodationParams) (RestoreAccommodationRes, error) {
+	res, err := c.sendRestoreAccommodation(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPutAccommodation(ctx context.Context, request *RequestAccommodation, params PutAccommodationParams) (res PutAccommodationRes, err error) {
+func (c *Client) sendRestoreAccommodation(ctx context.Context, params RestoreAccommodationParams) (res RestoreAccommodationRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
+	var pathParts [6]string
 	pathParts[0] = "/trips/"
 	{
 		// Encode "trip_id" parameter.
@@ -2786,22 +7088,38 @@ func (c *Client) sendPutAccommodation(ctx context.Context, request *RequestAccom
 		}
 		pathParts[3] = encoded
 	}
+	pathParts[4] = "/restore/"
+	{
+		// Encode "entry_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "entry_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.EntryID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePutAccommodationRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, PutAccommodationOperation, r); {
+			switch err := c.securityBearerauth(ctx, RestoreAccommodationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2835,7 +7153,7 @@ func (c *Client) sendPutAccommodation(ctx context.Context, request *RequestAccom
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePutAccommodationResponse(resp)
+	result, err := decodeRestoreAccommodationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2843,20 +7161,21 @@ func (c *Client) sendPutAccommodation(ctx context.Context, request *RequestAccom
 	return result, nil
 }
 
-// PutActivity invokes putActivity operation.
+// RestoreActivity invokes restoreActivity operation.
 //
-// Update activity.
+// Restores the version recorded by an entry of the history. Deleted activities are created again
+// with a new ID.
 //
-// PUT /trips/{trip_id}/activities/{activity_id}
-func (c *Client) PutActivity(ctx context.Context, request *RequestActivity, params PutActivityParams) (PutActivityRes, error) {
-	res, err := c.sendPutActivity(ctx, request, params)
+// POST /trips/{trip_id}/activities/{activity_id}/restore/{entry_id}
+func (c *Client) RestoreActivity(ctx context.Context, params RestoreActivityParams) (RestoreActivityRes, error) {
+	res, err := c.sendRestoreActivity(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPutActivity(ctx context.Context, request *RequestActivity, params PutActivityParams) (res PutActivityRes, err error) {
+func (c *Client) sendRestoreActivity(ctx context.Context, params RestoreActivityParams) (res RestoreActivityRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
+	var pathParts [6]string
 	pathParts[0] = "/trips/"
 	{
 		// Encode "trip_id" parameter.
@@ -2895,22 +7214,38 @@ func (c *Client) sendPutActivity(ctx context.Context, request *RequestActivity,
 		}
 		pathParts[3] = encoded
 	}
+	pathParts[4] = "/restore/"
+	{
+		// Encode "entry_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "entry_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.EntryID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePutActivityRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, PutActivityOperation, r); {
+			switch err := c.securityBearerauth(ctx, RestoreActivityOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2944,7 +7279,7 @@ func (c *Client) sendPutActivity(ctx context.Context, request *RequestActivity,
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePutActivityResponse(resp)
+	result, err := decodeRestoreActivityResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2952,20 +7287,20 @@ func (c *Client) sendPutActivity(ctx context.Context, request *RequestActivity,
 	return result, nil
 }
 
-// PutFlight invokes putFlight operation.
+// RestoreFromTrash invokes restoreFromTrash operation.
 //
-// Update flight.
+// Entities outside of the trip dates can not be restored, the trip dates may have changed since.
 //
-// PUT /trips/{trip_id}/flights/{flight_id}
-func (c *Client) PutFlight(ctx context.Context, params PutFlightParams) (PutFlightRes, error) {
-	res, err := c.sendPutFlight(ctx, params)
+// POST /trips/{trip_id}/trash/{kind}/{entity_id}/restore
+func (c *Client) RestoreFromTrash(ctx context.Context, params RestoreFromTrashParams) (RestoreFromTrashRes, error) {
+	res, err := c.sendRestoreFromTrash(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPutFlight(ctx context.Context, params PutFlightParams) (res PutFlightRes, err error) {
+func (c *Client) sendRestoreFromTrash(ctx context.Context, params RestoreFromTrashParams) (res RestoreFromTrashRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
+	var pathParts [7]string
 	pathParts[0] = "/trips/"
 	{
 		// Encode "trip_id" parameter.
@@ -2985,16 +7320,16 @@ func (c *Client) sendPutFlight(ctx context.Context, params PutFlightParams) (res
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/flights/"
+	pathParts[2] = "/trash/"
 	{
-		// Encode "flight_id" parameter.
+		// Encode "kind" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "flight_id",
+			Param:   "kind",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.FlightID))
+			return e.EncodeValue(conv.StringToString(string(params.Kind)))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -3004,9 +7339,29 @@ func (c *Client) sendPutFlight(ctx context.Context, params PutFlightParams) (res
 		}
 		pathParts[3] = encoded
 	}
+	pathParts[4] = "/"
+	{
+		// Encode "entity_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "entity_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.EntityID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	pathParts[6] = "/restore"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -3016,7 +7371,7 @@ func (c *Client) sendPutFlight(ctx context.Context, params PutFlightParams) (res
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, PutFlightOperation, r); {
+			switch err := c.securityBearerauth(ctx, RestoreFromTrashOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3050,7 +7405,7 @@ func (c *Client) sendPutFlight(ctx context.Context, params PutFlightParams) (res
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePutFlightResponse(resp)
+	result, err := decodeRestoreFromTrashResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3058,20 +7413,24 @@ func (c *Client) sendPutFlight(ctx context.Context, params PutFlightParams) (res
 	return result, nil
 }
 
-// PutTransportation invokes putTransportation operation.
+// RestoreTransportation invokes restoreTransportation operation.
 //
-// Update transportation.
+// Restores the version recorded by an entry of the history. Deleted transportation is created again
+// with a new ID.
+// Flights and train journeys are restored as generic transportation named after their legs,
+// replacing them
+// with a new ID if they still exist.
 //
-// PUT /trips/{trip_id}/transportation/{transportation_id}
-func (c *Client) PutTransportation(ctx context.Context, request *RequestTransportation, params PutTransportationParams) (PutTransportationRes, error) {
-	res, err := c.sendPutTransportation(ctx, request, params)
+// POST /trips/{trip_id}/transportation/{transportation_id}/restore/{entry_id}
+func (c *Client) RestoreTransportation(ctx context.Context, params RestoreTransportationParams) (RestoreTransportationRes, error) {
+	res, err := c.sendRestoreTransportation(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPutTransportation(ctx context.Context, request *RequestTransportation, params PutTransportationParams) (res PutTransportationRes, err error) {
+func (c *Client) sendRestoreTransportation(ctx context.Context, params RestoreTransportationParams) (res RestoreTransportationRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
+	var pathParts [6]string
 	pathParts[0] = "/trips/"
 	{
 		// Encode "trip_id" parameter.
@@ -3110,14 +7469,118 @@ func (c *Client) sendPutTransportation(ctx context.Context, request *RequestTran
 		}
 		pathParts[3] = encoded
 	}
+	pathParts[4] = "/restore/"
+	{
+		// Encode "entry_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "entry_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.EntryID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePutTransportationRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityBearerauth(ctx, RestoreTransportationOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Bearerauth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeRestoreTransportationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RestoreTrip invokes restoreTrip operation.
+//
+// Restore deleted trip.
+//
+// POST /trash/trips/{trip_id}/restore
+func (c *Client) RestoreTrip(ctx context.Context, params RestoreTripParams) (RestoreTripRes, error) {
+	res, err := c.sendRestoreTrip(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendRestoreTrip(ctx context.Context, params RestoreTripParams) (res RestoreTripRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/trash/trips/"
+	{
+		// Encode "trip_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trip_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TripID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/restore"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
 	}
 
 	{
@@ -3125,7 +7588,7 @@ func (c *Client) sendPutTransportation(ctx context.Context, request *RequestTran
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, PutTransportationOperation, r); {
+			switch err := c.securityBearerauth(ctx, RestoreTripOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3159,7 +7622,7 @@ func (c *Client) sendPutTransportation(ctx context.Context, request *RequestTran
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePutTransportationResponse(resp)
+	result, err := decodeRestoreTripResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3167,20 +7630,20 @@ func (c *Client) sendPutTransportation(ctx context.Context, request *RequestTran
 	return result, nil
 }
 
-// PutTrip invokes putTrip operation.
+// UnlinkAttachment invokes unlinkAttachment operation.
 //
-// Update trip.
+// Unlink attachment from a booking.
 //
-// PUT /trips/{trip_id}
-func (c *Client) PutTrip(ctx context.Context, request *RequestTrip, params PutTripParams) (PutTripRes, error) {
-	res, err := c.sendPutTrip(ctx, request, params)
+// DELETE /trips/{trip_id}/attachments/{attachment_id}/links/{target}/{target_id}
+func (c *Client) UnlinkAttachment(ctx context.Context, params UnlinkAttachmentParams) (UnlinkAttachmentRes, error) {
+	res, err := c.sendUnlinkAttachment(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPutTrip(ctx context.Context, request *RequestTrip, params PutTripParams) (res PutTripRes, err error) {
+func (c *Client) sendUnlinkAttachment(ctx context.Context, params UnlinkAttachmentParams) (res UnlinkAttachmentRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [8]string
 	pathParts[0] = "/trips/"
 	{
 		// Encode "trip_id" parameter.
@@ -3200,22 +7663,76 @@ func (c *Client) sendPutTrip(ctx context.Context, request *RequestTrip, params P
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/attachments/"
+	{
+		// Encode "attachment_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "attachment_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.AttachmentID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/links/"
+	{
+		// Encode "target" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "target",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(string(params.Target)))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	pathParts[6] = "/"
+	{
+		// Encode "target_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "target_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TargetID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[7] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePutTripRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityBearerauth(ctx, PutTripOperation, r); {
+			switch err := c.securityBearerauth(ctx, UnlinkAttachmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3249,7 +7766,7 @@ func (c *Client) sendPutTrip(ctx context.Context, request *RequestTrip, params P
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePutTripResponse(resp)
+	result, err := decodeUnlinkAttachmentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1,6 +1,10 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type CopyTripRes interface {
+	copyTripRes()
+}
+
 type DeleteAccommodationRes interface {
 	deleteAccommodationRes()
 }
@@ -13,6 +17,14 @@ type DeleteAttachmentRes interface {
 	deleteAttachmentRes()
 }
 
+type DeleteExpenseRes interface {
+	deleteExpenseRes()
+}
+
+type DeleteTrackRes interface {
+	deleteTrackRes()
+}
+
 type DeleteTransportationRes interface {
 	deleteTransportationRes()
 }
@@ -45,18 +57,90 @@ type GetAllTransportationRes interface {
 	getAllTransportationRes()
 }
 
+type GetAttachmentThumbnailRes interface {
+	getAttachmentThumbnailRes()
+}
+
+type GetAttachmentURLRes interface {
+	getAttachmentURLRes()
+}
+
 type GetAttachmentsRes interface {
 	getAttachmentsRes()
 }
 
+type GetBudgetReportRes interface {
+	getBudgetReportRes()
+}
+
+type GetBudgetRes interface {
+	getBudgetRes()
+}
+
+type GetChangesRes interface {
+	getChangesRes()
+}
+
+type GetChecksRes interface {
+	getChecksRes()
+}
+
+type GetCostsRes interface {
+	getCostsRes()
+}
+
+type GetEmissionsRes interface {
+	getEmissionsRes()
+}
+
+type GetExpenseRes interface {
+	getExpenseRes()
+}
+
+type GetExpensesRes interface {
+	getExpensesRes()
+}
+
 type GetGeoJsonRes interface {
 	getGeoJsonRes()
 }
 
+type GetGpxRes interface {
+	getGpxRes()
+}
+
+type GetHistoryRes interface {
+	getHistoryRes()
+}
+
+type GetItineraryPdfRes interface {
+	getItineraryPdfRes()
+}
+
+type GetItineraryRes interface {
+	getItineraryRes()
+}
+
+type GetKmlRes interface {
+	getKmlRes()
+}
+
 type GetLocationRes interface {
 	getLocationRes()
 }
 
+type GetSettlementRes interface {
+	getSettlementRes()
+}
+
+type GetStatsRes interface {
+	getStatsRes()
+}
+
+type GetTemplatesRes interface {
+	getTemplatesRes()
+}
+
 type GetTrainStationRes interface {
 	getTrainStationRes()
 }
@@ -65,10 +149,22 @@ type GetTransportationRes interface {
 	getTransportationRes()
 }
 
+type GetTrashRes interface {
+	getTrashRes()
+}
+
+type GetTripEventsRes interface {
+	getTripEventsRes()
+}
+
 type GetTripRes interface {
 	getTripRes()
 }
 
+type GetTripTrashRes interface {
+	getTripTrashRes()
+}
+
 type GetTripsRes interface {
 	getTripsRes()
 }
@@ -81,6 +177,14 @@ type GetUsersRes interface {
 	getUsersRes()
 }
 
+type ImportPhotosRes interface {
+	importPhotosRes()
+}
+
+type LinkAttachmentRes interface {
+	linkAttachmentRes()
+}
+
 type PostAccommodationRes interface {
 	postAccommodationRes()
 }
@@ -93,10 +197,22 @@ type PostAttachmentRes interface {
 	postAttachmentRes()
 }
 
+type PostChangesRes interface {
+	postChangesRes()
+}
+
+type PostExpenseRes interface {
+	postExpenseRes()
+}
+
 type PostFlightRes interface {
 	postFlightRes()
 }
 
+type PostTrackRes interface {
+	postTrackRes()
+}
+
 type PostTrainJourneyRes interface {
 	postTrainJourneyRes()
 }
@@ -117,10 +233,22 @@ type PutActivityRes interface {
 	putActivityRes()
 }
 
+type PutBudgetRes interface {
+	putBudgetRes()
+}
+
+type PutExpenseRes interface {
+	putExpenseRes()
+}
+
 type PutFlightRes interface {
 	putFlightRes()
 }
 
+type PutTrackRes interface {
+	putTrackRes()
+}
+
 type PutTransportationRes interface {
 	putTransportationRes()
 }
@@ -128,3 +256,27 @@ type PutTransportationRes interface {
 type PutTripRes interface {
 	putTripRes()
 }
+
+type RestoreAccommodationRes interface {
+	restoreAccommodationRes()
+}
+
+type RestoreActivityRes interface {
+	restoreActivityRes()
+}
+
+type RestoreFromTrashRes interface {
+	restoreFromTrashRes()
+}
+
+type RestoreTransportationRes interface {
+	restoreTransportationRes()
+}
+
+type RestoreTripRes interface {
+	restoreTripRes()
+}
+
+type UnlinkAttachmentRes interface {
+	unlinkAttachmentRes()
+}
@@ -6,36 +6,74 @@ package api
 type OperationName = string
 
 const (
-	DeleteAccommodationOperation  OperationName = "DeleteAccommodation"
-	DeleteActivityOperation       OperationName = "DeleteActivity"
-	DeleteAttachmentOperation     OperationName = "DeleteAttachment"
-	DeleteTransportationOperation OperationName = "DeleteTransportation"
-	DeleteTripOperation           OperationName = "DeleteTrip"
-	DownloadAttachmentOperation   OperationName = "DownloadAttachment"
-	GetAccommodationByIDOperation OperationName = "GetAccommodationByID"
-	GetActivitiesOperation        OperationName = "GetActivities"
-	GetActivityOperation          OperationName = "GetActivity"
-	GetAllAccommodationOperation  OperationName = "GetAllAccommodation"
-	GetAllTransportationOperation OperationName = "GetAllTransportation"
-	GetAttachmentsOperation       OperationName = "GetAttachments"
-	GetGeoJsonOperation           OperationName = "GetGeoJson"
-	GetLocationOperation          OperationName = "GetLocation"
-	GetTrainStationOperation      OperationName = "GetTrainStation"
-	GetTransportationOperation    OperationName = "GetTransportation"
-	GetTripOperation              OperationName = "GetTrip"
-	GetTripsOperation             OperationName = "GetTrips"
-	GetUserOperation              OperationName = "GetUser"
-	GetUsersOperation             OperationName = "GetUsers"
-	PostAccommodationOperation    OperationName = "PostAccommodation"
-	PostActivityOperation         OperationName = "PostActivity"
-	PostAttachmentOperation       OperationName = "PostAttachment"
-	PostFlightOperation           OperationName = "PostFlight"
-	PostTrainJourneyOperation     OperationName = "PostTrainJourney"
-	PostTransportationOperation   OperationName = "PostTransportation"
-	PostTripOperation             OperationName = "PostTrip"
-	PutAccommodationOperation     OperationName = "PutAccommodation"
-	PutActivityOperation          OperationName = "PutActivity"
-	PutFlightOperation            OperationName = "PutFlight"
-	PutTransportationOperation    OperationName = "PutTransportation"
-	PutTripOperation              OperationName = "PutTrip"
+	CopyTripOperation               OperationName = "CopyTrip"
+	DeleteAccommodationOperation    OperationName = "DeleteAccommodation"
+	DeleteActivityOperation         OperationName = "DeleteActivity"
+	DeleteAttachmentOperation       OperationName = "DeleteAttachment"
+	DeleteExpenseOperation          OperationName = "DeleteExpense"
+	DeleteTrackOperation            OperationName = "DeleteTrack"
+	DeleteTransportationOperation   OperationName = "DeleteTransportation"
+	DeleteTripOperation             OperationName = "DeleteTrip"
+	DownloadAttachmentOperation     OperationName = "DownloadAttachment"
+	GetAccommodationByIDOperation   OperationName = "GetAccommodationByID"
+	GetActivitiesOperation          OperationName = "GetActivities"
+	GetActivityOperation            OperationName = "GetActivity"
+	GetAllAccommodationOperation    OperationName = "GetAllAccommodation"
+	GetAllTransportationOperation   OperationName = "GetAllTransportation"
+	GetAttachmentThumbnailOperation OperationName = "GetAttachmentThumbnail"
+	GetAttachmentURLOperation       OperationName = "GetAttachmentURL"
+	GetAttachmentsOperation         OperationName = "GetAttachments"
+	GetBudgetOperation              OperationName = "GetBudget"
+	GetBudgetReportOperation        OperationName = "GetBudgetReport"
+	GetChangesOperation             OperationName = "GetChanges"
+	GetChecksOperation              OperationName = "GetChecks"
+	GetCostsOperation               OperationName = "GetCosts"
+	GetEmissionsOperation           OperationName = "GetEmissions"
+	GetExpenseOperation             OperationName = "GetExpense"
+	GetExpensesOperation            OperationName = "GetExpenses"
+	GetGeoJsonOperation             OperationName = "GetGeoJson"
+	GetGpxOperation                 OperationName = "GetGpx"
+	GetHistoryOperation             OperationName = "GetHistory"
+	GetItineraryOperation           OperationName = "GetItinerary"
+	GetItineraryPdfOperation        OperationName = "GetItineraryPdf"
+	GetKmlOperation                 OperationName = "GetKml"
+	GetLocationOperation            OperationName = "GetLocation"
+	GetSettlementOperation          OperationName = "GetSettlement"
+	GetStatsOperation               OperationName = "GetStats"
+	GetTemplatesOperation           OperationName = "GetTemplates"
+	GetTrainStationOperation        OperationName = "GetTrainStation"
+	GetTransportationOperation      OperationName = "GetTransportation"
+	GetTrashOperation               OperationName = "GetTrash"
+	GetTripOperation                OperationName = "GetTrip"
+	GetTripEventsOperation          OperationName = "GetTripEvents"
+	GetTripTrashOperation           OperationName = "GetTripTrash"
+	GetTripsOperation               OperationName = "GetTrips"
+	GetUserOperation                OperationName = "GetUser"
+	GetUsersOperation               OperationName = "GetUsers"
+	ImportPhotosOperation           OperationName = "ImportPhotos"
+	LinkAttachmentOperation         OperationName = "LinkAttachment"
+	PostAccommodationOperation      OperationName = "PostAccommodation"
+	PostActivityOperation           OperationName = "PostActivity"
+	PostAttachmentOperation         OperationName = "PostAttachment"
+	PostChangesOperation            OperationName = "PostChanges"
+	PostExpenseOperation            OperationName = "PostExpense"
+	PostFlightOperation             OperationName = "PostFlight"
+	PostTrackOperation              OperationName = "PostTrack"
+	PostTrainJourneyOperation       OperationName = "PostTrainJourney"
+	PostTransportationOperation     OperationName = "PostTransportation"
+	PostTripOperation               OperationName = "PostTrip"
+	PutAccommodationOperation       OperationName = "PutAccommodation"
+	PutActivityOperation            OperationName = "PutActivity"
+	PutBudgetOperation              OperationName = "PutBudget"
+	PutExpenseOperation             OperationName = "PutExpense"
+	PutFlightOperation              OperationName = "PutFlight"
+	PutTrackOperation               OperationName = "PutTrack"
+	PutTransportationOperation      OperationName = "PutTransportation"
+	PutTripOperation                OperationName = "PutTrip"
+	RestoreAccommodationOperation   OperationName = "RestoreAccommodation"
+	RestoreActivityOperation        OperationName = "RestoreActivity"
+	RestoreFromTrashOperation       OperationName = "RestoreFromTrash"
+	RestoreTransportationOperation  OperationName = "RestoreTransportation"
+	RestoreTripOperation            OperationName = "RestoreTrip"
+	UnlinkAttachmentOperation       OperationName = "UnlinkAttachment"
 )
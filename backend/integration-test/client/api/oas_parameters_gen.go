@@ -2,12 +2,20 @@
 
 package api
 
+// CopyTripParams is parameters of copyTrip operation.
+type CopyTripParams struct {
+	// Trip ID.
+	TripID int
+}
+
 // DeleteAccommodationParams is parameters of deleteAccommodation operation.
 type DeleteAccommodationParams struct {
 	// Trip ID.
 	TripID int
 	// Accommodation ID.
 	AccommodationID int
+	// ETag the request is conditional on.
+	IfMatch OptString
 }
 
 // DeleteActivityParams is parameters of deleteActivity operation.
@@ -16,6 +24,8 @@ type DeleteActivityParams struct {
 	TripID int
 	// Activity ID.
 	ActivityID int
+	// ETag the request is conditional on.
+	IfMatch OptString
 }
 
 // DeleteAttachmentParams is parameters of deleteAttachment operation.
@@ -24,6 +34,28 @@ type DeleteAttachmentParams struct {
 	TripID int
 	// Attachment ID.
 	AttachmentID int
+	// Revision of the attachment as ETag the request is conditional on.
+	IfMatch OptString
+}
+
+// DeleteExpenseParams is parameters of deleteExpense operation.
+type DeleteExpenseParams struct {
+	// Trip ID.
+	TripID int
+	// Expense ID.
+	ExpenseID int
+	// ETag the request is conditional on.
+	IfMatch OptString
+}
+
+// DeleteTrackParams is parameters of deleteTrack operation.
+type DeleteTrackParams struct {
+	// Trip ID.
+	TripID int
+	// Transportation ID.
+	TransportationID int
+	// ETag of the transportation the request is conditional on.
+	IfMatch OptString
 }
 
 // DeleteTransportationParams is parameters of deleteTransportation operation.
@@ -32,12 +64,16 @@ type DeleteTransportationParams struct {
 	TripID int
 	// Transportation ID.
 	TransportationID int
+	// ETag the request is conditional on.
+	IfMatch OptString
 }
 
 // DeleteTripParams is parameters of deleteTrip operation.
 type DeleteTripParams struct {
 	// Trip ID.
 	TripID int
+	// ETag the request is conditional on.
+	IfMatch OptString
 }
 
 // DownloadAttachmentParams is parameters of downloadAttachment operation.
@@ -46,6 +82,12 @@ type DownloadAttachmentParams struct {
 	TripID int
 	// Attachment ID.
 	AttachmentID int
+	// Byte range, e.g. bytes=0-1023.
+	Range OptString
+	// ETag the range request is conditional on.
+	IfRange OptString
+	// ETag of a cached copy.
+	IfNoneMatch OptString
 }
 
 // GetAccommodationByIDParams is parameters of getAccommodationByID operation.
@@ -54,6 +96,8 @@ type GetAccommodationByIDParams struct {
 	TripID int
 	// Accommodation ID.
 	AccommodationID int
+	// ETag of a cached copy.
+	IfNoneMatch OptString
 }
 
 // GetActivitiesParams is parameters of getActivities operation.
@@ -68,6 +112,8 @@ type GetActivityParams struct {
 	TripID int
 	// Activity ID.
 	ActivityID int
+	// ETag of a cached copy.
+	IfNoneMatch OptString
 }
 
 // GetAllAccommodationParams is parameters of getAllAccommodation operation.
@@ -82,24 +128,150 @@ type GetAllTransportationParams struct {
 	TripID int
 }
 
+// GetAttachmentThumbnailParams is parameters of getAttachmentThumbnail operation.
+type GetAttachmentThumbnailParams struct {
+	// Trip ID.
+	TripID int
+	// Attachment ID.
+	AttachmentID int
+	// Thumbnail size.
+	Size OptGetAttachmentThumbnailSize
+	// ETag of a cached copy.
+	IfNoneMatch OptString
+}
+
+// GetAttachmentURLParams is parameters of getAttachmentURL operation.
+type GetAttachmentURLParams struct {
+	// Trip ID.
+	TripID int
+	// Attachment ID.
+	AttachmentID int
+}
+
 // GetAttachmentsParams is parameters of getAttachments operation.
 type GetAttachmentsParams struct {
 	// Trip ID.
 	TripID int
 }
 
+// GetBudgetParams is parameters of getBudget operation.
+type GetBudgetParams struct {
+	// Trip ID.
+	TripID int
+}
+
+// GetBudgetReportParams is parameters of getBudgetReport operation.
+type GetBudgetReportParams struct {
+	// Trip ID.
+	TripID int
+}
+
+// GetChangesParams is parameters of getChanges operation.
+type GetChangesParams struct {
+	// Trip ID.
+	TripID int
+	// Cursor of the previous request.
+	Since OptInt
+}
+
+// GetChecksParams is parameters of getChecks operation.
+type GetChecksParams struct {
+	// Trip ID.
+	TripID int
+}
+
+// GetCostsParams is parameters of getCosts operation.
+type GetCostsParams struct {
+	// Trip ID.
+	TripID int
+}
+
+// GetEmissionsParams is parameters of getEmissions operation.
+type GetEmissionsParams struct {
+	// Trip ID.
+	TripID int
+}
+
+// GetExpenseParams is parameters of getExpense operation.
+type GetExpenseParams struct {
+	// Trip ID.
+	TripID int
+	// Expense ID.
+	ExpenseID int
+	// ETag of a cached copy.
+	IfNoneMatch OptString
+}
+
+// GetExpensesParams is parameters of getExpenses operation.
+type GetExpensesParams struct {
+	// Trip ID.
+	TripID int
+}
+
 // GetGeoJsonParams is parameters of getGeoJson operation.
 type GetGeoJsonParams struct {
 	// Trip ID.
 	TripID int
 }
 
+// GetGpxParams is parameters of getGpx operation.
+type GetGpxParams struct {
+	// Trip ID.
+	TripID int
+}
+
+// GetHistoryParams is parameters of getHistory operation.
+type GetHistoryParams struct {
+	// Trip ID.
+	TripID int
+	// Kind of entity.
+	Kind OptString
+	// ID of entity, in combination with kind.
+	EntityId OptInt
+	// ID of the user who made the changes.
+	UserId OptInt
+	// Only entries older than this entry.
+	Before OptInt
+	// Maximum number of entries.
+	Limit OptInt
+}
+
+// GetItineraryParams is parameters of getItinerary operation.
+type GetItineraryParams struct {
+	// Trip ID.
+	TripID int
+}
+
+// GetItineraryPdfParams is parameters of getItineraryPdf operation.
+type GetItineraryPdfParams struct {
+	// Trip ID.
+	TripID int
+}
+
+// GetKmlParams is parameters of getKml operation.
+type GetKmlParams struct {
+	// Trip ID.
+	TripID int
+}
+
 // GetLocationParams is parameters of getLocation operation.
 type GetLocationParams struct {
 	// Location query.
 	Query string
 }
 
+// GetSettlementParams is parameters of getSettlement operation.
+type GetSettlementParams struct {
+	// Trip ID.
+	TripID int
+}
+
+// GetStatsParams is parameters of getStats operation.
+type GetStatsParams struct {
+	// Only count trips and legs of this year.
+	Year OptInt
+}
+
 // GetTrainStationParams is parameters of getTrainStation operation.
 type GetTrainStationParams struct {
 	// Station query.
@@ -112,12 +284,28 @@ type GetTransportationParams struct {
 	TripID int
 	// Transportation ID.
 	TransportationID int
+	// ETag of a cached copy.
+	IfNoneMatch OptString
 }
 
 // GetTripParams is parameters of getTrip operation.
 type GetTripParams struct {
 	// Trip ID.
 	TripID int
+	// ETag of a cached copy.
+	IfNoneMatch OptString
+}
+
+// GetTripEventsParams is parameters of getTripEvents operation.
+type GetTripEventsParams struct {
+	// Trip ID.
+	TripID int
+}
+
+// GetTripTrashParams is parameters of getTripTrash operation.
+type GetTripTrashParams struct {
+	// Trip ID.
+	TripID int
 }
 
 // GetUserParams is parameters of getUser operation.
@@ -126,6 +314,24 @@ type GetUserParams struct {
 	UserID int
 }
 
+// ImportPhotosParams is parameters of importPhotos operation.
+type ImportPhotosParams struct {
+	// Trip ID.
+	TripID int
+}
+
+// LinkAttachmentParams is parameters of linkAttachment operation.
+type LinkAttachmentParams struct {
+	// Trip ID.
+	TripID int
+	// Attachment ID.
+	AttachmentID int
+	// Booking type.
+	Target LinkAttachmentTarget
+	// Booking ID.
+	TargetID int
+}
+
 // PostAccommodationParams is parameters of postAccommodation operation.
 type PostAccommodationParams struct {
 	// Trip ID.
@@ -144,12 +350,32 @@ type PostAttachmentParams struct {
 	TripID int
 }
 
+// PostChangesParams is parameters of postChanges operation.
+type PostChangesParams struct {
+	// Trip ID.
+	TripID int
+}
+
+// PostExpenseParams is parameters of postExpense operation.
+type PostExpenseParams struct {
+	// Trip ID.
+	TripID int
+}
+
 // PostFlightParams is parameters of postFlight operation.
 type PostFlightParams struct {
 	// Trip ID.
 	TripID int
 }
 
+// PostTrackParams is parameters of postTrack operation.
+type PostTrackParams struct {
+	// Trip ID.
+	TripID int
+	// Transportation ID.
+	TransportationID int
+}
+
 // PostTrainJourneyParams is parameters of postTrainJourney operation.
 type PostTrainJourneyParams struct {
 	// Trip ID.
@@ -168,6 +394,8 @@ type PutAccommodationParams struct {
 	TripID int
 	// Accommodation ID.
 	AccommodationID int
+	// ETag the request is conditional on.
+	IfMatch OptString
 }
 
 // PutActivityParams is parameters of putActivity operation.
@@ -176,6 +404,24 @@ type PutActivityParams struct {
 	TripID int
 	// Activity ID.
 	ActivityID int
+	// ETag the request is conditional on.
+	IfMatch OptString
+}
+
+// PutBudgetParams is parameters of putBudget operation.
+type PutBudgetParams struct {
+	// Trip ID.
+	TripID int
+}
+
+// PutExpenseParams is parameters of putExpense operation.
+type PutExpenseParams struct {
+	// Trip ID.
+	TripID int
+	// Expense ID.
+	ExpenseID int
+	// ETag the request is conditional on.
+	IfMatch OptString
 }
 
 // PutFlightParams is parameters of putFlight operation.
@@ -184,6 +430,18 @@ type PutFlightParams struct {
 	TripID int
 	// Flight ID.
 	FlightID int
+	// ETag the request is conditional on.
+	IfMatch OptString
+}
+
+// PutTrackParams is parameters of putTrack operation.
+type PutTrackParams struct {
+	// Trip ID.
+	TripID int
+	// Transportation ID.
+	TransportationID int
+	// ETag of the transportation the request is conditional on.
+	IfMatch OptString
 }
 
 // PutTransportationParams is parameters of putTransportation operation.
@@ -192,10 +450,72 @@ type PutTransportationParams struct {
 	TripID int
 	// Transportation ID.
 	TransportationID int
+	// ETag the request is conditional on.
+	IfMatch OptString
 }
 
 // PutTripParams is parameters of putTrip operation.
 type PutTripParams struct {
 	// Trip ID.
 	TripID int
+	// ETag the request is conditional on.
+	IfMatch OptString
+}
+
+// RestoreAccommodationParams is parameters of restoreAccommodation operation.
+type RestoreAccommodationParams struct {
+	// Trip ID.
+	TripID int
+	// Accommodation ID.
+	AccommodationID int
+	// History entry ID.
+	EntryID int
+}
+
+// RestoreActivityParams is parameters of restoreActivity operation.
+type RestoreActivityParams struct {
+	// Trip ID.
+	TripID int
+	// Activity ID.
+	ActivityID int
+	// History entry ID.
+	EntryID int
+}
+
+// RestoreFromTrashParams is parameters of restoreFromTrash operation.
+type RestoreFromTrashParams struct {
+	// Trip ID.
+	TripID int
+	// Kind of entity.
+	Kind RestoreFromTrashKind
+	// Entity ID.
+	EntityID int
+}
+
+// RestoreTransportationParams is parameters of restoreTransportation operation.
+type RestoreTransportationParams struct {
+	// Trip ID.
+	TripID int
+	// Transportation ID.
+	TransportationID int
+	// History entry ID.
+	EntryID int
+}
+
+// RestoreTripParams is parameters of restoreTrip operation.
+type RestoreTripParams struct {
+	// Trip ID.
+	TripID int
+}
+
+// UnlinkAttachmentParams is parameters of unlinkAttachment operation.
+type UnlinkAttachmentParams struct {
+	// Trip ID.
+	TripID int
+	// Attachment ID.
+	AttachmentID int
+	// Booking type.
+	Target UnlinkAttachmentTarget
+	// Booking ID.
+	TargetID int
 }
@@ -3,6 +3,10 @@
 package api
 
 import (
+	"io"
+
+	"github.com/go-faster/errors"
+
 	ht "github.com/ogen-go/ogen/http"
 )
 
@@ -31,6 +35,22 @@ func (s *Bearerauth) SetRoles(val []string) {
 	s.Roles = val
 }
 
+type CopyTripBadRequest ResponseError
+
+func (*CopyTripBadRequest) copyTripRes() {}
+
+type CopyTripForbidden ResponseError
+
+func (*CopyTripForbidden) copyTripRes() {}
+
+type CopyTripInternalServerError ResponseError
+
+func (*CopyTripInternalServerError) copyTripRes() {}
+
+type CopyTripNotFound ResponseError
+
+func (*CopyTripNotFound) copyTripRes() {}
+
 type DeleteAccommodationBadRequest ResponseError
 
 func (*DeleteAccommodationBadRequest) deleteAccommodationRes() {}
@@ -52,6 +72,10 @@ type DeleteAccommodationNotFound ResponseError
 
 func (*DeleteAccommodationNotFound) deleteAccommodationRes() {}
 
+type DeleteAccommodationPreconditionFailed ResponseError
+
+func (*DeleteAccommodationPreconditionFailed) deleteAccommodationRes() {}
+
 type DeleteActivityBadRequest ResponseError
 
 func (*DeleteActivityBadRequest) deleteActivityRes() {}
@@ -69,6 +93,10 @@ type DeleteActivityNoContent struct{}
 
 func (*DeleteActivityNoContent) deleteActivityRes() {}
 
+type DeleteActivityPreconditionFailed ResponseError
+
+func (*DeleteActivityPreconditionFailed) deleteActivityRes() {}
+
 type DeleteAttachmentForbidden ResponseError
 
 func (*DeleteAttachmentForbidden) deleteAttachmentRes() {}
@@ -82,6 +110,56 @@ type DeleteAttachmentNoContent struct{}
 
 func (*DeleteAttachmentNoContent) deleteAttachmentRes() {}
 
+type DeleteAttachmentPreconditionFailed ResponseError
+
+func (*DeleteAttachmentPreconditionFailed) deleteAttachmentRes() {}
+
+type DeleteExpenseBadRequest ResponseError
+
+func (*DeleteExpenseBadRequest) deleteExpenseRes() {}
+
+type DeleteExpenseForbidden ResponseError
+
+func (*DeleteExpenseForbidden) deleteExpenseRes() {}
+
+type DeleteExpenseInternalServerError ResponseError
+
+func (*DeleteExpenseInternalServerError) deleteExpenseRes() {}
+
+// DeleteExpenseNoContent is response for DeleteExpense operation.
+type DeleteExpenseNoContent struct{}
+
+func (*DeleteExpenseNoContent) deleteExpenseRes() {}
+
+type DeleteExpenseNotFound ResponseError
+
+func (*DeleteExpenseNotFound) deleteExpenseRes() {}
+
+type DeleteExpensePreconditionFailed ResponseError
+
+func (*DeleteExpensePreconditionFailed) deleteExpenseRes() {}
+
+type DeleteTrackForbidden ResponseError
+
+func (*DeleteTrackForbidden) deleteTrackRes() {}
+
+type DeleteTrackInternalServerError ResponseError
+
+func (*DeleteTrackInternalServerError) deleteTrackRes() {}
+
+// DeleteTrackNoContent is response for DeleteTrack operation.
+type DeleteTrackNoContent struct{}
+
+func (*DeleteTrackNoContent) deleteTrackRes() {}
+
+type DeleteTrackNotFound ResponseError
+
+func (*DeleteTrackNotFound) deleteTrackRes() {}
+
+type DeleteTrackPreconditionFailed ResponseError
+
+func (*DeleteTrackPreconditionFailed) deleteTrackRes() {}
+
 type DeleteTransportationForbidden ResponseError
 
 func (*DeleteTransportationForbidden) deleteTransportationRes() {}
@@ -99,6 +177,10 @@ type DeleteTransportationNotFound ResponseError
 
 func (*DeleteTransportationNotFound) deleteTransportationRes() {}
 
+type DeleteTransportationPreconditionFailed ResponseError
+
+func (*DeleteTransportationPreconditionFailed) deleteTransportationRes() {}
+
 type DeleteTripBadRequest ResponseError
 
 func (*DeleteTripBadRequest) deleteTripRes() {}
@@ -116,6 +198,10 @@ type DeleteTripNoContent struct{}
 
 func (*DeleteTripNoContent) deleteTripRes() {}
 
+type DeleteTripPreconditionFailed ResponseError
+
+func (*DeleteTripPreconditionFailed) deleteTripRes() {}
+
 type DownloadAttachmentForbidden ResponseError
 
 func (*DownloadAttachmentForbidden) downloadAttachmentRes() {}
@@ -124,19 +210,80 @@ type DownloadAttachmentInternalServerError ResponseError
 
 func (*DownloadAttachmentInternalServerError) downloadAttachmentRes() {}
 
+type DownloadAttachmentNotFound ResponseError
+
+func (*DownloadAttachmentNotFound) downloadAttachmentRes() {}
+
+// DownloadAttachmentNotModified is response for DownloadAttachment operation.
+type DownloadAttachmentNotModified struct{}
+
+func (*DownloadAttachmentNotModified) downloadAttachmentRes() {}
+
+type DownloadAttachmentOKApplicationJSON string
+
+func (*DownloadAttachmentOKApplicationJSON) downloadAttachmentRes() {}
+
+type DownloadAttachmentOKApplicationOctetStream struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s DownloadAttachmentOKApplicationOctetStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*DownloadAttachmentOKApplicationOctetStream) downloadAttachmentRes() {}
+
+type DownloadAttachmentPartialContentApplicationJSON string
+
+func (*DownloadAttachmentPartialContentApplicationJSON) downloadAttachmentRes() {}
+
+type DownloadAttachmentPartialContentApplicationOctetStream struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s DownloadAttachmentPartialContentApplicationOctetStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*DownloadAttachmentPartialContentApplicationOctetStream) downloadAttachmentRes() {}
+
+type DownloadAttachmentRequestedRangeNotSatisfiable ResponseError
+
+func (*DownloadAttachmentRequestedRangeNotSatisfiable) downloadAttachmentRes() {}
+
+// Accommodation is where we sleep in the night following Date.
 // Ref: #/components/schemas/entity.Accommodation
 type EntityAccommodation struct {
-	Address       NilString         `json:"address"`
-	ArrivalDate   string            `json:"arrivalDate"`
-	CheckInTime   NilString         `json:"checkInTime"`
-	CheckOutTime  NilString         `json:"checkOutTime"`
-	DepartureDate string            `json:"departureDate"`
-	Description   NilString         `json:"description"`
-	ID            int               `json:"id"`
-	Location      NilEntityLocation `json:"location"`
-	Name          string            `json:"name"`
-	Price         NilInt            `json:"price"`
-	TripId        int               `json:"tripId"`
+	Address           NilString                  `json:"address"`
+	ArrivalDate       string                     `json:"arrivalDate"`
+	Attachments       []EntityAttachmentMetadata `json:"attachments"`
+	BudgetAlert       OptNilEntityBudgetAlert    `json:"budgetAlert"`
+	CheckInTime       NilString                  `json:"checkInTime"`
+	CheckInUtcOffset  NilString                  `json:"checkInUtcOffset"`
+	CheckOutTime      NilString                  `json:"checkOutTime"`
+	CheckOutUtcOffset NilString                  `json:"checkOutUtcOffset"`
+	Currency          string                     `json:"currency"`
+	DepartureDate     string                     `json:"departureDate"`
+	Description       NilString                  `json:"description"`
+	ID                int                        `json:"id"`
+	Location          NilEntityLocation          `json:"location"`
+	Name              string                     `json:"name"`
+	Price             NilInt                     `json:"price"`
+	Revision          int                        `json:"revision"`
+	Sensitive         bool                       `json:"sensitive"`
+	TripId            int                        `json:"tripId"`
 }
 
 // GetAddress returns the value of Address.
@@ -149,16 +296,41 @@ func (s *EntityAccommodation) GetArrivalDate() string {
 	return s.ArrivalDate
 }
 
+// GetAttachments returns the value of Attachments.
+func (s *EntityAccommodation) GetAttachments() []EntityAttachmentMetadata {
+	return s.Attachments
+}
+
+// GetBudgetAlert returns the value of BudgetAlert.
+func (s *EntityAccommodation) GetBudgetAlert() OptNilEntityBudgetAlert {
+	return s.BudgetAlert
+}
+
 // GetCheckInTime returns the value of CheckInTime.
 func (s *EntityAccommodation) GetCheckInTime() NilString {
 	return s.CheckInTime
 }
 
+// GetCheckInUtcOffset returns the value of CheckInUtcOffset.
+func (s *EntityAccommodation) GetCheckInUtcOffset() NilString {
+	return s.CheckInUtcOffset
+}
+
 // GetCheckOutTime returns the value of CheckOutTime.
 func (s *EntityAccommodation) GetCheckOutTime() NilString {
 	return s.CheckOutTime
 }
 
+// GetCheckOutUtcOffset returns the value of CheckOutUtcOffset.
+func (s *EntityAccommodation) GetCheckOutUtcOffset() NilString {
+	return s.CheckOutUtcOffset
+}
+
+// GetCurrency returns the value of Currency.
+func (s *EntityAccommodation) GetCurrency() string {
+	return s.Currency
+}
+
 // GetDepartureDate returns the value of DepartureDate.
 func (s *EntityAccommodation) GetDepartureDate() string {
 	return s.DepartureDate
@@ -189,6 +361,16 @@ func (s *EntityAccommodation) GetPrice() NilInt {
 	return s.Price
 }
 
+// GetRevision returns the value of Revision.
+func (s *EntityAccommodation) GetRevision() int {
+	return s.Revision
+}
+
+// GetSensitive returns the value of Sensitive.
+func (s *EntityAccommodation) GetSensitive() bool {
+	return s.Sensitive
+}
+
 // GetTripId returns the value of TripId.
 func (s *EntityAccommodation) GetTripId() int {
 	return s.TripId
@@ -204,16 +386,41 @@ func (s *EntityAccommodation) SetArrivalDate(val string) {
 	s.ArrivalDate = val
 }
 
+// SetAttachments sets the value of Attachments.
+func (s *EntityAccommodation) SetAttachments(val []EntityAttachmentMetadata) {
+	s.Attachments = val
+}
+
+// SetBudgetAlert sets the value of BudgetAlert.
+func (s *EntityAccommodation) SetBudgetAlert(val OptNilEntityBudgetAlert) {
+	s.BudgetAlert = val
+}
+
 // SetCheckInTime sets the value of CheckInTime.
 func (s *EntityAccommodation) SetCheckInTime(val NilString) {
 	s.CheckInTime = val
 }
 
+// SetCheckInUtcOffset sets the value of CheckInUtcOffset.
+func (s *EntityAccommodation) SetCheckInUtcOffset(val NilString) {
+	s.CheckInUtcOffset = val
+}
+
 // SetCheckOutTime sets the value of CheckOutTime.
 func (s *EntityAccommodation) SetCheckOutTime(val NilString) {
 	s.CheckOutTime = val
 }
 
+// SetCheckOutUtcOffset sets the value of CheckOutUtcOffset.
+func (s *EntityAccommodation) SetCheckOutUtcOffset(val NilString) {
+	s.CheckOutUtcOffset = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *EntityAccommodation) SetCurrency(val string) {
+	s.Currency = val
+}
+
 // SetDepartureDate sets the value of DepartureDate.
 func (s *EntityAccommodation) SetDepartureDate(val string) {
 	s.DepartureDate = val
@@ -244,6 +451,16 @@ func (s *EntityAccommodation) SetPrice(val NilInt) {
 	s.Price = val
 }
 
+// SetRevision sets the value of Revision.
+func (s *EntityAccommodation) SetRevision(val int) {
+	s.Revision = val
+}
+
+// SetSensitive sets the value of Sensitive.
+func (s *EntityAccommodation) SetSensitive(val bool) {
+	s.Sensitive = val
+}
+
 // SetTripId sets the value of TripId.
 func (s *EntityAccommodation) SetTripId(val int) {
 	s.TripId = val
@@ -251,18 +468,26 @@ func (s *EntityAccommodation) SetTripId(val int) {
 
 func (*EntityAccommodation) getAccommodationByIDRes() {}
 func (*EntityAccommodation) postAccommodationRes()    {}
+func (*EntityAccommodation) restoreAccommodationRes() {}
 
+// Proposal is an activity that has not been saved yet.
 // Ref: #/components/schemas/entity.Activity
 type EntityActivity struct {
-	Address     NilString         `json:"address"`
-	Date        string            `json:"date"`
-	Description NilString         `json:"description"`
-	ID          int               `json:"id"`
-	Location    NilEntityLocation `json:"location"`
-	Name        string            `json:"name"`
-	Price       NilInt            `json:"price"`
-	Time        NilString         `json:"time"`
-	TripId      int               `json:"tripId"`
+	Address     NilString                  `json:"address"`
+	Attachments []EntityAttachmentMetadata `json:"attachments"`
+	BudgetAlert OptNilEntityBudgetAlert    `json:"budgetAlert"`
+	Currency    string                     `json:"currency"`
+	Date        string                     `json:"date"`
+	Description NilString                  `json:"description"`
+	ID          int                        `json:"id"`
+	Location    NilEntityLocation          `json:"location"`
+	Name        string                     `json:"name"`
+	Price       NilInt                     `json:"price"`
+	Revision    int                        `json:"revision"`
+	Sensitive   bool                       `json:"sensitive"`
+	Time        NilString                  `json:"time"`
+	TripId      int                        `json:"tripId"`
+	UtcOffset   NilString                  `json:"utcOffset"`
 }
 
 // GetAddress returns the value of Address.
@@ -270,6 +495,21 @@ func (s *EntityActivity) GetAddress() NilString {
 	return s.Address
 }
 
+// GetAttachments returns the value of Attachments.
+func (s *EntityActivity) GetAttachments() []EntityAttachmentMetadata {
+	return s.Attachments
+}
+
+// GetBudgetAlert returns the value of BudgetAlert.
+func (s *EntityActivity) GetBudgetAlert() OptNilEntityBudgetAlert {
+	return s.BudgetAlert
+}
+
+// GetCurrency returns the value of Currency.
+func (s *EntityActivity) GetCurrency() string {
+	return s.Currency
+}
+
 // GetDate returns the value of Date.
 func (s *EntityActivity) GetDate() string {
 	return s.Date
@@ -300,6 +540,16 @@ func (s *EntityActivity) GetPrice() NilInt {
 	return s.Price
 }
 
+// GetRevision returns the value of Revision.
+func (s *EntityActivity) GetRevision() int {
+	return s.Revision
+}
+
+// GetSensitive returns the value of Sensitive.
+func (s *EntityActivity) GetSensitive() bool {
+	return s.Sensitive
+}
+
 // GetTime returns the value of Time.
 func (s *EntityActivity) GetTime() NilString {
 	return s.Time
@@ -310,11 +560,31 @@ func (s *EntityActivity) GetTripId() int {
 	return s.TripId
 }
 
+// GetUtcOffset returns the value of UtcOffset.
+func (s *EntityActivity) GetUtcOffset() NilString {
+	return s.UtcOffset
+}
+
 // SetAddress sets the value of Address.
 func (s *EntityActivity) SetAddress(val NilString) {
 	s.Address = val
 }
 
+// SetAttachments sets the value of Attachments.
+func (s *EntityActivity) SetAttachments(val []EntityAttachmentMetadata) {
+	s.Attachments = val
+}
+
+// SetBudgetAlert sets the value of BudgetAlert.
+func (s *EntityActivity) SetBudgetAlert(val OptNilEntityBudgetAlert) {
+	s.BudgetAlert = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *EntityActivity) SetCurrency(val string) {
+	s.Currency = val
+}
+
 // SetDate sets the value of Date.
 func (s *EntityActivity) SetDate(val string) {
 	s.Date = val
@@ -345,6 +615,16 @@ func (s *EntityActivity) SetPrice(val NilInt) {
 	s.Price = val
 }
 
+// SetRevision sets the value of Revision.
+func (s *EntityActivity) SetRevision(val int) {
+	s.Revision = val
+}
+
+// SetSensitive sets the value of Sensitive.
+func (s *EntityActivity) SetSensitive(val bool) {
+	s.Sensitive = val
+}
+
 // SetTime sets the value of Time.
 func (s *EntityActivity) SetTime(val NilString) {
 	s.Time = val
@@ -355,8 +635,14 @@ func (s *EntityActivity) SetTripId(val int) {
 	s.TripId = val
 }
 
-func (*EntityActivity) getActivityRes()  {}
-func (*EntityActivity) postActivityRes() {}
+// SetUtcOffset sets the value of UtcOffset.
+func (s *EntityActivity) SetUtcOffset(val NilString) {
+	s.UtcOffset = val
+}
+
+func (*EntityActivity) getActivityRes()     {}
+func (*EntityActivity) postActivityRes()    {}
+func (*EntityActivity) restoreActivityRes() {}
 
 // Ref: #/components/schemas/entity.Airport
 type EntityAirport struct {
@@ -445,15 +731,25 @@ func (s *EntityAmbiguousFlightChoice) SetOriginIata(val string) {
 
 // Ref: #/components/schemas/entity.Attachment
 type EntityAttachment struct {
-	Blob   []int  `json:"blob"`
-	ID     int    `json:"id"`
-	Name   string `json:"name"`
-	TripId int    `json:"tripId"`
+	ContentType string `json:"contentType"`
+	CreatedAt   string `json:"createdAt"`
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Revision    int    `json:"revision"`
+	SHA256      string `json:"sha256"`
+	Size        int    `json:"size"`
+	TripId      int    `json:"tripId"`
+	UploadedBy  NilInt `json:"uploadedBy"`
+}
+
+// GetContentType returns the value of ContentType.
+func (s *EntityAttachment) GetContentType() string {
+	return s.ContentType
 }
 
-// GetBlob returns the value of Blob.
-func (s *EntityAttachment) GetBlob() []int {
-	return s.Blob
+// GetCreatedAt returns the value of CreatedAt.
+func (s *EntityAttachment) GetCreatedAt() string {
+	return s.CreatedAt
 }
 
 // GetID returns the value of ID.
@@ -466,14 +762,39 @@ func (s *EntityAttachment) GetName() string {
 	return s.Name
 }
 
+// GetRevision returns the value of Revision.
+func (s *EntityAttachment) GetRevision() int {
+	return s.Revision
+}
+
+// GetSHA256 returns the value of SHA256.
+func (s *EntityAttachment) GetSHA256() string {
+	return s.SHA256
+}
+
+// GetSize returns the value of Size.
+func (s *EntityAttachment) GetSize() int {
+	return s.Size
+}
+
 // GetTripId returns the value of TripId.
 func (s *EntityAttachment) GetTripId() int {
 	return s.TripId
 }
 
-// SetBlob sets the value of Blob.
-func (s *EntityAttachment) SetBlob(val []int) {
-	s.Blob = val
+// GetUploadedBy returns the value of UploadedBy.
+func (s *EntityAttachment) GetUploadedBy() NilInt {
+	return s.UploadedBy
+}
+
+// SetContentType sets the value of ContentType.
+func (s *EntityAttachment) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *EntityAttachment) SetCreatedAt(val string) {
+	s.CreatedAt = val
 }
 
 // SetID sets the value of ID.
@@ -486,1008 +807,4632 @@ func (s *EntityAttachment) SetName(val string) {
 	s.Name = val
 }
 
+// SetRevision sets the value of Revision.
+func (s *EntityAttachment) SetRevision(val int) {
+	s.Revision = val
+}
+
+// SetSHA256 sets the value of SHA256.
+func (s *EntityAttachment) SetSHA256(val string) {
+	s.SHA256 = val
+}
+
+// SetSize sets the value of Size.
+func (s *EntityAttachment) SetSize(val int) {
+	s.Size = val
+}
+
 // SetTripId sets the value of TripId.
 func (s *EntityAttachment) SetTripId(val int) {
 	s.TripId = val
 }
 
-func (*EntityAttachment) downloadAttachmentRes() {}
+// SetUploadedBy sets the value of UploadedBy.
+func (s *EntityAttachment) SetUploadedBy(val NilInt) {
+	s.UploadedBy = val
+}
 
-// Ref: #/components/schemas/entity.ErrAmbiguousFlightRequest
-type EntityErrAmbiguousFlightRequest map[string][]EntityAmbiguousFlightChoice
+// Ref: #/components/schemas/entity.AttachmentMetadata
+type EntityAttachmentMetadata struct {
+	ContentType string `json:"contentType"`
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Size        int    `json:"size"`
+}
 
-func (s *EntityErrAmbiguousFlightRequest) init() EntityErrAmbiguousFlightRequest {
-	m := *s
-	if m == nil {
-		m = map[string][]EntityAmbiguousFlightChoice{}
-		*s = m
-	}
-	return m
+// GetContentType returns the value of ContentType.
+func (s *EntityAttachmentMetadata) GetContentType() string {
+	return s.ContentType
 }
 
-func (*EntityErrAmbiguousFlightRequest) postFlightRes() {}
+// GetID returns the value of ID.
+func (s *EntityAttachmentMetadata) GetID() int {
+	return s.ID
+}
 
-// Ref: #/components/schemas/entity.FlightDetail
-type EntityFlightDetail struct {
-	Legs []EntityFlightLeg `json:"legs"`
-	Pnrs []EntityPNR       `json:"pnrs"`
+// GetName returns the value of Name.
+func (s *EntityAttachmentMetadata) GetName() string {
+	return s.Name
 }
 
-// GetLegs returns the value of Legs.
-func (s *EntityFlightDetail) GetLegs() []EntityFlightLeg {
-	return s.Legs
+// GetSize returns the value of Size.
+func (s *EntityAttachmentMetadata) GetSize() int {
+	return s.Size
 }
 
-// GetPnrs returns the value of Pnrs.
-func (s *EntityFlightDetail) GetPnrs() []EntityPNR {
-	return s.Pnrs
+// SetContentType sets the value of ContentType.
+func (s *EntityAttachmentMetadata) SetContentType(val string) {
+	s.ContentType = val
 }
 
-// SetLegs sets the value of Legs.
-func (s *EntityFlightDetail) SetLegs(val []EntityFlightLeg) {
-	s.Legs = val
+// SetID sets the value of ID.
+func (s *EntityAttachmentMetadata) SetID(val int) {
+	s.ID = val
 }
 
-// SetPnrs sets the value of Pnrs.
-func (s *EntityFlightDetail) SetPnrs(val []EntityPNR) {
-	s.Pnrs = val
+// SetName sets the value of Name.
+func (s *EntityAttachmentMetadata) SetName(val string) {
+	s.Name = val
 }
 
-// Ref: #/components/schemas/entity.FlightLeg
-type EntityFlightLeg struct {
-	Aircraft          NilString     `json:"aircraft"`
-	Airline           string        `json:"airline"`
-	AmadeusFlightDate NilString     `json:"amadeusFlightDate"`
-	ArrivalDateTime   string        `json:"arrivalDateTime"`
-	DepartureDateTime string        `json:"departureDateTime"`
-	Destination       EntityAirport `json:"destination"`
-	DurationInMinutes int           `json:"durationInMinutes"`
-	FlightNumber      string        `json:"flightNumber"`
-	ID                int           `json:"id"`
-	Origin            EntityAirport `json:"origin"`
+// SetSize sets the value of Size.
+func (s *EntityAttachmentMetadata) SetSize(val int) {
+	s.Size = val
 }
 
-// GetAircraft returns the value of Aircraft.
-func (s *EntityFlightLeg) GetAircraft() NilString {
-	return s.Aircraft
+// Ref: #/components/schemas/entity.AttachmentURL
+type EntityAttachmentURL struct {
+	ExpiresAt string `json:"expiresAt"`
+	URL       string `json:"url"`
 }
 
-// GetAirline returns the value of Airline.
-func (s *EntityFlightLeg) GetAirline() string {
-	return s.Airline
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *EntityAttachmentURL) GetExpiresAt() string {
+	return s.ExpiresAt
 }
 
-// GetAmadeusFlightDate returns the value of AmadeusFlightDate.
-func (s *EntityFlightLeg) GetAmadeusFlightDate() NilString {
-	return s.AmadeusFlightDate
+// GetURL returns the value of URL.
+func (s *EntityAttachmentURL) GetURL() string {
+	return s.URL
 }
 
-// GetArrivalDateTime returns the value of ArrivalDateTime.
-func (s *EntityFlightLeg) GetArrivalDateTime() string {
-	return s.ArrivalDateTime
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *EntityAttachmentURL) SetExpiresAt(val string) {
+	s.ExpiresAt = val
 }
 
-// GetDepartureDateTime returns the value of DepartureDateTime.
-func (s *EntityFlightLeg) GetDepartureDateTime() string {
-	return s.DepartureDateTime
+// SetURL sets the value of URL.
+func (s *EntityAttachmentURL) SetURL(val string) {
+	s.URL = val
 }
 
-// GetDestination returns the value of Destination.
-func (s *EntityFlightLeg) GetDestination() EntityAirport {
-	return s.Destination
+func (*EntityAttachmentURL) getAttachmentURLRes() {}
+
+// Ref: #/components/schemas/entity.Budget
+type EntityBudget struct {
+	Categories []EntityCategoryBudget `json:"categories"`
+	Total      NilFloat64             `json:"total"`
 }
 
-// GetDurationInMinutes returns the value of DurationInMinutes.
-func (s *EntityFlightLeg) GetDurationInMinutes() int {
-	return s.DurationInMinutes
+// GetCategories returns the value of Categories.
+func (s *EntityBudget) GetCategories() []EntityCategoryBudget {
+	return s.Categories
 }
 
-// GetFlightNumber returns the value of FlightNumber.
-func (s *EntityFlightLeg) GetFlightNumber() string {
-	return s.FlightNumber
+// GetTotal returns the value of Total.
+func (s *EntityBudget) GetTotal() NilFloat64 {
+	return s.Total
 }
 
-// GetID returns the value of ID.
-func (s *EntityFlightLeg) GetID() int {
-	return s.ID
+// SetCategories sets the value of Categories.
+func (s *EntityBudget) SetCategories(val []EntityCategoryBudget) {
+	s.Categories = val
 }
 
-// GetOrigin returns the value of Origin.
-func (s *EntityFlightLeg) GetOrigin() EntityAirport {
-	return s.Origin
+// SetTotal sets the value of Total.
+func (s *EntityBudget) SetTotal(val NilFloat64) {
+	s.Total = val
 }
 
-// SetAircraft sets the value of Aircraft.
-func (s *EntityFlightLeg) SetAircraft(val NilString) {
-	s.Aircraft = val
+func (*EntityBudget) getBudgetRes() {}
+
+// Ref: #/components/schemas/entity.BudgetAlert
+type EntityBudgetAlert struct {
+	Category EntityCostCategory `json:"category"`
+	Planned  float64            `json:"planned"`
+	Spent    float64            `json:"spent"`
 }
 
-// SetAirline sets the value of Airline.
-func (s *EntityFlightLeg) SetAirline(val string) {
-	s.Airline = val
+// GetCategory returns the value of Category.
+func (s *EntityBudgetAlert) GetCategory() EntityCostCategory {
+	return s.Category
 }
 
-// SetAmadeusFlightDate sets the value of AmadeusFlightDate.
-func (s *EntityFlightLeg) SetAmadeusFlightDate(val NilString) {
-	s.AmadeusFlightDate = val
+// GetPlanned returns the value of Planned.
+func (s *EntityBudgetAlert) GetPlanned() float64 {
+	return s.Planned
 }
 
-// SetArrivalDateTime sets the value of ArrivalDateTime.
-func (s *EntityFlightLeg) SetArrivalDateTime(val string) {
-	s.ArrivalDateTime = val
+// GetSpent returns the value of Spent.
+func (s *EntityBudgetAlert) GetSpent() float64 {
+	return s.Spent
 }
 
-// SetDepartureDateTime sets the value of DepartureDateTime.
-func (s *EntityFlightLeg) SetDepartureDateTime(val string) {
-	s.DepartureDateTime = val
+// SetCategory sets the value of Category.
+func (s *EntityBudgetAlert) SetCategory(val EntityCostCategory) {
+	s.Category = val
 }
 
-// SetDestination sets the value of Destination.
-func (s *EntityFlightLeg) SetDestination(val EntityAirport) {
-	s.Destination = val
+// SetPlanned sets the value of Planned.
+func (s *EntityBudgetAlert) SetPlanned(val float64) {
+	s.Planned = val
 }
 
-// SetDurationInMinutes sets the value of DurationInMinutes.
-func (s *EntityFlightLeg) SetDurationInMinutes(val int) {
-	s.DurationInMinutes = val
+// SetSpent sets the value of Spent.
+func (s *EntityBudgetAlert) SetSpent(val float64) {
+	s.Spent = val
 }
 
-// SetFlightNumber sets the value of FlightNumber.
-func (s *EntityFlightLeg) SetFlightNumber(val string) {
-	s.FlightNumber = val
+// Ref: #/components/schemas/entity.BudgetReport
+type EntityBudgetReport struct {
+	Categories    []EntityCategoryBudgetReport `json:"categories"`
+	DailyBurnRate float64                      `json:"dailyBurnRate"`
+	HomeCurrency  string                       `json:"homeCurrency"`
+	Planned       NilFloat64                   `json:"planned"`
+	Remaining     NilFloat64                   `json:"remaining"`
+	Spent         float64                      `json:"spent"`
 }
 
-// SetID sets the value of ID.
-func (s *EntityFlightLeg) SetID(val int) {
-	s.ID = val
+// GetCategories returns the value of Categories.
+func (s *EntityBudgetReport) GetCategories() []EntityCategoryBudgetReport {
+	return s.Categories
 }
 
-// SetOrigin sets the value of Origin.
-func (s *EntityFlightLeg) SetOrigin(val EntityAirport) {
-	s.Origin = val
+// GetDailyBurnRate returns the value of DailyBurnRate.
+func (s *EntityBudgetReport) GetDailyBurnRate() float64 {
+	return s.DailyBurnRate
 }
 
-// Ref: #/components/schemas/entity.GenericDetail
-type EntityGenericDetail struct {
-	DestinationAddress NilString `json:"destinationAddress"`
-	Name               string    `json:"name"`
-	OriginAddress      NilString `json:"originAddress"`
+// GetHomeCurrency returns the value of HomeCurrency.
+func (s *EntityBudgetReport) GetHomeCurrency() string {
+	return s.HomeCurrency
 }
 
-// GetDestinationAddress returns the value of DestinationAddress.
-func (s *EntityGenericDetail) GetDestinationAddress() NilString {
-	return s.DestinationAddress
+// GetPlanned returns the value of Planned.
+func (s *EntityBudgetReport) GetPlanned() NilFloat64 {
+	return s.Planned
 }
 
-// GetName returns the value of Name.
-func (s *EntityGenericDetail) GetName() string {
-	return s.Name
+// GetRemaining returns the value of Remaining.
+func (s *EntityBudgetReport) GetRemaining() NilFloat64 {
+	return s.Remaining
 }
 
-// GetOriginAddress returns the value of OriginAddress.
-func (s *EntityGenericDetail) GetOriginAddress() NilString {
-	return s.OriginAddress
+// GetSpent returns the value of Spent.
+func (s *EntityBudgetReport) GetSpent() float64 {
+	return s.Spent
 }
 
-// SetDestinationAddress sets the value of DestinationAddress.
-func (s *EntityGenericDetail) SetDestinationAddress(val NilString) {
-	s.DestinationAddress = val
+// SetCategories sets the value of Categories.
+func (s *EntityBudgetReport) SetCategories(val []EntityCategoryBudgetReport) {
+	s.Categories = val
 }
 
-// SetName sets the value of Name.
-func (s *EntityGenericDetail) SetName(val string) {
-	s.Name = val
+// SetDailyBurnRate sets the value of DailyBurnRate.
+func (s *EntityBudgetReport) SetDailyBurnRate(val float64) {
+	s.DailyBurnRate = val
 }
 
-// SetOriginAddress sets the value of OriginAddress.
-func (s *EntityGenericDetail) SetOriginAddress(val NilString) {
-	s.OriginAddress = val
+// SetHomeCurrency sets the value of HomeCurrency.
+func (s *EntityBudgetReport) SetHomeCurrency(val string) {
+	s.HomeCurrency = val
 }
 
-// Ref: #/components/schemas/entity.Location
-type EntityLocation struct {
-	ID        int     `json:"id"`
-	Latitude  float64 `json:"latitude"`
-	Longitude float64 `json:"longitude"`
+// SetPlanned sets the value of Planned.
+func (s *EntityBudgetReport) SetPlanned(val NilFloat64) {
+	s.Planned = val
 }
 
-// GetID returns the value of ID.
-func (s *EntityLocation) GetID() int {
-	return s.ID
+// SetRemaining sets the value of Remaining.
+func (s *EntityBudgetReport) SetRemaining(val NilFloat64) {
+	s.Remaining = val
 }
 
-// GetLatitude returns the value of Latitude.
-func (s *EntityLocation) GetLatitude() float64 {
-	return s.Latitude
+// SetSpent sets the value of Spent.
+func (s *EntityBudgetReport) SetSpent(val float64) {
+	s.Spent = val
 }
 
-// GetLongitude returns the value of Longitude.
-func (s *EntityLocation) GetLongitude() float64 {
-	return s.Longitude
+func (*EntityBudgetReport) getBudgetReportRes() {}
+
+type EntityCabinClass string
+
+// Ref: #/components/schemas/entity.CategoryBudget
+type EntityCategoryBudget struct {
+	Amount   float64 `json:"amount"`
+	Category string  `json:"category"`
 }
 
-// SetID sets the value of ID.
-func (s *EntityLocation) SetID(val int) {
-	s.ID = val
+// GetAmount returns the value of Amount.
+func (s *EntityCategoryBudget) GetAmount() float64 {
+	return s.Amount
 }
 
-// SetLatitude sets the value of Latitude.
-func (s *EntityLocation) SetLatitude(val float64) {
-	s.Latitude = val
+// GetCategory returns the value of Category.
+func (s *EntityCategoryBudget) GetCategory() string {
+	return s.Category
 }
 
-// SetLongitude sets the value of Longitude.
-func (s *EntityLocation) SetLongitude(val float64) {
-	s.Longitude = val
+// SetAmount sets the value of Amount.
+func (s *EntityCategoryBudget) SetAmount(val float64) {
+	s.Amount = val
 }
 
-// Ref: #/components/schemas/entity.PNR
-type EntityPNR struct {
-	Airline string `json:"airline"`
-	ID      int    `json:"id"`
-	Pnr     string `json:"pnr"`
+// SetCategory sets the value of Category.
+func (s *EntityCategoryBudget) SetCategory(val string) {
+	s.Category = val
 }
 
-// GetAirline returns the value of Airline.
-func (s *EntityPNR) GetAirline() string {
-	return s.Airline
+// Ref: #/components/schemas/entity.CategoryBudgetReport
+type EntityCategoryBudgetReport struct {
+	Category  string     `json:"category"`
+	Exceeded  bool       `json:"exceeded"`
+	Planned   NilFloat64 `json:"planned"`
+	Remaining NilFloat64 `json:"remaining"`
+	Spent     float64    `json:"spent"`
 }
 
-// GetID returns the value of ID.
-func (s *EntityPNR) GetID() int {
-	return s.ID
+// GetCategory returns the value of Category.
+func (s *EntityCategoryBudgetReport) GetCategory() string {
+	return s.Category
 }
 
-// GetPnr returns the value of Pnr.
-func (s *EntityPNR) GetPnr() string {
-	return s.Pnr
+// GetExceeded returns the value of Exceeded.
+func (s *EntityCategoryBudgetReport) GetExceeded() bool {
+	return s.Exceeded
 }
 
-// SetAirline sets the value of Airline.
-func (s *EntityPNR) SetAirline(val string) {
-	s.Airline = val
+// GetPlanned returns the value of Planned.
+func (s *EntityCategoryBudgetReport) GetPlanned() NilFloat64 {
+	return s.Planned
 }
 
-// SetID sets the value of ID.
-func (s *EntityPNR) SetID(val int) {
-	s.ID = val
+// GetRemaining returns the value of Remaining.
+func (s *EntityCategoryBudgetReport) GetRemaining() NilFloat64 {
+	return s.Remaining
 }
 
-// SetPnr sets the value of Pnr.
-func (s *EntityPNR) SetPnr(val string) {
-	s.Pnr = val
+// GetSpent returns the value of Spent.
+func (s *EntityCategoryBudgetReport) GetSpent() float64 {
+	return s.Spent
 }
 
-// Ref: #/components/schemas/entity.TrainDetail
-type EntityTrainDetail struct {
-	Legs         []EntityTrainLeg `json:"legs"`
-	RefreshToken string           `json:"refreshToken"`
+// SetCategory sets the value of Category.
+func (s *EntityCategoryBudgetReport) SetCategory(val string) {
+	s.Category = val
 }
 
-// GetLegs returns the value of Legs.
-func (s *EntityTrainDetail) GetLegs() []EntityTrainLeg {
-	return s.Legs
+// SetExceeded sets the value of Exceeded.
+func (s *EntityCategoryBudgetReport) SetExceeded(val bool) {
+	s.Exceeded = val
 }
 
-// GetRefreshToken returns the value of RefreshToken.
-func (s *EntityTrainDetail) GetRefreshToken() string {
-	return s.RefreshToken
+// SetPlanned sets the value of Planned.
+func (s *EntityCategoryBudgetReport) SetPlanned(val NilFloat64) {
+	s.Planned = val
 }
 
-// SetLegs sets the value of Legs.
-func (s *EntityTrainDetail) SetLegs(val []EntityTrainLeg) {
-	s.Legs = val
+// SetRemaining sets the value of Remaining.
+func (s *EntityCategoryBudgetReport) SetRemaining(val NilFloat64) {
+	s.Remaining = val
 }
 
-// SetRefreshToken sets the value of RefreshToken.
-func (s *EntityTrainDetail) SetRefreshToken(val string) {
-	s.RefreshToken = val
+// SetSpent sets the value of Spent.
+func (s *EntityCategoryBudgetReport) SetSpent(val float64) {
+	s.Spent = val
 }
 
-// Ref: #/components/schemas/entity.TrainLeg
-type EntityTrainLeg struct {
-	ArrivalDateTime   string             `json:"arrivalDateTime"`
-	DepartureDateTime string             `json:"departureDateTime"`
-	Destination       EntityTrainStation `json:"destination"`
-	DurationInMinutes int                `json:"durationInMinutes"`
-	ID                int                `json:"id"`
-	LineName          string             `json:"lineName"`
-	OperatorName      string             `json:"operatorName"`
-	Origin            EntityTrainStation `json:"origin"`
+// Ref: #/components/schemas/entity.CategoryCosts
+type EntityCategoryCosts struct {
+	Category string  `json:"category"`
+	Total    float64 `json:"total"`
 }
 
-// GetArrivalDateTime returns the value of ArrivalDateTime.
-func (s *EntityTrainLeg) GetArrivalDateTime() string {
-	return s.ArrivalDateTime
+// GetCategory returns the value of Category.
+func (s *EntityCategoryCosts) GetCategory() string {
+	return s.Category
 }
 
-// GetDepartureDateTime returns the value of DepartureDateTime.
-func (s *EntityTrainLeg) GetDepartureDateTime() string {
-	return s.DepartureDateTime
+// GetTotal returns the value of Total.
+func (s *EntityCategoryCosts) GetTotal() float64 {
+	return s.Total
 }
 
-// GetDestination returns the value of Destination.
-func (s *EntityTrainLeg) GetDestination() EntityTrainStation {
-	return s.Destination
+// SetCategory sets the value of Category.
+func (s *EntityCategoryCosts) SetCategory(val string) {
+	s.Category = val
 }
 
-// GetDurationInMinutes returns the value of DurationInMinutes.
-func (s *EntityTrainLeg) GetDurationInMinutes() int {
-	return s.DurationInMinutes
+// SetTotal sets the value of Total.
+func (s *EntityCategoryCosts) SetTotal(val float64) {
+	s.Total = val
+}
+
+// Ref: #/components/schemas/entity.ChangeResult
+type EntityChangeResult struct {
+	Error    OptString          `json:"error"`
+	ID       NilInt             `json:"id"`
+	Kind     string             `json:"kind"`
+	Revision NilInt             `json:"revision"`
+	Status   EntityChangeStatus `json:"status"`
+}
+
+// GetError returns the value of Error.
+func (s *EntityChangeResult) GetError() OptString {
+	return s.Error
 }
 
 // GetID returns the value of ID.
-func (s *EntityTrainLeg) GetID() int {
+func (s *EntityChangeResult) GetID() NilInt {
 	return s.ID
 }
 
-// GetLineName returns the value of LineName.
-func (s *EntityTrainLeg) GetLineName() string {
-	return s.LineName
+// GetKind returns the value of Kind.
+func (s *EntityChangeResult) GetKind() string {
+	return s.Kind
 }
 
-// GetOperatorName returns the value of OperatorName.
-func (s *EntityTrainLeg) GetOperatorName() string {
-	return s.OperatorName
+// GetRevision returns the value of Revision.
+func (s *EntityChangeResult) GetRevision() NilInt {
+	return s.Revision
 }
 
-// GetOrigin returns the value of Origin.
-func (s *EntityTrainLeg) GetOrigin() EntityTrainStation {
-	return s.Origin
+// GetStatus returns the value of Status.
+func (s *EntityChangeResult) GetStatus() EntityChangeStatus {
+	return s.Status
 }
 
-// SetArrivalDateTime sets the value of ArrivalDateTime.
-func (s *EntityTrainLeg) SetArrivalDateTime(val string) {
-	s.ArrivalDateTime = val
+// SetError sets the value of Error.
+func (s *EntityChangeResult) SetError(val OptString) {
+	s.Error = val
 }
 
-// SetDepartureDateTime sets the value of DepartureDateTime.
-func (s *EntityTrainLeg) SetDepartureDateTime(val string) {
-	s.DepartureDateTime = val
+// SetID sets the value of ID.
+func (s *EntityChangeResult) SetID(val NilInt) {
+	s.ID = val
 }
 
-// SetDestination sets the value of Destination.
-func (s *EntityTrainLeg) SetDestination(val EntityTrainStation) {
-	s.Destination = val
+// SetKind sets the value of Kind.
+func (s *EntityChangeResult) SetKind(val string) {
+	s.Kind = val
 }
 
-// SetDurationInMinutes sets the value of DurationInMinutes.
-func (s *EntityTrainLeg) SetDurationInMinutes(val int) {
-	s.DurationInMinutes = val
+// SetRevision sets the value of Revision.
+func (s *EntityChangeResult) SetRevision(val NilInt) {
+	s.Revision = val
 }
 
-// SetID sets the value of ID.
-func (s *EntityTrainLeg) SetID(val int) {
-	s.ID = val
+// SetStatus sets the value of Status.
+func (s *EntityChangeResult) SetStatus(val EntityChangeStatus) {
+	s.Status = val
 }
 
-// SetLineName sets the value of LineName.
-func (s *EntityTrainLeg) SetLineName(val string) {
-	s.LineName = val
+type EntityChangeStatus string
+
+type EntityChangeType string
+
+// Ref: #/components/schemas/entity.Changes
+type EntityChanges struct {
+	Accommodation  []EntityAccommodation  `json:"accommodation"`
+	Activities     []EntityActivity       `json:"activities"`
+	Attachments    []EntityAttachment     `json:"attachments"`
+	Cursor         int                    `json:"cursor"`
+	Deleted        []EntityTombstone      `json:"deleted"`
+	Expenses       []EntityExpense        `json:"expenses"`
+	Transportation []EntityTransportation `json:"transportation"`
+	Trip           OptEntityTrip          `json:"trip"`
 }
 
-// SetOperatorName sets the value of OperatorName.
-func (s *EntityTrainLeg) SetOperatorName(val string) {
-	s.OperatorName = val
+// GetAccommodation returns the value of Accommodation.
+func (s *EntityChanges) GetAccommodation() []EntityAccommodation {
+	return s.Accommodation
 }
 
-// SetOrigin sets the value of Origin.
-func (s *EntityTrainLeg) SetOrigin(val EntityTrainStation) {
-	s.Origin = val
+// GetActivities returns the value of Activities.
+func (s *EntityChanges) GetActivities() []EntityActivity {
+	return s.Activities
 }
 
-// Ref: #/components/schemas/entity.TrainStation
-type EntityTrainStation struct {
-	ID       string            `json:"id"`
-	Location NilEntityLocation `json:"location"`
-	Name     string            `json:"name"`
+// GetAttachments returns the value of Attachments.
+func (s *EntityChanges) GetAttachments() []EntityAttachment {
+	return s.Attachments
 }
 
-// GetID returns the value of ID.
-func (s *EntityTrainStation) GetID() string {
-	return s.ID
+// GetCursor returns the value of Cursor.
+func (s *EntityChanges) GetCursor() int {
+	return s.Cursor
 }
 
-// GetLocation returns the value of Location.
-func (s *EntityTrainStation) GetLocation() NilEntityLocation {
-	return s.Location
+// GetDeleted returns the value of Deleted.
+func (s *EntityChanges) GetDeleted() []EntityTombstone {
+	return s.Deleted
 }
 
-// GetName returns the value of Name.
-func (s *EntityTrainStation) GetName() string {
-	return s.Name
+// GetExpenses returns the value of Expenses.
+func (s *EntityChanges) GetExpenses() []EntityExpense {
+	return s.Expenses
 }
 
-// SetID sets the value of ID.
-func (s *EntityTrainStation) SetID(val string) {
-	s.ID = val
+// GetTransportation returns the value of Transportation.
+func (s *EntityChanges) GetTransportation() []EntityTransportation {
+	return s.Transportation
 }
 
-// SetLocation sets the value of Location.
-func (s *EntityTrainStation) SetLocation(val NilEntityLocation) {
-	s.Location = val
+// GetTrip returns the value of Trip.
+func (s *EntityChanges) GetTrip() OptEntityTrip {
+	return s.Trip
 }
 
-// SetName sets the value of Name.
-func (s *EntityTrainStation) SetName(val string) {
-	s.Name = val
+// SetAccommodation sets the value of Accommodation.
+func (s *EntityChanges) SetAccommodation(val []EntityAccommodation) {
+	s.Accommodation = val
 }
 
-func (*EntityTrainStation) getTrainStationRes() {}
+// SetActivities sets the value of Activities.
+func (s *EntityChanges) SetActivities(val []EntityActivity) {
+	s.Activities = val
+}
 
-// Ref: #/components/schemas/entity.Transportation
-type EntityTransportation struct {
-	ArrivalDateTime   string                    `json:"arrivalDateTime"`
-	DepartureDateTime string                    `json:"departureDateTime"`
-	Destination       NilEntityLocation         `json:"destination"`
-	FlightDetail      OptNilEntityFlightDetail  `json:"flightDetail"`
-	GenericDetail     OptNilEntityGenericDetail `json:"genericDetail"`
-	ID                int                       `json:"id"`
-	Origin            NilEntityLocation         `json:"origin"`
-	Price             NilInt                    `json:"price"`
-	TrainDetail       OptNilEntityTrainDetail   `json:"trainDetail"`
-	TripId            int                       `json:"tripId"`
-	Type              EntityTransportationType  `json:"type"`
+// SetAttachments sets the value of Attachments.
+func (s *EntityChanges) SetAttachments(val []EntityAttachment) {
+	s.Attachments = val
 }
 
-// GetArrivalDateTime returns the value of ArrivalDateTime.
-func (s *EntityTransportation) GetArrivalDateTime() string {
-	return s.ArrivalDateTime
+// SetCursor sets the value of Cursor.
+func (s *EntityChanges) SetCursor(val int) {
+	s.Cursor = val
 }
 
-// GetDepartureDateTime returns the value of DepartureDateTime.
-func (s *EntityTransportation) GetDepartureDateTime() string {
-	return s.DepartureDateTime
+// SetDeleted sets the value of Deleted.
+func (s *EntityChanges) SetDeleted(val []EntityTombstone) {
+	s.Deleted = val
 }
 
-// GetDestination returns the value of Destination.
-func (s *EntityTransportation) GetDestination() NilEntityLocation {
-	return s.Destination
+// SetExpenses sets the value of Expenses.
+func (s *EntityChanges) SetExpenses(val []EntityExpense) {
+	s.Expenses = val
 }
 
-// GetFlightDetail returns the value of FlightDetail.
-func (s *EntityTransportation) GetFlightDetail() OptNilEntityFlightDetail {
-	return s.FlightDetail
+// SetTransportation sets the value of Transportation.
+func (s *EntityChanges) SetTransportation(val []EntityTransportation) {
+	s.Transportation = val
 }
 
-// GetGenericDetail returns the value of GenericDetail.
-func (s *EntityTransportation) GetGenericDetail() OptNilEntityGenericDetail {
-	return s.GenericDetail
+// SetTrip sets the value of Trip.
+func (s *EntityChanges) SetTrip(val OptEntityTrip) {
+	s.Trip = val
+}
+
+func (*EntityChanges) getChangesRes() {}
+
+type EntityCheckType string
+
+// Ref: #/components/schemas/entity.CheckedEntity
+type EntityCheckedEntity struct {
+	ID   int    `json:"id"`
+	Kind string `json:"kind"`
+	Name string `json:"name"`
 }
 
 // GetID returns the value of ID.
-func (s *EntityTransportation) GetID() int {
+func (s *EntityCheckedEntity) GetID() int {
 	return s.ID
 }
 
-// GetOrigin returns the value of Origin.
-func (s *EntityTransportation) GetOrigin() NilEntityLocation {
-	return s.Origin
+// GetKind returns the value of Kind.
+func (s *EntityCheckedEntity) GetKind() string {
+	return s.Kind
 }
 
-// GetPrice returns the value of Price.
-func (s *EntityTransportation) GetPrice() NilInt {
-	return s.Price
+// GetName returns the value of Name.
+func (s *EntityCheckedEntity) GetName() string {
+	return s.Name
 }
 
-// GetTrainDetail returns the value of TrainDetail.
-func (s *EntityTransportation) GetTrainDetail() OptNilEntityTrainDetail {
-	return s.TrainDetail
+// SetID sets the value of ID.
+func (s *EntityCheckedEntity) SetID(val int) {
+	s.ID = val
 }
 
-// GetTripId returns the value of TripId.
-func (s *EntityTransportation) GetTripId() int {
-	return s.TripId
+// SetKind sets the value of Kind.
+func (s *EntityCheckedEntity) SetKind(val string) {
+	s.Kind = val
 }
 
-// GetType returns the value of Type.
-func (s *EntityTransportation) GetType() EntityTransportationType {
-	return s.Type
+// SetName sets the value of Name.
+func (s *EntityCheckedEntity) SetName(val string) {
+	s.Name = val
 }
 
-// SetArrivalDateTime sets the value of ArrivalDateTime.
-func (s *EntityTransportation) SetArrivalDateTime(val string) {
-	s.ArrivalDateTime = val
+type EntityCostCategory string
+
+// Ref: #/components/schemas/entity.Costs
+type EntityCosts struct {
+	Categories   []EntityCategoryCosts `json:"categories"`
+	Currencies   []EntityCurrencyCosts `json:"currencies"`
+	Days         []EntityDayCosts      `json:"days"`
+	HomeCurrency string                `json:"homeCurrency"`
+	Total        float64               `json:"total"`
 }
 
-// SetDepartureDateTime sets the value of DepartureDateTime.
-func (s *EntityTransportation) SetDepartureDateTime(val string) {
-	s.DepartureDateTime = val
+// GetCategories returns the value of Categories.
+func (s *EntityCosts) GetCategories() []EntityCategoryCosts {
+	return s.Categories
 }
 
-// SetDestination sets the value of Destination.
-func (s *EntityTransportation) SetDestination(val NilEntityLocation) {
-	s.Destination = val
+// GetCurrencies returns the value of Currencies.
+func (s *EntityCosts) GetCurrencies() []EntityCurrencyCosts {
+	return s.Currencies
 }
 
-// SetFlightDetail sets the value of FlightDetail.
-func (s *EntityTransportation) SetFlightDetail(val OptNilEntityFlightDetail) {
-	s.FlightDetail = val
+// GetDays returns the value of Days.
+func (s *EntityCosts) GetDays() []EntityDayCosts {
+	return s.Days
 }
 
-// SetGenericDetail sets the value of GenericDetail.
-func (s *EntityTransportation) SetGenericDetail(val OptNilEntityGenericDetail) {
-	s.GenericDetail = val
+// GetHomeCurrency returns the value of HomeCurrency.
+func (s *EntityCosts) GetHomeCurrency() string {
+	return s.HomeCurrency
 }
 
-// SetID sets the value of ID.
-func (s *EntityTransportation) SetID(val int) {
-	s.ID = val
+// GetTotal returns the value of Total.
+func (s *EntityCosts) GetTotal() float64 {
+	return s.Total
 }
 
-// SetOrigin sets the value of Origin.
-func (s *EntityTransportation) SetOrigin(val NilEntityLocation) {
-	s.Origin = val
+// SetCategories sets the value of Categories.
+func (s *EntityCosts) SetCategories(val []EntityCategoryCosts) {
+	s.Categories = val
 }
 
-// SetPrice sets the value of Price.
-func (s *EntityTransportation) SetPrice(val NilInt) {
-	s.Price = val
+// SetCurrencies sets the value of Currencies.
+func (s *EntityCosts) SetCurrencies(val []EntityCurrencyCosts) {
+	s.Currencies = val
 }
 
-// SetTrainDetail sets the value of TrainDetail.
-func (s *EntityTransportation) SetTrainDetail(val OptNilEntityTrainDetail) {
-	s.TrainDetail = val
+// SetDays sets the value of Days.
+func (s *EntityCosts) SetDays(val []EntityDayCosts) {
+	s.Days = val
 }
 
-// SetTripId sets the value of TripId.
-func (s *EntityTransportation) SetTripId(val int) {
-	s.TripId = val
+// SetHomeCurrency sets the value of HomeCurrency.
+func (s *EntityCosts) SetHomeCurrency(val string) {
+	s.HomeCurrency = val
 }
 
-// SetType sets the value of Type.
-func (s *EntityTransportation) SetType(val EntityTransportationType) {
-	s.Type = val
+// SetTotal sets the value of Total.
+func (s *EntityCosts) SetTotal(val float64) {
+	s.Total = val
 }
 
-func (*EntityTransportation) getTransportationRes()  {}
-func (*EntityTransportation) postFlightRes()         {}
-func (*EntityTransportation) postTrainJourneyRes()   {}
-func (*EntityTransportation) postTransportationRes() {}
+func (*EntityCosts) getCostsRes() {}
 
-type EntityTransportationType string
+// Ref: #/components/schemas/entity.CurrencyCosts
+type EntityCurrencyCosts struct {
+	Amount   float64    `json:"amount"`
+	Currency string     `json:"currency"`
+	Total    NilFloat64 `json:"total"`
+}
 
-// Ref: #/components/schemas/entity.Trip
-type EntityTrip struct {
-	Description NilString `json:"description"`
-	EndDate     string    `json:"endDate"`
-	ID          int       `json:"id"`
-	ImageUrl    NilString `json:"imageUrl"`
-	Name        string    `json:"name"`
-	OwnerID     int       `json:"owner_id"`
-	StartDate   string    `json:"startDate"`
+// GetAmount returns the value of Amount.
+func (s *EntityCurrencyCosts) GetAmount() float64 {
+	return s.Amount
 }
 
-// GetDescription returns the value of Description.
-func (s *EntityTrip) GetDescription() NilString {
-	return s.Description
+// GetCurrency returns the value of Currency.
+func (s *EntityCurrencyCosts) GetCurrency() string {
+	return s.Currency
 }
 
-// GetEndDate returns the value of EndDate.
-func (s *EntityTrip) GetEndDate() string {
-	return s.EndDate
+// GetTotal returns the value of Total.
+func (s *EntityCurrencyCosts) GetTotal() NilFloat64 {
+	return s.Total
 }
 
-// GetID returns the value of ID.
-func (s *EntityTrip) GetID() int {
-	return s.ID
+// SetAmount sets the value of Amount.
+func (s *EntityCurrencyCosts) SetAmount(val float64) {
+	s.Amount = val
 }
 
-// GetImageUrl returns the value of ImageUrl.
-func (s *EntityTrip) GetImageUrl() NilString {
-	return s.ImageUrl
+// SetCurrency sets the value of Currency.
+func (s *EntityCurrencyCosts) SetCurrency(val string) {
+	s.Currency = val
 }
 
-// GetName returns the value of Name.
-func (s *EntityTrip) GetName() string {
-	return s.Name
+// SetTotal sets the value of Total.
+func (s *EntityCurrencyCosts) SetTotal(val NilFloat64) {
+	s.Total = val
 }
 
-// GetOwnerID returns the value of OwnerID.
-func (s *EntityTrip) GetOwnerID() int {
-	return s.OwnerID
+type EntityDateChange string
+
+// Ref: #/components/schemas/entity.DayCosts
+type EntityDayCosts struct {
+	Date  string  `json:"date"`
+	Total float64 `json:"total"`
 }
 
-// GetStartDate returns the value of StartDate.
-func (s *EntityTrip) GetStartDate() string {
-	return s.StartDate
+// GetDate returns the value of Date.
+func (s *EntityDayCosts) GetDate() string {
+	return s.Date
 }
 
-// SetDescription sets the value of Description.
-func (s *EntityTrip) SetDescription(val NilString) {
-	s.Description = val
+// GetTotal returns the value of Total.
+func (s *EntityDayCosts) GetTotal() float64 {
+	return s.Total
 }
 
-// SetEndDate sets the value of EndDate.
-func (s *EntityTrip) SetEndDate(val string) {
-	s.EndDate = val
+// SetDate sets the value of Date.
+func (s *EntityDayCosts) SetDate(val string) {
+	s.Date = val
 }
 
-// SetID sets the value of ID.
-func (s *EntityTrip) SetID(val int) {
-	s.ID = val
+// SetTotal sets the value of Total.
+func (s *EntityDayCosts) SetTotal(val float64) {
+	s.Total = val
 }
 
-// SetImageUrl sets the value of ImageUrl.
-func (s *EntityTrip) SetImageUrl(val NilString) {
-	s.ImageUrl = val
+type EntityEntityKind string
+
+// Ref: #/components/schemas/entity.ErrAmbiguousFlightRequest
+type EntityErrAmbiguousFlightRequest map[string][]EntityAmbiguousFlightChoice
+
+func (s *EntityErrAmbiguousFlightRequest) init() EntityErrAmbiguousFlightRequest {
+	m := *s
+	if m == nil {
+		m = map[string][]EntityAmbiguousFlightChoice{}
+		*s = m
+	}
+	return m
 }
 
-// SetName sets the value of Name.
-func (s *EntityTrip) SetName(val string) {
-	s.Name = val
+func (*EntityErrAmbiguousFlightRequest) postFlightRes() {}
+
+// Ref: #/components/schemas/entity.Expense
+type EntityExpense struct {
+	AccommodationId  NilInt                     `json:"accommodationId"`
+	ActivityId       NilInt                     `json:"activityId"`
+	Amount           float64                    `json:"amount"`
+	AttachmentId     NilInt                     `json:"attachmentId"`
+	Category         string                     `json:"category"`
+	Currency         string                     `json:"currency"`
+	Date             string                     `json:"date"`
+	ID               int                        `json:"id"`
+	Name             string                     `json:"name"`
+	Participants     []EntityExpenseParticipant `json:"participants"`
+	PayerId          int                        `json:"payerId"`
+	Revision         int                        `json:"revision"`
+	SplitMode        EntitySplitMode            `json:"splitMode"`
+	TransportationId NilInt                     `json:"transportationId"`
+	TripId           int                        `json:"tripId"`
 }
 
-// SetOwnerID sets the value of OwnerID.
-func (s *EntityTrip) SetOwnerID(val int) {
-	s.OwnerID = val
+// GetAccommodationId returns the value of AccommodationId.
+func (s *EntityExpense) GetAccommodationId() NilInt {
+	return s.AccommodationId
 }
 
-// SetStartDate sets the value of StartDate.
-func (s *EntityTrip) SetStartDate(val string) {
-	s.StartDate = val
+// GetActivityId returns the value of ActivityId.
+func (s *EntityExpense) GetActivityId() NilInt {
+	return s.ActivityId
 }
 
-func (*EntityTrip) getTripRes()  {}
-func (*EntityTrip) postTripRes() {}
+// GetAmount returns the value of Amount.
+func (s *EntityExpense) GetAmount() float64 {
+	return s.Amount
+}
 
-// Ref: #/components/schemas/entity.User
-type EntityUser struct {
-	ID     int    `json:"id"`
-	JwtSub string `json:"jwtSub"`
-	Name   string `json:"name"`
+// GetAttachmentId returns the value of AttachmentId.
+func (s *EntityExpense) GetAttachmentId() NilInt {
+	return s.AttachmentId
 }
 
-// GetID returns the value of ID.
-func (s *EntityUser) GetID() int {
-	return s.ID
+// GetCategory returns the value of Category.
+func (s *EntityExpense) GetCategory() string {
+	return s.Category
 }
 
-// GetJwtSub returns the value of JwtSub.
-func (s *EntityUser) GetJwtSub() string {
-	return s.JwtSub
+// GetCurrency returns the value of Currency.
+func (s *EntityExpense) GetCurrency() string {
+	return s.Currency
+}
+
+// GetDate returns the value of Date.
+func (s *EntityExpense) GetDate() string {
+	return s.Date
+}
+
+// GetID returns the value of ID.
+func (s *EntityExpense) GetID() int {
+	return s.ID
 }
 
 // GetName returns the value of Name.
-func (s *EntityUser) GetName() string {
+func (s *EntityExpense) GetName() string {
 	return s.Name
 }
 
-// SetID sets the value of ID.
-func (s *EntityUser) SetID(val int) {
-	s.ID = val
+// GetParticipants returns the value of Participants.
+func (s *EntityExpense) GetParticipants() []EntityExpenseParticipant {
+	return s.Participants
 }
 
-// SetJwtSub sets the value of JwtSub.
-func (s *EntityUser) SetJwtSub(val string) {
-	s.JwtSub = val
+// GetPayerId returns the value of PayerId.
+func (s *EntityExpense) GetPayerId() int {
+	return s.PayerId
 }
 
-// SetName sets the value of Name.
-func (s *EntityUser) SetName(val string) {
-	s.Name = val
+// GetRevision returns the value of Revision.
+func (s *EntityExpense) GetRevision() int {
+	return s.Revision
 }
 
-func (*EntityUser) getUserRes() {}
+// GetSplitMode returns the value of SplitMode.
+func (s *EntityExpense) GetSplitMode() EntitySplitMode {
+	return s.SplitMode
+}
 
-type GetAccommodationByIDBadRequest ResponseError
+// GetTransportationId returns the value of TransportationId.
+func (s *EntityExpense) GetTransportationId() NilInt {
+	return s.TransportationId
+}
 
-func (*GetAccommodationByIDBadRequest) getAccommodationByIDRes() {}
+// GetTripId returns the value of TripId.
+func (s *EntityExpense) GetTripId() int {
+	return s.TripId
+}
 
-type GetAccommodationByIDForbidden ResponseError
+// SetAccommodationId sets the value of AccommodationId.
+func (s *EntityExpense) SetAccommodationId(val NilInt) {
+	s.AccommodationId = val
+}
 
-func (*GetAccommodationByIDForbidden) getAccommodationByIDRes() {}
+// SetActivityId sets the value of ActivityId.
+func (s *EntityExpense) SetActivityId(val NilInt) {
+	s.ActivityId = val
+}
 
-type GetAccommodationByIDInternalServerError ResponseError
+// SetAmount sets the value of Amount.
+func (s *EntityExpense) SetAmount(val float64) {
+	s.Amount = val
+}
 
-func (*GetAccommodationByIDInternalServerError) getAccommodationByIDRes() {}
+// SetAttachmentId sets the value of AttachmentId.
+func (s *EntityExpense) SetAttachmentId(val NilInt) {
+	s.AttachmentId = val
+}
 
-type GetAccommodationByIDNotFound ResponseError
+// SetCategory sets the value of Category.
+func (s *EntityExpense) SetCategory(val string) {
+	s.Category = val
+}
 
-func (*GetAccommodationByIDNotFound) getAccommodationByIDRes() {}
+// SetCurrency sets the value of Currency.
+func (s *EntityExpense) SetCurrency(val string) {
+	s.Currency = val
+}
 
-type GetActivitiesBadRequest ResponseError
+// SetDate sets the value of Date.
+func (s *EntityExpense) SetDate(val string) {
+	s.Date = val
+}
 
-func (*GetActivitiesBadRequest) getActivitiesRes() {}
+// SetID sets the value of ID.
+func (s *EntityExpense) SetID(val int) {
+	s.ID = val
+}
 
-type GetActivitiesForbidden ResponseError
+// SetName sets the value of Name.
+func (s *EntityExpense) SetName(val string) {
+	s.Name = val
+}
 
-func (*GetActivitiesForbidden) getActivitiesRes() {}
+// SetParticipants sets the value of Participants.
+func (s *EntityExpense) SetParticipants(val []EntityExpenseParticipant) {
+	s.Participants = val
+}
 
-type GetActivitiesInternalServerError ResponseError
+// SetPayerId sets the value of PayerId.
+func (s *EntityExpense) SetPayerId(val int) {
+	s.PayerId = val
+}
 
-func (*GetActivitiesInternalServerError) getActivitiesRes() {}
+// SetRevision sets the value of Revision.
+func (s *EntityExpense) SetRevision(val int) {
+	s.Revision = val
+}
 
-type GetActivitiesOKApplicationJSON []EntityActivity
+// SetSplitMode sets the value of SplitMode.
+func (s *EntityExpense) SetSplitMode(val EntitySplitMode) {
+	s.SplitMode = val
+}
 
-func (*GetActivitiesOKApplicationJSON) getActivitiesRes() {}
+// SetTransportationId sets the value of TransportationId.
+func (s *EntityExpense) SetTransportationId(val NilInt) {
+	s.TransportationId = val
+}
 
-type GetActivityBadRequest ResponseError
+// SetTripId sets the value of TripId.
+func (s *EntityExpense) SetTripId(val int) {
+	s.TripId = val
+}
 
-func (*GetActivityBadRequest) getActivityRes() {}
+func (*EntityExpense) getExpenseRes()  {}
+func (*EntityExpense) postExpenseRes() {}
 
-type GetActivityForbidden ResponseError
+// Ref: #/components/schemas/entity.ExpenseParticipant
+type EntityExpenseParticipant struct {
+	Amount float64    `json:"amount"`
+	Share  NilFloat64 `json:"share"`
+	UserId int        `json:"userId"`
+}
 
-func (*GetActivityForbidden) getActivityRes() {}
+// GetAmount returns the value of Amount.
+func (s *EntityExpenseParticipant) GetAmount() float64 {
+	return s.Amount
+}
 
-type GetActivityInternalServerError ResponseError
+// GetShare returns the value of Share.
+func (s *EntityExpenseParticipant) GetShare() NilFloat64 {
+	return s.Share
+}
 
-func (*GetActivityInternalServerError) getActivityRes() {}
+// GetUserId returns the value of UserId.
+func (s *EntityExpenseParticipant) GetUserId() int {
+	return s.UserId
+}
 
-type GetActivityNotFound ResponseError
+// SetAmount sets the value of Amount.
+func (s *EntityExpenseParticipant) SetAmount(val float64) {
+	s.Amount = val
+}
 
-func (*GetActivityNotFound) getActivityRes() {}
+// SetShare sets the value of Share.
+func (s *EntityExpenseParticipant) SetShare(val NilFloat64) {
+	s.Share = val
+}
 
-type GetAllAccommodationBadRequest ResponseError
+// SetUserId sets the value of UserId.
+func (s *EntityExpenseParticipant) SetUserId(val int) {
+	s.UserId = val
+}
 
-func (*GetAllAccommodationBadRequest) getAllAccommodationRes() {}
+// Ref: #/components/schemas/entity.FlightDetail
+type EntityFlightDetail struct {
+	Legs []EntityFlightLeg `json:"legs"`
+	Pnrs []EntityPNR       `json:"pnrs"`
+}
 
-type GetAllAccommodationForbidden ResponseError
+// GetLegs returns the value of Legs.
+func (s *EntityFlightDetail) GetLegs() []EntityFlightLeg {
+	return s.Legs
+}
 
-func (*GetAllAccommodationForbidden) getAllAccommodationRes() {}
+// GetPnrs returns the value of Pnrs.
+func (s *EntityFlightDetail) GetPnrs() []EntityPNR {
+	return s.Pnrs
+}
 
-type GetAllAccommodationInternalServerError ResponseError
+// SetLegs sets the value of Legs.
+func (s *EntityFlightDetail) SetLegs(val []EntityFlightLeg) {
+	s.Legs = val
+}
 
-func (*GetAllAccommodationInternalServerError) getAllAccommodationRes() {}
+// SetPnrs sets the value of Pnrs.
+func (s *EntityFlightDetail) SetPnrs(val []EntityPNR) {
+	s.Pnrs = val
+}
 
-type GetAllAccommodationOKApplicationJSON []EntityAccommodation
+// Ref: #/components/schemas/entity.FlightLeg
+type EntityFlightLeg struct {
+	Aircraft           NilString           `json:"aircraft"`
+	Airline            string              `json:"airline"`
+	AmadeusFlightDate  NilString           `json:"amadeusFlightDate"`
+	ArrivalDateTime    string              `json:"arrivalDateTime"`
+	ArrivalUtcOffset   NilString           `json:"arrivalUtcOffset"`
+	CabinClass         NilEntityCabinClass `json:"cabinClass"`
+	DepartureDateTime  string              `json:"departureDateTime"`
+	DepartureUtcOffset NilString           `json:"departureUtcOffset"`
+	Destination        EntityAirport       `json:"destination"`
+	DurationInMinutes  int                 `json:"durationInMinutes"`
+	FlightNumber       string              `json:"flightNumber"`
+	ID                 int                 `json:"id"`
+	Origin             EntityAirport       `json:"origin"`
+}
 
-func (*GetAllAccommodationOKApplicationJSON) getAllAccommodationRes() {}
+// GetAircraft returns the value of Aircraft.
+func (s *EntityFlightLeg) GetAircraft() NilString {
+	return s.Aircraft
+}
 
-type GetAllTransportationForbidden ResponseError
+// GetAirline returns the value of Airline.
+func (s *EntityFlightLeg) GetAirline() string {
+	return s.Airline
+}
 
-func (*GetAllTransportationForbidden) getAllTransportationRes() {}
+// GetAmadeusFlightDate returns the value of AmadeusFlightDate.
+func (s *EntityFlightLeg) GetAmadeusFlightDate() NilString {
+	return s.AmadeusFlightDate
+}
 
-type GetAllTransportationInternalServerError ResponseError
+// GetArrivalDateTime returns the value of ArrivalDateTime.
+func (s *EntityFlightLeg) GetArrivalDateTime() string {
+	return s.ArrivalDateTime
+}
 
-func (*GetAllTransportationInternalServerError) getAllTransportationRes() {}
+// GetArrivalUtcOffset returns the value of ArrivalUtcOffset.
+func (s *EntityFlightLeg) GetArrivalUtcOffset() NilString {
+	return s.ArrivalUtcOffset
+}
 
-type GetAllTransportationOKApplicationJSON []EntityTransportation
+// GetCabinClass returns the value of CabinClass.
+func (s *EntityFlightLeg) GetCabinClass() NilEntityCabinClass {
+	return s.CabinClass
+}
 
-func (*GetAllTransportationOKApplicationJSON) getAllTransportationRes() {}
+// GetDepartureDateTime returns the value of DepartureDateTime.
+func (s *EntityFlightLeg) GetDepartureDateTime() string {
+	return s.DepartureDateTime
+}
 
-type GetAttachmentsForbidden ResponseError
+// GetDepartureUtcOffset returns the value of DepartureUtcOffset.
+func (s *EntityFlightLeg) GetDepartureUtcOffset() NilString {
+	return s.DepartureUtcOffset
+}
 
-func (*GetAttachmentsForbidden) getAttachmentsRes() {}
+// GetDestination returns the value of Destination.
+func (s *EntityFlightLeg) GetDestination() EntityAirport {
+	return s.Destination
+}
 
-type GetAttachmentsInternalServerError ResponseError
+// GetDurationInMinutes returns the value of DurationInMinutes.
+func (s *EntityFlightLeg) GetDurationInMinutes() int {
+	return s.DurationInMinutes
+}
 
-func (*GetAttachmentsInternalServerError) getAttachmentsRes() {}
+// GetFlightNumber returns the value of FlightNumber.
+func (s *EntityFlightLeg) GetFlightNumber() string {
+	return s.FlightNumber
+}
 
-type GetAttachmentsOKApplicationJSON []EntityAttachment
+// GetID returns the value of ID.
+func (s *EntityFlightLeg) GetID() int {
+	return s.ID
+}
 
-func (*GetAttachmentsOKApplicationJSON) getAttachmentsRes() {}
+// GetOrigin returns the value of Origin.
+func (s *EntityFlightLeg) GetOrigin() EntityAirport {
+	return s.Origin
+}
 
-type GetGeoJsonForbidden ResponseError
+// SetAircraft sets the value of Aircraft.
+func (s *EntityFlightLeg) SetAircraft(val NilString) {
+	s.Aircraft = val
+}
 
-func (*GetGeoJsonForbidden) getGeoJsonRes() {}
+// SetAirline sets the value of Airline.
+func (s *EntityFlightLeg) SetAirline(val string) {
+	s.Airline = val
+}
 
-type GetGeoJsonInternalServerError ResponseError
+// SetAmadeusFlightDate sets the value of AmadeusFlightDate.
+func (s *EntityFlightLeg) SetAmadeusFlightDate(val NilString) {
+	s.AmadeusFlightDate = val
+}
+
+// SetArrivalDateTime sets the value of ArrivalDateTime.
+func (s *EntityFlightLeg) SetArrivalDateTime(val string) {
+	s.ArrivalDateTime = val
+}
+
+// SetArrivalUtcOffset sets the value of ArrivalUtcOffset.
+func (s *EntityFlightLeg) SetArrivalUtcOffset(val NilString) {
+	s.ArrivalUtcOffset = val
+}
+
+// SetCabinClass sets the value of CabinClass.
+func (s *EntityFlightLeg) SetCabinClass(val NilEntityCabinClass) {
+	s.CabinClass = val
+}
+
+// SetDepartureDateTime sets the value of DepartureDateTime.
+func (s *EntityFlightLeg) SetDepartureDateTime(val string) {
+	s.DepartureDateTime = val
+}
+
+// SetDepartureUtcOffset sets the value of DepartureUtcOffset.
+func (s *EntityFlightLeg) SetDepartureUtcOffset(val NilString) {
+	s.DepartureUtcOffset = val
+}
+
+// SetDestination sets the value of Destination.
+func (s *EntityFlightLeg) SetDestination(val EntityAirport) {
+	s.Destination = val
+}
+
+// SetDurationInMinutes sets the value of DurationInMinutes.
+func (s *EntityFlightLeg) SetDurationInMinutes(val int) {
+	s.DurationInMinutes = val
+}
+
+// SetFlightNumber sets the value of FlightNumber.
+func (s *EntityFlightLeg) SetFlightNumber(val string) {
+	s.FlightNumber = val
+}
+
+// SetID sets the value of ID.
+func (s *EntityFlightLeg) SetID(val int) {
+	s.ID = val
+}
+
+// SetOrigin sets the value of Origin.
+func (s *EntityFlightLeg) SetOrigin(val EntityAirport) {
+	s.Origin = val
+}
+
+// Ref: #/components/schemas/entity.GenericDetail
+type EntityGenericDetail struct {
+	DestinationAddress NilString `json:"destinationAddress"`
+	Name               string    `json:"name"`
+	OriginAddress      NilString `json:"originAddress"`
+}
+
+// GetDestinationAddress returns the value of DestinationAddress.
+func (s *EntityGenericDetail) GetDestinationAddress() NilString {
+	return s.DestinationAddress
+}
+
+// GetName returns the value of Name.
+func (s *EntityGenericDetail) GetName() string {
+	return s.Name
+}
+
+// GetOriginAddress returns the value of OriginAddress.
+func (s *EntityGenericDetail) GetOriginAddress() NilString {
+	return s.OriginAddress
+}
+
+// SetDestinationAddress sets the value of DestinationAddress.
+func (s *EntityGenericDetail) SetDestinationAddress(val NilString) {
+	s.DestinationAddress = val
+}
+
+// SetName sets the value of Name.
+func (s *EntityGenericDetail) SetName(val string) {
+	s.Name = val
+}
+
+// SetOriginAddress sets the value of OriginAddress.
+func (s *EntityGenericDetail) SetOriginAddress(val NilString) {
+	s.OriginAddress = val
+}
+
+// Ref: #/components/schemas/entity.HistoryEntry
+type EntityHistoryEntry struct {
+	After     *EntityHistoryEntryAfter  `json:"after"`
+	Before    *EntityHistoryEntryBefore `json:"before"`
+	CreatedAt string                    `json:"createdAt"`
+	EntityId  int                       `json:"entityId"`
+	ID        int                       `json:"id"`
+	Kind      string                    `json:"kind"`
+	TripId    int                       `json:"tripId"`
+	Type      string                    `json:"type"`
+	UserId    NilInt                    `json:"userId"`
+}
+
+// GetAfter returns the value of After.
+func (s *EntityHistoryEntry) GetAfter() *EntityHistoryEntryAfter {
+	return s.After
+}
+
+// GetBefore returns the value of Before.
+func (s *EntityHistoryEntry) GetBefore() *EntityHistoryEntryBefore {
+	return s.Before
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *EntityHistoryEntry) GetCreatedAt() string {
+	return s.CreatedAt
+}
+
+// GetEntityId returns the value of EntityId.
+func (s *EntityHistoryEntry) GetEntityId() int {
+	return s.EntityId
+}
+
+// GetID returns the value of ID.
+func (s *EntityHistoryEntry) GetID() int {
+	return s.ID
+}
+
+// GetKind returns the value of Kind.
+func (s *EntityHistoryEntry) GetKind() string {
+	return s.Kind
+}
+
+// GetTripId returns the value of TripId.
+func (s *EntityHistoryEntry) GetTripId() int {
+	return s.TripId
+}
+
+// GetType returns the value of Type.
+func (s *EntityHistoryEntry) GetType() string {
+	return s.Type
+}
+
+// GetUserId returns the value of UserId.
+func (s *EntityHistoryEntry) GetUserId() NilInt {
+	return s.UserId
+}
+
+// SetAfter sets the value of After.
+func (s *EntityHistoryEntry) SetAfter(val *EntityHistoryEntryAfter) {
+	s.After = val
+}
+
+// SetBefore sets the value of Before.
+func (s *EntityHistoryEntry) SetBefore(val *EntityHistoryEntryBefore) {
+	s.Before = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *EntityHistoryEntry) SetCreatedAt(val string) {
+	s.CreatedAt = val
+}
+
+// SetEntityId sets the value of EntityId.
+func (s *EntityHistoryEntry) SetEntityId(val int) {
+	s.EntityId = val
+}
+
+// SetID sets the value of ID.
+func (s *EntityHistoryEntry) SetID(val int) {
+	s.ID = val
+}
+
+// SetKind sets the value of Kind.
+func (s *EntityHistoryEntry) SetKind(val string) {
+	s.Kind = val
+}
+
+// SetTripId sets the value of TripId.
+func (s *EntityHistoryEntry) SetTripId(val int) {
+	s.TripId = val
+}
+
+// SetType sets the value of Type.
+func (s *EntityHistoryEntry) SetType(val string) {
+	s.Type = val
+}
+
+// SetUserId sets the value of UserId.
+func (s *EntityHistoryEntry) SetUserId(val NilInt) {
+	s.UserId = val
+}
+
+type EntityHistoryEntryAfter struct{}
+
+type EntityHistoryEntryBefore struct{}
+
+// Ref: #/components/schemas/entity.ItineraryCheck
+type EntityItineraryCheck struct {
+	Date     string                `json:"date"`
+	Entities []EntityCheckedEntity `json:"entities"`
+	Message  string                `json:"message"`
+	Type     EntityCheckType       `json:"type"`
+}
+
+// GetDate returns the value of Date.
+func (s *EntityItineraryCheck) GetDate() string {
+	return s.Date
+}
+
+// GetEntities returns the value of Entities.
+func (s *EntityItineraryCheck) GetEntities() []EntityCheckedEntity {
+	return s.Entities
+}
+
+// GetMessage returns the value of Message.
+func (s *EntityItineraryCheck) GetMessage() string {
+	return s.Message
+}
+
+// GetType returns the value of Type.
+func (s *EntityItineraryCheck) GetType() EntityCheckType {
+	return s.Type
+}
+
+// SetDate sets the value of Date.
+func (s *EntityItineraryCheck) SetDate(val string) {
+	s.Date = val
+}
+
+// SetEntities sets the value of Entities.
+func (s *EntityItineraryCheck) SetEntities(val []EntityCheckedEntity) {
+	s.Entities = val
+}
+
+// SetMessage sets the value of Message.
+func (s *EntityItineraryCheck) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetType sets the value of Type.
+func (s *EntityItineraryCheck) SetType(val EntityCheckType) {
+	s.Type = val
+}
+
+// Ref: #/components/schemas/entity.ItineraryDay
+type EntityItineraryDay struct {
+	Accommodation OptEntityAccommodation `json:"accommodation"`
+	Date          string                 `json:"date"`
+	Events        []EntityItineraryEvent `json:"events"`
+	// MissingAccommodation is set if the night is neither covered by accommodation nor spent travelling.
+	// It is never set for the last day.
+	MissingAccommodation bool `json:"missingAccommodation"`
+	// OvernightTransportation is still underway at midnight following Date.
+	OvernightTransportation []EntityTransportation `json:"overnightTransportation"`
+}
+
+// GetAccommodation returns the value of Accommodation.
+func (s *EntityItineraryDay) GetAccommodation() OptEntityAccommodation {
+	return s.Accommodation
+}
+
+// GetDate returns the value of Date.
+func (s *EntityItineraryDay) GetDate() string {
+	return s.Date
+}
+
+// GetEvents returns the value of Events.
+func (s *EntityItineraryDay) GetEvents() []EntityItineraryEvent {
+	return s.Events
+}
+
+// GetMissingAccommodation returns the value of MissingAccommodation.
+func (s *EntityItineraryDay) GetMissingAccommodation() bool {
+	return s.MissingAccommodation
+}
+
+// GetOvernightTransportation returns the value of OvernightTransportation.
+func (s *EntityItineraryDay) GetOvernightTransportation() []EntityTransportation {
+	return s.OvernightTransportation
+}
+
+// SetAccommodation sets the value of Accommodation.
+func (s *EntityItineraryDay) SetAccommodation(val OptEntityAccommodation) {
+	s.Accommodation = val
+}
+
+// SetDate sets the value of Date.
+func (s *EntityItineraryDay) SetDate(val string) {
+	s.Date = val
+}
+
+// SetEvents sets the value of Events.
+func (s *EntityItineraryDay) SetEvents(val []EntityItineraryEvent) {
+	s.Events = val
+}
+
+// SetMissingAccommodation sets the value of MissingAccommodation.
+func (s *EntityItineraryDay) SetMissingAccommodation(val bool) {
+	s.MissingAccommodation = val
+}
+
+// SetOvernightTransportation sets the value of OvernightTransportation.
+func (s *EntityItineraryDay) SetOvernightTransportation(val []EntityTransportation) {
+	s.OvernightTransportation = val
+}
+
+// Ref: #/components/schemas/entity.ItineraryEvent
+type EntityItineraryEvent struct {
+	Accommodation  OptEntityAccommodation   `json:"accommodation"`
+	Activity       OptEntityActivity        `json:"activity"`
+	Time           NilString                `json:"time"`
+	Transportation OptEntityTransportation  `json:"transportation"`
+	Type           EntityItineraryEventType `json:"type"`
+	UtcOffset      NilString                `json:"utcOffset"`
+}
+
+// GetAccommodation returns the value of Accommodation.
+func (s *EntityItineraryEvent) GetAccommodation() OptEntityAccommodation {
+	return s.Accommodation
+}
+
+// GetActivity returns the value of Activity.
+func (s *EntityItineraryEvent) GetActivity() OptEntityActivity {
+	return s.Activity
+}
+
+// GetTime returns the value of Time.
+func (s *EntityItineraryEvent) GetTime() NilString {
+	return s.Time
+}
+
+// GetTransportation returns the value of Transportation.
+func (s *EntityItineraryEvent) GetTransportation() OptEntityTransportation {
+	return s.Transportation
+}
+
+// GetType returns the value of Type.
+func (s *EntityItineraryEvent) GetType() EntityItineraryEventType {
+	return s.Type
+}
+
+// GetUtcOffset returns the value of UtcOffset.
+func (s *EntityItineraryEvent) GetUtcOffset() NilString {
+	return s.UtcOffset
+}
+
+// SetAccommodation sets the value of Accommodation.
+func (s *EntityItineraryEvent) SetAccommodation(val OptEntityAccommodation) {
+	s.Accommodation = val
+}
+
+// SetActivity sets the value of Activity.
+func (s *EntityItineraryEvent) SetActivity(val OptEntityActivity) {
+	s.Activity = val
+}
+
+// SetTime sets the value of Time.
+func (s *EntityItineraryEvent) SetTime(val NilString) {
+	s.Time = val
+}
+
+// SetTransportation sets the value of Transportation.
+func (s *EntityItineraryEvent) SetTransportation(val OptEntityTransportation) {
+	s.Transportation = val
+}
+
+// SetType sets the value of Type.
+func (s *EntityItineraryEvent) SetType(val EntityItineraryEventType) {
+	s.Type = val
+}
+
+// SetUtcOffset sets the value of UtcOffset.
+func (s *EntityItineraryEvent) SetUtcOffset(val NilString) {
+	s.UtcOffset = val
+}
+
+type EntityItineraryEventType string
+
+// Ref: #/components/schemas/entity.Location
+type EntityLocation struct {
+	ID        int     `json:"id"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// Timezone is the IANA name of the timezone at the location, e.g. "Europe/Berlin".
+	Timezone OptString `json:"timezone"`
+}
+
+// GetID returns the value of ID.
+func (s *EntityLocation) GetID() int {
+	return s.ID
+}
+
+// GetLatitude returns the value of Latitude.
+func (s *EntityLocation) GetLatitude() float64 {
+	return s.Latitude
+}
+
+// GetLongitude returns the value of Longitude.
+func (s *EntityLocation) GetLongitude() float64 {
+	return s.Longitude
+}
+
+// GetTimezone returns the value of Timezone.
+func (s *EntityLocation) GetTimezone() OptString {
+	return s.Timezone
+}
+
+// SetID sets the value of ID.
+func (s *EntityLocation) SetID(val int) {
+	s.ID = val
+}
+
+// SetLatitude sets the value of Latitude.
+func (s *EntityLocation) SetLatitude(val float64) {
+	s.Latitude = val
+}
+
+// SetLongitude sets the value of Longitude.
+func (s *EntityLocation) SetLongitude(val float64) {
+	s.Longitude = val
+}
+
+// SetTimezone sets the value of Timezone.
+func (s *EntityLocation) SetTimezone(val OptString) {
+	s.Timezone = val
+}
+
+// Ref: #/components/schemas/entity.MemberBalance
+type EntityMemberBalance struct {
+	Balance float64 `json:"balance"`
+	Owed    float64 `json:"owed"`
+	Paid    float64 `json:"paid"`
+	UserId  int     `json:"userId"`
+}
+
+// GetBalance returns the value of Balance.
+func (s *EntityMemberBalance) GetBalance() float64 {
+	return s.Balance
+}
+
+// GetOwed returns the value of Owed.
+func (s *EntityMemberBalance) GetOwed() float64 {
+	return s.Owed
+}
+
+// GetPaid returns the value of Paid.
+func (s *EntityMemberBalance) GetPaid() float64 {
+	return s.Paid
+}
+
+// GetUserId returns the value of UserId.
+func (s *EntityMemberBalance) GetUserId() int {
+	return s.UserId
+}
+
+// SetBalance sets the value of Balance.
+func (s *EntityMemberBalance) SetBalance(val float64) {
+	s.Balance = val
+}
+
+// SetOwed sets the value of Owed.
+func (s *EntityMemberBalance) SetOwed(val float64) {
+	s.Owed = val
+}
+
+// SetPaid sets the value of Paid.
+func (s *EntityMemberBalance) SetPaid(val float64) {
+	s.Paid = val
+}
+
+// SetUserId sets the value of UserId.
+func (s *EntityMemberBalance) SetUserId(val int) {
+	s.UserId = val
+}
+
+// Ref: #/components/schemas/entity.OutOfRangeEntity
+type EntityOutOfRangeEntity struct {
+	EndDate   string `json:"endDate"`
+	ID        int    `json:"id"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	StartDate string `json:"startDate"`
+}
+
+// GetEndDate returns the value of EndDate.
+func (s *EntityOutOfRangeEntity) GetEndDate() string {
+	return s.EndDate
+}
+
+// GetID returns the value of ID.
+func (s *EntityOutOfRangeEntity) GetID() int {
+	return s.ID
+}
+
+// GetKind returns the value of Kind.
+func (s *EntityOutOfRangeEntity) GetKind() string {
+	return s.Kind
+}
+
+// GetName returns the value of Name.
+func (s *EntityOutOfRangeEntity) GetName() string {
+	return s.Name
+}
+
+// GetStartDate returns the value of StartDate.
+func (s *EntityOutOfRangeEntity) GetStartDate() string {
+	return s.StartDate
+}
+
+// SetEndDate sets the value of EndDate.
+func (s *EntityOutOfRangeEntity) SetEndDate(val string) {
+	s.EndDate = val
+}
+
+// SetID sets the value of ID.
+func (s *EntityOutOfRangeEntity) SetID(val int) {
+	s.ID = val
+}
+
+// SetKind sets the value of Kind.
+func (s *EntityOutOfRangeEntity) SetKind(val string) {
+	s.Kind = val
+}
+
+// SetName sets the value of Name.
+func (s *EntityOutOfRangeEntity) SetName(val string) {
+	s.Name = val
+}
+
+// SetStartDate sets the value of StartDate.
+func (s *EntityOutOfRangeEntity) SetStartDate(val string) {
+	s.StartDate = val
+}
+
+// Ref: #/components/schemas/entity.PNR
+type EntityPNR struct {
+	Airline string `json:"airline"`
+	ID      int    `json:"id"`
+	Pnr     string `json:"pnr"`
+}
+
+// GetAirline returns the value of Airline.
+func (s *EntityPNR) GetAirline() string {
+	return s.Airline
+}
+
+// GetID returns the value of ID.
+func (s *EntityPNR) GetID() int {
+	return s.ID
+}
+
+// GetPnr returns the value of Pnr.
+func (s *EntityPNR) GetPnr() string {
+	return s.Pnr
+}
+
+// SetAirline sets the value of Airline.
+func (s *EntityPNR) SetAirline(val string) {
+	s.Airline = val
+}
+
+// SetID sets the value of ID.
+func (s *EntityPNR) SetID(val int) {
+	s.ID = val
+}
+
+// SetPnr sets the value of Pnr.
+func (s *EntityPNR) SetPnr(val string) {
+	s.Pnr = val
+}
+
+// Ref: #/components/schemas/entity.PhotoCluster
+type EntityPhotoCluster struct {
+	// ActivityID is the existing activity the photos were linked to.
+	ActivityId NilInt                     `json:"activityId"`
+	Date       string                     `json:"date"`
+	End        string                     `json:"end"`
+	Location   NilEntityLocation          `json:"location"`
+	Photos     []EntityAttachmentMetadata `json:"photos"`
+	Proposal   OptEntityActivity          `json:"proposal"`
+	Start      string                     `json:"start"`
+}
+
+// GetActivityId returns the value of ActivityId.
+func (s *EntityPhotoCluster) GetActivityId() NilInt {
+	return s.ActivityId
+}
+
+// GetDate returns the value of Date.
+func (s *EntityPhotoCluster) GetDate() string {
+	return s.Date
+}
+
+// GetEnd returns the value of End.
+func (s *EntityPhotoCluster) GetEnd() string {
+	return s.End
+}
+
+// GetLocation returns the value of Location.
+func (s *EntityPhotoCluster) GetLocation() NilEntityLocation {
+	return s.Location
+}
+
+// GetPhotos returns the value of Photos.
+func (s *EntityPhotoCluster) GetPhotos() []EntityAttachmentMetadata {
+	return s.Photos
+}
+
+// GetProposal returns the value of Proposal.
+func (s *EntityPhotoCluster) GetProposal() OptEntityActivity {
+	return s.Proposal
+}
+
+// GetStart returns the value of Start.
+func (s *EntityPhotoCluster) GetStart() string {
+	return s.Start
+}
+
+// SetActivityId sets the value of ActivityId.
+func (s *EntityPhotoCluster) SetActivityId(val NilInt) {
+	s.ActivityId = val
+}
+
+// SetDate sets the value of Date.
+func (s *EntityPhotoCluster) SetDate(val string) {
+	s.Date = val
+}
+
+// SetEnd sets the value of End.
+func (s *EntityPhotoCluster) SetEnd(val string) {
+	s.End = val
+}
+
+// SetLocation sets the value of Location.
+func (s *EntityPhotoCluster) SetLocation(val NilEntityLocation) {
+	s.Location = val
+}
+
+// SetPhotos sets the value of Photos.
+func (s *EntityPhotoCluster) SetPhotos(val []EntityAttachmentMetadata) {
+	s.Photos = val
+}
+
+// SetProposal sets the value of Proposal.
+func (s *EntityPhotoCluster) SetProposal(val OptEntityActivity) {
+	s.Proposal = val
+}
+
+// SetStart sets the value of Start.
+func (s *EntityPhotoCluster) SetStart(val string) {
+	s.Start = val
+}
+
+// Ref: #/components/schemas/entity.PhotoImport
+type EntityPhotoImport struct {
+	Clusters []EntityPhotoCluster `json:"clusters"`
+	// Unplaced are photos without a timestamp, which cannot be assigned to a day.
+	Unplaced []EntityAttachmentMetadata `json:"unplaced"`
+}
+
+// GetClusters returns the value of Clusters.
+func (s *EntityPhotoImport) GetClusters() []EntityPhotoCluster {
+	return s.Clusters
+}
+
+// GetUnplaced returns the value of Unplaced.
+func (s *EntityPhotoImport) GetUnplaced() []EntityAttachmentMetadata {
+	return s.Unplaced
+}
+
+// SetClusters sets the value of Clusters.
+func (s *EntityPhotoImport) SetClusters(val []EntityPhotoCluster) {
+	s.Clusters = val
+}
+
+// SetUnplaced sets the value of Unplaced.
+func (s *EntityPhotoImport) SetUnplaced(val []EntityAttachmentMetadata) {
+	s.Unplaced = val
+}
+
+func (*EntityPhotoImport) importPhotosRes() {}
+
+// Ref: #/components/schemas/entity.Settlement
+type EntitySettlement struct {
+	Balances     []EntityMemberBalance      `json:"balances"`
+	HomeCurrency string                     `json:"homeCurrency"`
+	Transfers    []EntitySettlementTransfer `json:"transfers"`
+}
+
+// GetBalances returns the value of Balances.
+func (s *EntitySettlement) GetBalances() []EntityMemberBalance {
+	return s.Balances
+}
+
+// GetHomeCurrency returns the value of HomeCurrency.
+func (s *EntitySettlement) GetHomeCurrency() string {
+	return s.HomeCurrency
+}
+
+// GetTransfers returns the value of Transfers.
+func (s *EntitySettlement) GetTransfers() []EntitySettlementTransfer {
+	return s.Transfers
+}
+
+// SetBalances sets the value of Balances.
+func (s *EntitySettlement) SetBalances(val []EntityMemberBalance) {
+	s.Balances = val
+}
+
+// SetHomeCurrency sets the value of HomeCurrency.
+func (s *EntitySettlement) SetHomeCurrency(val string) {
+	s.HomeCurrency = val
+}
+
+// SetTransfers sets the value of Transfers.
+func (s *EntitySettlement) SetTransfers(val []EntitySettlementTransfer) {
+	s.Transfers = val
+}
+
+func (*EntitySettlement) getSettlementRes() {}
+
+// Ref: #/components/schemas/entity.SettlementTransfer
+type EntitySettlementTransfer struct {
+	Amount     float64 `json:"amount"`
+	FromUserId int     `json:"fromUserId"`
+	ToUserId   int     `json:"toUserId"`
+}
+
+// GetAmount returns the value of Amount.
+func (s *EntitySettlementTransfer) GetAmount() float64 {
+	return s.Amount
+}
+
+// GetFromUserId returns the value of FromUserId.
+func (s *EntitySettlementTransfer) GetFromUserId() int {
+	return s.FromUserId
+}
+
+// GetToUserId returns the value of ToUserId.
+func (s *EntitySettlementTransfer) GetToUserId() int {
+	return s.ToUserId
+}
+
+// SetAmount sets the value of Amount.
+func (s *EntitySettlementTransfer) SetAmount(val float64) {
+	s.Amount = val
+}
+
+// SetFromUserId sets the value of FromUserId.
+func (s *EntitySettlementTransfer) SetFromUserId(val int) {
+	s.FromUserId = val
+}
+
+// SetToUserId sets the value of ToUserId.
+func (s *EntitySettlementTransfer) SetToUserId(val int) {
+	s.ToUserId = val
+}
+
+type EntitySplitMode string
+
+// Ref: #/components/schemas/entity.StatsCount
+type EntityStatsCount struct {
+	Count int    `json:"count"`
+	Name  string `json:"name"`
+}
+
+// GetCount returns the value of Count.
+func (s *EntityStatsCount) GetCount() int {
+	return s.Count
+}
+
+// GetName returns the value of Name.
+func (s *EntityStatsCount) GetName() string {
+	return s.Name
+}
+
+// SetCount sets the value of Count.
+func (s *EntityStatsCount) SetCount(val int) {
+	s.Count = val
+}
+
+// SetName sets the value of Name.
+func (s *EntityStatsCount) SetName(val string) {
+	s.Name = val
+}
+
+// Ref: #/components/schemas/entity.Tombstone
+type EntityTombstone struct {
+	ID       int              `json:"id"`
+	Kind     EntityEntityKind `json:"kind"`
+	Revision int              `json:"revision"`
+}
+
+// GetID returns the value of ID.
+func (s *EntityTombstone) GetID() int {
+	return s.ID
+}
+
+// GetKind returns the value of Kind.
+func (s *EntityTombstone) GetKind() EntityEntityKind {
+	return s.Kind
+}
+
+// GetRevision returns the value of Revision.
+func (s *EntityTombstone) GetRevision() int {
+	return s.Revision
+}
+
+// SetID sets the value of ID.
+func (s *EntityTombstone) SetID(val int) {
+	s.ID = val
+}
+
+// SetKind sets the value of Kind.
+func (s *EntityTombstone) SetKind(val EntityEntityKind) {
+	s.Kind = val
+}
+
+// SetRevision sets the value of Revision.
+func (s *EntityTombstone) SetRevision(val int) {
+	s.Revision = val
+}
+
+// Ref: #/components/schemas/entity.Track
+type EntityTrack struct {
+	Display               EntityTrackDisplay `json:"display"`
+	DistanceInMeters      float64            `json:"distanceInMeters"`
+	ElevationGainInMeters NilFloat64         `json:"elevationGainInMeters"`
+	EndDateTime           NilString          `json:"endDateTime"`
+	StartDateTime         NilString          `json:"startDateTime"`
+}
+
+// GetDisplay returns the value of Display.
+func (s *EntityTrack) GetDisplay() EntityTrackDisplay {
+	return s.Display
+}
+
+// GetDistanceInMeters returns the value of DistanceInMeters.
+func (s *EntityTrack) GetDistanceInMeters() float64 {
+	return s.DistanceInMeters
+}
+
+// GetElevationGainInMeters returns the value of ElevationGainInMeters.
+func (s *EntityTrack) GetElevationGainInMeters() NilFloat64 {
+	return s.ElevationGainInMeters
+}
+
+// GetEndDateTime returns the value of EndDateTime.
+func (s *EntityTrack) GetEndDateTime() NilString {
+	return s.EndDateTime
+}
+
+// GetStartDateTime returns the value of StartDateTime.
+func (s *EntityTrack) GetStartDateTime() NilString {
+	return s.StartDateTime
+}
+
+// SetDisplay sets the value of Display.
+func (s *EntityTrack) SetDisplay(val EntityTrackDisplay) {
+	s.Display = val
+}
+
+// SetDistanceInMeters sets the value of DistanceInMeters.
+func (s *EntityTrack) SetDistanceInMeters(val float64) {
+	s.DistanceInMeters = val
+}
+
+// SetElevationGainInMeters sets the value of ElevationGainInMeters.
+func (s *EntityTrack) SetElevationGainInMeters(val NilFloat64) {
+	s.ElevationGainInMeters = val
+}
+
+// SetEndDateTime sets the value of EndDateTime.
+func (s *EntityTrack) SetEndDateTime(val NilString) {
+	s.EndDateTime = val
+}
+
+// SetStartDateTime sets the value of StartDateTime.
+func (s *EntityTrack) SetStartDateTime(val NilString) {
+	s.StartDateTime = val
+}
+
+type EntityTrackDisplay string
+
+// Ref: #/components/schemas/entity.TrainDetail
+type EntityTrainDetail struct {
+	Legs         []EntityTrainLeg `json:"legs"`
+	RefreshToken string           `json:"refreshToken"`
+}
+
+// GetLegs returns the value of Legs.
+func (s *EntityTrainDetail) GetLegs() []EntityTrainLeg {
+	return s.Legs
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *EntityTrainDetail) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// SetLegs sets the value of Legs.
+func (s *EntityTrainDetail) SetLegs(val []EntityTrainLeg) {
+	s.Legs = val
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *EntityTrainDetail) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+// Ref: #/components/schemas/entity.TrainLeg
+type EntityTrainLeg struct {
+	ArrivalDateTime    string             `json:"arrivalDateTime"`
+	ArrivalUtcOffset   NilString          `json:"arrivalUtcOffset"`
+	DepartureDateTime  string             `json:"departureDateTime"`
+	DepartureUtcOffset NilString          `json:"departureUtcOffset"`
+	Destination        EntityTrainStation `json:"destination"`
+	DurationInMinutes  int                `json:"durationInMinutes"`
+	ID                 int                `json:"id"`
+	LineName           string             `json:"lineName"`
+	OperatorName       string             `json:"operatorName"`
+	Origin             EntityTrainStation `json:"origin"`
+}
+
+// GetArrivalDateTime returns the value of ArrivalDateTime.
+func (s *EntityTrainLeg) GetArrivalDateTime() string {
+	return s.ArrivalDateTime
+}
+
+// GetArrivalUtcOffset returns the value of ArrivalUtcOffset.
+func (s *EntityTrainLeg) GetArrivalUtcOffset() NilString {
+	return s.ArrivalUtcOffset
+}
+
+// GetDepartureDateTime returns the value of DepartureDateTime.
+func (s *EntityTrainLeg) GetDepartureDateTime() string {
+	return s.DepartureDateTime
+}
+
+// GetDepartureUtcOffset returns the value of DepartureUtcOffset.
+func (s *EntityTrainLeg) GetDepartureUtcOffset() NilString {
+	return s.DepartureUtcOffset
+}
+
+// GetDestination returns the value of Destination.
+func (s *EntityTrainLeg) GetDestination() EntityTrainStation {
+	return s.Destination
+}
+
+// GetDurationInMinutes returns the value of DurationInMinutes.
+func (s *EntityTrainLeg) GetDurationInMinutes() int {
+	return s.DurationInMinutes
+}
+
+// GetID returns the value of ID.
+func (s *EntityTrainLeg) GetID() int {
+	return s.ID
+}
+
+// GetLineName returns the value of LineName.
+func (s *EntityTrainLeg) GetLineName() string {
+	return s.LineName
+}
+
+// GetOperatorName returns the value of OperatorName.
+func (s *EntityTrainLeg) GetOperatorName() string {
+	return s.OperatorName
+}
+
+// GetOrigin returns the value of Origin.
+func (s *EntityTrainLeg) GetOrigin() EntityTrainStation {
+	return s.Origin
+}
+
+// SetArrivalDateTime sets the value of ArrivalDateTime.
+func (s *EntityTrainLeg) SetArrivalDateTime(val string) {
+	s.ArrivalDateTime = val
+}
+
+// SetArrivalUtcOffset sets the value of ArrivalUtcOffset.
+func (s *EntityTrainLeg) SetArrivalUtcOffset(val NilString) {
+	s.ArrivalUtcOffset = val
+}
+
+// SetDepartureDateTime sets the value of DepartureDateTime.
+func (s *EntityTrainLeg) SetDepartureDateTime(val string) {
+	s.DepartureDateTime = val
+}
+
+// SetDepartureUtcOffset sets the value of DepartureUtcOffset.
+func (s *EntityTrainLeg) SetDepartureUtcOffset(val NilString) {
+	s.DepartureUtcOffset = val
+}
+
+// SetDestination sets the value of Destination.
+func (s *EntityTrainLeg) SetDestination(val EntityTrainStation) {
+	s.Destination = val
+}
+
+// SetDurationInMinutes sets the value of DurationInMinutes.
+func (s *EntityTrainLeg) SetDurationInMinutes(val int) {
+	s.DurationInMinutes = val
+}
+
+// SetID sets the value of ID.
+func (s *EntityTrainLeg) SetID(val int) {
+	s.ID = val
+}
+
+// SetLineName sets the value of LineName.
+func (s *EntityTrainLeg) SetLineName(val string) {
+	s.LineName = val
+}
+
+// SetOperatorName sets the value of OperatorName.
+func (s *EntityTrainLeg) SetOperatorName(val string) {
+	s.OperatorName = val
+}
+
+// SetOrigin sets the value of Origin.
+func (s *EntityTrainLeg) SetOrigin(val EntityTrainStation) {
+	s.Origin = val
+}
+
+// Ref: #/components/schemas/entity.TrainStation
+type EntityTrainStation struct {
+	ID       string            `json:"id"`
+	Location NilEntityLocation `json:"location"`
+	Name     string            `json:"name"`
+}
+
+// GetID returns the value of ID.
+func (s *EntityTrainStation) GetID() string {
+	return s.ID
+}
+
+// GetLocation returns the value of Location.
+func (s *EntityTrainStation) GetLocation() NilEntityLocation {
+	return s.Location
+}
+
+// GetName returns the value of Name.
+func (s *EntityTrainStation) GetName() string {
+	return s.Name
+}
+
+// SetID sets the value of ID.
+func (s *EntityTrainStation) SetID(val string) {
+	s.ID = val
+}
+
+// SetLocation sets the value of Location.
+func (s *EntityTrainStation) SetLocation(val NilEntityLocation) {
+	s.Location = val
+}
+
+// SetName sets the value of Name.
+func (s *EntityTrainStation) SetName(val string) {
+	s.Name = val
+}
+
+func (*EntityTrainStation) getTrainStationRes() {}
+
+// Ref: #/components/schemas/entity.Transportation
+type EntityTransportation struct {
+	ArrivalDateTime  string                     `json:"arrivalDateTime"`
+	ArrivalUtcOffset NilString                  `json:"arrivalUtcOffset"`
+	Attachments      []EntityAttachmentMetadata `json:"attachments"`
+	BudgetAlert      OptNilEntityBudgetAlert    `json:"budgetAlert"`
+	// Co2e is the estimated emission in kg CO2e per passenger, nil if there is no emission factor for
+	// the type.
+	Co2e               OptNilFloat64             `json:"co2e"`
+	Currency           string                    `json:"currency"`
+	DepartureDateTime  string                    `json:"departureDateTime"`
+	DepartureUtcOffset NilString                 `json:"departureUtcOffset"`
+	Destination        NilEntityLocation         `json:"destination"`
+	FlightDetail       OptNilEntityFlightDetail  `json:"flightDetail"`
+	GenericDetail      OptNilEntityGenericDetail `json:"genericDetail"`
+	ID                 int                       `json:"id"`
+	Origin             NilEntityLocation         `json:"origin"`
+	Price              NilInt                    `json:"price"`
+	Revision           int                       `json:"revision"`
+	Track              OptNilEntityTrack         `json:"track"`
+	TrainDetail        OptNilEntityTrainDetail   `json:"trainDetail"`
+	TripId             int                       `json:"tripId"`
+	Type               EntityTransportationType  `json:"type"`
+}
+
+// GetArrivalDateTime returns the value of ArrivalDateTime.
+func (s *EntityTransportation) GetArrivalDateTime() string {
+	return s.ArrivalDateTime
+}
+
+// GetArrivalUtcOffset returns the value of ArrivalUtcOffset.
+func (s *EntityTransportation) GetArrivalUtcOffset() NilString {
+	return s.ArrivalUtcOffset
+}
+
+// GetAttachments returns the value of Attachments.
+func (s *EntityTransportation) GetAttachments() []EntityAttachmentMetadata {
+	return s.Attachments
+}
+
+// GetBudgetAlert returns the value of BudgetAlert.
+func (s *EntityTransportation) GetBudgetAlert() OptNilEntityBudgetAlert {
+	return s.BudgetAlert
+}
+
+// GetCo2e returns the value of Co2e.
+func (s *EntityTransportation) GetCo2e() OptNilFloat64 {
+	return s.Co2e
+}
+
+// GetCurrency returns the value of Currency.
+func (s *EntityTransportation) GetCurrency() string {
+	return s.Currency
+}
+
+// GetDepartureDateTime returns the value of DepartureDateTime.
+func (s *EntityTransportation) GetDepartureDateTime() string {
+	return s.DepartureDateTime
+}
+
+// GetDepartureUtcOffset returns the value of DepartureUtcOffset.
+func (s *EntityTransportation) GetDepartureUtcOffset() NilString {
+	return s.DepartureUtcOffset
+}
+
+// GetDestination returns the value of Destination.
+func (s *EntityTransportation) GetDestination() NilEntityLocation {
+	return s.Destination
+}
+
+// GetFlightDetail returns the value of FlightDetail.
+func (s *EntityTransportation) GetFlightDetail() OptNilEntityFlightDetail {
+	return s.FlightDetail
+}
+
+// GetGenericDetail returns the value of GenericDetail.
+func (s *EntityTransportation) GetGenericDetail() OptNilEntityGenericDetail {
+	return s.GenericDetail
+}
+
+// GetID returns the value of ID.
+func (s *EntityTransportation) GetID() int {
+	return s.ID
+}
+
+// GetOrigin returns the value of Origin.
+func (s *EntityTransportation) GetOrigin() NilEntityLocation {
+	return s.Origin
+}
+
+// GetPrice returns the value of Price.
+func (s *EntityTransportation) GetPrice() NilInt {
+	return s.Price
+}
+
+// GetRevision returns the value of Revision.
+func (s *EntityTransportation) GetRevision() int {
+	return s.Revision
+}
+
+// GetTrack returns the value of Track.
+func (s *EntityTransportation) GetTrack() OptNilEntityTrack {
+	return s.Track
+}
+
+// GetTrainDetail returns the value of TrainDetail.
+func (s *EntityTransportation) GetTrainDetail() OptNilEntityTrainDetail {
+	return s.TrainDetail
+}
+
+// GetTripId returns the value of TripId.
+func (s *EntityTransportation) GetTripId() int {
+	return s.TripId
+}
+
+// GetType returns the value of Type.
+func (s *EntityTransportation) GetType() EntityTransportationType {
+	return s.Type
+}
+
+// SetArrivalDateTime sets the value of ArrivalDateTime.
+func (s *EntityTransportation) SetArrivalDateTime(val string) {
+	s.ArrivalDateTime = val
+}
+
+// SetArrivalUtcOffset sets the value of ArrivalUtcOffset.
+func (s *EntityTransportation) SetArrivalUtcOffset(val NilString) {
+	s.ArrivalUtcOffset = val
+}
+
+// SetAttachments sets the value of Attachments.
+func (s *EntityTransportation) SetAttachments(val []EntityAttachmentMetadata) {
+	s.Attachments = val
+}
+
+// SetBudgetAlert sets the value of BudgetAlert.
+func (s *EntityTransportation) SetBudgetAlert(val OptNilEntityBudgetAlert) {
+	s.BudgetAlert = val
+}
+
+// SetCo2e sets the value of Co2e.
+func (s *EntityTransportation) SetCo2e(val OptNilFloat64) {
+	s.Co2e = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *EntityTransportation) SetCurrency(val string) {
+	s.Currency = val
+}
+
+// SetDepartureDateTime sets the value of DepartureDateTime.
+func (s *EntityTransportation) SetDepartureDateTime(val string) {
+	s.DepartureDateTime = val
+}
+
+// SetDepartureUtcOffset sets the value of DepartureUtcOffset.
+func (s *EntityTransportation) SetDepartureUtcOffset(val NilString) {
+	s.DepartureUtcOffset = val
+}
+
+// SetDestination sets the value of Destination.
+func (s *EntityTransportation) SetDestination(val NilEntityLocation) {
+	s.Destination = val
+}
+
+// SetFlightDetail sets the value of FlightDetail.
+func (s *EntityTransportation) SetFlightDetail(val OptNilEntityFlightDetail) {
+	s.FlightDetail = val
+}
+
+// SetGenericDetail sets the value of GenericDetail.
+func (s *EntityTransportation) SetGenericDetail(val OptNilEntityGenericDetail) {
+	s.GenericDetail = val
+}
+
+// SetID sets the value of ID.
+func (s *EntityTransportation) SetID(val int) {
+	s.ID = val
+}
+
+// SetOrigin sets the value of Origin.
+func (s *EntityTransportation) SetOrigin(val NilEntityLocation) {
+	s.Origin = val
+}
+
+// SetPrice sets the value of Price.
+func (s *EntityTransportation) SetPrice(val NilInt) {
+	s.Price = val
+}
+
+// SetRevision sets the value of Revision.
+func (s *EntityTransportation) SetRevision(val int) {
+	s.Revision = val
+}
+
+// SetTrack sets the value of Track.
+func (s *EntityTransportation) SetTrack(val OptNilEntityTrack) {
+	s.Track = val
+}
+
+// SetTrainDetail sets the value of TrainDetail.
+func (s *EntityTransportation) SetTrainDetail(val OptNilEntityTrainDetail) {
+	s.TrainDetail = val
+}
+
+// SetTripId sets the value of TripId.
+func (s *EntityTransportation) SetTripId(val int) {
+	s.TripId = val
+}
+
+// SetType sets the value of Type.
+func (s *EntityTransportation) SetType(val EntityTransportationType) {
+	s.Type = val
+}
+
+func (*EntityTransportation) getTransportationRes()     {}
+func (*EntityTransportation) postFlightRes()            {}
+func (*EntityTransportation) postTrackRes()             {}
+func (*EntityTransportation) postTrainJourneyRes()      {}
+func (*EntityTransportation) postTransportationRes()    {}
+func (*EntityTransportation) restoreTransportationRes() {}
+
+// Ref: #/components/schemas/entity.TransportationDistance
+type EntityTransportationDistance struct {
+	Kilometers float64 `json:"kilometers"`
+	Type       string  `json:"type"`
+}
+
+// GetKilometers returns the value of Kilometers.
+func (s *EntityTransportationDistance) GetKilometers() float64 {
+	return s.Kilometers
+}
+
+// GetType returns the value of Type.
+func (s *EntityTransportationDistance) GetType() string {
+	return s.Type
+}
+
+// SetKilometers sets the value of Kilometers.
+func (s *EntityTransportationDistance) SetKilometers(val float64) {
+	s.Kilometers = val
+}
+
+// SetType sets the value of Type.
+func (s *EntityTransportationDistance) SetType(val string) {
+	s.Type = val
+}
+
+// Ref: #/components/schemas/entity.TransportationEmissions
+type EntityTransportationEmissions struct {
+	Co2e float64 `json:"co2e"`
+	Type string  `json:"type"`
+}
+
+// GetCo2e returns the value of Co2e.
+func (s *EntityTransportationEmissions) GetCo2e() float64 {
+	return s.Co2e
+}
+
+// GetType returns the value of Type.
+func (s *EntityTransportationEmissions) GetType() string {
+	return s.Type
+}
+
+// SetCo2e sets the value of Co2e.
+func (s *EntityTransportationEmissions) SetCo2e(val float64) {
+	s.Co2e = val
+}
+
+// SetType sets the value of Type.
+func (s *EntityTransportationEmissions) SetType(val string) {
+	s.Type = val
+}
+
+type EntityTransportationType string
+
+// Ref: #/components/schemas/entity.TrashItem
+type EntityTrashItem struct {
+	DeletedAt string    `json:"deletedAt"`
+	ID        int       `json:"id"`
+	Kind      string    `json:"kind"`
+	Name      string    `json:"name"`
+	PurgeAt   NilString `json:"purgeAt"`
+	TripId    int       `json:"tripId"`
+}
+
+// GetDeletedAt returns the value of DeletedAt.
+func (s *EntityTrashItem) GetDeletedAt() string {
+	return s.DeletedAt
+}
+
+// GetID returns the value of ID.
+func (s *EntityTrashItem) GetID() int {
+	return s.ID
+}
+
+// GetKind returns the value of Kind.
+func (s *EntityTrashItem) GetKind() string {
+	return s.Kind
+}
+
+// GetName returns the value of Name.
+func (s *EntityTrashItem) GetName() string {
+	return s.Name
+}
+
+// GetPurgeAt returns the value of PurgeAt.
+func (s *EntityTrashItem) GetPurgeAt() NilString {
+	return s.PurgeAt
+}
+
+// GetTripId returns the value of TripId.
+func (s *EntityTrashItem) GetTripId() int {
+	return s.TripId
+}
+
+// SetDeletedAt sets the value of DeletedAt.
+func (s *EntityTrashItem) SetDeletedAt(val string) {
+	s.DeletedAt = val
+}
+
+// SetID sets the value of ID.
+func (s *EntityTrashItem) SetID(val int) {
+	s.ID = val
+}
+
+// SetKind sets the value of Kind.
+func (s *EntityTrashItem) SetKind(val string) {
+	s.Kind = val
+}
+
+// SetName sets the value of Name.
+func (s *EntityTrashItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetPurgeAt sets the value of PurgeAt.
+func (s *EntityTrashItem) SetPurgeAt(val NilString) {
+	s.PurgeAt = val
+}
+
+// SetTripId sets the value of TripId.
+func (s *EntityTrashItem) SetTripId(val int) {
+	s.TripId = val
+}
+
+// Ref: #/components/schemas/entity.TravelStats
+type EntityTravelStats struct {
+	Distances         []EntityTransportationDistance `json:"distances"`
+	FlightHours       float64                        `json:"flightHours"`
+	Flights           int                            `json:"flights"`
+	TopAircraft       []EntityStatsCount             `json:"topAircraft"`
+	TopAirlines       []EntityStatsCount             `json:"topAirlines"`
+	TopAirports       []EntityStatsCount             `json:"topAirports"`
+	TopTrainOperators []EntityStatsCount             `json:"topTrainOperators"`
+	TrainHours        float64                        `json:"trainHours"`
+	Trips             int                            `json:"trips"`
+	Year              NilInt                         `json:"year"`
+}
+
+// GetDistances returns the value of Distances.
+func (s *EntityTravelStats) GetDistances() []EntityTransportationDistance {
+	return s.Distances
+}
+
+// GetFlightHours returns the value of FlightHours.
+func (s *EntityTravelStats) GetFlightHours() float64 {
+	return s.FlightHours
+}
+
+// GetFlights returns the value of Flights.
+func (s *EntityTravelStats) GetFlights() int {
+	return s.Flights
+}
+
+// GetTopAircraft returns the value of TopAircraft.
+func (s *EntityTravelStats) GetTopAircraft() []EntityStatsCount {
+	return s.TopAircraft
+}
+
+// GetTopAirlines returns the value of TopAirlines.
+func (s *EntityTravelStats) GetTopAirlines() []EntityStatsCount {
+	return s.TopAirlines
+}
+
+// GetTopAirports returns the value of TopAirports.
+func (s *EntityTravelStats) GetTopAirports() []EntityStatsCount {
+	return s.TopAirports
+}
+
+// GetTopTrainOperators returns the value of TopTrainOperators.
+func (s *EntityTravelStats) GetTopTrainOperators() []EntityStatsCount {
+	return s.TopTrainOperators
+}
+
+// GetTrainHours returns the value of TrainHours.
+func (s *EntityTravelStats) GetTrainHours() float64 {
+	return s.TrainHours
+}
+
+// GetTrips returns the value of Trips.
+func (s *EntityTravelStats) GetTrips() int {
+	return s.Trips
+}
+
+// GetYear returns the value of Year.
+func (s *EntityTravelStats) GetYear() NilInt {
+	return s.Year
+}
+
+// SetDistances sets the value of Distances.
+func (s *EntityTravelStats) SetDistances(val []EntityTransportationDistance) {
+	s.Distances = val
+}
+
+// SetFlightHours sets the value of FlightHours.
+func (s *EntityTravelStats) SetFlightHours(val float64) {
+	s.FlightHours = val
+}
+
+// SetFlights sets the value of Flights.
+func (s *EntityTravelStats) SetFlights(val int) {
+	s.Flights = val
+}
+
+// SetTopAircraft sets the value of TopAircraft.
+func (s *EntityTravelStats) SetTopAircraft(val []EntityStatsCount) {
+	s.TopAircraft = val
+}
+
+// SetTopAirlines sets the value of TopAirlines.
+func (s *EntityTravelStats) SetTopAirlines(val []EntityStatsCount) {
+	s.TopAirlines = val
+}
+
+// SetTopAirports sets the value of TopAirports.
+func (s *EntityTravelStats) SetTopAirports(val []EntityStatsCount) {
+	s.TopAirports = val
+}
+
+// SetTopTrainOperators sets the value of TopTrainOperators.
+func (s *EntityTravelStats) SetTopTrainOperators(val []EntityStatsCount) {
+	s.TopTrainOperators = val
+}
+
+// SetTrainHours sets the value of TrainHours.
+func (s *EntityTravelStats) SetTrainHours(val float64) {
+	s.TrainHours = val
+}
+
+// SetTrips sets the value of Trips.
+func (s *EntityTravelStats) SetTrips(val int) {
+	s.Trips = val
+}
+
+// SetYear sets the value of Year.
+func (s *EntityTravelStats) SetYear(val NilInt) {
+	s.Year = val
+}
+
+func (*EntityTravelStats) getStatsRes() {}
+
+// Ref: #/components/schemas/entity.Trip
+type EntityTrip struct {
+	Budget       NilFloat64 `json:"budget"`
+	Description  NilString  `json:"description"`
+	EndDate      string     `json:"endDate"`
+	HomeCurrency string     `json:"homeCurrency"`
+	ID           int        `json:"id"`
+	ImageUrl     NilString  `json:"imageUrl"`
+	Name         string     `json:"name"`
+	OwnerID      int        `json:"owner_id"`
+	Revision     int        `json:"revision"`
+	StartDate    string     `json:"startDate"`
+	Template     bool       `json:"template"`
+}
+
+// GetBudget returns the value of Budget.
+func (s *EntityTrip) GetBudget() NilFloat64 {
+	return s.Budget
+}
+
+// GetDescription returns the value of Description.
+func (s *EntityTrip) GetDescription() NilString {
+	return s.Description
+}
+
+// GetEndDate returns the value of EndDate.
+func (s *EntityTrip) GetEndDate() string {
+	return s.EndDate
+}
+
+// GetHomeCurrency returns the value of HomeCurrency.
+func (s *EntityTrip) GetHomeCurrency() string {
+	return s.HomeCurrency
+}
+
+// GetID returns the value of ID.
+func (s *EntityTrip) GetID() int {
+	return s.ID
+}
+
+// GetImageUrl returns the value of ImageUrl.
+func (s *EntityTrip) GetImageUrl() NilString {
+	return s.ImageUrl
+}
+
+// GetName returns the value of Name.
+func (s *EntityTrip) GetName() string {
+	return s.Name
+}
+
+// GetOwnerID returns the value of OwnerID.
+func (s *EntityTrip) GetOwnerID() int {
+	return s.OwnerID
+}
+
+// GetRevision returns the value of Revision.
+func (s *EntityTrip) GetRevision() int {
+	return s.Revision
+}
+
+// GetStartDate returns the value of StartDate.
+func (s *EntityTrip) GetStartDate() string {
+	return s.StartDate
+}
+
+// GetTemplate returns the value of Template.
+func (s *EntityTrip) GetTemplate() bool {
+	return s.Template
+}
+
+// SetBudget sets the value of Budget.
+func (s *EntityTrip) SetBudget(val NilFloat64) {
+	s.Budget = val
+}
+
+// SetDescription sets the value of Description.
+func (s *EntityTrip) SetDescription(val NilString) {
+	s.Description = val
+}
+
+// SetEndDate sets the value of EndDate.
+func (s *EntityTrip) SetEndDate(val string) {
+	s.EndDate = val
+}
+
+// SetHomeCurrency sets the value of HomeCurrency.
+func (s *EntityTrip) SetHomeCurrency(val string) {
+	s.HomeCurrency = val
+}
+
+// SetID sets the value of ID.
+func (s *EntityTrip) SetID(val int) {
+	s.ID = val
+}
+
+// SetImageUrl sets the value of ImageUrl.
+func (s *EntityTrip) SetImageUrl(val NilString) {
+	s.ImageUrl = val
+}
+
+// SetName sets the value of Name.
+func (s *EntityTrip) SetName(val string) {
+	s.Name = val
+}
+
+// SetOwnerID sets the value of OwnerID.
+func (s *EntityTrip) SetOwnerID(val int) {
+	s.OwnerID = val
+}
+
+// SetRevision sets the value of Revision.
+func (s *EntityTrip) SetRevision(val int) {
+	s.Revision = val
+}
+
+// SetStartDate sets the value of StartDate.
+func (s *EntityTrip) SetStartDate(val string) {
+	s.StartDate = val
+}
+
+// SetTemplate sets the value of Template.
+func (s *EntityTrip) SetTemplate(val bool) {
+	s.Template = val
+}
+
+func (*EntityTrip) copyTripRes() {}
+func (*EntityTrip) getTripRes()  {}
+func (*EntityTrip) postTripRes() {}
+
+// Ref: #/components/schemas/entity.TripEmissions
+type EntityTripEmissions struct {
+	Total       float64                         `json:"total"`
+	Types       []EntityTransportationEmissions `json:"types"`
+	Unestimated int                             `json:"unestimated"`
+}
+
+// GetTotal returns the value of Total.
+func (s *EntityTripEmissions) GetTotal() float64 {
+	return s.Total
+}
+
+// GetTypes returns the value of Types.
+func (s *EntityTripEmissions) GetTypes() []EntityTransportationEmissions {
+	return s.Types
+}
+
+// GetUnestimated returns the value of Unestimated.
+func (s *EntityTripEmissions) GetUnestimated() int {
+	return s.Unestimated
+}
+
+// SetTotal sets the value of Total.
+func (s *EntityTripEmissions) SetTotal(val float64) {
+	s.Total = val
+}
+
+// SetTypes sets the value of Types.
+func (s *EntityTripEmissions) SetTypes(val []EntityTransportationEmissions) {
+	s.Types = val
+}
+
+// SetUnestimated sets the value of Unestimated.
+func (s *EntityTripEmissions) SetUnestimated(val int) {
+	s.Unestimated = val
+}
+
+func (*EntityTripEmissions) getEmissionsRes() {}
+
+// Ref: #/components/schemas/entity.User
+type EntityUser struct {
+	ID     int    `json:"id"`
+	JwtSub string `json:"jwtSub"`
+	Name   string `json:"name"`
+}
+
+// GetID returns the value of ID.
+func (s *EntityUser) GetID() int {
+	return s.ID
+}
+
+// GetJwtSub returns the value of JwtSub.
+func (s *EntityUser) GetJwtSub() string {
+	return s.JwtSub
+}
+
+// GetName returns the value of Name.
+func (s *EntityUser) GetName() string {
+	return s.Name
+}
+
+// SetID sets the value of ID.
+func (s *EntityUser) SetID(val int) {
+	s.ID = val
+}
+
+// SetJwtSub sets the value of JwtSub.
+func (s *EntityUser) SetJwtSub(val string) {
+	s.JwtSub = val
+}
+
+// SetName sets the value of Name.
+func (s *EntityUser) SetName(val string) {
+	s.Name = val
+}
+
+func (*EntityUser) getUserRes() {}
+
+type GetAccommodationByIDBadRequest ResponseError
+
+func (*GetAccommodationByIDBadRequest) getAccommodationByIDRes() {}
+
+type GetAccommodationByIDForbidden ResponseError
+
+func (*GetAccommodationByIDForbidden) getAccommodationByIDRes() {}
+
+type GetAccommodationByIDInternalServerError ResponseError
+
+func (*GetAccommodationByIDInternalServerError) getAccommodationByIDRes() {}
+
+type GetAccommodationByIDNotFound ResponseError
+
+func (*GetAccommodationByIDNotFound) getAccommodationByIDRes() {}
+
+// GetAccommodationByIDNotModified is response for GetAccommodationByID operation.
+type GetAccommodationByIDNotModified struct{}
+
+func (*GetAccommodationByIDNotModified) getAccommodationByIDRes() {}
+
+type GetActivitiesBadRequest ResponseError
+
+func (*GetActivitiesBadRequest) getActivitiesRes() {}
+
+type GetActivitiesForbidden ResponseError
+
+func (*GetActivitiesForbidden) getActivitiesRes() {}
+
+type GetActivitiesInternalServerError ResponseError
+
+func (*GetActivitiesInternalServerError) getActivitiesRes() {}
+
+type GetActivitiesOKApplicationJSON []EntityActivity
+
+func (*GetActivitiesOKApplicationJSON) getActivitiesRes() {}
+
+type GetActivityBadRequest ResponseError
+
+func (*GetActivityBadRequest) getActivityRes() {}
+
+type GetActivityForbidden ResponseError
+
+func (*GetActivityForbidden) getActivityRes() {}
+
+type GetActivityInternalServerError ResponseError
+
+func (*GetActivityInternalServerError) getActivityRes() {}
+
+type GetActivityNotFound ResponseError
+
+func (*GetActivityNotFound) getActivityRes() {}
+
+// GetActivityNotModified is response for GetActivity operation.
+type GetActivityNotModified struct{}
+
+func (*GetActivityNotModified) getActivityRes() {}
+
+type GetAllAccommodationBadRequest ResponseError
+
+func (*GetAllAccommodationBadRequest) getAllAccommodationRes() {}
+
+type GetAllAccommodationForbidden ResponseError
+
+func (*GetAllAccommodationForbidden) getAllAccommodationRes() {}
+
+type GetAllAccommodationInternalServerError ResponseError
+
+func (*GetAllAccommodationInternalServerError) getAllAccommodationRes() {}
+
+type GetAllAccommodationOKApplicationJSON []EntityAccommodation
+
+func (*GetAllAccommodationOKApplicationJSON) getAllAccommodationRes() {}
+
+type GetAllTransportationForbidden ResponseError
+
+func (*GetAllTransportationForbidden) getAllTransportationRes() {}
+
+type GetAllTransportationInternalServerError ResponseError
+
+func (*GetAllTransportationInternalServerError) getAllTransportationRes() {}
+
+type GetAllTransportationOKApplicationJSON []EntityTransportation
+
+func (*GetAllTransportationOKApplicationJSON) getAllTransportationRes() {}
+
+type GetAttachmentThumbnailBadRequest ResponseError
+
+func (*GetAttachmentThumbnailBadRequest) getAttachmentThumbnailRes() {}
+
+type GetAttachmentThumbnailForbidden ResponseError
+
+func (*GetAttachmentThumbnailForbidden) getAttachmentThumbnailRes() {}
+
+type GetAttachmentThumbnailInternalServerError ResponseError
+
+func (*GetAttachmentThumbnailInternalServerError) getAttachmentThumbnailRes() {}
+
+type GetAttachmentThumbnailNotFound ResponseError
+
+func (*GetAttachmentThumbnailNotFound) getAttachmentThumbnailRes() {}
+
+// GetAttachmentThumbnailNotModified is response for GetAttachmentThumbnail operation.
+type GetAttachmentThumbnailNotModified struct{}
+
+func (*GetAttachmentThumbnailNotModified) getAttachmentThumbnailRes() {}
+
+type GetAttachmentThumbnailOKApplicationJSON string
+
+func (*GetAttachmentThumbnailOKApplicationJSON) getAttachmentThumbnailRes() {}
+
+type GetAttachmentThumbnailOKImageJpeg struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetAttachmentThumbnailOKImageJpeg) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetAttachmentThumbnailOKImageJpeg) getAttachmentThumbnailRes() {}
+
+type GetAttachmentThumbnailSize string
+
+const (
+	GetAttachmentThumbnailSizeSmall  GetAttachmentThumbnailSize = "small"
+	GetAttachmentThumbnailSizeMedium GetAttachmentThumbnailSize = "medium"
+	GetAttachmentThumbnailSizeLarge  GetAttachmentThumbnailSize = "large"
+)
+
+// AllValues returns all GetAttachmentThumbnailSize values.
+func (GetAttachmentThumbnailSize) AllValues() []GetAttachmentThumbnailSize {
+	return []GetAttachmentThumbnailSize{
+		GetAttachmentThumbnailSizeSmall,
+		GetAttachmentThumbnailSizeMedium,
+		GetAttachmentThumbnailSizeLarge,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetAttachmentThumbnailSize) MarshalText() ([]byte, error) {
+	switch s {
+	case GetAttachmentThumbnailSizeSmall:
+		return []byte(s), nil
+	case GetAttachmentThumbnailSizeMedium:
+		return []byte(s), nil
+	case GetAttachmentThumbnailSizeLarge:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetAttachmentThumbnailSize) UnmarshalText(data []byte) error {
+	switch GetAttachmentThumbnailSize(data) {
+	case GetAttachmentThumbnailSizeSmall:
+		*s = GetAttachmentThumbnailSizeSmall
+		return nil
+	case GetAttachmentThumbnailSizeMedium:
+		*s = GetAttachmentThumbnailSizeMedium
+		return nil
+	case GetAttachmentThumbnailSizeLarge:
+		*s = GetAttachmentThumbnailSizeLarge
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetAttachmentThumbnailUnprocessableEntity ResponseError
+
+func (*GetAttachmentThumbnailUnprocessableEntity) getAttachmentThumbnailRes() {}
+
+type GetAttachmentURLForbidden ResponseError
+
+func (*GetAttachmentURLForbidden) getAttachmentURLRes() {}
+
+type GetAttachmentURLInternalServerError ResponseError
+
+func (*GetAttachmentURLInternalServerError) getAttachmentURLRes() {}
+
+type GetAttachmentURLNotFound ResponseError
+
+func (*GetAttachmentURLNotFound) getAttachmentURLRes() {}
+
+type GetAttachmentURLNotImplemented ResponseError
+
+func (*GetAttachmentURLNotImplemented) getAttachmentURLRes() {}
+
+type GetAttachmentsForbidden ResponseError
+
+func (*GetAttachmentsForbidden) getAttachmentsRes() {}
+
+type GetAttachmentsInternalServerError ResponseError
+
+func (*GetAttachmentsInternalServerError) getAttachmentsRes() {}
+
+type GetAttachmentsOKApplicationJSON []EntityAttachment
+
+func (*GetAttachmentsOKApplicationJSON) getAttachmentsRes() {}
+
+type GetBudgetBadRequest ResponseError
+
+func (*GetBudgetBadRequest) getBudgetRes() {}
+
+type GetBudgetForbidden ResponseError
+
+func (*GetBudgetForbidden) getBudgetRes() {}
+
+type GetBudgetInternalServerError ResponseError
+
+func (*GetBudgetInternalServerError) getBudgetRes() {}
+
+type GetBudgetReportBadRequest ResponseError
+
+func (*GetBudgetReportBadRequest) getBudgetReportRes() {}
+
+type GetBudgetReportForbidden ResponseError
+
+func (*GetBudgetReportForbidden) getBudgetReportRes() {}
+
+type GetBudgetReportInternalServerError ResponseError
+
+func (*GetBudgetReportInternalServerError) getBudgetReportRes() {}
+
+type GetChangesBadRequest ResponseError
+
+func (*GetChangesBadRequest) getChangesRes() {}
+
+type GetChangesForbidden ResponseError
+
+func (*GetChangesForbidden) getChangesRes() {}
+
+type GetChangesInternalServerError ResponseError
+
+func (*GetChangesInternalServerError) getChangesRes() {}
+
+type GetChecksBadRequest ResponseError
+
+func (*GetChecksBadRequest) getChecksRes() {}
+
+type GetChecksForbidden ResponseError
+
+func (*GetChecksForbidden) getChecksRes() {}
+
+type GetChecksInternalServerError ResponseError
+
+func (*GetChecksInternalServerError) getChecksRes() {}
+
+type GetChecksOKApplicationJSON []EntityItineraryCheck
+
+func (*GetChecksOKApplicationJSON) getChecksRes() {}
+
+type GetCostsBadRequest ResponseError
+
+func (*GetCostsBadRequest) getCostsRes() {}
+
+type GetCostsForbidden ResponseError
+
+func (*GetCostsForbidden) getCostsRes() {}
+
+type GetCostsInternalServerError ResponseError
+
+func (*GetCostsInternalServerError) getCostsRes() {}
+
+type GetEmissionsBadRequest ResponseError
+
+func (*GetEmissionsBadRequest) getEmissionsRes() {}
+
+type GetEmissionsForbidden ResponseError
+
+func (*GetEmissionsForbidden) getEmissionsRes() {}
+
+type GetEmissionsInternalServerError ResponseError
+
+func (*GetEmissionsInternalServerError) getEmissionsRes() {}
+
+type GetExpenseBadRequest ResponseError
+
+func (*GetExpenseBadRequest) getExpenseRes() {}
+
+type GetExpenseForbidden ResponseError
+
+func (*GetExpenseForbidden) getExpenseRes() {}
+
+type GetExpenseInternalServerError ResponseError
+
+func (*GetExpenseInternalServerError) getExpenseRes() {}
+
+type GetExpenseNotFound ResponseError
+
+func (*GetExpenseNotFound) getExpenseRes() {}
+
+// GetExpenseNotModified is response for GetExpense operation.
+type GetExpenseNotModified struct{}
+
+func (*GetExpenseNotModified) getExpenseRes() {}
+
+type GetExpensesBadRequest ResponseError
+
+func (*GetExpensesBadRequest) getExpensesRes() {}
+
+type GetExpensesForbidden ResponseError
+
+func (*GetExpensesForbidden) getExpensesRes() {}
+
+type GetExpensesInternalServerError ResponseError
+
+func (*GetExpensesInternalServerError) getExpensesRes() {}
+
+type GetExpensesOKApplicationJSON []EntityExpense
+
+func (*GetExpensesOKApplicationJSON) getExpensesRes() {}
+
+type GetGeoJsonForbidden ResponseError
+
+func (*GetGeoJsonForbidden) getGeoJsonRes() {}
+
+type GetGeoJsonInternalServerError ResponseError
+
+func (*GetGeoJsonInternalServerError) getGeoJsonRes() {}
+
+type GetGeoJsonOKApplicationJSON []string
+
+func (*GetGeoJsonOKApplicationJSON) getGeoJsonRes() {}
+
+type GetGpxBadRequest ResponseError
+
+func (*GetGpxBadRequest) getGpxRes() {}
+
+type GetGpxForbidden ResponseError
+
+func (*GetGpxForbidden) getGpxRes() {}
+
+type GetGpxInternalServerError ResponseError
+
+func (*GetGpxInternalServerError) getGpxRes() {}
+
+type GetGpxOKApplicationGpxXML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetGpxOKApplicationGpxXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetGpxOKApplicationGpxXML) getGpxRes() {}
+
+type GetGpxOKApplicationJSON string
+
+func (*GetGpxOKApplicationJSON) getGpxRes() {}
+
+type GetHistoryBadRequest ResponseError
+
+func (*GetHistoryBadRequest) getHistoryRes() {}
+
+type GetHistoryForbidden ResponseError
+
+func (*GetHistoryForbidden) getHistoryRes() {}
+
+type GetHistoryInternalServerError ResponseError
+
+func (*GetHistoryInternalServerError) getHistoryRes() {}
+
+type GetHistoryOKApplicationJSON []EntityHistoryEntry
+
+func (*GetHistoryOKApplicationJSON) getHistoryRes() {}
+
+type GetItineraryBadRequest ResponseError
+
+func (*GetItineraryBadRequest) getItineraryRes() {}
+
+type GetItineraryForbidden ResponseError
+
+func (*GetItineraryForbidden) getItineraryRes() {}
+
+type GetItineraryInternalServerError ResponseError
+
+func (*GetItineraryInternalServerError) getItineraryRes() {}
+
+type GetItineraryOKApplicationJSON []EntityItineraryDay
+
+func (*GetItineraryOKApplicationJSON) getItineraryRes() {}
+
+type GetItineraryPdfBadRequest ResponseError
+
+func (*GetItineraryPdfBadRequest) getItineraryPdfRes() {}
+
+type GetItineraryPdfForbidden ResponseError
+
+func (*GetItineraryPdfForbidden) getItineraryPdfRes() {}
+
+type GetItineraryPdfInternalServerError ResponseError
+
+func (*GetItineraryPdfInternalServerError) getItineraryPdfRes() {}
+
+type GetItineraryPdfOKApplicationJSON string
+
+func (*GetItineraryPdfOKApplicationJSON) getItineraryPdfRes() {}
+
+type GetItineraryPdfOKApplicationPdf struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetItineraryPdfOKApplicationPdf) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetItineraryPdfOKApplicationPdf) getItineraryPdfRes() {}
+
+type GetKmlBadRequest ResponseError
+
+func (*GetKmlBadRequest) getKmlRes() {}
+
+type GetKmlForbidden ResponseError
+
+func (*GetKmlForbidden) getKmlRes() {}
+
+type GetKmlInternalServerError ResponseError
+
+func (*GetKmlInternalServerError) getKmlRes() {}
+
+type GetKmlOKApplicationJSON string
+
+func (*GetKmlOKApplicationJSON) getKmlRes() {}
+
+type GetKmlOKApplicationVndGoogleEarthKmlXML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetKmlOKApplicationVndGoogleEarthKmlXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetKmlOKApplicationVndGoogleEarthKmlXML) getKmlRes() {}
+
+type GetSettlementBadRequest ResponseError
+
+func (*GetSettlementBadRequest) getSettlementRes() {}
+
+type GetSettlementConflict ResponseError
+
+func (*GetSettlementConflict) getSettlementRes() {}
+
+type GetSettlementForbidden ResponseError
+
+func (*GetSettlementForbidden) getSettlementRes() {}
+
+type GetSettlementInternalServerError ResponseError
+
+func (*GetSettlementInternalServerError) getSettlementRes() {}
+
+type GetStatsBadRequest ResponseError
+
+func (*GetStatsBadRequest) getStatsRes() {}
+
+type GetStatsInternalServerError ResponseError
+
+func (*GetStatsInternalServerError) getStatsRes() {}
+
+type GetTemplatesOKApplicationJSON []EntityTrip
+
+func (*GetTemplatesOKApplicationJSON) getTemplatesRes() {}
+
+type GetTransportationForbidden ResponseError
+
+func (*GetTransportationForbidden) getTransportationRes() {}
+
+type GetTransportationInternalServerError ResponseError
+
+func (*GetTransportationInternalServerError) getTransportationRes() {}
+
+type GetTransportationNotFound ResponseError
+
+func (*GetTransportationNotFound) getTransportationRes() {}
+
+// GetTransportationNotModified is response for GetTransportation operation.
+type GetTransportationNotModified struct{}
+
+func (*GetTransportationNotModified) getTransportationRes() {}
+
+type GetTrashOKApplicationJSON []EntityTrashItem
+
+func (*GetTrashOKApplicationJSON) getTrashRes() {}
+
+type GetTripBadRequest ResponseError
+
+func (*GetTripBadRequest) getTripRes() {}
+
+type GetTripEventsForbidden ResponseError
+
+func (*GetTripEventsForbidden) getTripEventsRes() {}
+
+type GetTripEventsInternalServerError ResponseError
+
+func (*GetTripEventsInternalServerError) getTripEventsRes() {}
+
+type GetTripEventsOKApplicationJSON string
+
+func (*GetTripEventsOKApplicationJSON) getTripEventsRes() {}
+
+type GetTripEventsOKTextEventStream struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetTripEventsOKTextEventStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetTripEventsOKTextEventStream) getTripEventsRes() {}
+
+type GetTripForbidden ResponseError
+
+func (*GetTripForbidden) getTripRes() {}
+
+type GetTripInternalServerError ResponseError
+
+func (*GetTripInternalServerError) getTripRes() {}
+
+// GetTripNotModified is response for GetTrip operation.
+type GetTripNotModified struct{}
+
+func (*GetTripNotModified) getTripRes() {}
+
+type GetTripTrashBadRequest ResponseError
+
+func (*GetTripTrashBadRequest) getTripTrashRes() {}
+
+type GetTripTrashForbidden ResponseError
+
+func (*GetTripTrashForbidden) getTripTrashRes() {}
+
+type GetTripTrashInternalServerError ResponseError
+
+func (*GetTripTrashInternalServerError) getTripTrashRes() {}
+
+type GetTripTrashOKApplicationJSON []EntityTrashItem
+
+func (*GetTripTrashOKApplicationJSON) getTripTrashRes() {}
+
+type GetTripsOKApplicationJSON []EntityTrip
+
+func (*GetTripsOKApplicationJSON) getTripsRes() {}
+
+type GetUsersOKApplicationJSON []EntityUser
+
+func (*GetUsersOKApplicationJSON) getUsersRes() {}
+
+type ImportPhotosBadRequest ResponseError
+
+func (*ImportPhotosBadRequest) importPhotosRes() {}
+
+type ImportPhotosForbidden ResponseError
+
+func (*ImportPhotosForbidden) importPhotosRes() {}
+
+type ImportPhotosInternalServerError ResponseError
+
+func (*ImportPhotosInternalServerError) importPhotosRes() {}
+
+type ImportPhotosLengthRequired ResponseError
+
+func (*ImportPhotosLengthRequired) importPhotosRes() {}
+
+type ImportPhotosRequestEntityTooLarge ResponseError
+
+func (*ImportPhotosRequestEntityTooLarge) importPhotosRes() {}
+
+type LinkAttachmentBadRequest ResponseError
+
+func (*LinkAttachmentBadRequest) linkAttachmentRes() {}
+
+type LinkAttachmentForbidden ResponseError
+
+func (*LinkAttachmentForbidden) linkAttachmentRes() {}
+
+type LinkAttachmentInternalServerError ResponseError
+
+func (*LinkAttachmentInternalServerError) linkAttachmentRes() {}
+
+// LinkAttachmentNoContent is response for LinkAttachment operation.
+type LinkAttachmentNoContent struct{}
+
+func (*LinkAttachmentNoContent) linkAttachmentRes() {}
+
+type LinkAttachmentNotFound ResponseError
+
+func (*LinkAttachmentNotFound) linkAttachmentRes() {}
+
+type LinkAttachmentTarget string
+
+const (
+	LinkAttachmentTargetTransportation LinkAttachmentTarget = "transportation"
+	LinkAttachmentTargetActivities     LinkAttachmentTarget = "activities"
+	LinkAttachmentTargetAccommodation  LinkAttachmentTarget = "accommodation"
+)
+
+// AllValues returns all LinkAttachmentTarget values.
+func (LinkAttachmentTarget) AllValues() []LinkAttachmentTarget {
+	return []LinkAttachmentTarget{
+		LinkAttachmentTargetTransportation,
+		LinkAttachmentTargetActivities,
+		LinkAttachmentTargetAccommodation,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s LinkAttachmentTarget) MarshalText() ([]byte, error) {
+	switch s {
+	case LinkAttachmentTargetTransportation:
+		return []byte(s), nil
+	case LinkAttachmentTargetActivities:
+		return []byte(s), nil
+	case LinkAttachmentTargetAccommodation:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LinkAttachmentTarget) UnmarshalText(data []byte) error {
+	switch LinkAttachmentTarget(data) {
+	case LinkAttachmentTargetTransportation:
+		*s = LinkAttachmentTargetTransportation
+		return nil
+	case LinkAttachmentTargetActivities:
+		*s = LinkAttachmentTargetActivities
+		return nil
+	case LinkAttachmentTargetAccommodation:
+		*s = LinkAttachmentTargetAccommodation
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// NewNilEntityCabinClass returns new NilEntityCabinClass with value set to v.
+func NewNilEntityCabinClass(v EntityCabinClass) NilEntityCabinClass {
+	return NilEntityCabinClass{
+		Value: v,
+	}
+}
+
+// NilEntityCabinClass is nullable EntityCabinClass.
+type NilEntityCabinClass struct {
+	Value EntityCabinClass
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilEntityCabinClass) SetTo(v EntityCabinClass) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilEntityCabinClass) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilEntityCabinClass) SetToNull() {
+	o.Null = true
+	var v EntityCabinClass
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilEntityCabinClass) Get() (v EntityCabinClass, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilEntityCabinClass) Or(d EntityCabinClass) EntityCabinClass {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilEntityLocation returns new NilEntityLocation with value set to v.
+func NewNilEntityLocation(v EntityLocation) NilEntityLocation {
+	return NilEntityLocation{
+		Value: v,
+	}
+}
+
+// NilEntityLocation is nullable EntityLocation.
+type NilEntityLocation struct {
+	Value EntityLocation
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilEntityLocation) SetTo(v EntityLocation) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilEntityLocation) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilEntityLocation) SetToNull() {
+	o.Null = true
+	var v EntityLocation
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilEntityLocation) Get() (v EntityLocation, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilEntityLocation) Or(d EntityLocation) EntityLocation {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+func (*NilEntityLocation) getLocationRes() {}
+
+// NewNilFloat64 returns new NilFloat64 with value set to v.
+func NewNilFloat64(v float64) NilFloat64 {
+	return NilFloat64{
+		Value: v,
+	}
+}
+
+// NilFloat64 is nullable float64.
+type NilFloat64 struct {
+	Value float64
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilFloat64) SetTo(v float64) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilFloat64) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilFloat64) SetToNull() {
+	o.Null = true
+	var v float64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilFloat64) Get() (v float64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilInt returns new NilInt with value set to v.
+func NewNilInt(v int) NilInt {
+	return NilInt{
+		Value: v,
+	}
+}
+
+// NilInt is nullable int.
+type NilInt struct {
+	Value int
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilInt) SetTo(v int) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilInt) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilInt) SetToNull() {
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilString returns new NilString with value set to v.
+func NewNilString(v string) NilString {
+	return NilString{
+		Value: v,
+	}
+}
+
+// NilString is nullable string.
+type NilString struct {
+	Value string
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilString) SetTo(v string) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilString) SetToNull() {
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEntityAccommodation returns new OptEntityAccommodation with value set to v.
+func NewOptEntityAccommodation(v EntityAccommodation) OptEntityAccommodation {
+	return OptEntityAccommodation{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEntityAccommodation is optional EntityAccommodation.
+type OptEntityAccommodation struct {
+	Value EntityAccommodation
+	Set   bool
+}
+
+// IsSet returns true if OptEntityAccommodation was set.
+func (o OptEntityAccommodation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEntityAccommodation) Reset() {
+	var v EntityAccommodation
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEntityAccommodation) SetTo(v EntityAccommodation) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEntityAccommodation) Get() (v EntityAccommodation, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEntityAccommodation) Or(d EntityAccommodation) EntityAccommodation {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEntityActivity returns new OptEntityActivity with value set to v.
+func NewOptEntityActivity(v EntityActivity) OptEntityActivity {
+	return OptEntityActivity{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEntityActivity is optional EntityActivity.
+type OptEntityActivity struct {
+	Value EntityActivity
+	Set   bool
+}
+
+// IsSet returns true if OptEntityActivity was set.
+func (o OptEntityActivity) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEntityActivity) Reset() {
+	var v EntityActivity
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEntityActivity) SetTo(v EntityActivity) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEntityActivity) Get() (v EntityActivity, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEntityActivity) Or(d EntityActivity) EntityActivity {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEntityDateChange returns new OptEntityDateChange with value set to v.
+func NewOptEntityDateChange(v EntityDateChange) OptEntityDateChange {
+	return OptEntityDateChange{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEntityDateChange is optional EntityDateChange.
+type OptEntityDateChange struct {
+	Value EntityDateChange
+	Set   bool
+}
+
+// IsSet returns true if OptEntityDateChange was set.
+func (o OptEntityDateChange) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEntityDateChange) Reset() {
+	var v EntityDateChange
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEntityDateChange) SetTo(v EntityDateChange) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEntityDateChange) Get() (v EntityDateChange, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEntityDateChange) Or(d EntityDateChange) EntityDateChange {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEntityTransportation returns new OptEntityTransportation with value set to v.
+func NewOptEntityTransportation(v EntityTransportation) OptEntityTransportation {
+	return OptEntityTransportation{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEntityTransportation is optional EntityTransportation.
+type OptEntityTransportation struct {
+	Value EntityTransportation
+	Set   bool
+}
+
+// IsSet returns true if OptEntityTransportation was set.
+func (o OptEntityTransportation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEntityTransportation) Reset() {
+	var v EntityTransportation
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEntityTransportation) SetTo(v EntityTransportation) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEntityTransportation) Get() (v EntityTransportation, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEntityTransportation) Or(d EntityTransportation) EntityTransportation {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEntityTrip returns new OptEntityTrip with value set to v.
+func NewOptEntityTrip(v EntityTrip) OptEntityTrip {
+	return OptEntityTrip{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEntityTrip is optional EntityTrip.
+type OptEntityTrip struct {
+	Value EntityTrip
+	Set   bool
+}
+
+// IsSet returns true if OptEntityTrip was set.
+func (o OptEntityTrip) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEntityTrip) Reset() {
+	var v EntityTrip
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEntityTrip) SetTo(v EntityTrip) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEntityTrip) Get() (v EntityTrip, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEntityTrip) Or(d EntityTrip) EntityTrip {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetAttachmentThumbnailSize returns new OptGetAttachmentThumbnailSize with value set to v.
+func NewOptGetAttachmentThumbnailSize(v GetAttachmentThumbnailSize) OptGetAttachmentThumbnailSize {
+	return OptGetAttachmentThumbnailSize{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetAttachmentThumbnailSize is optional GetAttachmentThumbnailSize.
+type OptGetAttachmentThumbnailSize struct {
+	Value GetAttachmentThumbnailSize
+	Set   bool
+}
+
+// IsSet returns true if OptGetAttachmentThumbnailSize was set.
+func (o OptGetAttachmentThumbnailSize) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetAttachmentThumbnailSize) Reset() {
+	var v GetAttachmentThumbnailSize
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetAttachmentThumbnailSize) SetTo(v GetAttachmentThumbnailSize) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetAttachmentThumbnailSize) Get() (v GetAttachmentThumbnailSize, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetAttachmentThumbnailSize) Or(d GetAttachmentThumbnailSize) GetAttachmentThumbnailSize {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilEntityBudgetAlert returns new OptNilEntityBudgetAlert with value set to v.
+func NewOptNilEntityBudgetAlert(v EntityBudgetAlert) OptNilEntityBudgetAlert {
+	return OptNilEntityBudgetAlert{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilEntityBudgetAlert is optional nullable EntityBudgetAlert.
+type OptNilEntityBudgetAlert struct {
+	Value EntityBudgetAlert
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilEntityBudgetAlert was set.
+func (o OptNilEntityBudgetAlert) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilEntityBudgetAlert) Reset() {
+	var v EntityBudgetAlert
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilEntityBudgetAlert) SetTo(v EntityBudgetAlert) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilEntityBudgetAlert) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilEntityBudgetAlert) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v EntityBudgetAlert
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilEntityBudgetAlert) Get() (v EntityBudgetAlert, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilEntityBudgetAlert) Or(d EntityBudgetAlert) EntityBudgetAlert {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilEntityFlightDetail returns new OptNilEntityFlightDetail with value set to v.
+func NewOptNilEntityFlightDetail(v EntityFlightDetail) OptNilEntityFlightDetail {
+	return OptNilEntityFlightDetail{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilEntityFlightDetail is optional nullable EntityFlightDetail.
+type OptNilEntityFlightDetail struct {
+	Value EntityFlightDetail
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilEntityFlightDetail was set.
+func (o OptNilEntityFlightDetail) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilEntityFlightDetail) Reset() {
+	var v EntityFlightDetail
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilEntityFlightDetail) SetTo(v EntityFlightDetail) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilEntityFlightDetail) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilEntityFlightDetail) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v EntityFlightDetail
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilEntityFlightDetail) Get() (v EntityFlightDetail, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
 
-func (*GetGeoJsonInternalServerError) getGeoJsonRes() {}
+// Or returns value if set, or given parameter if does not.
+func (o OptNilEntityFlightDetail) Or(d EntityFlightDetail) EntityFlightDetail {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
-type GetGeoJsonOKApplicationJSON []string
+// NewOptNilEntityGenericDetail returns new OptNilEntityGenericDetail with value set to v.
+func NewOptNilEntityGenericDetail(v EntityGenericDetail) OptNilEntityGenericDetail {
+	return OptNilEntityGenericDetail{
+		Value: v,
+		Set:   true,
+	}
+}
 
-func (*GetGeoJsonOKApplicationJSON) getGeoJsonRes() {}
+// OptNilEntityGenericDetail is optional nullable EntityGenericDetail.
+type OptNilEntityGenericDetail struct {
+	Value EntityGenericDetail
+	Set   bool
+	Null  bool
+}
 
-type GetTransportationForbidden ResponseError
+// IsSet returns true if OptNilEntityGenericDetail was set.
+func (o OptNilEntityGenericDetail) IsSet() bool { return o.Set }
 
-func (*GetTransportationForbidden) getTransportationRes() {}
+// Reset unsets value.
+func (o *OptNilEntityGenericDetail) Reset() {
+	var v EntityGenericDetail
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
 
-type GetTransportationInternalServerError ResponseError
+// SetTo sets value to v.
+func (o *OptNilEntityGenericDetail) SetTo(v EntityGenericDetail) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
 
-func (*GetTransportationInternalServerError) getTransportationRes() {}
+// IsNull returns true if value is Null.
+func (o OptNilEntityGenericDetail) IsNull() bool { return o.Null }
 
-type GetTransportationNotFound ResponseError
+// SetToNull sets value to null.
+func (o *OptNilEntityGenericDetail) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v EntityGenericDetail
+	o.Value = v
+}
 
-func (*GetTransportationNotFound) getTransportationRes() {}
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilEntityGenericDetail) Get() (v EntityGenericDetail, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
 
-type GetTripBadRequest ResponseError
+// Or returns value if set, or given parameter if does not.
+func (o OptNilEntityGenericDetail) Or(d EntityGenericDetail) EntityGenericDetail {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
-func (*GetTripBadRequest) getTripRes() {}
+// NewOptNilEntityTrack returns new OptNilEntityTrack with value set to v.
+func NewOptNilEntityTrack(v EntityTrack) OptNilEntityTrack {
+	return OptNilEntityTrack{
+		Value: v,
+		Set:   true,
+	}
+}
 
-type GetTripForbidden ResponseError
+// OptNilEntityTrack is optional nullable EntityTrack.
+type OptNilEntityTrack struct {
+	Value EntityTrack
+	Set   bool
+	Null  bool
+}
 
-func (*GetTripForbidden) getTripRes() {}
+// IsSet returns true if OptNilEntityTrack was set.
+func (o OptNilEntityTrack) IsSet() bool { return o.Set }
 
-type GetTripInternalServerError ResponseError
+// Reset unsets value.
+func (o *OptNilEntityTrack) Reset() {
+	var v EntityTrack
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
 
-func (*GetTripInternalServerError) getTripRes() {}
+// SetTo sets value to v.
+func (o *OptNilEntityTrack) SetTo(v EntityTrack) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
 
-type GetTripsOKApplicationJSON []EntityTrip
+// IsNull returns true if value is Null.
+func (o OptNilEntityTrack) IsNull() bool { return o.Null }
 
-func (*GetTripsOKApplicationJSON) getTripsRes() {}
+// SetToNull sets value to null.
+func (o *OptNilEntityTrack) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v EntityTrack
+	o.Value = v
+}
 
-type GetUsersOKApplicationJSON []EntityUser
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilEntityTrack) Get() (v EntityTrack, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
 
-func (*GetUsersOKApplicationJSON) getUsersRes() {}
+// Or returns value if set, or given parameter if does not.
+func (o OptNilEntityTrack) Or(d EntityTrack) EntityTrack {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
-// NewNilEntityLocation returns new NilEntityLocation with value set to v.
-func NewNilEntityLocation(v EntityLocation) NilEntityLocation {
-	return NilEntityLocation{
+// NewOptNilEntityTrainDetail returns new OptNilEntityTrainDetail with value set to v.
+func NewOptNilEntityTrainDetail(v EntityTrainDetail) OptNilEntityTrainDetail {
+	return OptNilEntityTrainDetail{
 		Value: v,
+		Set:   true,
 	}
 }
 
-// NilEntityLocation is nullable EntityLocation.
-type NilEntityLocation struct {
-	Value EntityLocation
+// OptNilEntityTrainDetail is optional nullable EntityTrainDetail.
+type OptNilEntityTrainDetail struct {
+	Value EntityTrainDetail
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilEntityTrainDetail was set.
+func (o OptNilEntityTrainDetail) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilEntityTrainDetail) Reset() {
+	var v EntityTrainDetail
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilEntityTrainDetail) SetTo(v EntityTrainDetail) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilEntityTrainDetail) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilEntityTrainDetail) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v EntityTrainDetail
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilEntityTrainDetail) Get() (v EntityTrainDetail, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilEntityTrainDetail) Or(d EntityTrainDetail) EntityTrainDetail {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilFloat64 returns new OptNilFloat64 with value set to v.
+func NewOptNilFloat64(v float64) OptNilFloat64 {
+	return OptNilFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilFloat64 is optional nullable float64.
+type OptNilFloat64 struct {
+	Value float64
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilFloat64 was set.
+func (o OptNilFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilFloat64) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilFloat64) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v float64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilFloat64) Get() (v float64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
 	Null  bool
 }
 
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
 // SetTo sets value to v.
-func (o *NilEntityLocation) SetTo(v EntityLocation) {
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
 	o.Null = false
 	o.Value = v
 }
 
 // IsNull returns true if value is Null.
-func (o NilEntityLocation) IsNull() bool { return o.Null }
+func (o OptNilString) IsNull() bool { return o.Null }
 
 // SetToNull sets value to null.
-func (o *NilEntityLocation) SetToNull() {
+func (o *OptNilString) SetToNull() {
+	o.Set = true
 	o.Null = true
-	var v EntityLocation
+	var v string
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o NilEntityLocation) Get() (v EntityLocation, ok bool) {
+func (o OptNilString) Get() (v string, ok bool) {
 	if o.Null {
 		return v, false
 	}
+	if !o.Set {
+		return v, false
+	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o NilEntityLocation) Or(d EntityLocation) EntityLocation {
+func (o OptNilString) Or(d string) string {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-func (*NilEntityLocation) getLocationRes() {}
-
-// NewNilInt returns new NilInt with value set to v.
-func NewNilInt(v int) NilInt {
-	return NilInt{
+// NewOptRequestAccommodation returns new OptRequestAccommodation with value set to v.
+func NewOptRequestAccommodation(v RequestAccommodation) OptRequestAccommodation {
+	return OptRequestAccommodation{
 		Value: v,
+		Set:   true,
 	}
 }
 
-// NilInt is nullable int.
-type NilInt struct {
-	Value int
-	Null  bool
+// OptRequestAccommodation is optional RequestAccommodation.
+type OptRequestAccommodation struct {
+	Value RequestAccommodation
+	Set   bool
 }
 
-// SetTo sets value to v.
-func (o *NilInt) SetTo(v int) {
-	o.Null = false
+// IsSet returns true if OptRequestAccommodation was set.
+func (o OptRequestAccommodation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRequestAccommodation) Reset() {
+	var v RequestAccommodation
 	o.Value = v
+	o.Set = false
 }
 
-// IsNull returns true if value is Null.
-func (o NilInt) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *NilInt) SetToNull() {
-	o.Null = true
-	var v int
+// SetTo sets value to v.
+func (o *OptRequestAccommodation) SetTo(v RequestAccommodation) {
+	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o NilInt) Get() (v int, ok bool) {
-	if o.Null {
+func (o OptRequestAccommodation) Get() (v RequestAccommodation, ok bool) {
+	if !o.Set {
 		return v, false
 	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o NilInt) Or(d int) int {
+func (o OptRequestAccommodation) Or(d RequestAccommodation) RequestAccommodation {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewNilString returns new NilString with value set to v.
-func NewNilString(v string) NilString {
-	return NilString{
+// NewOptRequestActivity returns new OptRequestActivity with value set to v.
+func NewOptRequestActivity(v RequestActivity) OptRequestActivity {
+	return OptRequestActivity{
 		Value: v,
+		Set:   true,
 	}
 }
 
-// NilString is nullable string.
-type NilString struct {
-	Value string
-	Null  bool
+// OptRequestActivity is optional RequestActivity.
+type OptRequestActivity struct {
+	Value RequestActivity
+	Set   bool
 }
 
-// SetTo sets value to v.
-func (o *NilString) SetTo(v string) {
-	o.Null = false
+// IsSet returns true if OptRequestActivity was set.
+func (o OptRequestActivity) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRequestActivity) Reset() {
+	var v RequestActivity
 	o.Value = v
+	o.Set = false
 }
 
-// IsNull returns true if value is Null.
-func (o NilString) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *NilString) SetToNull() {
-	o.Null = true
-	var v string
+// SetTo sets value to v.
+func (o *OptRequestActivity) SetTo(v RequestActivity) {
+	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o NilString) Get() (v string, ok bool) {
-	if o.Null {
+func (o OptRequestActivity) Get() (v RequestActivity, ok bool) {
+	if !o.Set {
 		return v, false
 	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o NilString) Or(d string) string {
+func (o OptRequestActivity) Or(d RequestActivity) RequestActivity {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilEntityFlightDetail returns new OptNilEntityFlightDetail with value set to v.
-func NewOptNilEntityFlightDetail(v EntityFlightDetail) OptNilEntityFlightDetail {
-	return OptNilEntityFlightDetail{
+// NewOptRequestExpense returns new OptRequestExpense with value set to v.
+func NewOptRequestExpense(v RequestExpense) OptRequestExpense {
+	return OptRequestExpense{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilEntityFlightDetail is optional nullable EntityFlightDetail.
-type OptNilEntityFlightDetail struct {
-	Value EntityFlightDetail
+// OptRequestExpense is optional RequestExpense.
+type OptRequestExpense struct {
+	Value RequestExpense
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilEntityFlightDetail was set.
-func (o OptNilEntityFlightDetail) IsSet() bool { return o.Set }
+// IsSet returns true if OptRequestExpense was set.
+func (o OptRequestExpense) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilEntityFlightDetail) Reset() {
-	var v EntityFlightDetail
+func (o *OptRequestExpense) Reset() {
+	var v RequestExpense
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilEntityFlightDetail) SetTo(v EntityFlightDetail) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilEntityFlightDetail) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilEntityFlightDetail) SetToNull() {
+func (o *OptRequestExpense) SetTo(v RequestExpense) {
 	o.Set = true
-	o.Null = true
-	var v EntityFlightDetail
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilEntityFlightDetail) Get() (v EntityFlightDetail, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptRequestExpense) Get() (v RequestExpense, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1495,62 +5440,45 @@ func (o OptNilEntityFlightDetail) Get() (v EntityFlightDetail, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilEntityFlightDetail) Or(d EntityFlightDetail) EntityFlightDetail {
+func (o OptRequestExpense) Or(d RequestExpense) RequestExpense {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilEntityGenericDetail returns new OptNilEntityGenericDetail with value set to v.
-func NewOptNilEntityGenericDetail(v EntityGenericDetail) OptNilEntityGenericDetail {
-	return OptNilEntityGenericDetail{
+// NewOptRequestTransportation returns new OptRequestTransportation with value set to v.
+func NewOptRequestTransportation(v RequestTransportation) OptRequestTransportation {
+	return OptRequestTransportation{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilEntityGenericDetail is optional nullable EntityGenericDetail.
-type OptNilEntityGenericDetail struct {
-	Value EntityGenericDetail
+// OptRequestTransportation is optional RequestTransportation.
+type OptRequestTransportation struct {
+	Value RequestTransportation
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilEntityGenericDetail was set.
-func (o OptNilEntityGenericDetail) IsSet() bool { return o.Set }
+// IsSet returns true if OptRequestTransportation was set.
+func (o OptRequestTransportation) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilEntityGenericDetail) Reset() {
-	var v EntityGenericDetail
+func (o *OptRequestTransportation) Reset() {
+	var v RequestTransportation
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilEntityGenericDetail) SetTo(v EntityGenericDetail) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilEntityGenericDetail) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilEntityGenericDetail) SetToNull() {
+func (o *OptRequestTransportation) SetTo(v RequestTransportation) {
 	o.Set = true
-	o.Null = true
-	var v EntityGenericDetail
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilEntityGenericDetail) Get() (v EntityGenericDetail, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptRequestTransportation) Get() (v RequestTransportation, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1558,62 +5486,45 @@ func (o OptNilEntityGenericDetail) Get() (v EntityGenericDetail, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilEntityGenericDetail) Or(d EntityGenericDetail) EntityGenericDetail {
+func (o OptRequestTransportation) Or(d RequestTransportation) RequestTransportation {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilEntityTrainDetail returns new OptNilEntityTrainDetail with value set to v.
-func NewOptNilEntityTrainDetail(v EntityTrainDetail) OptNilEntityTrainDetail {
-	return OptNilEntityTrainDetail{
+// NewOptRequestTrip returns new OptRequestTrip with value set to v.
+func NewOptRequestTrip(v RequestTrip) OptRequestTrip {
+	return OptRequestTrip{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilEntityTrainDetail is optional nullable EntityTrainDetail.
-type OptNilEntityTrainDetail struct {
-	Value EntityTrainDetail
+// OptRequestTrip is optional RequestTrip.
+type OptRequestTrip struct {
+	Value RequestTrip
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilEntityTrainDetail was set.
-func (o OptNilEntityTrainDetail) IsSet() bool { return o.Set }
+// IsSet returns true if OptRequestTrip was set.
+func (o OptRequestTrip) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilEntityTrainDetail) Reset() {
-	var v EntityTrainDetail
+func (o *OptRequestTrip) Reset() {
+	var v RequestTrip
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilEntityTrainDetail) SetTo(v EntityTrainDetail) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilEntityTrainDetail) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilEntityTrainDetail) SetToNull() {
+func (o *OptRequestTrip) SetTo(v RequestTrip) {
 	o.Set = true
-	o.Null = true
-	var v EntityTrainDetail
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilEntityTrainDetail) Get() (v EntityTrainDetail, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptRequestTrip) Get() (v RequestTrip, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1621,62 +5532,45 @@ func (o OptNilEntityTrainDetail) Get() (v EntityTrainDetail, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilEntityTrainDetail) Or(d EntityTrainDetail) EntityTrainDetail {
+func (o OptRequestTrip) Or(d RequestTrip) RequestTrip {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilString returns new OptNilString with value set to v.
-func NewOptNilString(v string) OptNilString {
-	return OptNilString{
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilString is optional nullable string.
-type OptNilString struct {
+// OptString is optional string.
+type OptString struct {
 	Value string
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilString was set.
-func (o OptNilString) IsSet() bool { return o.Set }
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilString) Reset() {
+func (o *OptString) Reset() {
 	var v string
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilString) SetTo(v string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilString) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilString) SetToNull() {
+func (o *OptString) SetTo(v string) {
 	o.Set = true
-	o.Null = true
-	var v string
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptString) Get() (v string, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1684,7 +5578,7 @@ func (o OptNilString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilString) Or(d string) string {
+func (o OptString) Or(d string) string {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -1715,6 +5609,10 @@ type PostActivityInternalServerError ResponseError
 
 func (*PostActivityInternalServerError) postActivityRes() {}
 
+type PostAttachmentBadRequest ResponseError
+
+func (*PostAttachmentBadRequest) postAttachmentRes() {}
+
 type PostAttachmentForbidden ResponseError
 
 func (*PostAttachmentForbidden) postAttachmentRes() {}
@@ -1723,11 +5621,51 @@ type PostAttachmentInternalServerError ResponseError
 
 func (*PostAttachmentInternalServerError) postAttachmentRes() {}
 
+type PostAttachmentLengthRequired ResponseError
+
+func (*PostAttachmentLengthRequired) postAttachmentRes() {}
+
 // PostAttachmentNoContent is response for PostAttachment operation.
 type PostAttachmentNoContent struct{}
 
 func (*PostAttachmentNoContent) postAttachmentRes() {}
 
+type PostAttachmentRequestEntityTooLarge ResponseError
+
+func (*PostAttachmentRequestEntityTooLarge) postAttachmentRes() {}
+
+type PostChangesBadRequest ResponseError
+
+func (*PostChangesBadRequest) postChangesRes() {}
+
+type PostChangesForbidden ResponseError
+
+func (*PostChangesForbidden) postChangesRes() {}
+
+type PostChangesInternalServerError ResponseError
+
+func (*PostChangesInternalServerError) postChangesRes() {}
+
+type PostChangesOKApplicationJSON []EntityChangeResult
+
+func (*PostChangesOKApplicationJSON) postChangesRes() {}
+
+type PostExpenseBadRequest ResponseError
+
+func (*PostExpenseBadRequest) postExpenseRes() {}
+
+type PostExpenseForbidden ResponseError
+
+func (*PostExpenseForbidden) postExpenseRes() {}
+
+type PostExpenseInternalServerError ResponseError
+
+func (*PostExpenseInternalServerError) postExpenseRes() {}
+
+type PostFlightBadRequest ResponseError
+
+func (*PostFlightBadRequest) postFlightRes() {}
+
 type PostFlightForbidden ResponseError
 
 func (*PostFlightForbidden) postFlightRes() {}
@@ -1736,6 +5674,26 @@ type PostFlightInternalServerError ResponseError
 
 func (*PostFlightInternalServerError) postFlightRes() {}
 
+type PostTrackBadRequest ResponseError
+
+func (*PostTrackBadRequest) postTrackRes() {}
+
+type PostTrackForbidden ResponseError
+
+func (*PostTrackForbidden) postTrackRes() {}
+
+type PostTrackInternalServerError ResponseError
+
+func (*PostTrackInternalServerError) postTrackRes() {}
+
+type PostTrackNotFound ResponseError
+
+func (*PostTrackNotFound) postTrackRes() {}
+
+type PostTrainJourneyBadRequest ResponseError
+
+func (*PostTrainJourneyBadRequest) postTrainJourneyRes() {}
+
 type PostTrainJourneyForbidden ResponseError
 
 func (*PostTrainJourneyForbidden) postTrainJourneyRes() {}
@@ -1744,6 +5702,10 @@ type PostTrainJourneyInternalServerError ResponseError
 
 func (*PostTrainJourneyInternalServerError) postTrainJourneyRes() {}
 
+type PostTransportationBadRequest ResponseError
+
+func (*PostTransportationBadRequest) postTransportationRes() {}
+
 type PostTransportationForbidden ResponseError
 
 func (*PostTransportationForbidden) postTransportationRes() {}
@@ -1781,6 +5743,10 @@ type PutAccommodationNotFound ResponseError
 
 func (*PutAccommodationNotFound) putAccommodationRes() {}
 
+type PutAccommodationPreconditionFailed ResponseError
+
+func (*PutAccommodationPreconditionFailed) putAccommodationRes() {}
+
 type PutActivityBadRequest ResponseError
 
 func (*PutActivityBadRequest) putActivityRes() {}
@@ -1791,12 +5757,58 @@ func (*PutActivityForbidden) putActivityRes() {}
 
 type PutActivityInternalServerError ResponseError
 
-func (*PutActivityInternalServerError) putActivityRes() {}
+func (*PutActivityInternalServerError) putActivityRes() {}
+
+// PutActivityNoContent is response for PutActivity operation.
+type PutActivityNoContent struct{}
+
+func (*PutActivityNoContent) putActivityRes() {}
+
+type PutActivityPreconditionFailed ResponseError
+
+func (*PutActivityPreconditionFailed) putActivityRes() {}
+
+type PutBudgetBadRequest ResponseError
+
+func (*PutBudgetBadRequest) putBudgetRes() {}
+
+type PutBudgetForbidden ResponseError
+
+func (*PutBudgetForbidden) putBudgetRes() {}
+
+type PutBudgetInternalServerError ResponseError
+
+func (*PutBudgetInternalServerError) putBudgetRes() {}
+
+// PutBudgetNoContent is response for PutBudget operation.
+type PutBudgetNoContent struct{}
+
+func (*PutBudgetNoContent) putBudgetRes() {}
+
+type PutExpenseBadRequest ResponseError
+
+func (*PutExpenseBadRequest) putExpenseRes() {}
+
+type PutExpenseForbidden ResponseError
+
+func (*PutExpenseForbidden) putExpenseRes() {}
+
+type PutExpenseInternalServerError ResponseError
+
+func (*PutExpenseInternalServerError) putExpenseRes() {}
+
+// PutExpenseNoContent is response for PutExpense operation.
+type PutExpenseNoContent struct{}
+
+func (*PutExpenseNoContent) putExpenseRes() {}
+
+type PutExpenseNotFound ResponseError
+
+func (*PutExpenseNotFound) putExpenseRes() {}
 
-// PutActivityNoContent is response for PutActivity operation.
-type PutActivityNoContent struct{}
+type PutExpensePreconditionFailed ResponseError
 
-func (*PutActivityNoContent) putActivityRes() {}
+func (*PutExpensePreconditionFailed) putExpenseRes() {}
 
 type PutFlightForbidden ResponseError
 
@@ -1815,6 +5827,39 @@ type PutFlightNotFound ResponseError
 
 func (*PutFlightNotFound) putFlightRes() {}
 
+type PutFlightPreconditionFailed ResponseError
+
+func (*PutFlightPreconditionFailed) putFlightRes() {}
+
+type PutTrackBadRequest ResponseError
+
+func (*PutTrackBadRequest) putTrackRes() {}
+
+type PutTrackForbidden ResponseError
+
+func (*PutTrackForbidden) putTrackRes() {}
+
+type PutTrackInternalServerError ResponseError
+
+func (*PutTrackInternalServerError) putTrackRes() {}
+
+// PutTrackNoContent is response for PutTrack operation.
+type PutTrackNoContent struct{}
+
+func (*PutTrackNoContent) putTrackRes() {}
+
+type PutTrackNotFound ResponseError
+
+func (*PutTrackNotFound) putTrackRes() {}
+
+type PutTrackPreconditionFailed ResponseError
+
+func (*PutTrackPreconditionFailed) putTrackRes() {}
+
+type PutTransportationBadRequest ResponseError
+
+func (*PutTransportationBadRequest) putTransportationRes() {}
+
 type PutTransportationForbidden ResponseError
 
 func (*PutTransportationForbidden) putTransportationRes() {}
@@ -1828,10 +5873,18 @@ type PutTransportationNoContent struct{}
 
 func (*PutTransportationNoContent) putTransportationRes() {}
 
+type PutTransportationPreconditionFailed ResponseError
+
+func (*PutTransportationPreconditionFailed) putTransportationRes() {}
+
 type PutTripBadRequest ResponseError
 
 func (*PutTripBadRequest) putTripRes() {}
 
+type PutTripConflictApplicationJSON []EntityOutOfRangeEntity
+
+func (*PutTripConflictApplicationJSON) putTripRes() {}
+
 type PutTripForbidden ResponseError
 
 func (*PutTripForbidden) putTripRes() {}
@@ -1845,17 +5898,23 @@ type PutTripNoContent struct{}
 
 func (*PutTripNoContent) putTripRes() {}
 
+type PutTripPreconditionFailed ResponseError
+
+func (*PutTripPreconditionFailed) putTripRes() {}
+
 // Ref: #/components/schemas/request.Accommodation
 type RequestAccommodation struct {
 	Address       NilString         `json:"address"`
 	ArrivalDate   string            `json:"arrivalDate"`
 	CheckInTime   NilString         `json:"checkInTime"`
 	CheckOutTime  NilString         `json:"checkOutTime"`
+	Currency      OptString         `json:"currency"`
 	DepartureDate string            `json:"departureDate"`
 	Description   NilString         `json:"description"`
 	Location      NilEntityLocation `json:"location"`
 	Name          string            `json:"name"`
 	Price         NilInt            `json:"price"`
+	Sensitive     OptBool           `json:"sensitive"`
 }
 
 // GetAddress returns the value of Address.
@@ -1878,162 +5937,527 @@ func (s *RequestAccommodation) GetCheckOutTime() NilString {
 	return s.CheckOutTime
 }
 
+// GetCurrency returns the value of Currency.
+func (s *RequestAccommodation) GetCurrency() OptString {
+	return s.Currency
+}
+
 // GetDepartureDate returns the value of DepartureDate.
 func (s *RequestAccommodation) GetDepartureDate() string {
 	return s.DepartureDate
 }
 
-// GetDescription returns the value of Description.
-func (s *RequestAccommodation) GetDescription() NilString {
-	return s.Description
+// GetDescription returns the value of Description.
+func (s *RequestAccommodation) GetDescription() NilString {
+	return s.Description
+}
+
+// GetLocation returns the value of Location.
+func (s *RequestAccommodation) GetLocation() NilEntityLocation {
+	return s.Location
+}
+
+// GetName returns the value of Name.
+func (s *RequestAccommodation) GetName() string {
+	return s.Name
+}
+
+// GetPrice returns the value of Price.
+func (s *RequestAccommodation) GetPrice() NilInt {
+	return s.Price
+}
+
+// GetSensitive returns the value of Sensitive.
+func (s *RequestAccommodation) GetSensitive() OptBool {
+	return s.Sensitive
+}
+
+// SetAddress sets the value of Address.
+func (s *RequestAccommodation) SetAddress(val NilString) {
+	s.Address = val
+}
+
+// SetArrivalDate sets the value of ArrivalDate.
+func (s *RequestAccommodation) SetArrivalDate(val string) {
+	s.ArrivalDate = val
+}
+
+// SetCheckInTime sets the value of CheckInTime.
+func (s *RequestAccommodation) SetCheckInTime(val NilString) {
+	s.CheckInTime = val
+}
+
+// SetCheckOutTime sets the value of CheckOutTime.
+func (s *RequestAccommodation) SetCheckOutTime(val NilString) {
+	s.CheckOutTime = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *RequestAccommodation) SetCurrency(val OptString) {
+	s.Currency = val
+}
+
+// SetDepartureDate sets the value of DepartureDate.
+func (s *RequestAccommodation) SetDepartureDate(val string) {
+	s.DepartureDate = val
+}
+
+// SetDescription sets the value of Description.
+func (s *RequestAccommodation) SetDescription(val NilString) {
+	s.Description = val
+}
+
+// SetLocation sets the value of Location.
+func (s *RequestAccommodation) SetLocation(val NilEntityLocation) {
+	s.Location = val
+}
+
+// SetName sets the value of Name.
+func (s *RequestAccommodation) SetName(val string) {
+	s.Name = val
+}
+
+// SetPrice sets the value of Price.
+func (s *RequestAccommodation) SetPrice(val NilInt) {
+	s.Price = val
+}
+
+// SetSensitive sets the value of Sensitive.
+func (s *RequestAccommodation) SetSensitive(val OptBool) {
+	s.Sensitive = val
+}
+
+// Ref: #/components/schemas/request.Activity
+type RequestActivity struct {
+	Address     NilString         `json:"address"`
+	Currency    OptString         `json:"currency"`
+	Date        string            `json:"date"`
+	Description NilString         `json:"description"`
+	Location    NilEntityLocation `json:"location"`
+	Name        string            `json:"name"`
+	Price       NilInt            `json:"price"`
+	Sensitive   OptBool           `json:"sensitive"`
+	Time        NilString         `json:"time"`
+}
+
+// GetAddress returns the value of Address.
+func (s *RequestActivity) GetAddress() NilString {
+	return s.Address
+}
+
+// GetCurrency returns the value of Currency.
+func (s *RequestActivity) GetCurrency() OptString {
+	return s.Currency
+}
+
+// GetDate returns the value of Date.
+func (s *RequestActivity) GetDate() string {
+	return s.Date
+}
+
+// GetDescription returns the value of Description.
+func (s *RequestActivity) GetDescription() NilString {
+	return s.Description
+}
+
+// GetLocation returns the value of Location.
+func (s *RequestActivity) GetLocation() NilEntityLocation {
+	return s.Location
+}
+
+// GetName returns the value of Name.
+func (s *RequestActivity) GetName() string {
+	return s.Name
+}
+
+// GetPrice returns the value of Price.
+func (s *RequestActivity) GetPrice() NilInt {
+	return s.Price
+}
+
+// GetSensitive returns the value of Sensitive.
+func (s *RequestActivity) GetSensitive() OptBool {
+	return s.Sensitive
+}
+
+// GetTime returns the value of Time.
+func (s *RequestActivity) GetTime() NilString {
+	return s.Time
+}
+
+// SetAddress sets the value of Address.
+func (s *RequestActivity) SetAddress(val NilString) {
+	s.Address = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *RequestActivity) SetCurrency(val OptString) {
+	s.Currency = val
+}
+
+// SetDate sets the value of Date.
+func (s *RequestActivity) SetDate(val string) {
+	s.Date = val
+}
+
+// SetDescription sets the value of Description.
+func (s *RequestActivity) SetDescription(val NilString) {
+	s.Description = val
+}
+
+// SetLocation sets the value of Location.
+func (s *RequestActivity) SetLocation(val NilEntityLocation) {
+	s.Location = val
+}
+
+// SetName sets the value of Name.
+func (s *RequestActivity) SetName(val string) {
+	s.Name = val
+}
+
+// SetPrice sets the value of Price.
+func (s *RequestActivity) SetPrice(val NilInt) {
+	s.Price = val
+}
+
+// SetSensitive sets the value of Sensitive.
+func (s *RequestActivity) SetSensitive(val OptBool) {
+	s.Sensitive = val
+}
+
+// SetTime sets the value of Time.
+func (s *RequestActivity) SetTime(val NilString) {
+	s.Time = val
+}
+
+// Ref: #/components/schemas/request.Budget
+type RequestBudget struct {
+	Categories []EntityCategoryBudget `json:"categories"`
+	Total      NilFloat64             `json:"total"`
+}
+
+// GetCategories returns the value of Categories.
+func (s *RequestBudget) GetCategories() []EntityCategoryBudget {
+	return s.Categories
+}
+
+// GetTotal returns the value of Total.
+func (s *RequestBudget) GetTotal() NilFloat64 {
+	return s.Total
+}
+
+// SetCategories sets the value of Categories.
+func (s *RequestBudget) SetCategories(val []EntityCategoryBudget) {
+	s.Categories = val
+}
+
+// SetTotal sets the value of Total.
+func (s *RequestBudget) SetTotal(val NilFloat64) {
+	s.Total = val
+}
+
+// Ref: #/components/schemas/request.Change
+type RequestChange struct {
+	Accommodation  OptRequestAccommodation  `json:"accommodation"`
+	Activity       OptRequestActivity       `json:"activity"`
+	BaseRevision   OptInt                   `json:"baseRevision"`
+	ClientId       OptString                `json:"clientId"`
+	Expense        OptRequestExpense        `json:"expense"`
+	ID             OptInt                   `json:"id"`
+	Kind           string                   `json:"kind"`
+	Transportation OptRequestTransportation `json:"transportation"`
+	Trip           OptRequestTrip           `json:"trip"`
+	Type           EntityChangeType         `json:"type"`
+}
+
+// GetAccommodation returns the value of Accommodation.
+func (s *RequestChange) GetAccommodation() OptRequestAccommodation {
+	return s.Accommodation
+}
+
+// GetActivity returns the value of Activity.
+func (s *RequestChange) GetActivity() OptRequestActivity {
+	return s.Activity
+}
+
+// GetBaseRevision returns the value of BaseRevision.
+func (s *RequestChange) GetBaseRevision() OptInt {
+	return s.BaseRevision
+}
+
+// GetClientId returns the value of ClientId.
+func (s *RequestChange) GetClientId() OptString {
+	return s.ClientId
+}
+
+// GetExpense returns the value of Expense.
+func (s *RequestChange) GetExpense() OptRequestExpense {
+	return s.Expense
+}
+
+// GetID returns the value of ID.
+func (s *RequestChange) GetID() OptInt {
+	return s.ID
+}
+
+// GetKind returns the value of Kind.
+func (s *RequestChange) GetKind() string {
+	return s.Kind
+}
+
+// GetTransportation returns the value of Transportation.
+func (s *RequestChange) GetTransportation() OptRequestTransportation {
+	return s.Transportation
+}
+
+// GetTrip returns the value of Trip.
+func (s *RequestChange) GetTrip() OptRequestTrip {
+	return s.Trip
+}
+
+// GetType returns the value of Type.
+func (s *RequestChange) GetType() EntityChangeType {
+	return s.Type
+}
+
+// SetAccommodation sets the value of Accommodation.
+func (s *RequestChange) SetAccommodation(val OptRequestAccommodation) {
+	s.Accommodation = val
+}
+
+// SetActivity sets the value of Activity.
+func (s *RequestChange) SetActivity(val OptRequestActivity) {
+	s.Activity = val
+}
+
+// SetBaseRevision sets the value of BaseRevision.
+func (s *RequestChange) SetBaseRevision(val OptInt) {
+	s.BaseRevision = val
+}
+
+// SetClientId sets the value of ClientId.
+func (s *RequestChange) SetClientId(val OptString) {
+	s.ClientId = val
+}
+
+// SetExpense sets the value of Expense.
+func (s *RequestChange) SetExpense(val OptRequestExpense) {
+	s.Expense = val
+}
+
+// SetID sets the value of ID.
+func (s *RequestChange) SetID(val OptInt) {
+	s.ID = val
+}
+
+// SetKind sets the value of Kind.
+func (s *RequestChange) SetKind(val string) {
+	s.Kind = val
+}
+
+// SetTransportation sets the value of Transportation.
+func (s *RequestChange) SetTransportation(val OptRequestTransportation) {
+	s.Transportation = val
+}
+
+// SetTrip sets the value of Trip.
+func (s *RequestChange) SetTrip(val OptRequestTrip) {
+	s.Trip = val
+}
+
+// SetType sets the value of Type.
+func (s *RequestChange) SetType(val EntityChangeType) {
+	s.Type = val
+}
+
+// Ref: #/components/schemas/request.ChangeBatch
+type RequestChangeBatch struct {
+	Changes []RequestChange `json:"changes"`
+}
+
+// GetChanges returns the value of Changes.
+func (s *RequestChangeBatch) GetChanges() []RequestChange {
+	return s.Changes
+}
+
+// SetChanges sets the value of Changes.
+func (s *RequestChangeBatch) SetChanges(val []RequestChange) {
+	s.Changes = val
+}
+
+// Ref: #/components/schemas/request.Expense
+type RequestExpense struct {
+	AccommodationId  NilInt                      `json:"accommodationId"`
+	ActivityId       NilInt                      `json:"activityId"`
+	Amount           float64                     `json:"amount"`
+	AttachmentId     NilInt                      `json:"attachmentId"`
+	Category         OptString                   `json:"category"`
+	Currency         OptString                   `json:"currency"`
+	Date             string                      `json:"date"`
+	Name             string                      `json:"name"`
+	Participants     []RequestExpenseParticipant `json:"participants"`
+	PayerId          int                         `json:"payerId"`
+	SplitMode        string                      `json:"splitMode"`
+	TransportationId NilInt                      `json:"transportationId"`
+}
+
+// GetAccommodationId returns the value of AccommodationId.
+func (s *RequestExpense) GetAccommodationId() NilInt {
+	return s.AccommodationId
 }
 
-// GetLocation returns the value of Location.
-func (s *RequestAccommodation) GetLocation() NilEntityLocation {
-	return s.Location
+// GetActivityId returns the value of ActivityId.
+func (s *RequestExpense) GetActivityId() NilInt {
+	return s.ActivityId
 }
 
-// GetName returns the value of Name.
-func (s *RequestAccommodation) GetName() string {
-	return s.Name
+// GetAmount returns the value of Amount.
+func (s *RequestExpense) GetAmount() float64 {
+	return s.Amount
 }
 
-// GetPrice returns the value of Price.
-func (s *RequestAccommodation) GetPrice() NilInt {
-	return s.Price
+// GetAttachmentId returns the value of AttachmentId.
+func (s *RequestExpense) GetAttachmentId() NilInt {
+	return s.AttachmentId
 }
 
-// SetAddress sets the value of Address.
-func (s *RequestAccommodation) SetAddress(val NilString) {
-	s.Address = val
+// GetCategory returns the value of Category.
+func (s *RequestExpense) GetCategory() OptString {
+	return s.Category
 }
 
-// SetArrivalDate sets the value of ArrivalDate.
-func (s *RequestAccommodation) SetArrivalDate(val string) {
-	s.ArrivalDate = val
+// GetCurrency returns the value of Currency.
+func (s *RequestExpense) GetCurrency() OptString {
+	return s.Currency
 }
 
-// SetCheckInTime sets the value of CheckInTime.
-func (s *RequestAccommodation) SetCheckInTime(val NilString) {
-	s.CheckInTime = val
+// GetDate returns the value of Date.
+func (s *RequestExpense) GetDate() string {
+	return s.Date
 }
 
-// SetCheckOutTime sets the value of CheckOutTime.
-func (s *RequestAccommodation) SetCheckOutTime(val NilString) {
-	s.CheckOutTime = val
+// GetName returns the value of Name.
+func (s *RequestExpense) GetName() string {
+	return s.Name
 }
 
-// SetDepartureDate sets the value of DepartureDate.
-func (s *RequestAccommodation) SetDepartureDate(val string) {
-	s.DepartureDate = val
+// GetParticipants returns the value of Participants.
+func (s *RequestExpense) GetParticipants() []RequestExpenseParticipant {
+	return s.Participants
 }
 
-// SetDescription sets the value of Description.
-func (s *RequestAccommodation) SetDescription(val NilString) {
-	s.Description = val
+// GetPayerId returns the value of PayerId.
+func (s *RequestExpense) GetPayerId() int {
+	return s.PayerId
 }
 
-// SetLocation sets the value of Location.
-func (s *RequestAccommodation) SetLocation(val NilEntityLocation) {
-	s.Location = val
+// GetSplitMode returns the value of SplitMode.
+func (s *RequestExpense) GetSplitMode() string {
+	return s.SplitMode
 }
 
-// SetName sets the value of Name.
-func (s *RequestAccommodation) SetName(val string) {
-	s.Name = val
+// GetTransportationId returns the value of TransportationId.
+func (s *RequestExpense) GetTransportationId() NilInt {
+	return s.TransportationId
 }
 
-// SetPrice sets the value of Price.
-func (s *RequestAccommodation) SetPrice(val NilInt) {
-	s.Price = val
+// SetAccommodationId sets the value of AccommodationId.
+func (s *RequestExpense) SetAccommodationId(val NilInt) {
+	s.AccommodationId = val
 }
 
-// Ref: #/components/schemas/request.Activity
-type RequestActivity struct {
-	Address     NilString         `json:"address"`
-	Date        string            `json:"date"`
-	Description NilString         `json:"description"`
-	Location    NilEntityLocation `json:"location"`
-	Name        string            `json:"name"`
-	Price       NilInt            `json:"price"`
-	Time        NilString         `json:"time"`
+// SetActivityId sets the value of ActivityId.
+func (s *RequestExpense) SetActivityId(val NilInt) {
+	s.ActivityId = val
 }
 
-// GetAddress returns the value of Address.
-func (s *RequestActivity) GetAddress() NilString {
-	return s.Address
+// SetAmount sets the value of Amount.
+func (s *RequestExpense) SetAmount(val float64) {
+	s.Amount = val
 }
 
-// GetDate returns the value of Date.
-func (s *RequestActivity) GetDate() string {
-	return s.Date
+// SetAttachmentId sets the value of AttachmentId.
+func (s *RequestExpense) SetAttachmentId(val NilInt) {
+	s.AttachmentId = val
 }
 
-// GetDescription returns the value of Description.
-func (s *RequestActivity) GetDescription() NilString {
-	return s.Description
+// SetCategory sets the value of Category.
+func (s *RequestExpense) SetCategory(val OptString) {
+	s.Category = val
 }
 
-// GetLocation returns the value of Location.
-func (s *RequestActivity) GetLocation() NilEntityLocation {
-	return s.Location
+// SetCurrency sets the value of Currency.
+func (s *RequestExpense) SetCurrency(val OptString) {
+	s.Currency = val
 }
 
-// GetName returns the value of Name.
-func (s *RequestActivity) GetName() string {
-	return s.Name
+// SetDate sets the value of Date.
+func (s *RequestExpense) SetDate(val string) {
+	s.Date = val
 }
 
-// GetPrice returns the value of Price.
-func (s *RequestActivity) GetPrice() NilInt {
-	return s.Price
+// SetName sets the value of Name.
+func (s *RequestExpense) SetName(val string) {
+	s.Name = val
 }
 
-// GetTime returns the value of Time.
-func (s *RequestActivity) GetTime() NilString {
-	return s.Time
+// SetParticipants sets the value of Participants.
+func (s *RequestExpense) SetParticipants(val []RequestExpenseParticipant) {
+	s.Participants = val
 }
 
-// SetAddress sets the value of Address.
-func (s *RequestActivity) SetAddress(val NilString) {
-	s.Address = val
+// SetPayerId sets the value of PayerId.
+func (s *RequestExpense) SetPayerId(val int) {
+	s.PayerId = val
 }
 
-// SetDate sets the value of Date.
-func (s *RequestActivity) SetDate(val string) {
-	s.Date = val
+// SetSplitMode sets the value of SplitMode.
+func (s *RequestExpense) SetSplitMode(val string) {
+	s.SplitMode = val
 }
 
-// SetDescription sets the value of Description.
-func (s *RequestActivity) SetDescription(val NilString) {
-	s.Description = val
+// SetTransportationId sets the value of TransportationId.
+func (s *RequestExpense) SetTransportationId(val NilInt) {
+	s.TransportationId = val
 }
 
-// SetLocation sets the value of Location.
-func (s *RequestActivity) SetLocation(val NilEntityLocation) {
-	s.Location = val
+// Ref: #/components/schemas/request.ExpenseParticipant
+type RequestExpenseParticipant struct {
+	Share  OptFloat64 `json:"share"`
+	UserId int        `json:"userId"`
 }
 
-// SetName sets the value of Name.
-func (s *RequestActivity) SetName(val string) {
-	s.Name = val
+// GetShare returns the value of Share.
+func (s *RequestExpenseParticipant) GetShare() OptFloat64 {
+	return s.Share
 }
 
-// SetPrice sets the value of Price.
-func (s *RequestActivity) SetPrice(val NilInt) {
-	s.Price = val
+// GetUserId returns the value of UserId.
+func (s *RequestExpenseParticipant) GetUserId() int {
+	return s.UserId
 }
 
-// SetTime sets the value of Time.
-func (s *RequestActivity) SetTime(val NilString) {
-	s.Time = val
+// SetShare sets the value of Share.
+func (s *RequestExpenseParticipant) SetShare(val OptFloat64) {
+	s.Share = val
+}
+
+// SetUserId sets the value of UserId.
+func (s *RequestExpenseParticipant) SetUserId(val int) {
+	s.UserId = val
 }
 
 // Ref: #/components/schemas/request.Flight
 type RequestFlight struct {
-	Legs  []RequestFlightLeg `json:"legs"`
-	Pnrs  []EntityPNR        `json:"pnrs"`
-	Price NilInt             `json:"price"`
+	Currency OptString          `json:"currency"`
+	Legs     []RequestFlightLeg `json:"legs"`
+	Pnrs     []EntityPNR        `json:"pnrs"`
+	Price    NilInt             `json:"price"`
+}
+
+// GetCurrency returns the value of Currency.
+func (s *RequestFlight) GetCurrency() OptString {
+	return s.Currency
 }
 
 // GetLegs returns the value of Legs.
@@ -2051,6 +6475,11 @@ func (s *RequestFlight) GetPrice() NilInt {
 	return s.Price
 }
 
+// SetCurrency sets the value of Currency.
+func (s *RequestFlight) SetCurrency(val OptString) {
+	s.Currency = val
+}
+
 // SetLegs sets the value of Legs.
 func (s *RequestFlight) SetLegs(val []RequestFlightLeg) {
 	s.Legs = val
@@ -2068,9 +6497,16 @@ func (s *RequestFlight) SetPrice(val NilInt) {
 
 // Ref: #/components/schemas/request.FlightLeg
 type RequestFlightLeg struct {
-	Date          string    `json:"date"`
-	FlightNumber  string    `json:"flightNumber"`
-	OriginAirport NilString `json:"originAirport"`
+	// CabinClass is only used to estimate emissions, economy is assumed if it is not set.
+	CabinClass    OptNilString `json:"cabinClass"`
+	Date          string       `json:"date"`
+	FlightNumber  string       `json:"flightNumber"`
+	OriginAirport NilString    `json:"originAirport"`
+}
+
+// GetCabinClass returns the value of CabinClass.
+func (s *RequestFlightLeg) GetCabinClass() OptNilString {
+	return s.CabinClass
 }
 
 // GetDate returns the value of Date.
@@ -2088,6 +6524,11 @@ func (s *RequestFlightLeg) GetOriginAirport() NilString {
 	return s.OriginAirport
 }
 
+// SetCabinClass sets the value of CabinClass.
+func (s *RequestFlightLeg) SetCabinClass(val OptNilString) {
+	s.CabinClass = val
+}
+
 // SetDate sets the value of Date.
 func (s *RequestFlightLeg) SetDate(val string) {
 	s.Date = val
@@ -2103,8 +6544,24 @@ func (s *RequestFlightLeg) SetOriginAirport(val NilString) {
 	s.OriginAirport = val
 }
 
+// Ref: #/components/schemas/request.Track
+type RequestTrack struct {
+	Display string `json:"display"`
+}
+
+// GetDisplay returns the value of Display.
+func (s *RequestTrack) GetDisplay() string {
+	return s.Display
+}
+
+// SetDisplay sets the value of Display.
+func (s *RequestTrack) SetDisplay(val string) {
+	s.Display = val
+}
+
 // Ref: #/components/schemas/request.TrainJourney
 type RequestTrainJourney struct {
+	Currency      OptString `json:"currency"`
 	DepartureDate string    `json:"departureDate"`
 	FromStationId string    `json:"fromStationId"`
 	Price         NilInt    `json:"price"`
@@ -2113,6 +6570,11 @@ type RequestTrainJourney struct {
 	ViaStationId  NilString `json:"viaStationId"`
 }
 
+// GetCurrency returns the value of Currency.
+func (s *RequestTrainJourney) GetCurrency() OptString {
+	return s.Currency
+}
+
 // GetDepartureDate returns the value of DepartureDate.
 func (s *RequestTrainJourney) GetDepartureDate() string {
 	return s.DepartureDate
@@ -2143,6 +6605,11 @@ func (s *RequestTrainJourney) GetViaStationId() NilString {
 	return s.ViaStationId
 }
 
+// SetCurrency sets the value of Currency.
+func (s *RequestTrainJourney) SetCurrency(val OptString) {
+	s.Currency = val
+}
+
 // SetDepartureDate sets the value of DepartureDate.
 func (s *RequestTrainJourney) SetDepartureDate(val string) {
 	s.DepartureDate = val
@@ -2176,6 +6643,7 @@ func (s *RequestTrainJourney) SetViaStationId(val NilString) {
 // Ref: #/components/schemas/request.Transportation
 type RequestTransportation struct {
 	ArrivalDateTime    string            `json:"arrivalDateTime"`
+	Currency           OptString         `json:"currency"`
 	DepartureDateTime  string            `json:"departureDateTime"`
 	Destination        NilEntityLocation `json:"destination"`
 	DestinationAddress NilString         `json:"destinationAddress"`
@@ -2191,6 +6659,11 @@ func (s *RequestTransportation) GetArrivalDateTime() string {
 	return s.ArrivalDateTime
 }
 
+// GetCurrency returns the value of Currency.
+func (s *RequestTransportation) GetCurrency() OptString {
+	return s.Currency
+}
+
 // GetDepartureDateTime returns the value of DepartureDateTime.
 func (s *RequestTransportation) GetDepartureDateTime() string {
 	return s.DepartureDateTime
@@ -2236,6 +6709,11 @@ func (s *RequestTransportation) SetArrivalDateTime(val string) {
 	s.ArrivalDateTime = val
 }
 
+// SetCurrency sets the value of Currency.
+func (s *RequestTransportation) SetCurrency(val OptString) {
+	s.Currency = val
+}
+
 // SetDepartureDateTime sets the value of DepartureDateTime.
 func (s *RequestTransportation) SetDepartureDateTime(val string) {
 	s.DepartureDateTime = val
@@ -2278,11 +6756,19 @@ func (s *RequestTransportation) SetType(val string) {
 
 // Ref: #/components/schemas/request.Trip
 type RequestTrip struct {
-	Description NilString `json:"description"`
-	EndDate     string    `json:"endDate"`
-	ImageUrl    NilString `json:"imageUrl"`
-	Name        string    `json:"name"`
-	StartDate   string    `json:"startDate"`
+	DateChange   OptEntityDateChange `json:"dateChange"`
+	Description  NilString           `json:"description"`
+	EndDate      string              `json:"endDate"`
+	HomeCurrency OptString           `json:"homeCurrency"`
+	ImageUrl     NilString           `json:"imageUrl"`
+	Name         string              `json:"name"`
+	StartDate    string              `json:"startDate"`
+	Template     OptBool             `json:"template"`
+}
+
+// GetDateChange returns the value of DateChange.
+func (s *RequestTrip) GetDateChange() OptEntityDateChange {
+	return s.DateChange
 }
 
 // GetDescription returns the value of Description.
@@ -2295,6 +6781,11 @@ func (s *RequestTrip) GetEndDate() string {
 	return s.EndDate
 }
 
+// GetHomeCurrency returns the value of HomeCurrency.
+func (s *RequestTrip) GetHomeCurrency() OptString {
+	return s.HomeCurrency
+}
+
 // GetImageUrl returns the value of ImageUrl.
 func (s *RequestTrip) GetImageUrl() NilString {
 	return s.ImageUrl
@@ -2310,6 +6801,16 @@ func (s *RequestTrip) GetStartDate() string {
 	return s.StartDate
 }
 
+// GetTemplate returns the value of Template.
+func (s *RequestTrip) GetTemplate() OptBool {
+	return s.Template
+}
+
+// SetDateChange sets the value of DateChange.
+func (s *RequestTrip) SetDateChange(val OptEntityDateChange) {
+	s.DateChange = val
+}
+
 // SetDescription sets the value of Description.
 func (s *RequestTrip) SetDescription(val NilString) {
 	s.Description = val
@@ -2320,6 +6821,11 @@ func (s *RequestTrip) SetEndDate(val string) {
 	s.EndDate = val
 }
 
+// SetHomeCurrency sets the value of HomeCurrency.
+func (s *RequestTrip) SetHomeCurrency(val OptString) {
+	s.HomeCurrency = val
+}
+
 // SetImageUrl sets the value of ImageUrl.
 func (s *RequestTrip) SetImageUrl(val NilString) {
 	s.ImageUrl = val
@@ -2335,6 +6841,59 @@ func (s *RequestTrip) SetStartDate(val string) {
 	s.StartDate = val
 }
 
+// SetTemplate sets the value of Template.
+func (s *RequestTrip) SetTemplate(val OptBool) {
+	s.Template = val
+}
+
+// Ref: #/components/schemas/request.TripCopy
+type RequestTripCopy struct {
+	Attachments     OptBool   `json:"attachments"`
+	Name            OptString `json:"name"`
+	ResolveBookings OptBool   `json:"resolveBookings"`
+	StartDate       string    `json:"startDate"`
+}
+
+// GetAttachments returns the value of Attachments.
+func (s *RequestTripCopy) GetAttachments() OptBool {
+	return s.Attachments
+}
+
+// GetName returns the value of Name.
+func (s *RequestTripCopy) GetName() OptString {
+	return s.Name
+}
+
+// GetResolveBookings returns the value of ResolveBookings.
+func (s *RequestTripCopy) GetResolveBookings() OptBool {
+	return s.ResolveBookings
+}
+
+// GetStartDate returns the value of StartDate.
+func (s *RequestTripCopy) GetStartDate() string {
+	return s.StartDate
+}
+
+// SetAttachments sets the value of Attachments.
+func (s *RequestTripCopy) SetAttachments(val OptBool) {
+	s.Attachments = val
+}
+
+// SetName sets the value of Name.
+func (s *RequestTripCopy) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetResolveBookings sets the value of ResolveBookings.
+func (s *RequestTripCopy) SetResolveBookings(val OptBool) {
+	s.ResolveBookings = val
+}
+
+// SetStartDate sets the value of StartDate.
+func (s *RequestTripCopy) SetStartDate(val string) {
+	s.StartDate = val
+}
+
 // Ref: #/components/schemas/response.Error
 type ResponseError struct {
 	Detail OptNilString `json:"detail"`
@@ -2362,11 +6921,230 @@ func (s *ResponseError) SetError(val string) {
 }
 
 func (*ResponseError) getLocationRes()     {}
+func (*ResponseError) getTemplatesRes()    {}
 func (*ResponseError) getTrainStationRes() {}
+func (*ResponseError) getTrashRes()        {}
 func (*ResponseError) getTripsRes()        {}
 func (*ResponseError) getUserRes()         {}
 func (*ResponseError) getUsersRes()        {}
 
+type RestoreAccommodationBadRequest ResponseError
+
+func (*RestoreAccommodationBadRequest) restoreAccommodationRes() {}
+
+type RestoreAccommodationForbidden ResponseError
+
+func (*RestoreAccommodationForbidden) restoreAccommodationRes() {}
+
+type RestoreAccommodationInternalServerError ResponseError
+
+func (*RestoreAccommodationInternalServerError) restoreAccommodationRes() {}
+
+type RestoreAccommodationNotFound ResponseError
+
+func (*RestoreAccommodationNotFound) restoreAccommodationRes() {}
+
+type RestoreActivityBadRequest ResponseError
+
+func (*RestoreActivityBadRequest) restoreActivityRes() {}
+
+type RestoreActivityForbidden ResponseError
+
+func (*RestoreActivityForbidden) restoreActivityRes() {}
+
+type RestoreActivityInternalServerError ResponseError
+
+func (*RestoreActivityInternalServerError) restoreActivityRes() {}
+
+type RestoreActivityNotFound ResponseError
+
+func (*RestoreActivityNotFound) restoreActivityRes() {}
+
+type RestoreFromTrashBadRequest ResponseError
+
+func (*RestoreFromTrashBadRequest) restoreFromTrashRes() {}
+
+type RestoreFromTrashForbidden ResponseError
+
+func (*RestoreFromTrashForbidden) restoreFromTrashRes() {}
+
+type RestoreFromTrashInternalServerError ResponseError
+
+func (*RestoreFromTrashInternalServerError) restoreFromTrashRes() {}
+
+type RestoreFromTrashKind string
+
+const (
+	RestoreFromTrashKindTransportation RestoreFromTrashKind = "transportation"
+	RestoreFromTrashKindActivity       RestoreFromTrashKind = "activity"
+	RestoreFromTrashKindAccommodation  RestoreFromTrashKind = "accommodation"
+	RestoreFromTrashKindExpense        RestoreFromTrashKind = "expense"
+	RestoreFromTrashKindAttachment     RestoreFromTrashKind = "attachment"
+)
+
+// AllValues returns all RestoreFromTrashKind values.
+func (RestoreFromTrashKind) AllValues() []RestoreFromTrashKind {
+	return []RestoreFromTrashKind{
+		RestoreFromTrashKindTransportation,
+		RestoreFromTrashKindActivity,
+		RestoreFromTrashKindAccommodation,
+		RestoreFromTrashKindExpense,
+		RestoreFromTrashKindAttachment,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RestoreFromTrashKind) MarshalText() ([]byte, error) {
+	switch s {
+	case RestoreFromTrashKindTransportation:
+		return []byte(s), nil
+	case RestoreFromTrashKindActivity:
+		return []byte(s), nil
+	case RestoreFromTrashKindAccommodation:
+		return []byte(s), nil
+	case RestoreFromTrashKindExpense:
+		return []byte(s), nil
+	case RestoreFromTrashKindAttachment:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RestoreFromTrashKind) UnmarshalText(data []byte) error {
+	switch RestoreFromTrashKind(data) {
+	case RestoreFromTrashKindTransportation:
+		*s = RestoreFromTrashKindTransportation
+		return nil
+	case RestoreFromTrashKindActivity:
+		*s = RestoreFromTrashKindActivity
+		return nil
+	case RestoreFromTrashKindAccommodation:
+		*s = RestoreFromTrashKindAccommodation
+		return nil
+	case RestoreFromTrashKindExpense:
+		*s = RestoreFromTrashKindExpense
+		return nil
+	case RestoreFromTrashKindAttachment:
+		*s = RestoreFromTrashKindAttachment
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// RestoreFromTrashNoContent is response for RestoreFromTrash operation.
+type RestoreFromTrashNoContent struct{}
+
+func (*RestoreFromTrashNoContent) restoreFromTrashRes() {}
+
+type RestoreFromTrashNotFound ResponseError
+
+func (*RestoreFromTrashNotFound) restoreFromTrashRes() {}
+
+type RestoreTransportationBadRequest ResponseError
+
+func (*RestoreTransportationBadRequest) restoreTransportationRes() {}
+
+type RestoreTransportationForbidden ResponseError
+
+func (*RestoreTransportationForbidden) restoreTransportationRes() {}
+
+type RestoreTransportationInternalServerError ResponseError
+
+func (*RestoreTransportationInternalServerError) restoreTransportationRes() {}
+
+type RestoreTransportationNotFound ResponseError
+
+func (*RestoreTransportationNotFound) restoreTransportationRes() {}
+
+type RestoreTripBadRequest ResponseError
+
+func (*RestoreTripBadRequest) restoreTripRes() {}
+
+type RestoreTripInternalServerError ResponseError
+
+func (*RestoreTripInternalServerError) restoreTripRes() {}
+
+// RestoreTripNoContent is response for RestoreTrip operation.
+type RestoreTripNoContent struct{}
+
+func (*RestoreTripNoContent) restoreTripRes() {}
+
+type RestoreTripNotFound ResponseError
+
+func (*RestoreTripNotFound) restoreTripRes() {}
+
+type UnlinkAttachmentBadRequest ResponseError
+
+func (*UnlinkAttachmentBadRequest) unlinkAttachmentRes() {}
+
+type UnlinkAttachmentForbidden ResponseError
+
+func (*UnlinkAttachmentForbidden) unlinkAttachmentRes() {}
+
+type UnlinkAttachmentInternalServerError ResponseError
+
+func (*UnlinkAttachmentInternalServerError) unlinkAttachmentRes() {}
+
+// UnlinkAttachmentNoContent is response for UnlinkAttachment operation.
+type UnlinkAttachmentNoContent struct{}
+
+func (*UnlinkAttachmentNoContent) unlinkAttachmentRes() {}
+
+type UnlinkAttachmentNotFound ResponseError
+
+func (*UnlinkAttachmentNotFound) unlinkAttachmentRes() {}
+
+type UnlinkAttachmentTarget string
+
+const (
+	UnlinkAttachmentTargetTransportation UnlinkAttachmentTarget = "transportation"
+	UnlinkAttachmentTargetActivities     UnlinkAttachmentTarget = "activities"
+	UnlinkAttachmentTargetAccommodation  UnlinkAttachmentTarget = "accommodation"
+)
+
+// AllValues returns all UnlinkAttachmentTarget values.
+func (UnlinkAttachmentTarget) AllValues() []UnlinkAttachmentTarget {
+	return []UnlinkAttachmentTarget{
+		UnlinkAttachmentTargetTransportation,
+		UnlinkAttachmentTargetActivities,
+		UnlinkAttachmentTargetAccommodation,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UnlinkAttachmentTarget) MarshalText() ([]byte, error) {
+	switch s {
+	case UnlinkAttachmentTargetTransportation:
+		return []byte(s), nil
+	case UnlinkAttachmentTargetActivities:
+		return []byte(s), nil
+	case UnlinkAttachmentTargetAccommodation:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UnlinkAttachmentTarget) UnmarshalText(data []byte) error {
+	switch UnlinkAttachmentTarget(data) {
+	case UnlinkAttachmentTargetTransportation:
+		*s = UnlinkAttachmentTargetTransportation
+		return nil
+	case UnlinkAttachmentTargetActivities:
+		*s = UnlinkAttachmentTargetActivities
+		return nil
+	case UnlinkAttachmentTargetAccommodation:
+		*s = UnlinkAttachmentTargetAccommodation
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/v1.AttachmentsParam
 type V1AttachmentsParamMultipart struct {
 	Attachments []ht.MultipartFile `json:"attachments"`
@@ -2381,3 +7159,44 @@ func (s *V1AttachmentsParamMultipart) GetAttachments() []ht.MultipartFile {
 func (s *V1AttachmentsParamMultipart) SetAttachments(val []ht.MultipartFile) {
 	s.Attachments = val
 }
+
+// Ref: #/components/schemas/v1.PhotosParam
+type V1PhotosParamMultipart struct {
+	Photos []ht.MultipartFile `json:"photos"`
+}
+
+// GetPhotos returns the value of Photos.
+func (s *V1PhotosParamMultipart) GetPhotos() []ht.MultipartFile {
+	return s.Photos
+}
+
+// SetPhotos sets the value of Photos.
+func (s *V1PhotosParamMultipart) SetPhotos(val []ht.MultipartFile) {
+	s.Photos = val
+}
+
+// Ref: #/components/schemas/v1.TrackParam
+type V1TrackParamMultipart struct {
+	Display OptString        `json:"display"`
+	Track   ht.MultipartFile `json:"track"`
+}
+
+// GetDisplay returns the value of Display.
+func (s *V1TrackParamMultipart) GetDisplay() OptString {
+	return s.Display
+}
+
+// GetTrack returns the value of Track.
+func (s *V1TrackParamMultipart) GetTrack() ht.MultipartFile {
+	return s.Track
+}
+
+// SetDisplay sets the value of Display.
+func (s *V1TrackParamMultipart) SetDisplay(val OptString) {
+	s.Display = val
+}
+
+// SetTrack sets the value of Track.
+func (s *V1TrackParamMultipart) SetTrack(val ht.MultipartFile) {
+	s.Track = val
+}
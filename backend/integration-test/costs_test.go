@@ -0,0 +1,93 @@
+package integration_test
+
+import (
+	"kompass/integration-test/client/api"
+)
+
+func (suite *IntegrationTestSuite) TestCosts() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+
+	suite.postActivityWithPrice(tripID, "Whale Watching", 110, api.NewOptString("usd"))
+	suite.postActivityWithPrice(tripID, "Museum", 1000, api.NewOptString("XXX"))
+	_, err := suite.api.PostAccommodation(suite.T().Context(), &api.RequestAccommodation{
+		Name:          "My Accommodation",
+		ArrivalDate:   "2025-02-01",
+		DepartureDate: "2025-02-03",
+		Description:   api.NilString{Null: true},
+		CheckInTime:   api.NilString{Null: true},
+		CheckOutTime:  api.NilString{Null: true},
+		Address:       api.NilString{Null: true},
+		Location:      api.NilEntityLocation{Null: true},
+		Price:         api.NewNilInt(100),
+	}, api.PostAccommodationParams{TripID: tripID})
+	suite.NoError(err)
+
+	// when
+	res, err := suite.api.GetCosts(suite.T().Context(), api.GetCostsParams{TripID: tripID})
+
+	// then
+	suite.NoError(err)
+	costs := res.(*api.EntityCosts)
+	suite.Equal("EUR", costs.HomeCurrency)
+	suite.Equal(200.0, costs.Total)
+
+	suite.Len(costs.Categories, 5)
+	suite.Equal(api.EntityCostCategory("TRANSPORTATION"), costs.Categories[0].Category)
+	suite.Equal(0.0, costs.Categories[0].Total)
+	suite.Equal(100.0, costs.Categories[1].Total)
+	suite.Equal(100.0, costs.Categories[2].Total)
+
+	suite.Len(costs.Days, 2)
+	suite.Equal("2025-02-01", costs.Days[0].Date)
+	suite.Equal(150.0, costs.Days[0].Total)
+	suite.Equal("2025-02-02", costs.Days[1].Date)
+	suite.Equal(50.0, costs.Days[1].Total)
+
+	suite.Len(costs.Currencies, 3)
+	suite.Equal("EUR", costs.Currencies[0].Currency)
+	suite.Equal(100.0, costs.Currencies[0].Total.Value)
+	suite.Equal("USD", costs.Currencies[1].Currency)
+	suite.Equal(110.0, costs.Currencies[1].Amount)
+	suite.Equal(100.0, costs.Currencies[1].Total.Value)
+	suite.Equal("XXX", costs.Currencies[2].Currency)
+	suite.True(costs.Currencies[2].Total.Null)
+}
+
+func (suite *IntegrationTestSuite) TestInvalidCurrency() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+
+	// when
+	res, err := suite.api.PostActivity(suite.T().Context(), &api.RequestActivity{
+		Name:        "My Activity",
+		Date:        "2025-02-01",
+		Description: api.NilString{Null: true},
+		Time:        api.NilString{Null: true},
+		Address:     api.NilString{Null: true},
+		Location:    api.NilEntityLocation{Null: true},
+		Price:       api.NewNilInt(100),
+		Currency:    api.NewOptString("Euro"),
+	}, api.PostActivityParams{TripID: tripID})
+
+	// then
+	suite.NoError(err)
+	suite.IsType(&api.PostActivityBadRequest{}, res)
+}
+
+func (suite *IntegrationTestSuite) postActivityWithPrice(tripID int, name string, price int, currency api.OptString) {
+	res, err := suite.api.PostActivity(suite.T().Context(), &api.RequestActivity{
+		Name:        name,
+		Date:        "2025-02-01",
+		Description: api.NilString{Null: true},
+		Time:        api.NilString{Null: true},
+		Address:     api.NilString{Null: true},
+		Location:    api.NilEntityLocation{Null: true},
+		Price:       api.NewNilInt(price),
+		Currency:    currency,
+	}, api.PostActivityParams{TripID: tripID})
+	suite.NoError(err)
+	suite.IsType(&api.EntityActivity{}, res)
+}
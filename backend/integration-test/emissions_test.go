@@ -0,0 +1,40 @@
+package integration_test
+
+import (
+	"kompass/integration-test/client/api"
+)
+
+func (suite *IntegrationTestSuite) TestEmissions() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	transportation := suite.postAndRetrieveTransportation(tripID)
+	_, err := suite.api.PostTransportation(suite.T().Context(), &api.RequestTransportation{
+		Name:              "Unknown Transportation",
+		Type:              "OTHER",
+		DepartureDateTime: "2025-10-07T12:00:00.000000",
+		ArrivalDateTime:   "2025-10-07T13:00:00.000000",
+		Origin: api.NewNilEntityLocation(api.EntityLocation{
+			Latitude:  -41.29439,
+			Longitude: 174.00670,
+		}),
+		Destination: api.NewNilEntityLocation(api.EntityLocation{
+			Latitude:  -41.299795,
+			Longitude: 174.77919,
+		}),
+	}, api.PostTransportationParams{TripID: tripID})
+	suite.NoError(err)
+
+	// when
+	res, err := suite.api.GetEmissions(suite.T().Context(), api.GetEmissionsParams{TripID: tripID})
+
+	// then
+	suite.NoError(err)
+	suite.Positive(transportation.Co2e.Value)
+
+	emissions := res.(*api.EntityTripEmissions)
+	suite.InDelta(transportation.Co2e.Value, emissions.Total, 0.001)
+	suite.Len(emissions.Types, 1)
+	suite.Equal(api.EntityTransportationType("FERRY"), emissions.Types[0].Type)
+	suite.Equal(1, emissions.Unestimated)
+}
@@ -0,0 +1,105 @@
+package integration_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"kompass/integration-test/client/api"
+	"kompass/integration-test/util"
+	"net/http"
+	"strings"
+	"time"
+)
+
+func (suite *IntegrationTestSuite) TestTripEventStream() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	events := suite.openEventStream(tripID, DefaultUser)
+
+	// when
+	activity := suite.postAndRetrieveActivity(tripID)
+
+	// then
+	name, data := suite.nextEvent(events)
+	suite.Equal("activity.created", name)
+	var event map[string]any
+	suite.Require().NoError(json.Unmarshal([]byte(data), &event))
+	suite.Equal(float64(tripID), event["tripId"])
+	suite.Equal("created", event["type"])
+	suite.Equal("activity", event["kind"])
+	suite.Equal(float64(activity.ID), event["id"])
+}
+
+func (suite *IntegrationTestSuite) TestTripEventStreamOutlivesWriteTimeout() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	events := suite.openEventStream(tripID, DefaultUser)
+
+	// when
+	time.Sleep(6 * time.Second)
+	activity := suite.postAndRetrieveActivity(tripID)
+
+	// then
+	name, data := suite.nextEvent(events)
+	suite.Equal("activity.created", name)
+	var event map[string]any
+	suite.Require().NoError(json.Unmarshal([]byte(data), &event))
+	suite.Equal(float64(activity.ID), event["id"])
+}
+
+func (suite *IntegrationTestSuite) TestTripEventStreamForbidden() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+
+	// when
+	res, err := suite.userApi(ForbiddenUser).GetTripEvents(suite.T().Context(), api.GetTripEventsParams{TripID: tripID})
+
+	// then
+	suite.NoError(err)
+	suite.IsType(&api.GetTripEventsForbidden{}, res)
+}
+
+// openEventStream subscribes to the events of a trip. The generated client reads the whole response, which never
+// ends for event streams, so the stream is read line by line instead.
+func (suite *IntegrationTestSuite) openEventStream(tripID int, user util.UserName) *bufio.Scanner {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	suite.T().Cleanup(cancel)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/trips/%d/events", suite.server, tripID), nil)
+	suite.Require().NoError(err)
+	req.Header.Set("Authorization", "Bearer "+string(util.GenerateJwtForUser(suite.T(), user, suite.privateKey)))
+
+	res, err := http.DefaultClient.Do(req)
+	suite.Require().NoError(err)
+	suite.T().Cleanup(func() { res.Body.Close() })
+	suite.Require().Equal(http.StatusOK, res.StatusCode)
+	suite.Equal("text/event-stream", res.Header.Get("Content-Type"))
+
+	// the comment sent on connect guarantees that the subscription is active before changes are made
+	events := bufio.NewScanner(res.Body)
+	suite.Require().True(events.Scan())
+	suite.Equal(": connected", events.Text())
+	return events
+}
+
+func (suite *IntegrationTestSuite) nextEvent(events *bufio.Scanner) (string, string) {
+	var name, data string
+	for events.Scan() {
+		line := events.Text()
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data = strings.TrimPrefix(line, "data: ")
+		case line == "" && name != "":
+			return name, data
+		}
+	}
+	suite.Require().NoError(events.Err())
+	suite.FailNow("event stream ended")
+	return "", ""
+}
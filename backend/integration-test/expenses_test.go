@@ -0,0 +1,106 @@
+package integration_test
+
+import (
+	"kompass/integration-test/client/api"
+)
+
+func (suite *IntegrationTestSuite) TestCrudExpense() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	ownerID := suite.getOwnerID(tripID)
+	activity := suite.postAndRetrieveActivity(tripID)
+
+	// when (post)
+	res, err := suite.api.PostExpense(suite.T().Context(), &api.RequestExpense{
+		Name:      "Whale Watching",
+		Date:      "2025-02-01",
+		Amount:    100,
+		PayerId:   ownerID,
+		SplitMode: "EXACT",
+		Participants: []api.RequestExpenseParticipant{
+			{UserId: ownerID, Share: api.NewOptFloat64(100)},
+		},
+		TransportationId: api.NilInt{Null: true},
+		ActivityId:       api.NewNilInt(activity.ID),
+		AccommodationId:  api.NilInt{Null: true},
+		AttachmentId:     api.NilInt{Null: true},
+	}, api.PostExpenseParams{TripID: tripID})
+
+	// then (post)
+	suite.NoError(err)
+	expense := res.(*api.EntityExpense)
+	suite.Equal("EUR", expense.Currency)
+	suite.Equal(activity.ID, expense.ActivityId.Value)
+	suite.Len(expense.Participants, 1)
+	suite.Equal(100.0, expense.Participants[0].Amount)
+
+	// when (settlement)
+	settlementRes, err := suite.api.GetSettlement(suite.T().Context(), api.GetSettlementParams{TripID: tripID})
+
+	// then (settlement)
+	suite.NoError(err)
+	settlement := settlementRes.(*api.EntitySettlement)
+	suite.Len(settlement.Balances, 1)
+	suite.Equal(100.0, settlement.Balances[0].Paid)
+	suite.Equal(0.0, settlement.Balances[0].Balance)
+	suite.Empty(settlement.Transfers)
+
+	// when (delete)
+	deleteRes, err := suite.api.DeleteExpense(suite.T().Context(), api.DeleteExpenseParams{TripID: tripID, ExpenseID: expense.ID})
+
+	// then (delete)
+	suite.NoError(err)
+	suite.IsType(&api.DeleteExpenseNoContent{}, deleteRes)
+}
+
+func (suite *IntegrationTestSuite) TestInvalidExpense() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	ownerID := suite.getOwnerID(tripID)
+
+	for name, expense := range map[string]api.RequestExpense{
+		"exact amounts do not add up": {
+			Amount:       100,
+			PayerId:      ownerID,
+			SplitMode:    "EXACT",
+			Participants: []api.RequestExpenseParticipant{{UserId: ownerID, Share: api.NewOptFloat64(90)}},
+		},
+		"participant is no member": {
+			Amount:       100,
+			PayerId:      ownerID,
+			SplitMode:    "EQUAL",
+			Participants: []api.RequestExpenseParticipant{{UserId: ownerID}, {UserId: 404}},
+		},
+		"activity of another trip": {
+			Amount:       100,
+			PayerId:      ownerID,
+			SplitMode:    "EQUAL",
+			Participants: []api.RequestExpenseParticipant{{UserId: ownerID}},
+			ActivityId:   api.NewNilInt(404),
+		},
+	} {
+		expense.Name = name
+		expense.Date = "2025-02-01"
+		expense.TransportationId.Null = true
+		expense.AccommodationId.Null = true
+		expense.AttachmentId.Null = true
+		if expense.ActivityId.Value == 0 {
+			expense.ActivityId.Null = true
+		}
+
+		// when
+		res, err := suite.api.PostExpense(suite.T().Context(), &expense, api.PostExpenseParams{TripID: tripID})
+
+		// then
+		suite.NoError(err, name)
+		suite.IsType(&api.PostExpenseBadRequest{}, res, name)
+	}
+}
+
+func (suite *IntegrationTestSuite) getOwnerID(tripID int) int {
+	res, err := suite.api.GetTrip(suite.T().Context(), api.GetTripParams{TripID: tripID})
+	suite.NoError(err)
+	return res.(*api.EntityTrip).OwnerID
+}
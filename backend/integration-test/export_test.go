@@ -0,0 +1,74 @@
+package integration_test
+
+import (
+	"io"
+	"kompass/integration-test/client/api"
+	"strings"
+)
+
+func (suite *IntegrationTestSuite) TestExportGpx() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	suite.postAndRetrieveTransportation(tripID)
+	suite.postAndRetrieveActivity(tripID)
+
+	// when
+	res, err := suite.api.GetGpx(suite.T().Context(), api.GetGpxParams{TripID: tripID})
+	suite.NoError(err)
+
+	// then
+	gpx, ok := res.(*api.GetGpxOKApplicationGpxXML)
+	suite.Require().True(ok)
+	content, err := io.ReadAll(gpx)
+	suite.NoError(err)
+
+	suite.Contains(string(content), "<trk>")
+	suite.Contains(string(content), "<name>2025-10-06: My Transportation</name>")
+	suite.Contains(string(content), "<type>FERRY</type>")
+	suite.Contains(string(content), "<name>2025-02-01: My Activity</name>")
+}
+
+func (suite *IntegrationTestSuite) TestExportKml() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	suite.postAndRetrieveTransportation(tripID)
+	suite.postAndRetrieveActivity(tripID)
+
+	// when
+	res, err := suite.api.GetKml(suite.T().Context(), api.GetKmlParams{TripID: tripID})
+	suite.NoError(err)
+
+	// then
+	kml, ok := res.(*api.GetKmlOKApplicationVndGoogleEarthKmlXML)
+	suite.Require().True(ok)
+	content, err := io.ReadAll(kml)
+	suite.NoError(err)
+
+	suite.Contains(string(content), "<name>2025-02-01</name>")
+	suite.Contains(string(content), "<name>2025-10-06</name>")
+	suite.Contains(string(content), "<styleUrl>#FERRY</styleUrl>")
+	suite.Contains(string(content), "<LineString>")
+}
+
+func (suite *IntegrationTestSuite) TestExportItineraryPdf() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	suite.postAndRetrieveFlightDetail(tripID, "2026-02-01", "LH717", api.NilString{Null: true})
+	suite.postAndRetrieveTransportation(tripID)
+	suite.postAndRetrieveActivity(tripID)
+
+	// when
+	res, err := suite.api.GetItineraryPdf(suite.T().Context(), api.GetItineraryPdfParams{TripID: tripID})
+	suite.NoError(err)
+
+	// then
+	pdf, ok := res.(*api.GetItineraryPdfOKApplicationPdf)
+	suite.Require().True(ok)
+	content, err := io.ReadAll(pdf)
+	suite.NoError(err)
+
+	suite.True(strings.HasPrefix(string(content), "%PDF-"))
+}
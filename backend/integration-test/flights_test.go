@@ -6,7 +6,7 @@ import (
 
 func (suite *IntegrationTestSuite) TestCrudFlight() {
 	// given
-	tripID := suite.CreateTrip()
+	tripID := suite.CreateTripBetween("2026-01-01", "2026-12-31")
 	defer suite.DeleteTrip(tripID)
 
 	// when (post)
@@ -23,6 +23,12 @@ func (suite *IntegrationTestSuite) TestCrudFlight() {
 	suite.Equal("Boeing 747-8i", flightDetail.Legs[0].Aircraft.Value)
 	suite.Equal("2026-02-01T12:35:00", flightDetail.Legs[0].DepartureDateTime)
 	suite.Equal("2026-02-01T19:00:00", flightDetail.Legs[0].ArrivalDateTime)
+	suite.Equal("Europe/Berlin", flightDetail.Legs[0].Origin.Location.Value.Timezone.Value)
+	suite.Equal("Asia/Tokyo", flightDetail.Legs[0].Destination.Location.Value.Timezone.Value)
+	suite.Equal("+01:00", flightDetail.Legs[0].DepartureUtcOffset.Value)
+	suite.Equal("+09:00", flightDetail.Legs[0].ArrivalUtcOffset.Value)
+	suite.Equal("+01:00", flight.DepartureUtcOffset.Value)
+	suite.Equal("+09:00", flight.ArrivalUtcOffset.Value)
 
 	// when (put)
 	updatedFlight := suite.putAndRetrieveFlight(tripID, flight.ID)
@@ -40,7 +46,7 @@ func (suite *IntegrationTestSuite) TestCrudFlight() {
 
 func (suite *IntegrationTestSuite) TestFLightEk412() {
 	// given
-	tripID := suite.CreateTrip()
+	tripID := suite.CreateTripBetween("2026-01-01", "2026-12-31")
 	defer suite.DeleteTrip(tripID)
 
 	date := "2026-01-30"
@@ -0,0 +1,130 @@
+package integration_test
+
+import (
+	"kompass/integration-test/client/api"
+)
+
+func (suite *IntegrationTestSuite) TestGetHistoryOfActivity() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	activity := suite.postAndRetrieveActivity(tripID)
+	suite.putAndRetrieveActivity(tripID, activity.ID)
+	_, err := suite.api.DeleteActivity(suite.T().Context(), api.DeleteActivityParams{TripID: tripID, ActivityID: activity.ID})
+	suite.NoError(err)
+
+	// when
+	entries := suite.getHistory(api.GetHistoryParams{
+		TripID:   tripID,
+		Kind:     api.NewOptString("activity"),
+		EntityId: api.NewOptInt(activity.ID),
+	})
+
+	// then
+	suite.Len(entries, 3)
+	suite.Equal("deleted", entries[0].Type)
+	suite.NotNil(entries[0].Before)
+	suite.Nil(entries[0].After)
+	suite.Equal("updated", entries[1].Type)
+	suite.NotNil(entries[1].Before)
+	suite.NotNil(entries[1].After)
+	suite.Equal("created", entries[2].Type)
+	suite.Nil(entries[2].Before)
+	suite.NotNil(entries[2].After)
+	for _, entry := range entries {
+		suite.Equal(activity.ID, entry.EntityId)
+		suite.False(entry.UserId.Null)
+	}
+
+	older := suite.getHistory(api.GetHistoryParams{TripID: tripID, Before: api.NewOptInt(entries[1].ID), Limit: api.NewOptInt(1)})
+	suite.Len(older, 1)
+	suite.Equal(entries[2].ID, older[0].ID)
+}
+
+func (suite *IntegrationTestSuite) TestRestoreDeletedActivity() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	activity := suite.postAndRetrieveActivity(tripID)
+	suite.putAndRetrieveActivity(tripID, activity.ID)
+	_, err := suite.api.DeleteActivity(suite.T().Context(), api.DeleteActivityParams{TripID: tripID, ActivityID: activity.ID})
+	suite.NoError(err)
+	entries := suite.getHistory(api.GetHistoryParams{TripID: tripID, Kind: api.NewOptString("activity")})
+	suite.Len(entries, 3)
+
+	// when
+	res, err := suite.api.RestoreActivity(suite.T().Context(), api.RestoreActivityParams{
+		TripID:     tripID,
+		ActivityID: activity.ID,
+		EntryID:    entries[1].ID,
+	})
+	suite.NoError(err)
+
+	// then
+	restored := res.(*api.EntityActivity)
+	suite.NotEqual(activity.ID, restored.ID)
+	suite.Equal("Updated Activity", restored.Name)
+
+	entries = suite.getHistory(api.GetHistoryParams{TripID: tripID, Kind: api.NewOptString("activity")})
+	suite.Len(entries, 4)
+	suite.Equal("created", entries[0].Type)
+	suite.Equal(restored.ID, entries[0].EntityId)
+}
+
+func (suite *IntegrationTestSuite) TestRestoreDeletedFlight() {
+	// given
+	tripID := suite.CreateTripBetween("2026-01-01", "2026-12-31")
+	defer suite.DeleteTrip(tripID)
+	flight := suite.postAndRetrieveFlightDetail(tripID, "2026-02-01", "LH717", api.NilString{Null: true})
+	_, err := suite.api.DeleteTransportation(suite.T().Context(), api.DeleteTransportationParams{TripID: tripID, TransportationID: flight.ID})
+	suite.NoError(err)
+	entries := suite.getHistory(api.GetHistoryParams{TripID: tripID, Kind: api.NewOptString("transportation")})
+	suite.Len(entries, 2)
+
+	// when
+	res, err := suite.api.RestoreTransportation(suite.T().Context(), api.RestoreTransportationParams{
+		TripID:           tripID,
+		TransportationID: flight.ID,
+		EntryID:          entries[0].ID,
+	})
+	suite.NoError(err)
+
+	// then
+	restored := res.(*api.EntityTransportation)
+	suite.NotEqual(flight.ID, restored.ID)
+	suite.Equal(api.EntityTransportationType("FLIGHT"), restored.Type)
+	suite.Equal(flight.DepartureDateTime, restored.DepartureDateTime)
+	suite.Equal(flight.ArrivalDateTime, restored.ArrivalDateTime)
+	genericDetail, ok := restored.GenericDetail.Get()
+	suite.True(ok)
+	suite.Equal("LH 717", genericDetail.Name)
+}
+
+func (suite *IntegrationTestSuite) TestGetHistoryOfRestoredTrip() {
+	// given
+	tripID := suite.CreateTrip()
+	suite.DeleteTrip(tripID)
+	_, err := suite.api.RestoreTrip(suite.T().Context(), api.RestoreTripParams{TripID: tripID})
+	suite.NoError(err)
+	defer suite.DeleteTrip(tripID)
+
+	// when
+	entries := suite.getHistory(api.GetHistoryParams{TripID: tripID, Kind: api.NewOptString("trip")})
+
+	// then
+	suite.Len(entries, 3)
+	suite.Equal("created", entries[0].Type)
+	suite.Equal("deleted", entries[1].Type)
+	suite.NotNil(entries[1].Before)
+	suite.Nil(entries[1].After)
+	for _, entry := range entries {
+		suite.Equal(tripID, entry.EntityId)
+	}
+}
+
+func (suite *IntegrationTestSuite) getHistory(params api.GetHistoryParams) []api.EntityHistoryEntry {
+	res, err := suite.api.GetHistory(suite.T().Context(), params)
+	suite.NoError(err)
+
+	return *res.(*api.GetHistoryOKApplicationJSON)
+}
@@ -0,0 +1,92 @@
+package integration_test
+
+import (
+	"kompass/integration-test/client/api"
+)
+
+func (suite *IntegrationTestSuite) TestItinerary() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	suite.postAndRetrieveActivity(tripID)
+	_, err := suite.api.PostAccommodation(suite.T().Context(), &api.RequestAccommodation{
+		Name:          "My Accommodation",
+		ArrivalDate:   "2025-02-01",
+		DepartureDate: "2025-02-03",
+		Description:   api.NilString{Null: true},
+		CheckInTime:   api.NewNilString("15:00:00"),
+		CheckOutTime:  api.NilString{Null: true},
+		Address:       api.NilString{Null: true},
+		Location:      api.NilEntityLocation{Null: true},
+		Price:         api.NilInt{Null: true},
+	}, api.PostAccommodationParams{TripID: tripID})
+	suite.NoError(err)
+
+	// when
+	res, err := suite.api.GetItinerary(suite.T().Context(), api.GetItineraryParams{TripID: tripID})
+
+	// then
+	suite.NoError(err)
+	days := *res.(*api.GetItineraryOKApplicationJSON)
+	suite.Len(days, 366)
+	suite.Equal("2025-01-01", days[0].Date)
+	suite.True(days[0].MissingAccommodation)
+	suite.False(days[365].MissingAccommodation)
+
+	arrival := days[31]
+	suite.Equal("2025-02-01", arrival.Date)
+	suite.Len(arrival.Events, 2)
+	suite.Equal(api.EntityItineraryEventType("CHECK_IN"), arrival.Events[0].Type)
+	suite.Equal(api.EntityItineraryEventType("ACTIVITY"), arrival.Events[1].Type)
+	suite.Equal("My Accommodation", arrival.Accommodation.Value.Name)
+	suite.False(arrival.MissingAccommodation)
+
+	departure := days[33]
+	suite.Equal("2025-02-03", departure.Date)
+	suite.Equal(api.EntityItineraryEventType("CHECK_OUT"), departure.Events[0].Type)
+	suite.False(departure.Accommodation.Set)
+	suite.True(departure.MissingAccommodation)
+}
+
+func (suite *IntegrationTestSuite) TestChecks() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	first := suite.postAndRetrieveTransportation(tripID)
+	_, err := suite.api.PostTransportation(suite.T().Context(), &api.RequestTransportation{
+		Name:              "Overlapping Transportation",
+		Type:              "FERRY",
+		DepartureDateTime: "2025-10-06T15:00:00.000000",
+		ArrivalDateTime:   "2025-10-06T16:00:00.000000",
+		Origin: api.NewNilEntityLocation(api.EntityLocation{
+			Latitude:  -41.29439,
+			Longitude: 174.00670,
+		}),
+		Destination: api.NewNilEntityLocation(api.EntityLocation{
+			Latitude:  -41.299795,
+			Longitude: 174.77919,
+		}),
+	}, api.PostTransportationParams{TripID: tripID})
+	suite.NoError(err)
+
+	// when
+	res, err := suite.api.GetChecks(suite.T().Context(), api.GetChecksParams{TripID: tripID})
+
+	// then
+	suite.NoError(err)
+	checks := *res.(*api.GetChecksOKApplicationJSON)
+	suite.Equal(api.EntityCheckType("MISSING_ACCOMMODATION"), checks[0].Type)
+	suite.Equal("2025-01-01", checks[0].Date)
+
+	var overlapping []api.EntityItineraryCheck
+	for _, check := range checks {
+		if check.Type == "OVERLAPPING_TRANSPORTATION" {
+			overlapping = append(overlapping, check)
+		}
+	}
+	suite.Len(overlapping, 1)
+	suite.Equal("2025-10-06", overlapping[0].Date)
+	suite.Len(overlapping[0].Entities, 2)
+	suite.Equal(first.ID, overlapping[0].Entities[0].ID)
+	suite.Equal("Overlapping Transportation", overlapping[0].Entities[1].Name)
+}
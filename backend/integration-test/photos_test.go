@@ -0,0 +1,141 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"kompass/integration-test/client/api"
+	"math"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+func (suite *IntegrationTestSuite) TestImportPhotosProposesActivity() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+
+	// when
+	photoImport := suite.importPhotos(tripID, map[string][]byte{
+		"first.jpg":  suite.photoWithExif("2025:03:10 10:15:00", -41.2865, 174.7762),
+		"second.jpg": suite.photoWithExif("2025:03:10 10:45:00", -41.2867, 174.7765),
+		"plain.jpg":  suite.photoWithExif("", 0, 0),
+	})
+
+	// then
+	suite.Require().Len(photoImport.Clusters, 1)
+	cluster := photoImport.Clusters[0]
+	suite.Equal("2025-03-10", cluster.Date)
+	suite.Equal("10:15:00", cluster.Start)
+	suite.Equal("10:45:00", cluster.End)
+	suite.Len(cluster.Photos, 2)
+	suite.True(cluster.ActivityId.Null)
+	suite.Require().True(cluster.Proposal.Set)
+	suite.Equal("Te Papa Tongarewa", cluster.Proposal.Value.Name)
+	suite.Equal("2025-03-10", cluster.Proposal.Value.Date)
+	suite.Equal("Pacific/Auckland", cluster.Proposal.Value.Location.Value.Timezone.Value)
+
+	suite.Require().Len(photoImport.Unplaced, 1)
+	suite.Equal("plain.jpg", photoImport.Unplaced[0].Name)
+}
+
+func (suite *IntegrationTestSuite) TestImportPhotosLinksExistingActivity() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	activity := suite.postAndRetrieveActivity(tripID)
+
+	// when
+	photoImport := suite.importPhotos(tripID, map[string][]byte{
+		"photo.jpg": suite.photoWithExif("2025:02:01 14:00:00", 12.3405, 43.2102),
+	})
+
+	// then
+	suite.Require().Len(photoImport.Clusters, 1)
+	cluster := photoImport.Clusters[0]
+	suite.Equal(activity.ID, cluster.ActivityId.Value)
+	suite.False(cluster.Proposal.Set)
+
+	linked := suite.getActivity(tripID, activity.ID)
+	suite.Require().Len(linked.Attachments, 1)
+	suite.Equal("photo.jpg", linked.Attachments[0].Name)
+	suite.Equal("image/jpeg", linked.Attachments[0].ContentType)
+}
+
+func (suite *IntegrationTestSuite) importPhotos(tripID int, photos map[string][]byte) api.EntityPhotoImport {
+	var files []ht.MultipartFile
+	for name, content := range photos {
+		files = append(files, ht.MultipartFile{Name: name, File: bytes.NewReader(content), Size: int64(len(content))})
+	}
+
+	res, err := suite.api.ImportPhotos(suite.T().Context(), &api.V1PhotosParamMultipart{Photos: files}, api.ImportPhotosParams{TripID: tripID})
+	suite.Require().NoError(err)
+	photoImport, ok := res.(*api.EntityPhotoImport)
+	suite.Require().True(ok)
+
+	return *photoImport
+}
+
+// photoWithExif encodes a small JPEG with an Exif segment containing the time it was taken and its coordinates. An
+// empty dateTime creates a JPEG without Exif data.
+func (suite *IntegrationTestSuite) photoWithExif(dateTime string, latitude float64, longitude float64) []byte {
+	var encoded bytes.Buffer
+	suite.Require().NoError(jpeg.Encode(&encoded, image.NewRGBA(image.Rect(0, 0, 16, 16)), nil))
+	if dateTime == "" {
+		return encoded.Bytes()
+	}
+
+	le := binary.LittleEndian
+	tiff := make([]byte, 178)
+	copy(tiff, "II*\x00")
+	le.PutUint32(tiff[4:], 8)
+	entry := func(offset int, tag uint16, typ uint16, count uint32, value uint32) {
+		le.PutUint16(tiff[offset:], tag)
+		le.PutUint16(tiff[offset+2:], typ)
+		le.PutUint32(tiff[offset+4:], count)
+		le.PutUint32(tiff[offset+8:], value)
+	}
+	rationals := func(offset int, value float64) {
+		value = math.Abs(value)
+		degrees := math.Floor(value)
+		minutes := math.Floor((value - degrees) * 60)
+		seconds := math.Round(((value-degrees)*60 - minutes) * 60 * 100)
+		for i, r := range [][2]uint32{{uint32(degrees), 1}, {uint32(minutes), 1}, {uint32(seconds), 100}} {
+			le.PutUint32(tiff[offset+i*8:], r[0])
+			le.PutUint32(tiff[offset+i*8+4:], r[1])
+		}
+	}
+	reference := func(value float64, positive string, negative string) uint32 {
+		if value < 0 {
+			return uint32(negative[0])
+		}
+		return uint32(positive[0])
+	}
+
+	// IFD0 pointing to the Exif and GPS IFDs
+	le.PutUint16(tiff[8:], 2)
+	entry(10, 0x8769, 4, 1, 38)
+	entry(22, 0x8825, 4, 1, 56)
+	// Exif IFD with DateTimeOriginal
+	le.PutUint16(tiff[38:], 1)
+	entry(40, 0x9003, 2, 20, 110)
+	// GPS IFD with latitude and longitude
+	le.PutUint16(tiff[56:], 4)
+	entry(58, 0x0001, 2, 2, reference(latitude, "N", "S"))
+	entry(70, 0x0002, 5, 3, 130)
+	entry(82, 0x0003, 2, 2, reference(longitude, "E", "W"))
+	entry(94, 0x0004, 5, 3, 154)
+	copy(tiff[110:], dateTime)
+	rationals(130, latitude)
+	rationals(154, longitude)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	var photo bytes.Buffer
+	photo.Write(encoded.Bytes()[:2])
+	photo.Write([]byte{0xff, 0xe1})
+	suite.Require().NoError(binary.Write(&photo, binary.BigEndian, uint16(len(segment)+2)))
+	photo.Write(segment)
+	photo.Write(encoded.Bytes()[2:])
+	return photo.Bytes()
+}
@@ -0,0 +1,45 @@
+package integration_test
+
+import (
+	"kompass/integration-test/client/api"
+)
+
+func (suite *IntegrationTestSuite) TestStats() {
+	// given
+	tripID := suite.CreateTripBetween("2031-01-01", "2031-12-31")
+	defer suite.DeleteTrip(tripID)
+	_, err := suite.api.PostTransportation(suite.T().Context(), &api.RequestTransportation{
+		Name:              "My Transportation",
+		Type:              "FERRY",
+		DepartureDateTime: "2031-10-06T12:34:00.000000",
+		ArrivalDateTime:   "2031-10-06T18:47:00.000000",
+		Origin: api.NewNilEntityLocation(api.EntityLocation{
+			Latitude:  -41.29439,
+			Longitude: 174.00670,
+		}),
+		Destination: api.NewNilEntityLocation(api.EntityLocation{
+			Latitude:  -41.299795,
+			Longitude: 174.77919,
+		}),
+	}, api.PostTransportationParams{TripID: tripID})
+	suite.NoError(err)
+
+	// when
+	res, err := suite.api.GetStats(suite.T().Context(), api.GetStatsParams{Year: api.NewOptInt(2031)})
+	suite.NoError(err)
+	empty, err := suite.api.GetStats(suite.T().Context(), api.GetStatsParams{Year: api.NewOptInt(2032)})
+	suite.NoError(err)
+
+	// then
+	stats := res.(*api.EntityTravelStats)
+	suite.Equal(2031, stats.Year.Value)
+	suite.Equal(1, stats.Trips)
+	suite.Len(stats.Distances, 1)
+	suite.Equal(api.EntityTransportationType("FERRY"), stats.Distances[0].Type)
+	suite.InDelta(64.6, stats.Distances[0].Kilometers, 1)
+	suite.Zero(stats.Flights)
+	suite.Empty(stats.TopAirports)
+
+	suite.Zero(empty.(*api.EntityTravelStats).Trips)
+	suite.Empty(empty.(*api.EntityTravelStats).Distances)
+}
@@ -49,11 +49,20 @@ func (suite *IntegrationTestSuite) userApi(user util.UserName) *api.Client {
 }
 
 func (suite *IntegrationTestSuite) CreateTripUser(user util.UserName) int {
+	return suite.createTrip(user, "2025-01-01", "2026-01-01")
+}
+
+// CreateTripBetween creates a trip for bookings outside of the dates of the default trip.
+func (suite *IntegrationTestSuite) CreateTripBetween(startDate string, endDate string) int {
+	return suite.createTrip(DefaultUser, startDate, endDate)
+}
+
+func (suite *IntegrationTestSuite) createTrip(user util.UserName, startDate string, endDate string) int {
 	res, err := suite.userApi(user).PostTrip(suite.T().Context(), &api.RequestTrip{
 		Name:        "Test Trip",
 		Description: api.NewNilString("This is a test"),
-		StartDate:   "2025-01-01",
-		EndDate:     "2026-01-01",
+		StartDate:   startDate,
+		EndDate:     endDate,
 		ImageUrl:    api.NilString{},
 	})
 	suite.NoError(err)
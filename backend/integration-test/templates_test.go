@@ -0,0 +1,122 @@
+package integration_test
+
+import (
+	"kompass/integration-test/client/api"
+	"kompass/integration-test/util"
+)
+
+func (suite *IntegrationTestSuite) TestCopyTrip() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	activity := suite.postAndRetrieveActivity(tripID)
+
+	// when
+	res, err := suite.api.CopyTrip(suite.T().Context(), &api.RequestTripCopy{
+		StartDate: "2025-01-11",
+		Name:      api.NewOptString("Copied Trip"),
+	}, api.CopyTripParams{TripID: tripID})
+	suite.NoError(err)
+
+	// then
+	copied := res.(*api.EntityTrip)
+	defer suite.DeleteTrip(copied.ID)
+	suite.NotEqual(tripID, copied.ID)
+	suite.Equal("Copied Trip", copied.Name)
+	suite.Equal("2025-01-11", copied.StartDate)
+	suite.Equal("2026-01-11", copied.EndDate)
+
+	activities := suite.getActivities(DefaultUser, copied.ID)
+	suite.Len(activities, 1)
+	suite.NotEqual(activity.ID, activities[0].ID)
+	suite.Equal(activity.Name, activities[0].Name)
+	suite.Equal("2025-02-11", activities[0].Date)
+}
+
+func (suite *IntegrationTestSuite) TestCopyTemplate() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	suite.postAndRetrieveActivity(tripID)
+	tripCopy := &api.RequestTripCopy{StartDate: "2025-06-01"}
+
+	res, err := suite.userApi(ForbiddenUser).CopyTrip(suite.T().Context(), tripCopy, api.CopyTripParams{TripID: tripID})
+	suite.NoError(err)
+	suite.IsType(&api.CopyTripForbidden{}, res)
+
+	_, err = suite.api.PutTrip(suite.T().Context(), &api.RequestTrip{
+		Name:        "Test Trip",
+		Description: api.NewNilString("This is a test"),
+		StartDate:   "2025-01-01",
+		EndDate:     "2026-01-01",
+		ImageUrl:    api.NilString{},
+		Template:    api.NewOptBool(true),
+	}, api.PutTripParams{TripID: tripID})
+	suite.NoError(err)
+
+	// when
+	templates, err := suite.userApi(ForbiddenUser).GetTemplates(suite.T().Context())
+	suite.NoError(err)
+	res, err = suite.userApi(ForbiddenUser).CopyTrip(suite.T().Context(), tripCopy, api.CopyTripParams{TripID: tripID})
+	suite.NoError(err)
+
+	// then
+	suite.Contains(*templates.(*api.GetTemplatesOKApplicationJSON), suite.getTrip(tripID))
+
+	copied := res.(*api.EntityTrip)
+	defer suite.DeleteTripUser(ForbiddenUser, copied.ID)
+	suite.False(copied.Template)
+	suite.Len(suite.getActivities(ForbiddenUser, copied.ID), 1)
+}
+
+func (suite *IntegrationTestSuite) TestCopyFlightAsManualTransportation() {
+	// given
+	tripID := suite.CreateTripBetween("2026-01-01", "2026-12-31")
+	defer suite.DeleteTrip(tripID)
+	flight := suite.postAndRetrieveFlightDetail(tripID, "2026-02-01", "LH717", api.NilString{Null: true})
+
+	// when
+	res, err := suite.api.CopyTrip(suite.T().Context(), &api.RequestTripCopy{StartDate: "2026-01-02"}, api.CopyTripParams{TripID: tripID})
+	suite.NoError(err)
+
+	// then
+	copied := res.(*api.EntityTrip)
+	defer suite.DeleteTrip(copied.ID)
+	transportationRes, err := suite.api.GetAllTransportation(suite.T().Context(), api.GetAllTransportationParams{TripID: copied.ID})
+	suite.NoError(err)
+	transportation := *transportationRes.(*api.GetAllTransportationOKApplicationJSON)
+	suite.Len(transportation, 1)
+	suite.NotEqual(flight.ID, transportation[0].ID)
+	suite.Equal(api.EntityTransportationType("FLIGHT"), transportation[0].Type)
+	suite.Equal("2026-02-02T12:35:00", transportation[0].DepartureDateTime)
+	genericDetail, ok := transportation[0].GenericDetail.Get()
+	suite.True(ok)
+	suite.Equal("LH 717", genericDetail.Name)
+}
+
+func (suite *IntegrationTestSuite) TestCopyMissingTrip() {
+	// given
+	tripID := suite.CreateTrip()
+	suite.DeleteTrip(tripID)
+
+	// when
+	res, err := suite.api.CopyTrip(suite.T().Context(), &api.RequestTripCopy{StartDate: "2025-06-01"}, api.CopyTripParams{TripID: tripID})
+
+	// then
+	suite.NoError(err)
+	suite.IsType(&api.CopyTripForbidden{}, res)
+}
+
+func (suite *IntegrationTestSuite) getTrip(tripID int) api.EntityTrip {
+	res, err := suite.api.GetTrip(suite.T().Context(), api.GetTripParams{TripID: tripID})
+	suite.NoError(err)
+
+	return *res.(*api.EntityTrip)
+}
+
+func (suite *IntegrationTestSuite) getActivities(user util.UserName, tripID int) []api.EntityActivity {
+	res, err := suite.userApi(user).GetActivities(suite.T().Context(), api.GetActivitiesParams{TripID: tripID})
+	suite.NoError(err)
+
+	return *res.(*api.GetActivitiesOKApplicationJSON)
+}
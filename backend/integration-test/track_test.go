@@ -0,0 +1,101 @@
+package integration_test
+
+import (
+	"kompass/integration-test/client/api"
+	"strings"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+const hikeGpx = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+  <trk>
+    <trkseg>
+      <trkpt lat="-41.29439" lon="174.00670"><ele>10</ele><time>2025-10-06T08:00:00Z</time></trkpt>
+      <trkpt lat="-41.29700" lon="174.40000"><ele>110</ele><time>2025-10-06T10:00:00Z</time></trkpt>
+      <trkpt lat="-41.299795" lon="174.77919"><ele>60</ele><time>2025-10-06T12:30:00Z</time></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+func (suite *IntegrationTestSuite) TestImportTrack() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	transportation := suite.postAndRetrieveTransportation(tripID)
+
+	// when (post)
+	res, err := suite.api.PostTrack(suite.T().Context(), &api.V1TrackParamMultipart{
+		Track: ht.MultipartFile{Name: "hike.gpx", File: strings.NewReader(hikeGpx)},
+	}, api.PostTrackParams{TripID: tripID, TransportationID: transportation.ID})
+
+	// then (post)
+	suite.NoError(err)
+	imported, ok := res.(*api.EntityTransportation)
+	suite.Require().True(ok)
+	suite.Equal("2025-10-06T08:00:00", imported.DepartureDateTime)
+	suite.Equal("2025-10-06T12:30:00", imported.ArrivalDateTime)
+	suite.Equal(api.EntityTrackDisplay("BOTH"), imported.Track.Value.Display)
+	suite.Greater(imported.Track.Value.DistanceInMeters, 60000.0)
+	suite.Equal(100.0, imported.Track.Value.ElevationGainInMeters.Value)
+
+	// when (put)
+	putRes, err := suite.api.PutTrack(suite.T().Context(), &api.RequestTrack{Display: "ACTUAL"},
+		api.PutTrackParams{TripID: tripID, TransportationID: transportation.ID})
+
+	// then (put)
+	suite.NoError(err)
+	suite.IsType(&api.PutTrackNoContent{}, putRes)
+	suite.Equal(api.EntityTrackDisplay("ACTUAL"), suite.getTransportation(tripID, transportation.ID).Track.Value.Display)
+
+	// when (delete)
+	deleteRes, err := suite.api.DeleteTrack(suite.T().Context(), api.DeleteTrackParams{TripID: tripID, TransportationID: transportation.ID})
+
+	// then (delete)
+	suite.NoError(err)
+	suite.IsType(&api.DeleteTrackNoContent{}, deleteRes)
+	suite.False(suite.getTransportation(tripID, transportation.ID).Track.Set)
+}
+
+func (suite *IntegrationTestSuite) TestImportInvalidTrack() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	transportation := suite.postAndRetrieveTransportation(tripID)
+
+	// when
+	res, err := suite.api.PostTrack(suite.T().Context(), &api.V1TrackParamMultipart{
+		Track: ht.MultipartFile{Name: "hike.txt", File: strings.NewReader("not a track")},
+	}, api.PostTrackParams{TripID: tripID, TransportationID: transportation.ID})
+
+	// then
+	suite.NoError(err)
+	suite.IsType(&api.PostTrackBadRequest{}, res)
+}
+
+func (suite *IntegrationTestSuite) TestImportTrackOutsideTripDates() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	transportation := suite.postAndRetrieveTransportation(tripID)
+	track := strings.ReplaceAll(hikeGpx, "2025-10-06", "2027-10-06")
+
+	// when
+	res, err := suite.api.PostTrack(suite.T().Context(), &api.V1TrackParamMultipart{
+		Track: ht.MultipartFile{Name: "hike.gpx", File: strings.NewReader(track)},
+	}, api.PostTrackParams{TripID: tripID, TransportationID: transportation.ID})
+
+	// then
+	suite.NoError(err)
+	suite.IsType(&api.PostTrackBadRequest{}, res)
+	unchanged := suite.getTransportation(tripID, transportation.ID)
+	suite.Equal(transportation.DepartureDateTime, unchanged.DepartureDateTime)
+	suite.False(unchanged.Track.Set)
+}
+
+func (suite *IntegrationTestSuite) getTransportation(tripID int, transportationID int) api.EntityTransportation {
+	res, err := suite.api.GetTransportation(suite.T().Context(), api.GetTransportationParams{TripID: tripID, TransportationID: transportationID})
+	suite.NoError(err)
+
+	return *res.(*api.EntityTransportation)
+}
@@ -16,6 +16,9 @@ func (suite *IntegrationTestSuite) TestCrudTransportation() {
 	// then (post)
 	suite.Equal("My Transportation", transportation.GenericDetail.Value.Name)
 	suite.Equal(123, transportation.Price.Value)
+	suite.Equal("Pacific/Auckland", transportation.Origin.Value.Timezone.Value)
+	suite.Equal("+13:00", transportation.DepartureUtcOffset.Value)
+	suite.Equal("+13:00", transportation.ArrivalUtcOffset.Value)
 
 	// when (put)
 	updatedTransportation := suite.putAndRetrieveTransportation(tripID, transportation.ID)
@@ -112,6 +115,32 @@ func (suite *IntegrationTestSuite) TestForbiddenUserPermissions() {
 	suite.Equal(flight, *newFlight)
 }
 
+func (suite *IntegrationTestSuite) TestPostTransportationOutOfRange() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+
+	// when
+	res, err := suite.api.PostTransportation(suite.T().Context(), &api.RequestTransportation{
+		Name:              "My Transportation",
+		Type:              "FERRY",
+		DepartureDateTime: "2026-01-01T22:00:00.000000",
+		ArrivalDateTime:   "2026-01-02T06:00:00.000000",
+		Origin: api.NewNilEntityLocation(api.EntityLocation{
+			Latitude:  -41.29439,
+			Longitude: 174.00670,
+		}),
+		Destination: api.NewNilEntityLocation(api.EntityLocation{
+			Latitude:  -41.299795,
+			Longitude: 174.77919,
+		}),
+	}, api.PostTransportationParams{TripID: tripID})
+
+	// then
+	suite.NoError(err)
+	suite.IsType(&api.PostTransportationBadRequest{}, res)
+}
+
 func (suite *IntegrationTestSuite) postAndRetrieveTransportation(tripID int) api.EntityTransportation {
 	_, err := suite.api.PostTransportation(suite.T().Context(), &api.RequestTransportation{
 		Name:              "My Transportation",
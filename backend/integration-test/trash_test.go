@@ -0,0 +1,102 @@
+package integration_test
+
+import (
+	"kompass/integration-test/client/api"
+)
+
+func (suite *IntegrationTestSuite) TestRestoreActivityFromTrash() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	activity := suite.postAndRetrieveActivity(tripID)
+	_, err := suite.api.DeleteActivity(suite.T().Context(), api.DeleteActivityParams{TripID: tripID, ActivityID: activity.ID})
+	suite.NoError(err)
+
+	// when (list)
+	items := suite.getTripTrash(tripID)
+
+	// then (list)
+	suite.Len(items, 1)
+	suite.Equal("activity", items[0].Kind)
+	suite.Equal(activity.ID, items[0].ID)
+	suite.Equal(activity.Name, items[0].Name)
+	suite.False(items[0].PurgeAt.Null)
+
+	// when (restore)
+	res, err := suite.api.RestoreFromTrash(suite.T().Context(), api.RestoreFromTrashParams{
+		TripID:   tripID,
+		Kind:     api.RestoreFromTrashKindActivity,
+		EntityID: activity.ID,
+	})
+
+	// then (restore)
+	suite.NoError(err)
+	suite.IsType(&api.RestoreFromTrashNoContent{}, res)
+	suite.Empty(suite.getTripTrash(tripID))
+
+	getByID, err := suite.api.GetActivity(suite.T().Context(), api.GetActivityParams{TripID: tripID, ActivityID: activity.ID})
+	suite.NoError(err)
+	suite.Equal(activity.Name, getByID.(*api.EntityActivity).Name)
+
+	res, err = suite.api.RestoreFromTrash(suite.T().Context(), api.RestoreFromTrashParams{
+		TripID:   tripID,
+		Kind:     api.RestoreFromTrashKindActivity,
+		EntityID: activity.ID,
+	})
+	suite.NoError(err)
+	suite.IsType(&api.RestoreFromTrashNotFound{}, res)
+}
+
+func (suite *IntegrationTestSuite) TestRestoreTripFromTrash() {
+	// given
+	tripID := suite.CreateTrip()
+	activity := suite.postAndRetrieveActivity(tripID)
+	suite.DeleteTrip(tripID)
+
+	// when (list)
+	res, err := suite.api.GetTrash(suite.T().Context())
+	suite.NoError(err)
+
+	// then (list)
+	item := findTrashItem(*res.(*api.GetTrashOKApplicationJSON), "trip", tripID)
+	suite.Equal(tripID, item.TripId)
+	suite.NotEmpty(item.DeletedAt)
+	getByID, err := suite.api.GetTrip(suite.T().Context(), api.GetTripParams{TripID: tripID})
+	suite.NoError(err)
+	suite.IsNotType(&api.EntityTrip{}, getByID)
+
+	restored, err := suite.userApi(ForbiddenUser).RestoreTrip(suite.T().Context(), api.RestoreTripParams{TripID: tripID})
+	suite.NoError(err)
+	suite.IsType(&api.RestoreTripNotFound{}, restored)
+
+	// when (restore)
+	restored, err = suite.api.RestoreTrip(suite.T().Context(), api.RestoreTripParams{TripID: tripID})
+	defer suite.DeleteTrip(tripID)
+
+	// then (restore)
+	suite.NoError(err)
+	suite.IsType(&api.RestoreTripNoContent{}, restored)
+
+	getByID, err = suite.api.GetTrip(suite.T().Context(), api.GetTripParams{TripID: tripID})
+	suite.NoError(err)
+	suite.IsType(&api.EntityTrip{}, getByID)
+	getActivity, err := suite.api.GetActivity(suite.T().Context(), api.GetActivityParams{TripID: tripID, ActivityID: activity.ID})
+	suite.NoError(err)
+	suite.IsType(&api.EntityActivity{}, getActivity)
+}
+
+func (suite *IntegrationTestSuite) getTripTrash(tripID int) []api.EntityTrashItem {
+	res, err := suite.api.GetTripTrash(suite.T().Context(), api.GetTripTrashParams{TripID: tripID})
+	suite.NoError(err)
+
+	return *res.(*api.GetTripTrashOKApplicationJSON)
+}
+
+func findTrashItem(items []api.EntityTrashItem, kind string, id int) api.EntityTrashItem {
+	for _, item := range items {
+		if item.Kind == kind && item.ID == id {
+			return item
+		}
+	}
+	return api.EntityTrashItem{}
+}
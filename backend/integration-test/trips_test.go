@@ -42,3 +42,57 @@ func (suite *IntegrationTestSuite) TestGetTripForbidden() {
 	// then
 	suite.IsType(&api.GetTripForbidden{}, res)
 }
+
+func (suite *IntegrationTestSuite) TestUpdateTripDates() {
+	// given
+	tripID := suite.CreateTrip()
+	defer suite.DeleteTrip(tripID)
+	activity := suite.postAndRetrieveActivity(tripID)
+	trashed := suite.postAndRetrieveActivity(tripID)
+	_, err := suite.api.DeleteActivity(suite.T().Context(), api.DeleteActivityParams{TripID: tripID, ActivityID: trashed.ID})
+	suite.NoError(err)
+	trip := &api.RequestTrip{
+		Name:        "Test Trip",
+		Description: api.NewNilString("This is a test"),
+		StartDate:   "2025-03-01",
+		EndDate:     "2026-03-01",
+		ImageUrl:    api.NilString{},
+	}
+
+	// when (validate)
+	res, err := suite.api.PutTrip(suite.T().Context(), trip, api.PutTripParams{TripID: tripID})
+
+	// then (validate)
+	suite.NoError(err)
+	outOfRange := *res.(*api.PutTripConflictApplicationJSON)
+	suite.Len(outOfRange, 1)
+	suite.Equal("activity", outOfRange[0].Kind)
+	suite.Equal(activity.ID, outOfRange[0].ID)
+	suite.Equal("2025-02-01", outOfRange[0].StartDate)
+	suite.Equal("2025-01-01", suite.getTrip(tripID).StartDate)
+
+	// when (shift)
+	trip.DateChange = api.NewOptEntityDateChange("SHIFT")
+	res, err = suite.api.PutTrip(suite.T().Context(), trip, api.PutTripParams{TripID: tripID})
+
+	// then (shift)
+	suite.NoError(err)
+	suite.IsType(&api.PutTripNoContent{}, res)
+	suite.Equal("2025-03-01", suite.getTrip(tripID).StartDate)
+	suite.Equal("2025-04-01", suite.getActivities(DefaultUser, tripID)[0].Date)
+
+	entries := suite.getHistory(api.GetHistoryParams{TripID: tripID, Kind: api.NewOptString("activity"), EntityId: api.NewOptInt(activity.ID)})
+	suite.Equal("updated", entries[0].Type)
+	suite.NotNil(entries[0].Before)
+
+	restored, err := suite.api.RestoreFromTrash(suite.T().Context(), api.RestoreFromTrashParams{
+		TripID:   tripID,
+		Kind:     api.RestoreFromTrashKindActivity,
+		EntityID: trashed.ID,
+	})
+	suite.NoError(err)
+	suite.IsType(&api.RestoreFromTrashNoContent{}, restored)
+	for _, a := range suite.getActivities(DefaultUser, tripID) {
+		suite.Equal("2025-04-01", a.Date)
+	}
+}
@@ -33,6 +33,8 @@ func StartBackendSubprocess(t testing.TB, dbConnectionString string, wiremockURL
 		fmt.Sprintf("AMADEUS_URL=%s/amadeus", wiremockURL),
 		fmt.Sprintf("DBVENDO_URL=%s/dbvendo", wiremockURL),
 		fmt.Sprintf("ORS_URL=%s/ors", wiremockURL),
+		fmt.Sprintf("ECB_URL=%s/ecb", wiremockURL),
+		"ENCRYPTION_MASTER_KEY=a29tcGFzcy1pbnRlZ3JhdGlvbi10ZXN0LW1hc3Rlci0=",
 	)
 
 	cmd.Stdout = NewSubprocessLogger("kompass", ansiGreen, false)
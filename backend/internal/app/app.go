@@ -2,19 +2,37 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"kompass/internal/controller/http/v1/response"
+	"kompass/internal/repo"
 	"kompass/internal/repo/amadeus"
+	"kompass/internal/repo/boundary"
+	emissionfactors "kompass/internal/repo/emissions"
+	"kompass/internal/repo/exchangerates"
 	"kompass/internal/repo/opentraveldata"
 	"kompass/internal/repo/webapi"
 	"kompass/internal/usecase"
 	"kompass/internal/usecase/accommodation"
 	"kompass/internal/usecase/activities"
 	"kompass/internal/usecase/attachments"
+	"kompass/internal/usecase/budgets"
+	"kompass/internal/usecase/changes"
+	"kompass/internal/usecase/costs"
+	"kompass/internal/usecase/emissions"
+	"kompass/internal/usecase/events"
+	"kompass/internal/usecase/expenses"
+	"kompass/internal/usecase/export"
 	"kompass/internal/usecase/flights"
 	"kompass/internal/usecase/geocoding"
+	"kompass/internal/usecase/history"
+	"kompass/internal/usecase/itinerary"
+	"kompass/internal/usecase/photos"
+	"kompass/internal/usecase/stats"
+	"kompass/internal/usecase/templates"
 	"kompass/internal/usecase/trains"
 	"kompass/internal/usecase/transportation"
+	"kompass/internal/usecase/trash"
 	"kompass/internal/usecase/trips"
 	"kompass/internal/usecase/users"
 	"kompass/pkg/postgres"
@@ -22,6 +40,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/jackc/pgx/v5/stdlib"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
@@ -43,13 +62,44 @@ func Run(cfg *config.Config) {
 	}
 	defer pg.Close()
 
+	storage, err := blobStorage(cfg.Attachments, cfg.Attachments.Storage, pg)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - Run - blobStorage: %w", err))
+	}
+
+	keys, err := tripKeys(cfg.Encryption, pg)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - Run - tripKeys: %w", err))
+	}
+
 	// Use-Case
-	useCases := createUseCases(cfg, pg)
+	useCases := createUseCases(cfg, pg, storage, keys, log)
+
+	listenCtx, stopListening := context.WithCancel(context.Background())
+	defer stopListening()
+	go useCases.Events.Listen(listenCtx)
+
+	purgeCtx, stopPurging := context.WithCancel(context.Background())
+	defer stopPurging()
+	go useCases.Trash.PurgeRegularly(purgeCtx)
 
 	// HTTP Server
+	upload := httpserver.RouteConfig{
+		BodyLimit:    cfg.HTTP.UploadBodyLimit,
+		StreamBody:   true,
+		ReadTimeout:  cfg.HTTP.TransferTimeout,
+		WriteTimeout: cfg.HTTP.TransferTimeout,
+	}
+	download := httpserver.RouteConfig{WriteTimeout: cfg.HTTP.TransferTimeout}
 	httpServer := httpserver.New(
 		httpserver.Port(cfg.HTTP.Port),
 		httpserver.Prefork(cfg.HTTP.UsePreforkMode),
+		httpserver.BodyLimit(cfg.HTTP.BodyLimit),
+		httpserver.Route(fiber.MethodPost, "/api/v1/trips/*/attachments", upload),
+		httpserver.Route(fiber.MethodPost, "/api/v1/trips/*/photos", upload),
+		httpserver.Route(fiber.MethodGet, "/api/v1/trips/*/attachments/*", download),
+		// event streams stay open until the client disconnects
+		httpserver.Route(fiber.MethodGet, "/api/v1/trips/*/events", httpserver.RouteConfig{WriteTimeout: httpserver.NoTimeout}),
 		httpserver.ErrorHandler(response.ErrorHandler),
 	)
 	http.NewRouter(httpServer.App, cfg, useCases, log)
@@ -67,27 +117,50 @@ func Run(cfg *config.Config) {
 	}
 
 	// Shutdown
+	// closing the event streams first, as open streams would delay the shutdown of the server
+	stopListening()
 	err = httpServer.Shutdown()
 	if err != nil {
 		log.Error(fmt.Errorf("app - Run - httpServer.Shutdown: %w", err))
 	}
 }
 
-func createUseCases(cfg *config.Config, pg *postgres.Postgres) usecase.UseCases {
-	flightsRepo := persistent.NewFlightsRepo(pg)
+func createUseCases(cfg *config.Config, pg *postgres.Postgres, storage repo.BlobStorage, keys *persistent.TripKeys, log logger.Interface) usecase.UseCases {
+	flightsRepo := persistent.NewFlightsRepo(pg, keys)
 	transportationRepo := persistent.NewTransportationRepo(pg, flightsRepo, persistent.NewTrainsRepo(pg))
+	tripsRepo := persistent.NewTripsRepo(pg)
+	activitiesRepo := persistent.NewActivitiesRepo(pg, keys)
+	accommodationRepo := persistent.NewAccommodationRepo(pg, keys)
+	userRepo := persistent.NewUserRepo(pg)
+	expensesRepo := persistent.NewExpensesRepo(pg)
+	attachmentsRepo := persistent.NewAttachmentsRepo(pg, storage, keys)
+	budgetsRepo := persistent.NewBudgetsRepo(pg)
 	ors := webapi.NewOpenRouteServiceWebAPI(cfg.WebApi)
 	optd := opentraveldata.New(cfg.WebApi)
+	boundaries := boundary.New()
 
-	usersUseCase := users.New(persistent.NewUserRepo(pg))
-	tripsUseCase := trips.New(persistent.NewTripsRepo(pg))
-	transportationUseCase := transportation.New(transportationRepo, ors)
-	flightsUseCase := flights.New(transportationRepo, flightsRepo, amadeus.New(cfg.WebApi, optd))
-	trainsUseCase := trains.New(transportationRepo, webapi.NewDbVendoWebAPI(cfg.WebApi))
-	activitiesUseCase := activities.New(persistent.NewActivitiesRepo(pg), tripsUseCase)
-	accommodationUseCase := accommodation.New(persistent.NewAccommodationRepo(pg), tripsUseCase)
-	attachmentsUseCase := attachments.New(persistent.NewAttachmentsRepo(pg))
+	eventsUseCase := events.New(persistent.NewEventsRepo(pg), log)
+	historyUseCase := history.New(persistent.NewHistoryRepo(pg, keys), tripsRepo, transportationRepo, activitiesRepo, accommodationRepo, expensesRepo, attachmentsRepo, budgetsRepo, log)
+	usersUseCase := users.New(userRepo)
+	tripsUseCase := trips.New(tripsRepo, eventsUseCase, historyUseCase)
+	costsUseCase := costs.New(tripsRepo, transportationRepo, activitiesRepo, accommodationRepo, expensesRepo, persistent.NewExchangeRatesRepo(pg), exchangeRateProvider(cfg.WebApi))
+	budgetsUseCase := budgets.New(budgetsRepo, tripsUseCase, costsUseCase, eventsUseCase, historyUseCase)
+	emissionsUseCase := emissions.New(transportationRepo, emissionfactors.New(cfg.Emissions.FactorsFile), boundaries)
+	transportationUseCase := transportation.New(transportationRepo, tripsUseCase, ors, boundaries, budgetsUseCase, emissionsUseCase, eventsUseCase, historyUseCase)
+	flightsUseCase := flights.New(transportationRepo, flightsRepo, amadeus.New(cfg.WebApi, optd), tripsUseCase, budgetsUseCase, eventsUseCase, historyUseCase)
+	trainsUseCase := trains.New(transportationRepo, webapi.NewDbVendoWebAPI(cfg.WebApi), tripsUseCase, boundaries, budgetsUseCase, eventsUseCase, historyUseCase)
+	activitiesUseCase := activities.New(activitiesRepo, tripsUseCase, budgetsUseCase, boundaries, eventsUseCase, historyUseCase)
+	accommodationUseCase := accommodation.New(accommodationRepo, tripsUseCase, budgetsUseCase, boundaries, eventsUseCase, historyUseCase)
+	attachmentsUseCase := attachments.New(attachmentsRepo, cfg.Attachments, log, eventsUseCase, historyUseCase)
+	photosUseCase := photos.New(attachmentsUseCase, activitiesUseCase, tripsUseCase, ors, boundaries)
 	geocodingUseCase := geocoding.New(trainsUseCase, ors)
+	itineraryUseCase := itinerary.New(tripsRepo, transportationRepo, activitiesRepo, accommodationRepo, cfg.Checks)
+	expensesUseCase := expenses.New(expensesRepo, userRepo, tripsUseCase, costsUseCase, eventsUseCase, historyUseCase)
+	exportUseCase := export.New(tripsRepo, transportationRepo, activitiesRepo, accommodationRepo, userRepo, itineraryUseCase, cfg.App)
+	changesUseCase := changes.New(persistent.NewSyncRepo(pg), tripsUseCase, transportationUseCase, activitiesUseCase, accommodationUseCase, expensesUseCase, attachmentsUseCase)
+	trashUseCase := trash.New(persistent.NewTrashRepo(pg, attachmentsRepo), cfg.Trash.Retention, eventsUseCase, historyUseCase, log)
+	statsUseCase := stats.New(tripsRepo, transportationRepo)
+	templatesUseCase := templates.New(userRepo, tripsUseCase, transportationUseCase, flightsUseCase, trainsUseCase, activitiesUseCase, accommodationUseCase, attachmentsUseCase, log)
 
 	return usecase.UseCases{
 		Users:          usersUseCase,
@@ -99,7 +172,27 @@ func createUseCases(cfg *config.Config, pg *postgres.Postgres) usecase.UseCases
 		Activities:     activitiesUseCase,
 		Accommodation:  accommodationUseCase,
 		Attachments:    attachmentsUseCase,
+		Photos:         photosUseCase,
+		Export:         exportUseCase,
+		Itinerary:      itineraryUseCase,
+		Costs:          costsUseCase,
+		Expenses:       expensesUseCase,
+		Budgets:        budgetsUseCase,
+		Events:         eventsUseCase,
+		Changes:        changesUseCase,
+		History:        historyUseCase,
+		Trash:          trashUseCase,
+		Templates:      templatesUseCase,
+		Stats:          statsUseCase,
+		Emissions:      emissionsUseCase,
+	}
+}
+
+func exchangeRateProvider(cfg config.WebApi) repo.ExchangeRateProvider {
+	if cfg.ExchangeRatesFile != "" {
+		return exchangerates.NewFile(cfg.ExchangeRatesFile)
 	}
+	return exchangerates.NewECB(cfg)
 }
 
 func connectAndMigrate(cfg config.PG) (*postgres.Postgres, error) {
@@ -0,0 +1,68 @@
+package app
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"kompass/config"
+	"kompass/internal/repo"
+	"kompass/internal/repo/blobstorage"
+	persistent "kompass/internal/repo/postgres"
+	"kompass/internal/usecase/attachments"
+	"kompass/pkg/logger"
+	"kompass/pkg/postgres"
+)
+
+// MigrateAttachments moves the contents of all attachments from one storage backend to another. Afterwards,
+// ATTACHMENT_STORAGE has to be set to the target backend.
+func MigrateAttachments(cfg *config.Config, args []string) {
+	log := logger.New(cfg.Log.Level)
+
+	flags := flag.NewFlagSet("migrate-attachments", flag.ExitOnError)
+	from := flags.String("from", "postgres", "storage backend to copy from: postgres, filesystem or s3")
+	to := flags.String("to", cfg.Attachments.Storage, "storage backend to copy to: postgres, filesystem or s3")
+	deleteSource := flags.Bool("delete", false, "delete contents from the source backend after copying them")
+	_ = flags.Parse(args)
+
+	if *from == *to {
+		log.Fatal(fmt.Errorf("app - MigrateAttachments: source and target are both %s", *from))
+	}
+
+	pg, err := connectAndMigrate(cfg.PG)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - MigrateAttachments - ConnectAndMigrate: %w", err))
+	}
+	defer pg.Close()
+
+	source, err := blobStorage(cfg.Attachments, *from, pg)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - MigrateAttachments - source: %w", err))
+	}
+	target, err := blobStorage(cfg.Attachments, *to, pg)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - MigrateAttachments - target: %w", err))
+	}
+	keys, err := tripKeys(cfg.Encryption, pg)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - MigrateAttachments - tripKeys: %w", err))
+	}
+
+	copied, err := attachments.MigrateStorage(context.Background(), persistent.NewAttachmentsRepo(pg, source, keys), source, target, *deleteSource)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - MigrateAttachments - after copying %d blobs: %w", copied, err))
+	}
+	log.Info("app - MigrateAttachments: copied %d blobs from %s to %s", copied, *from, *to)
+}
+
+func blobStorage(cfg config.Attachments, backend string, pg *postgres.Postgres) (repo.BlobStorage, error) {
+	switch backend {
+	case "postgres":
+		return persistent.NewBlobsRepo(pg), nil
+	case "filesystem":
+		return blobstorage.NewFilesystem(cfg.StoragePath)
+	case "s3":
+		return blobstorage.NewS3(context.Background(), cfg)
+	default:
+		return nil, fmt.Errorf("unknown attachment storage: %s", backend)
+	}
+}
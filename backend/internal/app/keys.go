@@ -0,0 +1,72 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"kompass/config"
+	persistent "kompass/internal/repo/postgres"
+	"kompass/pkg/envelope"
+	"kompass/pkg/logger"
+	"kompass/pkg/postgres"
+)
+
+// RotateKeys re-wraps the data keys of all trips with the current master key. With -data-keys, every trip gets a new
+// data key and everything is re-encrypted with it, which also encrypts data stored before encryption was enabled.
+func RotateKeys(cfg *config.Config, args []string) {
+	log := logger.New(cfg.Log.Level)
+
+	flags := flag.NewFlagSet("rotate-keys", flag.ExitOnError)
+	dataKeys := flags.Bool("data-keys", false, "create new data keys and re-encrypt all data")
+	_ = flags.Parse(args)
+
+	if cfg.Encryption.MasterKey == "" {
+		log.Fatal(errors.New("app - RotateKeys: ENCRYPTION_MASTER_KEY is not set"))
+	}
+
+	pg, err := connectAndMigrate(cfg.PG)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - RotateKeys - ConnectAndMigrate: %w", err))
+	}
+	defer pg.Close()
+
+	keys, err := tripKeys(cfg.Encryption, pg)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - RotateKeys - tripKeys: %w", err))
+	}
+
+	ctx := context.Background()
+	rewrapped, err := keys.RewrapDataKeys(ctx)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - RotateKeys - after re-wrapping %d data keys: %w", rewrapped, err))
+	}
+	log.Info("app - RotateKeys: re-wrapped %d data keys", rewrapped)
+
+	if !*dataKeys {
+		return
+	}
+
+	storage, err := blobStorage(cfg.Attachments, cfg.Attachments.Storage, pg)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - RotateKeys - blobStorage: %w", err))
+	}
+	rotated, err := keys.RotateDataKeys(ctx, persistent.NewAttachmentsRepo(pg, storage, keys))
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - RotateKeys - after rotating %d data keys: %w", rotated, err))
+	}
+	log.Info("app - RotateKeys: rotated the data keys of %d trips", rotated)
+}
+
+// tripKeys disables encryption if no master key is configured.
+func tripKeys(cfg config.Encryption, pg *postgres.Postgres) (*persistent.TripKeys, error) {
+	if cfg.MasterKey == "" {
+		return persistent.NewTripKeys(pg, nil), nil
+	}
+
+	keyring, err := envelope.NewKeyring(cfg.MasterKey, cfg.PreviousMasterKeys...)
+	if err != nil {
+		return nil, err
+	}
+	return persistent.NewTripKeys(pg, keyring), nil
+}
@@ -61,6 +61,7 @@ func RetrieveOrCreateUserMiddleware(uc usecase.Users) func(c *fiber.Ctx) error {
 		}
 
 		ctx.Locals("user", user)
+		ctx.SetUserContext(usecase.WithActor(ctx.UserContext(), user))
 		return ctx.Next()
 	}
 }
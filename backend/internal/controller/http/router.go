@@ -48,18 +48,32 @@ func NewRouter(app *fiber.App, cfg *config.Config, useCases usecase.UseCases, lo
 		apiV1Group.Use(middleware.JwtMiddleware(cfg.Auth))
 		apiV1Group.Use(middleware.RetrieveOrCreateUserMiddleware(useCases.Users))
 
+		v1.NewStatsRoutes(apiV1Group, useCases.Stats, log)
 		v1.NewUserRoutes(apiV1Group, useCases.Users, log)
 		v1.NewGeocodingRoutes(apiV1Group, useCases.Geocoding, log)
 
+		v1.NewTemplateRoutes(apiV1Group, useCases.Templates, log)
+
 		authorization := middleware.TripAuthorization(useCases.Users)
 		tripsV1Group := v1.NewTripRoutes(apiV1Group, useCases.Trips, log, authorization)
 		{
+			v1.NewEventRoutes(tripsV1Group, useCases.Events, useCases.Users, log)
 			v1.NewTransportationRoutes(tripsV1Group, useCases.Transportation, log)
 			v1.NewFlightRoutes(tripsV1Group, useCases.Flights, log)
 			v1.NewTrainRoutes(tripsV1Group, useCases.Trains, log)
-			v1.NewActivityRoutes(tripsV1Group, useCases.Activities, log)
-			v1.NewAccommodationRoutes(tripsV1Group, useCases.Accommodation, log)
+			v1.NewActivityRoutes(tripsV1Group, useCases.Activities, useCases.Users, log)
+			v1.NewAccommodationRoutes(tripsV1Group, useCases.Accommodation, useCases.Users, log)
 			v1.NewAttachmentRoutes(tripsV1Group, useCases.Attachments, log)
+			v1.NewPhotoRoutes(tripsV1Group, useCases.Photos, log)
+			v1.NewItineraryRoutes(tripsV1Group, useCases.Itinerary, useCases.Users, log)
+			v1.NewEmissionsRoutes(tripsV1Group, useCases.Emissions, log)
+			v1.NewCostsRoutes(tripsV1Group, useCases.Costs, log)
+			v1.NewExpenseRoutes(tripsV1Group, useCases.Expenses, log)
+			v1.NewBudgetRoutes(tripsV1Group, useCases.Budgets, log)
+			v1.NewExportRoutes(tripsV1Group, useCases.Export, log)
+			v1.NewChangesRoutes(tripsV1Group, useCases.Changes, useCases.Users, log)
+			v1.NewHistoryRoutes(tripsV1Group, useCases.History, log)
 		}
+		v1.NewTrashRoutes(apiV1Group, tripsV1Group, useCases.Trash, log)
 	}
 }
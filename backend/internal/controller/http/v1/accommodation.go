@@ -12,9 +12,10 @@ import (
 )
 
 type AccommodationV1 struct {
-	uc  usecase.Accommodation
-	log logger.Interface
-	v   *validator.Validate
+	uc    usecase.Accommodation
+	users usecase.Users
+	log   logger.Interface
+	v     *validator.Validate
 }
 
 // @Summary     Get all accommodation
@@ -38,6 +39,15 @@ func (r *AccommodationV1) getAllAccommodation(ctx *fiber.Ctx) error {
 	if err != nil {
 		return fmt.Errorf("get accommodation: %w", err)
 	}
+	show, err := showSensitive(ctx, r.users, int32(tripID))
+	if err != nil {
+		return err
+	}
+	if !show {
+		for i := range accommodation {
+			accommodation[i] = accommodation[i].Redacted()
+		}
+	}
 
 	return ctx.Status(http.StatusOK).JSON(accommodation)
 }
@@ -48,7 +58,9 @@ func (r *AccommodationV1) getAllAccommodation(ctx *fiber.Ctx) error {
 // @Produce     json
 // @Param       trip_id path int true "Trip ID"
 // @Param       accommodation_id path int true "Accommodation ID"
+// @Param       If-None-Match header string false "ETag of a cached copy"
 // @Success     200 {object} entity.Accommodation
+// @Success     304
 // @Failure     400 {object} response.Error
 // @Failure     403 {object} response.Error
 // @Failure     404 {object} response.Error
@@ -69,8 +81,15 @@ func (r *AccommodationV1) getAccommodationByID(ctx *fiber.Ctx) error {
 	if err != nil {
 		return fmt.Errorf("get accommodation [id=%d]: %w", accommodationID, err)
 	}
+	show, err := showSensitive(ctx, r.users, int32(tripID))
+	if err != nil {
+		return err
+	}
+	if !show {
+		accommodation = accommodation.Redacted()
+	}
 
-	return ctx.Status(http.StatusOK).JSON(accommodation)
+	return sendWithRevision(ctx, accommodation.Revision, accommodation)
 }
 
 // @Summary     Add accommodation
@@ -113,10 +132,12 @@ func (r *AccommodationV1) postAccommodation(ctx *fiber.Ctx) error {
 // @Param       trip_id path int true "Trip ID"
 // @Param       accommodation_id path int true "Accommodation ID"
 // @Param       request body request.Accommodation true "accommodation"
+// @Param       If-Match header string false "ETag the request is conditional on"
 // @Success     204
 // @Failure     400 {object} response.Error
 // @Failure     403 {object} response.Error
 // @Failure     404 {object} response.Error
+// @Failure     412 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Security    bearerauth
 // @Router      /trips/{trip_id}/accommodation/{accommodation_id} [put]
@@ -135,7 +156,12 @@ func (r *AccommodationV1) putAccommodation(ctx *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "invalid request body")
 	}
 
-	if err := r.uc.UpdateAccommodation(ctx.UserContext(), int32(tripID), int32(accommodationID), *body); err != nil {
+	revision, err := ifMatchRevision(ctx, r.revision(ctx, tripID, accommodationID))
+	if err != nil {
+		return fmt.Errorf("update accommodation with id %d: %w", accommodationID, err)
+	}
+
+	if err := r.uc.UpdateAccommodation(ctx.UserContext(), int32(tripID), int32(accommodationID), *body, revision); err != nil {
 		return fmt.Errorf("update accommodation with id %d: %w", accommodationID, err)
 	}
 
@@ -143,14 +169,17 @@ func (r *AccommodationV1) putAccommodation(ctx *fiber.Ctx) error {
 }
 
 // @Summary     Delete accommodation
+// @Description The accommodation is moved to the trash and can be restored until it is purged.
 // @ID          deleteAccommodation
 // @Tags  	    accommodation
 // @Param       trip_id path int true "Trip ID"
 // @Param       accommodation_id path int true "Accommodation ID"
+// @Param       If-Match header string false "ETag the request is conditional on"
 // @Success     204
 // @Failure     400 {object} response.Error
 // @Failure     403 {object} response.Error
 // @Failure     404 {object} response.Error
+// @Failure     412 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Security    bearerauth
 // @Router      /trips/{trip_id}/accommodation/{accommodation_id} [delete]
@@ -164,9 +193,58 @@ func (r *AccommodationV1) deleteAccommodation(ctx *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "unable to parse accommodation_id")
 	}
 
-	if err := r.uc.DeleteAccommodation(ctx.UserContext(), int32(tripID), int32(accommodationID)); err != nil {
+	revision, err := ifMatchRevision(ctx, r.revision(ctx, tripID, accommodationID))
+	if err != nil {
+		return fmt.Errorf("delete accommodation with id %d: %w", accommodationID, err)
+	}
+
+	if err := r.uc.DeleteAccommodation(ctx.UserContext(), int32(tripID), int32(accommodationID), revision); err != nil {
 		return fmt.Errorf("delete accommodation with id %d: %w", accommodationID, err)
 	}
 
 	return ctx.SendStatus(http.StatusNoContent)
 }
+
+// @Summary     Restore accommodation
+// @Description Restores the version recorded by an entry of the history. Deleted accommodation is created again with a new ID.
+// @ID          restoreAccommodation
+// @Tags  	    accommodation
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Param       accommodation_id path int true "Accommodation ID"
+// @Param       entry_id path int true "History entry ID"
+// @Success     200 {object} entity.Accommodation
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/accommodation/{accommodation_id}/restore/{entry_id} [post]
+func (r *AccommodationV1) restoreAccommodation(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+	accommodationID, err := ctx.ParamsInt("accommodation_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse accommodation_id")
+	}
+	entryID, err := ctx.ParamsInt("entry_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse entry_id")
+	}
+
+	accommodation, err := r.uc.RestoreAccommodation(ctx.UserContext(), int32(tripID), int32(accommodationID), int32(entryID))
+	if err != nil {
+		return fmt.Errorf("restore accommodation [id=%d]: %w", accommodationID, err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(accommodation)
+}
+
+func (r *AccommodationV1) revision(ctx *fiber.Ctx, tripID, accommodationID int) func() (int64, error) {
+	return func() (int64, error) {
+		accommodation, err := r.uc.GetAccommodationByID(ctx.UserContext(), int32(tripID), int32(accommodationID))
+		return accommodation.Revision, err
+	}
+}
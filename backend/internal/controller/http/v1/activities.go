@@ -11,9 +11,10 @@ import (
 )
 
 type ActivitiesV1 struct {
-	uc  usecase.Activities
-	log logger.Interface
-	v   *validator.Validate
+	uc    usecase.Activities
+	users usecase.Users
+	log   logger.Interface
+	v     *validator.Validate
 }
 
 // @Summary     Get all activities
@@ -37,6 +38,15 @@ func (r *ActivitiesV1) getActivities(ctx *fiber.Ctx) error {
 	if err != nil {
 		return fmt.Errorf("get activities: %w", err)
 	}
+	show, err := showSensitive(ctx, r.users, int32(tripID))
+	if err != nil {
+		return err
+	}
+	if !show {
+		for i := range activities {
+			activities[i] = activities[i].Redacted()
+		}
+	}
 
 	return ctx.Status(http.StatusOK).JSON(activities)
 }
@@ -47,7 +57,9 @@ func (r *ActivitiesV1) getActivities(ctx *fiber.Ctx) error {
 // @Produce     json
 // @Param       trip_id path int true "Trip ID"
 // @Param       activity_id path int true "Activity ID"
+// @Param       If-None-Match header string false "ETag of a cached copy"
 // @Success     200 {object} entity.Activity
+// @Success     304
 // @Failure     400 {object} response.Error
 // @Failure     403 {object} response.Error
 // @Failure     404 {object} response.Error
@@ -68,8 +80,15 @@ func (r *ActivitiesV1) getActivity(ctx *fiber.Ctx) error {
 	if err != nil {
 		return fmt.Errorf("get activity [id=%d]: %w", activityID, err)
 	}
+	show, err := showSensitive(ctx, r.users, int32(tripID))
+	if err != nil {
+		return err
+	}
+	if !show {
+		activity = activity.Redacted()
+	}
 
-	return ctx.Status(http.StatusOK).JSON(activity)
+	return sendWithRevision(ctx, activity.Revision, activity)
 }
 
 // @Summary     Add activity
@@ -112,9 +131,11 @@ func (r *ActivitiesV1) postActivity(ctx *fiber.Ctx) error {
 // @Param       trip_id path int true "Trip ID"
 // @Param       activity_id path int true "Activity ID"
 // @Param       request body request.Activity true "activity"
+// @Param       If-Match header string false "ETag the request is conditional on"
 // @Success     204
 // @Failure     400 {object} response.Error
 // @Failure     403 {object} response.Error
+// @Failure     412 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Security    bearerauth
 // @Router      /trips/{trip_id}/activities/{activity_id} [put]
@@ -133,7 +154,12 @@ func (r *ActivitiesV1) putActivity(ctx *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "invalid request body")
 	}
 
-	if err := r.uc.UpdateActivity(ctx.UserContext(), int32(tripID), int32(activityID), *body); err != nil {
+	revision, err := ifMatchRevision(ctx, r.revision(ctx, tripID, activityID))
+	if err != nil {
+		return fmt.Errorf("update activity with id %d: %w", activityID, err)
+	}
+
+	if err := r.uc.UpdateActivity(ctx.UserContext(), int32(tripID), int32(activityID), *body, revision); err != nil {
 		return fmt.Errorf("update activity with id %d: %w", activityID, err)
 	}
 
@@ -141,13 +167,16 @@ func (r *ActivitiesV1) putActivity(ctx *fiber.Ctx) error {
 }
 
 // @Summary     Delete activity
+// @Description The activity is moved to the trash and can be restored until it is purged.
 // @ID          deleteActivity
 // @Tags  	    activities
 // @Param       trip_id path int true "Trip ID"
 // @Param       activity_id path int true "Activity ID"
+// @Param       If-Match header string false "ETag the request is conditional on"
 // @Success     204
 // @Failure     400 {object} response.Error
 // @Failure     403 {object} response.Error
+// @Failure     412 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Security    bearerauth
 // @Router      /trips/{trip_id}/activities/{activity_id} [delete]
@@ -161,9 +190,58 @@ func (r *ActivitiesV1) deleteActivity(ctx *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "unable to parse activity_id")
 	}
 
-	if err := r.uc.DeleteActivity(ctx.UserContext(), int32(tripID), int32(activityID)); err != nil {
+	revision, err := ifMatchRevision(ctx, r.revision(ctx, tripID, activityID))
+	if err != nil {
+		return fmt.Errorf("delete activity with id %d: %w", activityID, err)
+	}
+
+	if err := r.uc.DeleteActivity(ctx.UserContext(), int32(tripID), int32(activityID), revision); err != nil {
 		return fmt.Errorf("delete activity with id %d: %w", activityID, err)
 	}
 
 	return ctx.SendStatus(http.StatusNoContent)
 }
+
+// @Summary     Restore activity
+// @Description Restores the version recorded by an entry of the history. Deleted activities are created again with a new ID.
+// @ID          restoreActivity
+// @Tags  	    activities
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Param       activity_id path int true "Activity ID"
+// @Param       entry_id path int true "History entry ID"
+// @Success     200 {object} entity.Activity
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/activities/{activity_id}/restore/{entry_id} [post]
+func (r *ActivitiesV1) restoreActivity(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+	activityID, err := ctx.ParamsInt("activity_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse activity_id")
+	}
+	entryID, err := ctx.ParamsInt("entry_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse entry_id")
+	}
+
+	activity, err := r.uc.RestoreActivity(ctx.UserContext(), int32(tripID), int32(activityID), int32(entryID))
+	if err != nil {
+		return fmt.Errorf("restore activity [id=%d]: %w", activityID, err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(activity)
+}
+
+func (r *ActivitiesV1) revision(ctx *fiber.Ctx, tripID, activityID int) func() (int64, error) {
+	return func() (int64, error) {
+		activity, err := r.uc.GetActivityByID(ctx.UserContext(), int32(tripID), int32(activityID))
+		return activity.Revision, err
+	}
+}
@@ -1,12 +1,15 @@
 package v1
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"kompass/internal/entity"
 	"kompass/internal/usecase"
 	"kompass/pkg/logger"
 	"mime"
 	"net/http"
-	"path/filepath"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -43,13 +46,21 @@ func (r *AttachmentsV1) getAttachments(ctx *fiber.Ctx) error {
 }
 
 // @Summary     Download attachment by ID
+// @Description Supports single byte ranges. The ETag is the SHA-256 hash of the content.
 // @ID          downloadAttachment
 // @Tags  	    attachments
-// @Produce     json
+// @Produce     octet-stream
 // @Param       trip_id path int true "Trip ID"
 // @Param       attachment_id path int true "Attachment ID"
-// @Success     200 {object} entity.Attachment
+// @Param       Range header string false "Byte range, e.g. bytes=0-1023"
+// @Param       If-Range header string false "ETag the range request is conditional on"
+// @Param       If-None-Match header string false "ETag of a cached copy"
+// @Success     200 {string} string
+// @Success     206 {string} string
+// @Success     304
 // @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     416 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Security    bearerauth
 // @Router      /trips/{trip_id}/attachments/{attachment_id} [get]
@@ -63,22 +74,150 @@ func (r *AttachmentsV1) downloadAttachment(ctx *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "unable to parse attachment_id")
 	}
 
-	attachment, err := r.uc.GetAttachmentByID(ctx.UserContext(), int32(tripID), int32(attachmentID))
+	attachment, content, err := r.uc.OpenAttachment(ctx.UserContext(), int32(tripID), int32(attachmentID))
 	if err != nil {
 		return fmt.Errorf("get attachment [id=%d]: %w", attachmentID, err)
 	}
 
-	ctx.Set("Content-Type", determineContentType(attachment.Name))
-	ctx.Set("Content-Disposition", "inline; filename="+attachment.Name)
-	return ctx.Send(attachment.Blob)
+	etag := `"` + attachment.Sha256 + `"`
+	ctx.Set(fiber.HeaderETag, etag)
+	ctx.Set(fiber.HeaderAcceptRanges, "bytes")
+	if ctx.Fresh() {
+		content.Close()
+		return ctx.SendStatus(http.StatusNotModified)
+	}
+
+	disposition := "attachment"
+	if displayInline(attachment.ContentType) {
+		disposition = "inline"
+	}
+	ctx.Set(fiber.HeaderContentType, attachment.ContentType)
+	ctx.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	ctx.Set(fiber.HeaderContentDisposition, mime.FormatMediaType(disposition, map[string]string{"filename": attachment.Name}))
+
+	start, length := int64(0), attachment.Size
+	if ctx.Get(fiber.HeaderRange) != "" && ifRangeMatches(ctx.Get(fiber.HeaderIfRange), etag) {
+		byteRange, err := ctx.Range(int(attachment.Size))
+		if errors.Is(err, fiber.ErrRangeUnsatisfiable) {
+			content.Close()
+			ctx.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes */%d", attachment.Size))
+			return fiber.NewError(http.StatusRequestedRangeNotSatisfiable, "range not satisfiable")
+		}
+		// malformed and multipart ranges are answered with the full content
+		if err == nil && byteRange.Type == "bytes" && len(byteRange.Ranges) == 1 {
+			start = int64(byteRange.Ranges[0].Start)
+			length = int64(byteRange.Ranges[0].End) - start + 1
+			ctx.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes %d-%d/%d", start, start+length-1, attachment.Size))
+			ctx.Status(http.StatusPartialContent)
+		}
+	}
+
+	if _, err := content.Seek(start, io.SeekStart); err != nil {
+		content.Close()
+		return fmt.Errorf("seek attachment [id=%d]: %w", attachmentID, err)
+	}
+	// the stream is closed once the response has been sent
+	return ctx.SendStream(limitedReadCloser{io.LimitReader(content, length), content}, int(length))
+}
+
+// displayInline allows browsers to display images and PDFs. Everything else is downloaded, so that uploaded HTML or
+// SVG files never run scripts in the origin of the app.
+func displayInline(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/pdf" || (strings.HasPrefix(mediaType, "image/") && mediaType != "image/svg+xml")
 }
 
-func determineContentType(filename string) string {
-	ext := filepath.Ext(filename)
-	if contentType := mime.TypeByExtension(ext); contentType != "" {
-		return contentType
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// @Summary     Get pre-signed download URL of attachment
+// @Description Only available if the storage backend supports pre-signed URLs and they are enabled.
+// @ID          getAttachmentURL
+// @Tags  	    attachments
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Param       attachment_id path int true "Attachment ID"
+// @Success     200 {object} entity.AttachmentURL
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Failure     501 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/attachments/{attachment_id}/url [get]
+func (r *AttachmentsV1) getAttachmentURL(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+	attachmentID, err := ctx.ParamsInt("attachment_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse attachment_id")
+	}
+
+	url, err := r.uc.GetAttachmentURL(ctx.UserContext(), int32(tripID), int32(attachmentID))
+	if err != nil {
+		return fmt.Errorf("get url of attachment [id=%d]: %w", attachmentID, err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(url)
+}
+
+// @Summary     Get thumbnail of attachment
+// @Description Available for JPEG, PNG and WebP images. Thumbnails are JPEG images that fit into a square of 160 (small),
+// @Description 480 (medium) or 1280 (large) pixels.
+// @ID          getAttachmentThumbnail
+// @Tags  	    attachments
+// @Produce     jpeg
+// @Param       trip_id path int true "Trip ID"
+// @Param       attachment_id path int true "Attachment ID"
+// @Param       size query string false "Thumbnail size" Enums(small, medium, large) default(medium)
+// @Param       If-None-Match header string false "ETag of a cached copy"
+// @Success     200 {string} string
+// @Success     304
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     422 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/attachments/{attachment_id}/thumbnail [get]
+func (r *AttachmentsV1) getThumbnail(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+	attachmentID, err := ctx.ParamsInt("attachment_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse attachment_id")
+	}
+	size := entity.ThumbnailSize(ctx.Query("size", string(entity.MEDIUM_THUMBNAIL)))
+
+	attachment, thumbnail, err := r.uc.GetThumbnail(ctx.UserContext(), int32(tripID), int32(attachmentID), size)
+	if err != nil {
+		return fmt.Errorf("get thumbnail of attachment [id=%d]: %w", attachmentID, err)
+	}
+
+	// thumbnails that are generated again are equivalent, but not necessarily byte-identical
+	ctx.Set(fiber.HeaderETag, fmt.Sprintf(`W/"%s-%s"`, attachment.Sha256, size))
+	ctx.Set(fiber.HeaderCacheControl, "private, max-age=31536000, immutable")
+	if ctx.Fresh() {
+		return ctx.SendStatus(http.StatusNotModified)
 	}
-	return "application/octet-stream"
+
+	ctx.Set(fiber.HeaderContentType, "image/jpeg")
+	ctx.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	return ctx.Status(http.StatusOK).Send(thumbnail)
+}
+
+// ifRangeMatches reports whether a range request applies. Dates are not supported as validators, so these requests
+// are answered with the full content.
+func ifRangeMatches(ifRange string, etag string) bool {
+	return ifRange == "" || ifRange == etag
 }
 
 type AttachmentsParam struct {
@@ -92,7 +231,10 @@ type AttachmentsParam struct {
 // @Param       trip_id path int true "Trip ID"
 // @Param       attachments formData AttachmentsParam true "attachment"
 // @Success     204
+// @Failure     400 {object} response.Error
 // @Failure     403 {object} response.Error
+// @Failure     411 {object} response.Error
+// @Failure     413 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Security    bearerauth
 // @Router      /trips/{trip_id}/attachments [post]
@@ -102,17 +244,16 @@ func (r *AttachmentsV1) postAttachment(ctx *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
 	}
 
-	form, err := ctx.MultipartForm()
+	form, err := streamedMultipartForm(ctx)
 	if err != nil {
 		return err
 	}
+	defer form.RemoveAll()
 
 	files := form.File["attachments"]
 
 	for _, file := range files {
-		fmt.Println(file.Filename, file.Size, file.Header["Content-Type"][0])
-
-		_, err = r.uc.CreateAttachment(ctx.UserContext(), int32(tripID), file)
+		_, err = r.uc.CreateAttachment(ctx.UserContext(), int32(tripID), userIdFromCtx(ctx), file)
 		if err != nil {
 			return fmt.Errorf("create attachment: %w", err)
 		}
@@ -122,12 +263,15 @@ func (r *AttachmentsV1) postAttachment(ctx *fiber.Ctx) error {
 }
 
 // @Summary     Delete attachment
+// @Description The attachment is moved to the trash and can be restored until it is purged.
 // @ID          deleteAttachment
 // @Tags  	    attachments
 // @Param       trip_id path int true "Trip ID"
 // @Param       attachment_id path int true "Attachment ID"
+// @Param       If-Match header string false "Revision of the attachment as ETag the request is conditional on"
 // @Success     204
 // @Failure     403 {object} response.Error
+// @Failure     412 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Security    bearerauth
 // @Router      /trips/{trip_id}/attachments/{attachment_id} [delete]
@@ -141,9 +285,89 @@ func (r *AttachmentsV1) deleteAttachment(ctx *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "unable to parse attachment_id")
 	}
 
-	if err := r.uc.DeleteAttachment(ctx.UserContext(), int32(tripID), int32(attachmentID)); err != nil {
+	// the ETag of downloads is the hash of the content, deletions are conditional on the revision instead
+	revision, err := ifMatchRevision(ctx, func() (int64, error) {
+		attachment, err := r.uc.GetAttachmentByID(ctx.UserContext(), int32(tripID), int32(attachmentID))
+		return attachment.Revision, err
+	})
+	if err != nil {
+		return fmt.Errorf("delete attachment with id %d: %w", attachmentID, err)
+	}
+
+	if err := r.uc.DeleteAttachment(ctx.UserContext(), int32(tripID), int32(attachmentID), revision); err != nil {
 		return fmt.Errorf("delete attachment with id %d: %w", attachmentID, err)
 	}
 
 	return ctx.SendStatus(http.StatusNoContent)
 }
+
+// @Summary     Link attachment to a booking
+// @ID          linkAttachment
+// @Tags  	    attachments
+// @Param       trip_id path int true "Trip ID"
+// @Param       attachment_id path int true "Attachment ID"
+// @Param       target path string true "Booking type" Enums(transportation, activities, accommodation)
+// @Param       target_id path int true "Booking ID"
+// @Success     204
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/attachments/{attachment_id}/links/{target}/{target_id} [put]
+func (r *AttachmentsV1) linkAttachment(ctx *fiber.Ctx) error {
+	tripID, attachmentID, target, targetID, err := parseAttachmentLink(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := r.uc.LinkAttachment(ctx.UserContext(), tripID, attachmentID, target, targetID); err != nil {
+		return fmt.Errorf("link attachment [id=%d]: %w", attachmentID, err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+// @Summary     Unlink attachment from a booking
+// @ID          unlinkAttachment
+// @Tags  	    attachments
+// @Param       trip_id path int true "Trip ID"
+// @Param       attachment_id path int true "Attachment ID"
+// @Param       target path string true "Booking type" Enums(transportation, activities, accommodation)
+// @Param       target_id path int true "Booking ID"
+// @Success     204
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/attachments/{attachment_id}/links/{target}/{target_id} [delete]
+func (r *AttachmentsV1) unlinkAttachment(ctx *fiber.Ctx) error {
+	tripID, attachmentID, target, targetID, err := parseAttachmentLink(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := r.uc.UnlinkAttachment(ctx.UserContext(), tripID, attachmentID, target, targetID); err != nil {
+		return fmt.Errorf("unlink attachment [id=%d]: %w", attachmentID, err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+func parseAttachmentLink(ctx *fiber.Ctx) (int32, int32, entity.AttachmentLinkTarget, int32, error) {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return 0, 0, "", 0, fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+	attachmentID, err := ctx.ParamsInt("attachment_id")
+	if err != nil {
+		return 0, 0, "", 0, fiber.NewError(http.StatusBadRequest, "unable to parse attachment_id")
+	}
+	targetID, err := ctx.ParamsInt("target_id")
+	if err != nil {
+		return 0, 0, "", 0, fiber.NewError(http.StatusBadRequest, "unable to parse target_id")
+	}
+
+	return int32(tripID), int32(attachmentID), entity.AttachmentLinkTarget(ctx.Params("target")), int32(targetID), nil
+}
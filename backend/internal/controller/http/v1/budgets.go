@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+)
+
+type BudgetsV1 struct {
+	uc  usecase.Budgets
+	log logger.Interface
+	v   *validator.Validate
+}
+
+// @Summary     Get budget
+// @ID          getBudget
+// @Tags  	    budget
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Success     200 {object} entity.Budget
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/budget [get]
+func (r *BudgetsV1) getBudget(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	budget, err := r.uc.GetBudget(ctx.UserContext(), int32(tripID))
+	if err != nil {
+		return fmt.Errorf("get budget: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(budget)
+}
+
+// @Summary     Update budget
+// @ID          putBudget
+// @Tags  	    budget
+// @Accept      json
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Param       request body request.Budget true "budget"
+// @Success     204
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/budget [put]
+func (r *BudgetsV1) putBudget(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	body, err := ParseAndValidateRequestBody[request.Budget](ctx, r.v)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid request body")
+	}
+
+	if err := r.uc.SaveBudget(ctx.UserContext(), int32(tripID), *body); err != nil {
+		return fmt.Errorf("update budget: %w", err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+// @Summary     Compare costs to budget
+// @ID          getBudgetReport
+// @Tags  	    budget
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Success     200 {object} entity.BudgetReport
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/budget/report [get]
+func (r *BudgetsV1) getBudgetReport(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	report, err := r.uc.GetBudgetReport(ctx.UserContext(), int32(tripID))
+	if err != nil {
+		return fmt.Errorf("get budget report: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(report)
+}
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+	"strconv"
+)
+
+type ChangesV1 struct {
+	uc    usecase.Changes
+	users usecase.Users
+	log   logger.Interface
+	v     *validator.Validate
+}
+
+// @Summary     Get everything of a trip that changed since a cursor
+// @Description Omitting the cursor returns the whole trip. The returned cursor is passed to the next request.
+// @ID          getChanges
+// @Tags  	    changes
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Param       since query int false "Cursor of the previous request"
+// @Success     200 {object} entity.Changes
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/changes [get]
+func (r *ChangesV1) getChanges(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+	since, err := strconv.ParseInt(ctx.Query("since", "0"), 10, 64)
+	if err != nil || since < 0 {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse since")
+	}
+
+	changes, err := r.uc.GetChanges(ctx.UserContext(), int32(tripID), since)
+	if err != nil {
+		return fmt.Errorf("get changes: %w", err)
+	}
+	show, err := showSensitive(ctx, r.users, int32(tripID))
+	if err != nil {
+		return err
+	}
+	if !show {
+		changes = changes.Redacted()
+	}
+
+	return ctx.Status(http.StatusOK).JSON(changes)
+}
+
+// @Summary     Apply changes made offline
+// @Description Changes are applied in order. Updates and deletions based on an outdated revision are not applied and
+// @Description reported as conflicts. Creations with a clientId are applied once, pushing them again reports the entity
+// @Description created the first time.
+// @ID          postChanges
+// @Tags  	    changes
+// @Accept      json
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Param       request body request.ChangeBatch true "changes"
+// @Success     200 {object} []entity.ChangeResult
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/changes [post]
+func (r *ChangesV1) postChanges(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	body, err := ParseAndValidateRequestBody[request.ChangeBatch](ctx, r.v)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid request body")
+	}
+
+	results, err := r.uc.ApplyChanges(ctx.UserContext(), int32(tripID), body.Changes)
+	if err != nil {
+		return fmt.Errorf("apply changes: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(results)
+}
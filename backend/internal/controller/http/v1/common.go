@@ -1,12 +1,21 @@
 package v1
 
 import (
+	"errors"
 	"fmt"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 	"kompass/internal/entity"
+	"kompass/internal/usecase"
+	"mime/multipart"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 )
 
+// ParseAndValidateRequestBody parses and validates the body with the validate tags of V. Optional fields of request
+// bodies are marked with binding:"optional" for swag, the validator has no optional tag and panics on unknown tags.
 func ParseAndValidateRequestBody[V interface{}](ctx *fiber.Ctx, v *validator.Validate) (*V, error) {
 	var body V
 
@@ -21,7 +30,104 @@ func ParseAndValidateRequestBody[V interface{}](ctx *fiber.Ctx, v *validator.Val
 	return &body, nil
 }
 
+// multipartMemory is how much of the files of a streamed form is kept in memory, the rest is written to temporary files.
+const multipartMemory = 1 << 20
+
+// streamedMultipartForm parses the form of a route configured with a streamed body part by part while it is read, so
+// that large uploads never end up in memory. The caller has to remove the temporary files of the form.
+func streamedMultipartForm(ctx *fiber.Ctx) (*multipart.Form, error) {
+	request := ctx.Request()
+	boundary := string(request.Header.MultipartFormBoundary())
+	if boundary == "" {
+		return nil, fiber.NewError(http.StatusBadRequest, "expected a multipart form")
+	}
+	if len(request.Header.ContentEncoding()) > 0 {
+		return nil, fiber.NewError(http.StatusUnsupportedMediaType, "compressed forms are not supported")
+	}
+
+	form, err := multipart.NewReader(request.BodyStream(), boundary).ReadForm(multipartMemory)
+	if errors.Is(err, multipart.ErrMessageTooLarge) {
+		return nil, fiber.ErrRequestEntityTooLarge
+	} else if err != nil {
+		return nil, fiber.NewError(http.StatusBadRequest, "unable to read multipart form")
+	}
+	return form, nil
+}
+
 func userIdFromCtx(ctx *fiber.Ctx) int32 {
 	user := ctx.Locals("user").(entity.User)
 	return user.ID
 }
+
+// showSensitive reports whether the user may read the descriptions marked sensitive in the trip.
+func showSensitive(ctx *fiber.Ctx, users usecase.Users, tripID int32) (bool, error) {
+	show, err := users.HasReadSensitivePermission(ctx.UserContext(), userIdFromCtx(ctx), tripID)
+	if err != nil {
+		return false, fmt.Errorf("has read sensitive permission: %w", err)
+	}
+	return show, nil
+}
+
+// revisionTag is the ETag of an entity, which changes with every revision of the entity.
+func revisionTag(revision int64) string {
+	return fmt.Sprintf(`"%d"`, revision)
+}
+
+// sendWithRevision responds with the entity and its revision as ETag, or with 304 if the client already has the revision.
+func sendWithRevision(ctx *fiber.Ctx, revision int64, entity interface{}) error {
+	ctx.Set(fiber.HeaderETag, revisionTag(revision))
+	if ctx.Fresh() {
+		return ctx.SendStatus(http.StatusNotModified)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(entity)
+}
+
+// ifMatchRevision returns the revision a request with an If-Match header is conditional on. It is checked by the write
+// itself, as the entity may change until it is written. Requests without the header or with "*" are unconditional. The
+// current revision is only retrieved to pick one of several ETags.
+func ifMatchRevision(ctx *fiber.Ctx, currentRevision func() (int64, error)) (*int64, error) {
+	ifMatch := ctx.Get(fiber.HeaderIfMatch)
+	if ifMatch == "" {
+		return nil, nil
+	}
+
+	revisions := []int64{}
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return nil, nil
+		}
+		// weak ETags never match, as If-Match uses the strong comparison
+		unquoted, ok := strings.CutPrefix(candidate, `"`)
+		if !ok {
+			continue
+		}
+		unquoted, ok = strings.CutSuffix(unquoted, `"`)
+		if !ok {
+			continue
+		}
+		if revision, err := strconv.ParseInt(unquoted, 10, 64); err == nil {
+			revisions = append(revisions, revision)
+		}
+	}
+
+	switch len(revisions) {
+	case 0:
+		return nil, modifiedInTheMeantime()
+	case 1:
+		return &revisions[0], nil
+	}
+	revision, err := currentRevision()
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(revisions, revision) {
+		return nil, modifiedInTheMeantime()
+	}
+	return &revision, nil
+}
+
+func modifiedInTheMeantime() error {
+	return fiber.NewError(http.StatusPreconditionFailed, "entity was modified in the meantime")
+}
@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"fmt"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CostsV1 struct {
+	uc  usecase.Costs
+	log logger.Interface
+}
+
+// @Summary     Get costs converted to the home currency
+// @ID          getCosts
+// @Tags  	    costs
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Success     200 {object} entity.Costs
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/costs [get]
+func (r *CostsV1) getCosts(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	costs, err := r.uc.GetCosts(ctx.UserContext(), int32(tripID))
+	if err != nil {
+		return fmt.Errorf("get costs: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(costs)
+}
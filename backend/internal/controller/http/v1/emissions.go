@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"fmt"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type EmissionsV1 struct {
+	uc  usecase.Emissions
+	log logger.Interface
+}
+
+// @Summary     Get estimated emissions of trip
+// @Description Sums up the estimated kg CO2e per passenger of all transportation, in total and by transportation type.
+// @Description Transportation without emission factor is counted as unestimated.
+// @ID          getEmissions
+// @Tags  	    transportation
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Success     200 {object} entity.TripEmissions
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/emissions [get]
+func (r *EmissionsV1) getEmissions(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	emissions, err := r.uc.GetTripEmissions(ctx.UserContext(), int32(tripID))
+	if err != nil {
+		return fmt.Errorf("get emissions: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(emissions)
+}
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// heartbeatInterval keeps idle streams open through proxies, detects clients that disconnected and closes the streams
+// of users who lost access to the trip.
+const heartbeatInterval = 15 * time.Second
+
+type EventsV1 struct {
+	uc    usecase.Events
+	users usecase.Users
+	log   logger.Interface
+}
+
+// @Summary     Stream trip changes
+// @Description Server-Sent Events stream of the changes to the trip made by all users. Each event is named
+// @Description "<kind>.<type>", e.g. "activity.created", and its data is an entity.TripEvent as JSON.
+// @ID          getTripEvents
+// @Tags  	    trips
+// @Produce     text/event-stream
+// @Param       trip_id path int true "Trip ID"
+// @Success     200 {string} string
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/events [get]
+func (r *EventsV1) getEvents(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	userID := userIdFromCtx(ctx)
+	events, unsubscribe := r.uc.Subscribe(int32(tripID))
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer unsubscribe()
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+
+		// sent right away, so clients know the subscription is active
+		fmt.Fprint(w, ": connected\n\n")
+		for {
+			if err := w.Flush(); err != nil {
+				return
+			}
+
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				data, err := json.Marshal(event)
+				if err != nil {
+					r.log.Error(err, "http - v1 - getEvents")
+					return
+				}
+				fmt.Fprintf(w, "event: %s.%s\ndata: %s\n\n", event.Kind, event.Type, data)
+			case <-heartbeat.C:
+				// the request context is gone once the handler returned
+				canRead, err := r.users.HasReadPermission(context.Background(), userID, int32(tripID))
+				if err != nil {
+					r.log.Error(err, "http - v1 - getEvents")
+					return
+				}
+				if !canRead {
+					return
+				}
+				fmt.Fprint(w, ": heartbeat\n\n")
+			}
+		}
+	})
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+)
+
+type ExpensesV1 struct {
+	uc  usecase.Expenses
+	log logger.Interface
+	v   *validator.Validate
+}
+
+// @Summary     Get all expenses
+// @ID          getExpenses
+// @Tags  	    expenses
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Success     200 {object} []entity.Expense
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/expenses [get]
+func (r *ExpensesV1) getExpenses(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	expenses, err := r.uc.GetExpenses(ctx.UserContext(), int32(tripID))
+	if err != nil {
+		return fmt.Errorf("get expenses: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(expenses)
+}
+
+// @Summary     Get expense by ID
+// @ID          getExpense
+// @Tags  	    expenses
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Param       expense_id path int true "Expense ID"
+// @Param       If-None-Match header string false "ETag of a cached copy"
+// @Success     200 {object} entity.Expense
+// @Success     304
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/expenses/{expense_id} [get]
+func (r *ExpensesV1) getExpense(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+	expenseID, err := ctx.ParamsInt("expense_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse expense_id")
+	}
+
+	expense, err := r.uc.GetExpenseByID(ctx.UserContext(), int32(tripID), int32(expenseID))
+	if err != nil {
+		return fmt.Errorf("get expense [id=%d]: %w", expenseID, err)
+	}
+
+	return sendWithRevision(ctx, expense.Revision, expense)
+}
+
+// @Summary     Add expense
+// @ID          postExpense
+// @Tags  	    expenses
+// @Accept      json
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Param       request body request.Expense true "expense"
+// @Success     200 {object} entity.Expense
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/expenses [post]
+func (r *ExpensesV1) postExpense(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	body, err := ParseAndValidateRequestBody[request.Expense](ctx, r.v)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid request body")
+	}
+
+	expense, err := r.uc.CreateExpense(ctx.UserContext(), int32(tripID), *body)
+	if err != nil {
+		return fmt.Errorf("create expense: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(expense)
+}
+
+// @Summary     Update expense
+// @ID          putExpense
+// @Tags  	    expenses
+// @Accept      json
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Param       expense_id path int true "Expense ID"
+// @Param       request body request.Expense true "expense"
+// @Param       If-Match header string false "ETag the request is conditional on"
+// @Success     204
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     412 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/expenses/{expense_id} [put]
+func (r *ExpensesV1) putExpense(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+	expenseID, err := ctx.ParamsInt("expense_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse expense_id")
+	}
+
+	body, err := ParseAndValidateRequestBody[request.Expense](ctx, r.v)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid request body")
+	}
+
+	revision, err := ifMatchRevision(ctx, r.revision(ctx, tripID, expenseID))
+	if err != nil {
+		return fmt.Errorf("update expense with id %d: %w", expenseID, err)
+	}
+
+	if err := r.uc.UpdateExpense(ctx.UserContext(), int32(tripID), int32(expenseID), *body, revision); err != nil {
+		return fmt.Errorf("update expense with id %d: %w", expenseID, err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+// @Summary     Delete expense
+// @Description The expense is moved to the trash and can be restored until it is purged.
+// @ID          deleteExpense
+// @Tags  	    expenses
+// @Param       trip_id path int true "Trip ID"
+// @Param       expense_id path int true "Expense ID"
+// @Param       If-Match header string false "ETag the request is conditional on"
+// @Success     204
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     412 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/expenses/{expense_id} [delete]
+func (r *ExpensesV1) deleteExpense(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+	expenseID, err := ctx.ParamsInt("expense_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse expense_id")
+	}
+
+	revision, err := ifMatchRevision(ctx, r.revision(ctx, tripID, expenseID))
+	if err != nil {
+		return fmt.Errorf("delete expense with id %d: %w", expenseID, err)
+	}
+
+	if err := r.uc.DeleteExpense(ctx.UserContext(), int32(tripID), int32(expenseID), revision); err != nil {
+		return fmt.Errorf("delete expense with id %d: %w", expenseID, err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+// @Summary     Get transfers that settle all expenses
+// @ID          getSettlement
+// @Tags  	    expenses
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Success     200 {object} entity.Settlement
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     409 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/settlement [get]
+func (r *ExpensesV1) getSettlement(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	settlement, err := r.uc.GetSettlement(ctx.UserContext(), int32(tripID))
+	if err != nil {
+		return fmt.Errorf("get settlement: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(settlement)
+}
+
+func (r *ExpensesV1) revision(ctx *fiber.Ctx, tripID, expenseID int) func() (int64, error) {
+	return func() (int64, error) {
+		expense, err := r.uc.GetExpenseByID(ctx.UserContext(), int32(tripID), int32(expenseID))
+		return expense.Revision, err
+	}
+}
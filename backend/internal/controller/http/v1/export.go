@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"fmt"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ExportV1 struct {
+	uc  usecase.Export
+	log logger.Interface
+}
+
+// @Summary     Export trip as GPX
+// @ID          getGpx
+// @Tags  	    export
+// @Produce     application/gpx+xml
+// @Param       trip_id path int true "Trip ID"
+// @Success     200 {string} string
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/export/gpx [get]
+func (r *ExportV1) getGpx(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	gpx, err := r.uc.ExportGpx(ctx.UserContext(), int32(tripID))
+	if err != nil {
+		return fmt.Errorf("export gpx: %w", err)
+	}
+
+	ctx.Set("Content-Type", "application/gpx+xml")
+	ctx.Set("Content-Disposition", fmt.Sprintf("attachment; filename=trip-%d.gpx", tripID))
+	return ctx.Send(gpx)
+}
+
+// @Summary     Export trip as KML
+// @ID          getKml
+// @Tags  	    export
+// @Produce     application/vnd.google-earth.kml+xml
+// @Param       trip_id path int true "Trip ID"
+// @Success     200 {string} string
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/export/kml [get]
+func (r *ExportV1) getKml(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	kml, err := r.uc.ExportKml(ctx.UserContext(), int32(tripID))
+	if err != nil {
+		return fmt.Errorf("export kml: %w", err)
+	}
+
+	ctx.Set("Content-Type", "application/vnd.google-earth.kml+xml")
+	ctx.Set("Content-Disposition", fmt.Sprintf("attachment; filename=trip-%d.kml", tripID))
+	return ctx.Send(kml)
+}
+
+// @Summary     Export printable itinerary as PDF
+// @ID          getItineraryPdf
+// @Tags  	    export
+// @Produce     application/pdf
+// @Param       trip_id path int true "Trip ID"
+// @Success     200 {string} string
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/itinerary.pdf [get]
+func (r *ExportV1) getItineraryPdf(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	pdf, err := r.uc.ExportPdf(ctx.UserContext(), userIdFromCtx(ctx), int32(tripID))
+	if err != nil {
+		return fmt.Errorf("export pdf: %w", err)
+	}
+
+	ctx.Set("Content-Type", "application/pdf")
+	ctx.Set("Content-Disposition", fmt.Sprintf("inline; filename=trip-%d.pdf", tripID))
+	return ctx.Send(pdf)
+}
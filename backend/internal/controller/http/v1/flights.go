@@ -26,6 +26,7 @@ type FlightsV1 struct {
 // @Param       trip_id path int true "Trip ID"
 // @Param       request body request.Flight true "flight"
 // @Success     200 {object} entity.Transportation
+// @Failure     400 {object} response.Error
 // @Failure     403 {object} response.Error
 // @Failure     422 {object} entity.ErrAmbiguousFlightRequest
 // @Failure     500 {object} response.Error
@@ -59,9 +60,11 @@ func (r *FlightsV1) postFlight(ctx *fiber.Ctx) error {
 // @Tags  	    flights
 // @Param       trip_id path int true "Trip ID"
 // @Param       flight_id path int true "Flight ID"
+// @Param       If-Match header string false "ETag the request is conditional on"
 // @Success     204
 // @Failure     403 {object} response.Error
 // @Failure     404 {object} response.Error
+// @Failure     412 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Security    bearerauth
 // @Router      /trips/{trip_id}/flights/{flight_id} [put]
@@ -75,7 +78,15 @@ func (r *FlightsV1) putFlight(ctx *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "unable to parse flight_id")
 	}
 
-	if err := r.uc.UpdateFlight(ctx.UserContext(), int32(tripID), int32(flightID)); err != nil {
+	revision, err := ifMatchRevision(ctx, func() (int64, error) {
+		flight, err := r.uc.GetFlightByID(ctx.UserContext(), int32(tripID), int32(flightID))
+		return flight.Revision, err
+	})
+	if err != nil {
+		return fmt.Errorf("update flight with id %d: %w", flightID, err)
+	}
+
+	if err := r.uc.UpdateFlight(ctx.UserContext(), int32(tripID), int32(flightID), revision); err != nil {
 		return fmt.Errorf("update flight with id %d: %w", flightID, err)
 	}
 
@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+	"strconv"
+)
+
+type HistoryV1 struct {
+	uc  usecase.History
+	log logger.Interface
+}
+
+// @Summary     Get history of changes
+// @Description Entries are returned newest first. Older entries are paged by passing the ID of the oldest entry received so far as before.
+// @ID          getHistory
+// @Tags  	    history
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Param       kind query string false "Kind of entity"
+// @Param       entityId query int false "ID of entity, in combination with kind"
+// @Param       userId query int false "ID of the user who made the changes"
+// @Param       before query int false "Only entries older than this entry"
+// @Param       limit query int false "Maximum number of entries" default(50)
+// @Success     200 {object} []entity.HistoryEntry
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/history [get]
+func (r *HistoryV1) getHistory(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	filter := request.HistoryFilter{}
+	if kind := ctx.Query("kind"); kind != "" {
+		filter.Kind = (*entity.EntityKind)(&kind)
+	}
+	if filter.EntityID, err = queryInt32(ctx, "entityId"); err != nil {
+		return err
+	}
+	if filter.UserID, err = queryInt32(ctx, "userId"); err != nil {
+		return err
+	}
+	if filter.Before, err = queryInt32(ctx, "before"); err != nil {
+		return err
+	}
+	limit, err := queryInt32(ctx, "limit")
+	if err != nil {
+		return err
+	}
+	if limit != nil {
+		filter.Limit = *limit
+	}
+
+	entries, err := r.uc.GetHistory(ctx.UserContext(), int32(tripID), filter)
+	if err != nil {
+		return fmt.Errorf("get history: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(entries)
+}
+
+func queryInt32(ctx *fiber.Ctx, name string) (*int32, error) {
+	value := ctx.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return nil, fiber.NewError(http.StatusBadRequest, "unable to parse "+name)
+	}
+	result := int32(parsed)
+	return &result, nil
+}
@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"fmt"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ItineraryV1 struct {
+	uc    usecase.Itinerary
+	users usecase.Users
+	log   logger.Interface
+}
+
+// @Summary     Get day-by-day itinerary
+// @ID          getItinerary
+// @Tags  	    itinerary
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Success     200 {object} []entity.ItineraryDay
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/itinerary [get]
+func (r *ItineraryV1) getItinerary(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	itinerary, err := r.uc.GetItinerary(ctx.UserContext(), int32(tripID))
+	if err != nil {
+		return fmt.Errorf("get itinerary: %w", err)
+	}
+	show, err := showSensitive(ctx, r.users, int32(tripID))
+	if err != nil {
+		return err
+	}
+	if !show {
+		for i := range itinerary {
+			itinerary[i] = itinerary[i].Redacted()
+		}
+	}
+
+	return ctx.Status(http.StatusOK).JSON(itinerary)
+}
+
+// @Summary     Check the itinerary for problems
+// @Description Reports overlapping transportation, activities during transit, nights without accommodation,
+// @Description accommodation far from the day's last arrival and flight connections or train transfers that are too short.
+// @ID          getChecks
+// @Tags  	    itinerary
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Success     200 {object} []entity.ItineraryCheck
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/checks [get]
+func (r *ItineraryV1) getChecks(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	checks, err := r.uc.GetChecks(ctx.UserContext(), int32(tripID))
+	if err != nil {
+		return fmt.Errorf("get checks: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(checks)
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"fmt"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PhotosV1 struct {
+	uc  usecase.Photos
+	log logger.Interface
+}
+
+type PhotosParam struct {
+	Photos []string `format:"binary"`
+}
+
+// @Summary     Import photos
+// @Description Stores the photos as attachments and groups them by the time and place in their Exif data. Groups are
+// @Description linked to a nearby activity on the same day, otherwise an activity is proposed but not created.
+// @ID          importPhotos
+// @Tags  	    attachments
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Param       photos formData PhotosParam true "JPEG or HEIC photos"
+// @Success     200 {object} entity.PhotoImport
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     411 {object} response.Error
+// @Failure     413 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/photos [post]
+func (r *PhotosV1) postPhotos(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	form, err := streamedMultipartForm(ctx)
+	if err != nil {
+		return err
+	}
+	defer form.RemoveAll()
+
+	result, err := r.uc.ImportPhotos(ctx.UserContext(), int32(tripID), userIdFromCtx(ctx), form.File["photos"])
+	if err != nil {
+		return fmt.Errorf("import photos: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(result)
+}
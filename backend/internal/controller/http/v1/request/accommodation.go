@@ -13,7 +13,9 @@ type Accommodation struct {
 	CheckInTime   *civil.Time      `json:"checkInTime" extensions:"nullable"`
 	CheckOutTime  *civil.Time      `json:"checkOutTime" extensions:"nullable"`
 	Description   *string          `json:"description" extensions:"nullable"`
+	Sensitive     bool             `json:"sensitive,omitempty" binding:"optional"`
 	Address       *string          `json:"address" extensions:"nullable"`
 	Location      *entity.Location `json:"location" extensions:"nullable"`
 	Price         *int32           `json:"price" extensions:"nullable"`
+	Currency      *string          `json:"currency,omitempty" binding:"optional" example:"EUR"`
 }
@@ -11,7 +11,9 @@ type Activity struct {
 	Date        civil.Date       `json:"date"`
 	Time        *civil.Time      `json:"time" extensions:"nullable"`
 	Description *string          `json:"description" extensions:"nullable"`
+	Sensitive   bool             `json:"sensitive,omitempty" binding:"optional"`
 	Address     *string          `json:"address" extensions:"nullable"`
 	Location    *entity.Location `json:"location" extensions:"nullable"`
 	Price       *int32           `json:"price" extensions:"nullable"`
+	Currency    *string          `json:"currency,omitempty" binding:"optional" example:"EUR"`
 }
@@ -0,0 +1,10 @@
+package request
+
+import (
+	"kompass/internal/entity"
+)
+
+type Budget struct {
+	Total      *float64                `json:"total" extensions:"nullable"`
+	Categories []entity.CategoryBudget `json:"categories"`
+}
@@ -0,0 +1,27 @@
+package request
+
+import (
+	"kompass/internal/entity"
+
+	"cloud.google.com/go/civil"
+)
+
+type Expense struct {
+	Name             string               `json:"name"`
+	Date             civil.Date           `json:"date"`
+	Amount           float64              `json:"amount"`
+	Currency         *string              `json:"currency,omitempty" binding:"optional" example:"EUR"`
+	PayerID          int32                `json:"payerId"`
+	SplitMode        entity.SplitMode     `json:"splitMode"`
+	Category         *entity.CostCategory `json:"category,omitempty" binding:"optional"`
+	Participants     []ExpenseParticipant `json:"participants"`
+	TransportationID *int32               `json:"transportationId" extensions:"nullable"`
+	ActivityID       *int32               `json:"activityId" extensions:"nullable"`
+	AccommodationID  *int32               `json:"accommodationId" extensions:"nullable"`
+	AttachmentID     *int32               `json:"attachmentId" extensions:"nullable"`
+}
+
+type ExpenseParticipant struct {
+	UserID int32    `json:"userId"`
+	Share  *float64 `json:"share,omitempty" binding:"optional"`
+}
@@ -9,10 +9,13 @@ type FlightLeg struct {
 	Date          civil.Date `json:"date"          example:"2026-01-30"`
 	FlightNumber  string     `json:"flightNumber"  example:"EK412"`
 	OriginAirport *string    `json:"originAirport" extensions:"nullable" example:"SYD"`
+	// CabinClass is only used to estimate emissions, economy is assumed if it is not set.
+	CabinClass *entity.CabinClass `json:"cabinClass,omitempty" binding:"optional" extensions:"nullable"`
 }
 
 type Flight struct {
-	Legs  []FlightLeg  `json:"legs"`
-	PNRs  []entity.PNR `json:"pnrs"`
-	Price *int32       `json:"price" extensions:"nullable"`
+	Legs     []FlightLeg  `json:"legs"`
+	PNRs     []entity.PNR `json:"pnrs"`
+	Price    *int32       `json:"price" extensions:"nullable"`
+	Currency *string      `json:"currency,omitempty" binding:"optional" example:"EUR"`
 }
@@ -0,0 +1,15 @@
+package request
+
+import (
+	"kompass/internal/entity"
+)
+
+// HistoryFilter selects entries of the history, newest first. Older entries are paged by passing the ID of the
+// oldest entry received so far as Before.
+type HistoryFilter struct {
+	Kind     *entity.EntityKind
+	EntityID *int32
+	UserID   *int32
+	Before   *int32
+	Limit    int32
+}
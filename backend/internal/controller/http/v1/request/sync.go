@@ -0,0 +1,25 @@
+package request
+
+import (
+	"kompass/internal/entity"
+)
+
+type ChangeBatch struct {
+	Changes []Change `json:"changes"`
+}
+
+// Change is an edit made offline. Updates and deletions name the revision of the entity they are based on, the
+// payload matching the kind is required for creations and updates. Creations with a ClientID are applied only once,
+// pushing them again returns the entity created the first time.
+type Change struct {
+	Type           entity.ChangeType `json:"type"`
+	Kind           entity.EntityKind `json:"kind"`
+	ClientID       *string           `json:"clientId,omitempty" validate:"omitempty" binding:"optional" maxLength:"100"`
+	ID             *int32            `json:"id,omitempty" validate:"omitempty" binding:"optional"`
+	BaseRevision   *int64            `json:"baseRevision,omitempty" validate:"omitempty" binding:"optional"`
+	Trip           *Trip             `json:"trip,omitempty" validate:"omitempty" binding:"optional"`
+	Transportation *Transportation   `json:"transportation,omitempty" validate:"omitempty" binding:"optional"`
+	Activity       *Activity         `json:"activity,omitempty" validate:"omitempty" binding:"optional"`
+	Accommodation  *Accommodation    `json:"accommodation,omitempty" validate:"omitempty" binding:"optional"`
+	Expense        *Expense          `json:"expense,omitempty" validate:"omitempty" binding:"optional"`
+}
@@ -0,0 +1,7 @@
+package request
+
+import "kompass/internal/entity"
+
+type Track struct {
+	Display entity.TrackDisplay `json:"display"`
+}
@@ -9,4 +9,5 @@ type TrainJourney struct {
 	DepartureDate civil.Date `json:"departureDate" example:"2025-09-20"`
 	ViaStationID  *string    `json:"viaStationId"  example:"8596008" extensions:"nullable"`
 	Price         *int32     `json:"price" extensions:"nullable"`
+	Currency      *string    `json:"currency,omitempty" binding:"optional" example:"EUR"`
 }
@@ -15,4 +15,5 @@ type Transportation struct {
 	OriginAddress      *string                   `json:"originAddress" extensions:"nullable"`
 	DestinationAddress *string                   `json:"destinationAddress" extensions:"nullable"`
 	Price              *int32                    `json:"price" extensions:"nullable"`
+	Currency           *string                   `json:"currency,omitempty" binding:"optional" example:"EUR"`
 }
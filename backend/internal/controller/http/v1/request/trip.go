@@ -2,12 +2,28 @@ package request
 
 import (
 	"cloud.google.com/go/civil"
+	"kompass/internal/entity"
 )
 
+// Trip is used to create and update trips. DateChange only applies to updates changing the dates, VALIDATE is assumed
+// if none is given.
 type Trip struct {
-	Name        string     `json:"name"`
-	StartDate   civil.Date `json:"startDate"`
-	EndDate     civil.Date `json:"endDate"`
-	Description *string    `json:"description" extensions:"nullable"`
-	ImageUrl    *string    `json:"imageUrl" extensions:"nullable"`
+	Name         string             `json:"name"`
+	StartDate    civil.Date         `json:"startDate"`
+	EndDate      civil.Date         `json:"endDate"`
+	Description  *string            `json:"description" extensions:"nullable"`
+	ImageUrl     *string            `json:"imageUrl" extensions:"nullable"`
+	HomeCurrency *string            `json:"homeCurrency,omitempty" binding:"optional" example:"EUR"`
+	Template     *bool              `json:"template,omitempty" binding:"optional"`
+	DateChange   *entity.DateChange `json:"dateChange,omitempty" binding:"optional"`
+}
+
+// TripCopy creates a new trip from an existing trip or template. Dates and times are shifted by the days between the
+// start dates. With ResolveBookings, flights and trains are looked up again on the new dates instead of being copied
+// as manual transportation.
+type TripCopy struct {
+	StartDate       civil.Date `json:"startDate"`
+	Name            *string    `json:"name,omitempty" binding:"optional"`
+	Attachments     bool       `json:"attachments,omitempty" binding:"optional"`
+	ResolveBookings bool       `json:"resolveBookings,omitempty" binding:"optional"`
 }
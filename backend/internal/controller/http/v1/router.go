@@ -51,6 +51,12 @@ func NewTripRoutes(apiV1Group fiber.Router, uc usecase.Trips, log logger.Interfa
 	return tripsV1Group
 }
 
+func NewEventRoutes(tripsV1Group fiber.Router, uc usecase.Events, users usecase.Users, log logger.Interface) {
+	r := &EventsV1{uc: uc, users: users, log: log}
+
+	tripsV1Group.Get("/events", r.getEvents)
+}
+
 func NewTransportationRoutes(tripsV1Group fiber.Router, uc usecase.Transportation, log logger.Interface) {
 	r := &TransportationV1{
 		uc:  uc,
@@ -67,6 +73,10 @@ func NewTransportationRoutes(tripsV1Group fiber.Router, uc usecase.Transportatio
 		transportationV1Group.Get("/:transportation_id", r.getTransportation)
 		transportationV1Group.Put("/:transportation_id", r.putTransportation)
 		transportationV1Group.Delete("/:transportation_id", r.deleteTransportation)
+		transportationV1Group.Post("/:transportation_id/restore/:entry_id", r.restoreTransportation)
+		transportationV1Group.Post("/:transportation_id/track", r.postTrack)
+		transportationV1Group.Put("/:transportation_id/track", r.putTrack)
+		transportationV1Group.Delete("/:transportation_id/track", r.deleteTrack)
 	}
 }
 
@@ -91,8 +101,8 @@ func NewTrainRoutes(apiV1Group fiber.Router, uc usecase.Trains, log logger.Inter
 	}
 }
 
-func NewActivityRoutes(tripsV1Group fiber.Router, uc usecase.Activities, log logger.Interface) {
-	r := &ActivitiesV1{uc: uc, log: log, v: validator.New(validator.WithRequiredStructEnabled())}
+func NewActivityRoutes(tripsV1Group fiber.Router, uc usecase.Activities, users usecase.Users, log logger.Interface) {
+	r := &ActivitiesV1{uc: uc, users: users, log: log, v: validator.New(validator.WithRequiredStructEnabled())}
 
 	activitiesV1Group := tripsV1Group.Group("/activities")
 
@@ -102,11 +112,12 @@ func NewActivityRoutes(tripsV1Group fiber.Router, uc usecase.Activities, log log
 		activitiesV1Group.Get("/:activity_id", r.getActivity)
 		activitiesV1Group.Put("/:activity_id", r.putActivity)
 		activitiesV1Group.Delete("/:activity_id", r.deleteActivity)
+		activitiesV1Group.Post("/:activity_id/restore/:entry_id", r.restoreActivity)
 	}
 }
 
-func NewAccommodationRoutes(tripsV1Group fiber.Router, uc usecase.Accommodation, log logger.Interface) {
-	r := &AccommodationV1{uc: uc, log: log, v: validator.New(validator.WithRequiredStructEnabled())}
+func NewAccommodationRoutes(tripsV1Group fiber.Router, uc usecase.Accommodation, users usecase.Users, log logger.Interface) {
+	r := &AccommodationV1{uc: uc, users: users, log: log, v: validator.New(validator.WithRequiredStructEnabled())}
 
 	accommodationV1Group := tripsV1Group.Group("/accommodation")
 
@@ -116,6 +127,7 @@ func NewAccommodationRoutes(tripsV1Group fiber.Router, uc usecase.Accommodation,
 		accommodationV1Group.Get("/:accommodation_id", r.getAccommodationByID)
 		accommodationV1Group.Put("/:accommodation_id", r.putAccommodation)
 		accommodationV1Group.Delete("/:accommodation_id", r.deleteAccommodation)
+		accommodationV1Group.Post("/:accommodation_id/restore/:entry_id", r.restoreAccommodation)
 	}
 }
 
@@ -128,6 +140,122 @@ func NewAttachmentRoutes(tripsV1Group fiber.Router, uc usecase.Attachments, log
 		attachmentsV1Group.Get("", r.getAttachments)
 		attachmentsV1Group.Post("", r.postAttachment)
 		attachmentsV1Group.Get("/:attachment_id", r.downloadAttachment)
+		attachmentsV1Group.Get("/:attachment_id/url", r.getAttachmentURL)
+		attachmentsV1Group.Get("/:attachment_id/thumbnail", r.getThumbnail)
 		attachmentsV1Group.Delete("/:attachment_id", r.deleteAttachment)
+		attachmentsV1Group.Put("/:attachment_id/links/:target/:target_id", r.linkAttachment)
+		attachmentsV1Group.Delete("/:attachment_id/links/:target/:target_id", r.unlinkAttachment)
+	}
+}
+
+func NewPhotoRoutes(tripsV1Group fiber.Router, uc usecase.Photos, log logger.Interface) {
+	r := &PhotosV1{uc: uc, log: log}
+
+	tripsV1Group.Post("/photos", r.postPhotos)
+}
+
+func NewItineraryRoutes(tripsV1Group fiber.Router, uc usecase.Itinerary, users usecase.Users, log logger.Interface) {
+	r := &ItineraryV1{uc: uc, users: users, log: log}
+
+	tripsV1Group.Get("/itinerary", r.getItinerary)
+	tripsV1Group.Get("/checks", r.getChecks)
+}
+
+func NewEmissionsRoutes(tripsV1Group fiber.Router, uc usecase.Emissions, log logger.Interface) {
+	r := &EmissionsV1{uc: uc, log: log}
+
+	tripsV1Group.Get("/emissions", r.getEmissions)
+}
+
+func NewExpenseRoutes(tripsV1Group fiber.Router, uc usecase.Expenses, log logger.Interface) {
+	r := &ExpensesV1{uc: uc, log: log, v: validator.New(validator.WithRequiredStructEnabled())}
+
+	expensesV1Group := tripsV1Group.Group("/expenses")
+
+	{
+		expensesV1Group.Get("", r.getExpenses)
+		expensesV1Group.Post("", r.postExpense)
+		expensesV1Group.Get("/:expense_id", r.getExpense)
+		expensesV1Group.Put("/:expense_id", r.putExpense)
+		expensesV1Group.Delete("/:expense_id", r.deleteExpense)
+	}
+
+	tripsV1Group.Get("/settlement", r.getSettlement)
+}
+
+func NewBudgetRoutes(tripsV1Group fiber.Router, uc usecase.Budgets, log logger.Interface) {
+	r := &BudgetsV1{uc: uc, log: log, v: validator.New(validator.WithRequiredStructEnabled())}
+
+	budgetV1Group := tripsV1Group.Group("/budget")
+
+	{
+		budgetV1Group.Get("", r.getBudget)
+		budgetV1Group.Put("", r.putBudget)
+		budgetV1Group.Get("/report", r.getBudgetReport)
+	}
+}
+
+func NewCostsRoutes(tripsV1Group fiber.Router, uc usecase.Costs, log logger.Interface) {
+	r := &CostsV1{uc: uc, log: log}
+
+	tripsV1Group.Get("/costs", r.getCosts)
+}
+
+func NewExportRoutes(tripsV1Group fiber.Router, uc usecase.Export, log logger.Interface) {
+	r := &ExportV1{uc: uc, log: log}
+
+	exportV1Group := tripsV1Group.Group("/export")
+
+	{
+		exportV1Group.Get("/gpx", r.getGpx)
+		exportV1Group.Get("/kml", r.getKml)
 	}
+
+	tripsV1Group.Get("/itinerary.pdf", r.getItineraryPdf)
+}
+
+func NewChangesRoutes(tripsV1Group fiber.Router, uc usecase.Changes, users usecase.Users, log logger.Interface) {
+	r := &ChangesV1{uc: uc, users: users, log: log, v: validator.New(validator.WithRequiredStructEnabled())}
+
+	changesV1Group := tripsV1Group.Group("/changes")
+
+	{
+		changesV1Group.Get("", r.getChanges)
+		changesV1Group.Post("", r.postChanges)
+	}
+}
+
+func NewHistoryRoutes(tripsV1Group fiber.Router, uc usecase.History, log logger.Interface) {
+	r := &HistoryV1{uc: uc, log: log}
+
+	tripsV1Group.Get("/history", r.getHistory)
+}
+
+func NewTrashRoutes(apiV1Group fiber.Router, tripsV1Group fiber.Router, uc usecase.Trash, log logger.Interface) {
+	r := &TrashV1{uc: uc, log: log}
+
+	apiV1Group.Get("/trash", r.getTrash)
+	apiV1Group.Post("/trash/trips/:trip_id/restore", r.restoreTrip)
+
+	tripsV1Group.Get("/trash", r.getTripTrash)
+	tripsV1Group.Post("/trash/:kind/:entity_id/restore", r.restoreFromTrash)
+}
+
+// NewTemplateRoutes registers routes that need no permissions for the trip, so it has to be called before
+// NewTripRoutes. Copying checks the permissions itself, as templates can be copied by any user.
+func NewTemplateRoutes(apiV1Group fiber.Router, uc usecase.Templates, log logger.Interface) {
+	r := &TemplatesV1{
+		uc:  uc,
+		log: log,
+		v:   validator.New(validator.WithRequiredStructEnabled()),
+	}
+
+	apiV1Group.Get("/templates", r.getTemplates)
+	apiV1Group.Post("/trips/:trip_id/copy", r.copyTrip)
+}
+
+func NewStatsRoutes(apiV1Group fiber.Router, uc usecase.Stats, log logger.Interface) {
+	r := &StatsV1{uc: uc, log: log}
+
+	apiV1Group.Get("/users/me/stats", r.getStats)
 }
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"fmt"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type StatsV1 struct {
+	uc  usecase.Stats
+	log logger.Interface
+}
+
+// @Summary     Get travel statistics
+// @Description Aggregates distances, hours travelled, flights and the most frequent airports, airlines, aircraft and
+// @Description train operators over all trips the user owns or is a member of.
+// @ID          getStats
+// @Tags  	    users
+// @Produce     json
+// @Param       year query int false "Only count trips and legs of this year"
+// @Success     200 {object} entity.TravelStats
+// @Failure     400 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /users/me/stats [get]
+func (r *StatsV1) getStats(ctx *fiber.Ctx) error {
+	year, err := queryInt32(ctx, "year")
+	if err != nil {
+		return err
+	}
+
+	stats, err := r.uc.GetStats(ctx.UserContext(), userIdFromCtx(ctx), year)
+	if err != nil {
+		return fmt.Errorf("get stats: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(stats)
+}
@@ -0,0 +1,72 @@
+package v1
+
+import (
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TemplatesV1 struct {
+	uc  usecase.Templates
+	log logger.Interface
+	v   *validator.Validate
+}
+
+// @Summary     Get trip templates
+// @Description Templates are trips that any user can copy.
+// @ID          getTemplates
+// @Tags  	    templates
+// @Produce     json
+// @Success     200 {object} []entity.Trip
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /templates [get]
+func (r *TemplatesV1) getTemplates(ctx *fiber.Ctx) error {
+	templates, err := r.uc.GetTemplates(ctx.UserContext())
+	if err != nil {
+		return fmt.Errorf("get templates: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(templates)
+}
+
+// @Summary     Copy trip
+// @Description Copies activities, accommodation, transportation and optionally attachments into a new trip, shifting
+// @Description all dates by the days between the start dates. Expenses and members are not copied. Any user can copy
+// @Description templates.
+// @ID          copyTrip
+// @Tags  	    templates
+// @Accept      json
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Param       request body request.TripCopy true "copy"
+// @Success     200 {object} entity.Trip
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/copy [post]
+func (r *TemplatesV1) copyTrip(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	body, err := ParseAndValidateRequestBody[request.TripCopy](ctx, r.v)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "parse request body")
+	}
+
+	trip, err := r.uc.CopyTrip(ctx.UserContext(), userIdFromCtx(ctx), int32(tripID), *body)
+	if err != nil {
+		return fmt.Errorf("copy trip [id=%d]: %w", tripID, err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(trip)
+}
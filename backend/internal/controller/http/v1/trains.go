@@ -25,6 +25,7 @@ type TrainsV1 struct {
 // @Param       trip_id path int true "Trip ID"
 // @Param       request body request.TrainJourney true "train journey"
 // @Success     200 {object} entity.Transportation
+// @Failure     400 {object} response.Error
 // @Failure     403 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Security    bearerauth
@@ -3,6 +3,7 @@ package v1
 import (
 	"fmt"
 	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
 	"kompass/internal/usecase"
 	"kompass/pkg/logger"
 	"net/http"
@@ -25,6 +26,7 @@ type TransportationV1 struct {
 // @Param       trip_id path int true "Trip ID"
 // @Param       request body request.Transportation true "transportation"
 // @Success     200 {object} entity.Transportation
+// @Failure     400 {object} response.Error
 // @Failure     403 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Security    bearerauth
@@ -56,8 +58,11 @@ func (r *TransportationV1) postTransportation(ctx *fiber.Ctx) error {
 // @Param       trip_id path int true "Trip ID"
 // @Param       transportation_id path int true "Transportation ID"
 // @Param       request body request.Transportation true "transportation"
+// @Param       If-Match header string false "ETag the request is conditional on"
 // @Success     204
+// @Failure     400 {object} response.Error
 // @Failure     403 {object} response.Error
+// @Failure     412 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Security    bearerauth
 // @Router      /trips/{trip_id}/transportation/{transportation_id} [put]
@@ -76,7 +81,12 @@ func (r *TransportationV1) putTransportation(ctx *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "invalid request body")
 	}
 
-	if _, err := r.uc.UpdateTransportation(ctx.UserContext(), int32(tripID), int32(transportationID), *body); err != nil {
+	revision, err := ifMatchRevision(ctx, r.revision(ctx, tripID, transportationID))
+	if err != nil {
+		return fmt.Errorf("update transportation with id %d: %w", transportationID, err)
+	}
+
+	if _, err := r.uc.UpdateTransportation(ctx.UserContext(), int32(tripID), int32(transportationID), *body, revision); err != nil {
 		return fmt.Errorf("update transportation with id %d: %w", transportationID, err)
 	}
 
@@ -113,7 +123,9 @@ func (r *TransportationV1) getAllTransportation(ctx *fiber.Ctx) error {
 // @Produce     json
 // @Param       trip_id path int true "Trip ID"
 // @Param       transportation_id path int true "Transportation ID"
+// @Param       If-None-Match header string false "ETag of a cached copy"
 // @Success     200 {object} entity.Transportation
+// @Success     304
 // @Failure     403 {object} response.Error
 // @Failure     404 {object} response.Error
 // @Failure     500 {object} response.Error
@@ -134,17 +146,20 @@ func (r *TransportationV1) getTransportation(ctx *fiber.Ctx) error {
 		return fmt.Errorf("get transportation [id=%d]: %w", transportationID, err)
 	}
 
-	return ctx.Status(http.StatusOK).JSON(transportation)
+	return sendWithRevision(ctx, transportation.Revision, transportation)
 }
 
 // @Summary     Delete Transportation
+// @Description The transportation is moved to the trash and can be restored until it is purged.
 // @ID          deleteTransportation
 // @Tags  	    transportation
 // @Param       trip_id path int true "Trip ID"
 // @Param       transportation_id path int true "Transportation ID"
+// @Param       If-Match header string false "ETag the request is conditional on"
 // @Success     204
 // @Failure     403 {object} response.Error
 // @Failure     404 {object} response.Error
+// @Failure     412 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Security    bearerauth
 // @Router      /trips/{trip_id}/transportation/{transportation_id} [delete]
@@ -158,7 +173,12 @@ func (r *TransportationV1) deleteTransportation(ctx *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "parse transportation_id")
 	}
 
-	if err := r.uc.DeleteTransportation(ctx.UserContext(), int32(tripID), int32(transportationID)); err != nil {
+	revision, err := ifMatchRevision(ctx, r.revision(ctx, tripID, transportationID))
+	if err != nil {
+		return fmt.Errorf("delete transportation with id %d: %w", transportationID, err)
+	}
+
+	if err := r.uc.DeleteTransportation(ctx.UserContext(), int32(tripID), int32(transportationID), revision); err != nil {
 		return fmt.Errorf("delete transportation with id %d: %w", transportationID, err)
 	}
 
@@ -188,3 +208,171 @@ func (r *TransportationV1) getGeoJson(ctx *fiber.Ctx) error {
 
 	return ctx.Status(http.StatusOK).JSON(geojson)
 }
+
+type TrackParam struct {
+	Track   string              `format:"binary"`
+	Display entity.TrackDisplay `validate:"optional"`
+}
+
+// @Summary     Import recorded track
+// @ID          postTrack
+// @Tags  	    transportation
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Param       transportation_id path int true "Transportation ID"
+// @Param       track formData TrackParam true "track"
+// @Success     200 {object} entity.Transportation
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/transportation/{transportation_id}/track [post]
+func (r *TransportationV1) postTrack(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+	transportationID, err := ctx.ParamsInt("transportation_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse transportation_id")
+	}
+
+	file, err := ctx.FormFile("track")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "missing track file")
+	}
+	display := entity.TrackDisplay(ctx.FormValue("display", string(entity.BOTH)))
+
+	transportation, err := r.uc.ImportTrack(ctx.UserContext(), int32(tripID), int32(transportationID), file, display)
+	if err != nil {
+		return fmt.Errorf("import track: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(transportation)
+}
+
+// @Summary     Update track display
+// @ID          putTrack
+// @Tags  	    transportation
+// @Accept      json
+// @Param       trip_id path int true "Trip ID"
+// @Param       transportation_id path int true "Transportation ID"
+// @Param       request body request.Track true "track"
+// @Param       If-Match header string false "ETag of the transportation the request is conditional on"
+// @Success     204
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     412 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/transportation/{transportation_id}/track [put]
+func (r *TransportationV1) putTrack(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+	transportationID, err := ctx.ParamsInt("transportation_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse transportation_id")
+	}
+
+	body, err := ParseAndValidateRequestBody[request.Track](ctx, r.v)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid request body")
+	}
+
+	revision, err := ifMatchRevision(ctx, r.revision(ctx, tripID, transportationID))
+	if err != nil {
+		return fmt.Errorf("update track display: %w", err)
+	}
+
+	if err := r.uc.UpdateTrackDisplay(ctx.UserContext(), int32(tripID), int32(transportationID), body.Display, revision); err != nil {
+		return fmt.Errorf("update track display: %w", err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+// @Summary     Delete recorded track
+// @ID          deleteTrack
+// @Tags  	    transportation
+// @Param       trip_id path int true "Trip ID"
+// @Param       transportation_id path int true "Transportation ID"
+// @Param       If-Match header string false "ETag of the transportation the request is conditional on"
+// @Success     204
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     412 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/transportation/{transportation_id}/track [delete]
+func (r *TransportationV1) deleteTrack(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+	transportationID, err := ctx.ParamsInt("transportation_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse transportation_id")
+	}
+
+	revision, err := ifMatchRevision(ctx, r.revision(ctx, tripID, transportationID))
+	if err != nil {
+		return fmt.Errorf("delete track: %w", err)
+	}
+
+	if err := r.uc.DeleteTrack(ctx.UserContext(), int32(tripID), int32(transportationID), revision); err != nil {
+		return fmt.Errorf("delete track: %w", err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+// @Summary     Restore transportation
+// @Description Restores the version recorded by an entry of the history. Deleted transportation is created again with a new ID.
+// @Description Flights and train journeys are restored as generic transportation named after their legs, replacing them
+// @Description with a new ID if they still exist.
+// @ID          restoreTransportation
+// @Tags  	    transportation
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Param       transportation_id path int true "Transportation ID"
+// @Param       entry_id path int true "History entry ID"
+// @Success     200 {object} entity.Transportation
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/transportation/{transportation_id}/restore/{entry_id} [post]
+func (r *TransportationV1) restoreTransportation(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+	transportationID, err := ctx.ParamsInt("transportation_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse transportation_id")
+	}
+	entryID, err := ctx.ParamsInt("entry_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse entry_id")
+	}
+
+	transportation, err := r.uc.RestoreTransportation(ctx.UserContext(), int32(tripID), int32(transportationID), int32(entryID))
+	if err != nil {
+		return fmt.Errorf("restore transportation [id=%d]: %w", transportationID, err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(transportation)
+}
+
+func (r *TransportationV1) revision(ctx *fiber.Ctx, tripID, transportationID int) func() (int64, error) {
+	return func() (int64, error) {
+		transportation, err := r.uc.GetTransportationByID(ctx.UserContext(), int32(tripID), int32(transportationID))
+		return transportation.Revision, err
+	}
+}
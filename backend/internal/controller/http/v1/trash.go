@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"kompass/internal/entity"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+)
+
+type TrashV1 struct {
+	uc  usecase.Trash
+	log logger.Interface
+}
+
+// @Summary     Get deleted trips and entities of all trips
+// @Description Items are purged once purgeAt has passed. Entities of deleted trips are restored with the trip.
+// @ID          getTrash
+// @Tags  	    trash
+// @Produce     json
+// @Success     200 {object} []entity.TrashItem
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trash [get]
+func (r *TrashV1) getTrash(ctx *fiber.Ctx) error {
+	items, err := r.uc.GetTrash(ctx.UserContext(), userIdFromCtx(ctx))
+	if err != nil {
+		return fmt.Errorf("get trash: %w", err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(items)
+}
+
+// @Summary     Restore deleted trip
+// @ID          restoreTrip
+// @Tags  	    trash
+// @Param       trip_id path int true "Trip ID"
+// @Success     204
+// @Failure     400 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trash/trips/{trip_id}/restore [post]
+func (r *TrashV1) restoreTrip(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	if err := r.uc.RestoreTrip(ctx.UserContext(), userIdFromCtx(ctx), int32(tripID)); err != nil {
+		return fmt.Errorf("restore trip [id=%d]: %w", tripID, err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+// @Summary     Get deleted entities of trip
+// @ID          getTripTrash
+// @Tags  	    trash
+// @Produce     json
+// @Param       trip_id path int true "Trip ID"
+// @Success     200 {object} []entity.TrashItem
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/trash [get]
+func (r *TrashV1) getTripTrash(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+
+	items, err := r.uc.GetTripTrash(ctx.UserContext(), userIdFromCtx(ctx), int32(tripID))
+	if err != nil {
+		return fmt.Errorf("get trash of trip [id=%d]: %w", tripID, err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(items)
+}
+
+// @Summary     Restore deleted entity of trip
+// @Description Entities outside of the trip dates can not be restored, the trip dates may have changed since.
+// @ID          restoreFromTrash
+// @Tags  	    trash
+// @Param       trip_id path int true "Trip ID"
+// @Param       kind path string true "Kind of entity" Enums(transportation, activity, accommodation, expense, attachment)
+// @Param       entity_id path int true "Entity ID"
+// @Success     204
+// @Failure     400 {object} response.Error
+// @Failure     403 {object} response.Error
+// @Failure     404 {object} response.Error
+// @Failure     500 {object} response.Error
+// @Security    bearerauth
+// @Router      /trips/{trip_id}/trash/{kind}/{entity_id}/restore [post]
+func (r *TrashV1) restoreFromTrash(ctx *fiber.Ctx) error {
+	tripID, err := ctx.ParamsInt("trip_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse trip_id")
+	}
+	entityID, err := ctx.ParamsInt("entity_id")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "unable to parse entity_id")
+	}
+	kind := entity.EntityKind(ctx.Params("kind"))
+
+	if err := r.uc.Restore(ctx.UserContext(), int32(tripID), kind, int32(entityID)); err != nil {
+		return fmt.Errorf("restore %s [id=%d]: %w", kind, entityID, err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
@@ -1,8 +1,10 @@
 package v1
 
 import (
+	"errors"
 	"fmt"
 	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
 	"kompass/internal/usecase"
 	"kompass/pkg/logger"
 	"net/http"
@@ -39,7 +41,9 @@ func (r *TripsV1) getTrips(ctx *fiber.Ctx) error {
 // @Tags  	    trips
 // @Produce     json
 // @Param       trip_id path int true "Trip ID"
+// @Param       If-None-Match header string false "ETag of a cached copy"
 // @Success     200 {object} entity.Trip
+// @Success     304
 // @Failure     400 {object} response.Error
 // @Failure     403 {object} response.Error
 // @Failure     500 {object} response.Error
@@ -56,7 +60,7 @@ func (r *TripsV1) getTrip(ctx *fiber.Ctx) error {
 		return fmt.Errorf("get trip [id=%d]: %w", tripID, err)
 	}
 
-	return ctx.Status(http.StatusOK).JSON(trip)
+	return sendWithRevision(ctx, trip.Revision, trip)
 }
 
 // @Summary     Add trip
@@ -85,14 +89,19 @@ func (r *TripsV1) postTrip(ctx *fiber.Ctx) error {
 }
 
 // @Summary     Update trip
+// @Description If the dates change, entities of the trip are either validated against the new dates or shifted by the
+// @Description days the start date moved, see dateChange. Entities outside of the new dates are returned with 409.
 // @ID          putTrip
 // @Tags  	    trips
 // @Accept      json
 // @Param       trip_id path int true "Trip ID"
 // @Param       request body request.Trip true "trip"
+// @Param       If-Match header string false "ETag the request is conditional on"
 // @Success     204
 // @Failure     403 {object} response.Error
 // @Failure     400 {object} response.Error
+// @Failure     409 {object} []entity.OutOfRangeEntity
+// @Failure     412 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Security    bearerauth
 // @Router      /trips/{trip_id} [put]
@@ -107,7 +116,16 @@ func (r *TripsV1) putTrip(ctx *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "invalid request body")
 	}
 
-	if err := r.uc.UpdateTrip(ctx.UserContext(), int32(tripID), *body); err != nil {
+	revision, err := ifMatchRevision(ctx, r.revision(ctx, tripID))
+	if err != nil {
+		return fmt.Errorf("update trip with id %d: %w", tripID, err)
+	}
+
+	if err := r.uc.UpdateTrip(ctx.UserContext(), int32(tripID), *body, revision); err != nil {
+		var outOfRange entity.ErrDatesOutOfRange
+		if errors.As(err, &outOfRange) {
+			return ctx.Status(http.StatusConflict).JSON(outOfRange)
+		}
 		return fmt.Errorf("update trip with id %d: %w", tripID, err)
 	}
 
@@ -115,12 +133,15 @@ func (r *TripsV1) putTrip(ctx *fiber.Ctx) error {
 }
 
 // @Summary     Delete trip
+// @Description The trip is moved to the trash with all of its entities and can be restored until it is purged.
 // @ID          deleteTrip
 // @Tags  	    trips
 // @Param       trip_id path int true "Trip ID"
+// @Param       If-Match header string false "ETag the request is conditional on"
 // @Success     204
 // @Failure     400 {object} response.Error
 // @Failure     403 {object} response.Error
+// @Failure     412 {object} response.Error
 // @Failure     500 {object} response.Error
 // @Security    bearerauth
 // @Router      /trips/{trip_id} [delete]
@@ -130,9 +151,21 @@ func (r *TripsV1) deleteTrip(ctx *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "parse trip_id")
 	}
 
-	if err := r.uc.DeleteTrip(ctx.UserContext(), int32(tripID)); err != nil {
+	revision, err := ifMatchRevision(ctx, r.revision(ctx, tripID))
+	if err != nil {
+		return fmt.Errorf("delete trip with id %d: %w", tripID, err)
+	}
+
+	if err := r.uc.DeleteTrip(ctx.UserContext(), int32(tripID), revision); err != nil {
 		return fmt.Errorf("delete trip with id %d: %w", tripID, err)
 	}
 
 	return ctx.SendStatus(http.StatusNoContent)
 }
+
+func (r *TripsV1) revision(ctx *fiber.Ctx, tripID int) func() (int64, error) {
+	return func() (int64, error) {
+		trip, err := r.uc.GetTripByID(ctx.UserContext(), int32(tripID))
+		return trip.Revision, err
+	}
+}
@@ -5,15 +5,30 @@ import (
 )
 
 type Accommodation struct {
-	ID            int32       `json:"id"`
-	TripID        int32       `json:"tripId"`
-	Name          string      `json:"name"`
-	ArrivalDate   civil.Date  `json:"arrivalDate"`
-	DepartureDate civil.Date  `json:"departureDate"`
-	CheckInTime   *civil.Time `json:"checkInTime" extensions:"nullable"`
-	CheckOutTime  *civil.Time `json:"checkOutTime" extensions:"nullable"`
-	Description   *string     `json:"description" extensions:"nullable"`
-	Address       *string     `json:"address" extensions:"nullable"`
-	Location      *Location   `json:"location" extensions:"nullable"`
-	Price         *int32      `json:"price" extensions:"nullable"`
+	ID                int32                `json:"id"`
+	TripID            int32                `json:"tripId"`
+	Revision          int64                `json:"revision"`
+	Name              string               `json:"name"`
+	ArrivalDate       civil.Date           `json:"arrivalDate"`
+	DepartureDate     civil.Date           `json:"departureDate"`
+	CheckInTime       *civil.Time          `json:"checkInTime" extensions:"nullable"`
+	CheckOutTime      *civil.Time          `json:"checkOutTime" extensions:"nullable"`
+	CheckInUtcOffset  *string              `json:"checkInUtcOffset" extensions:"nullable"`
+	CheckOutUtcOffset *string              `json:"checkOutUtcOffset" extensions:"nullable"`
+	Description       *string              `json:"description" extensions:"nullable"`
+	Sensitive         bool                 `json:"sensitive"`
+	Address           *string              `json:"address" extensions:"nullable"`
+	Location          *Location            `json:"location" extensions:"nullable"`
+	Price             *int32               `json:"price" extensions:"nullable"`
+	Currency          string               `json:"currency" example:"EUR"`
+	Attachments       []AttachmentMetadata `json:"attachments"`
+	BudgetAlert       *BudgetAlert         `json:"budgetAlert,omitempty" validate:"optional" extensions:"nullable"`
+}
+
+// Redacted hides the description if it is marked sensitive, for users without the read_sensitive permission.
+func (a Accommodation) Redacted() Accommodation {
+	if a.Sensitive {
+		a.Description = nil
+	}
+	return a
 }
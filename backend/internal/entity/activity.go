@@ -5,13 +5,27 @@ import (
 )
 
 type Activity struct {
-	ID          int32       `json:"id"`
-	TripID      int32       `json:"tripId"`
-	Name        string      `json:"name"`
-	Date        civil.Date  `json:"date"`
-	Time        *civil.Time `json:"time" extensions:"nullable"`
-	Description *string     `json:"description" extensions:"nullable"`
-	Address     *string     `json:"address" extensions:"nullable"`
-	Location    *Location   `json:"location" extensions:"nullable"`
-	Price       *int32      `json:"price" extensions:"nullable"`
+	ID          int32                `json:"id"`
+	TripID      int32                `json:"tripId"`
+	Revision    int64                `json:"revision"`
+	Name        string               `json:"name"`
+	Date        civil.Date           `json:"date"`
+	Time        *civil.Time          `json:"time" extensions:"nullable"`
+	UtcOffset   *string              `json:"utcOffset" extensions:"nullable"`
+	Description *string              `json:"description" extensions:"nullable"`
+	Sensitive   bool                 `json:"sensitive"`
+	Address     *string              `json:"address" extensions:"nullable"`
+	Location    *Location            `json:"location" extensions:"nullable"`
+	Price       *int32               `json:"price" extensions:"nullable"`
+	Currency    string               `json:"currency" example:"EUR"`
+	Attachments []AttachmentMetadata `json:"attachments"`
+	BudgetAlert *BudgetAlert         `json:"budgetAlert,omitempty" validate:"optional" extensions:"nullable"`
+}
+
+// Redacted hides the description if it is marked sensitive, for users without the read_sensitive permission.
+func (a Activity) Redacted() Activity {
+	if a.Sensitive {
+		a.Description = nil
+	}
+	return a
 }
@@ -1,8 +1,93 @@
 package entity
 
+import (
+	"mime"
+	"path/filepath"
+	"time"
+)
+
+// Attachment is the metadata of an uploaded file. The content is only available through the download endpoint.
+// ContentType is sniffed from the content on upload and Sha256 is its hex-encoded hash. Revision is the revision of the
+// trip in which the attachment was last changed, see Changes.
 type Attachment struct {
-	ID     int32  `json:"id"`
-	TripID int32  `json:"tripId"`
-	Name   string `json:"name"`
-	Blob   []byte `json:"blob"`
+	ID          int32     `json:"id"`
+	TripID      int32     `json:"tripId"`
+	Revision    int64     `json:"revision"`
+	Name        string    `json:"name"`
+	Size        int64     `json:"size"`
+	ContentType string    `json:"contentType" example:"application/pdf"`
+	Sha256      string    `json:"sha256"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UploadedBy  *int32    `json:"uploadedBy" extensions:"nullable"`
+}
+
+// AttachmentBlob is the content shared by all attachments with the same blob key. Size is the size in storage, which
+// is larger than the size of the attachment if it is encrypted.
+type AttachmentBlob struct {
+	Key  string
+	Size int64
+}
+
+// AttachmentURL is a pre-signed URL to download an attachment directly from the storage backend.
+type AttachmentURL struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// AttachmentMetadata describes an attachment linked to a booking without its content.
+type AttachmentMetadata struct {
+	ID          int32  `json:"id"`
+	Name        string `json:"name"`
+	Size        int64  `json:"size"`
+	ContentType string `json:"contentType" example:"application/pdf"`
+}
+
+// AttachmentLinkTarget is the kind of booking an attachment is linked to. The values match the paths of the
+// bookings in the API.
+type AttachmentLinkTarget string
+
+const (
+	TRANSPORTATION_LINK AttachmentLinkTarget = "transportation"
+	ACTIVITY_LINK       AttachmentLinkTarget = "activities"
+	ACCOMMODATION_LINK  AttachmentLinkTarget = "accommodation"
+)
+
+func (t AttachmentLinkTarget) IsValid() bool {
+	switch t {
+	case TRANSPORTATION_LINK, ACTIVITY_LINK, ACCOMMODATION_LINK:
+		return true
+	}
+	return false
+}
+
+// ThumbnailSize is the size of the square a thumbnail fits into.
+type ThumbnailSize string
+
+const (
+	SMALL_THUMBNAIL  ThumbnailSize = "small"
+	MEDIUM_THUMBNAIL ThumbnailSize = "medium"
+	LARGE_THUMBNAIL  ThumbnailSize = "large"
+)
+
+var ThumbnailSizes = []ThumbnailSize{SMALL_THUMBNAIL, MEDIUM_THUMBNAIL, LARGE_THUMBNAIL}
+
+// Pixels returns the edge length of the square, or zero if the size is invalid.
+func (s ThumbnailSize) Pixels() int {
+	switch s {
+	case SMALL_THUMBNAIL:
+		return 160
+	case MEDIUM_THUMBNAIL:
+		return 480
+	case LARGE_THUMBNAIL:
+		return 1280
+	}
+	return 0
+}
+
+// ContentTypeByName guesses the content type of a file from the extension of its name.
+func ContentTypeByName(filename string) string {
+	if contentType := mime.TypeByExtension(filepath.Ext(filename)); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
 }
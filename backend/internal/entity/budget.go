@@ -0,0 +1,38 @@
+package entity
+
+// Budget limits the costs of a trip in its home currency, in total and per category.
+type Budget struct {
+	Total      *float64         `json:"total" extensions:"nullable"`
+	Categories []CategoryBudget `json:"categories"`
+}
+
+type CategoryBudget struct {
+	Category CostCategory `json:"category"`
+	Amount   float64      `json:"amount"`
+}
+
+// BudgetReport compares the costs of a trip to its budget. Planned and Remaining are null if no budget is set.
+// The daily burn rate divides the costs by the days of the trip that have passed, or all days once it is over.
+type BudgetReport struct {
+	HomeCurrency  string                 `json:"homeCurrency"`
+	Planned       *float64               `json:"planned" extensions:"nullable"`
+	Spent         float64                `json:"spent"`
+	Remaining     *float64               `json:"remaining" extensions:"nullable"`
+	DailyBurnRate float64                `json:"dailyBurnRate"`
+	Categories    []CategoryBudgetReport `json:"categories"`
+}
+
+type CategoryBudgetReport struct {
+	Category  CostCategory `json:"category"`
+	Planned   *float64     `json:"planned" extensions:"nullable"`
+	Spent     float64      `json:"spent"`
+	Remaining *float64     `json:"remaining" extensions:"nullable"`
+	Exceeded  bool         `json:"exceeded"`
+}
+
+// BudgetAlert is returned when a new booking makes the costs of its category exceed the budget.
+type BudgetAlert struct {
+	Category CostCategory `json:"category"`
+	Planned  float64      `json:"planned"`
+	Spent    float64      `json:"spent"`
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"cloud.google.com/go/civil"
+)
+
+type CheckType string
+
+const (
+	OVERLAPPING_TRANSPORTATION CheckType = "OVERLAPPING_TRANSPORTATION"
+	ACTIVITY_IN_TRANSIT        CheckType = "ACTIVITY_IN_TRANSIT"
+	MISSING_ACCOMMODATION      CheckType = "MISSING_ACCOMMODATION"
+	DISTANT_ACCOMMODATION      CheckType = "DISTANT_ACCOMMODATION"
+	SHORT_FLIGHT_CONNECTION    CheckType = "SHORT_FLIGHT_CONNECTION"
+	SHORT_TRAIN_TRANSFER       CheckType = "SHORT_TRAIN_TRANSFER"
+)
+
+// ItineraryCheck is a possible problem of the itinerary on Date. Entities are the entities involved, the message
+// describes the problem for display.
+type ItineraryCheck struct {
+	Type     CheckType       `json:"type"`
+	Date     civil.Date      `json:"date"`
+	Message  string          `json:"message"`
+	Entities []CheckedEntity `json:"entities"`
+}
+
+type CheckedEntity struct {
+	Kind EntityKind `json:"kind"`
+	ID   int32      `json:"id"`
+	Name string     `json:"name"`
+}
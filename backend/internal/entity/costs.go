@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"cloud.google.com/go/civil"
+)
+
+type CostCategory string
+
+const (
+	TRANSPORTATION_COSTS CostCategory = "TRANSPORTATION"
+	ACCOMMODATION_COSTS  CostCategory = "ACCOMMODATION"
+	ACTIVITY_COSTS       CostCategory = "ACTIVITY"
+	FOOD_COSTS           CostCategory = "FOOD"
+	OTHER_COSTS          CostCategory = "OTHER"
+)
+
+var CostCategories = []CostCategory{TRANSPORTATION_COSTS, ACCOMMODATION_COSTS, ACTIVITY_COSTS, FOOD_COSTS, OTHER_COSTS}
+
+func (c CostCategory) IsValid() bool {
+	for _, category := range CostCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// ExchangeRate is the amount of Currency that equals one euro on Date.
+type ExchangeRate struct {
+	Date     civil.Date `json:"date"`
+	Currency string     `json:"currency"`
+	Rate     float64    `json:"rate"`
+}
+
+// Costs are converted to the home currency of the trip at the rate of the day the cost is incurred on, i.e. the
+// departure, arrival, activity or expense date. Expenses linked to a booking are not counted twice. Prices without
+// a known exchange rate are only part of Currencies.
+type Costs struct {
+	HomeCurrency string          `json:"homeCurrency"`
+	Total        float64         `json:"total"`
+	Categories   []CategoryCosts `json:"categories"`
+	Days         []DayCosts      `json:"days"`
+	Currencies   []CurrencyCosts `json:"currencies"`
+}
+
+type CategoryCosts struct {
+	Category CostCategory `json:"category"`
+	Total    float64      `json:"total"`
+}
+
+// DayCosts spread the price of accommodation evenly across its nights.
+type DayCosts struct {
+	Date  civil.Date `json:"date"`
+	Total float64    `json:"total"`
+}
+
+// CurrencyCosts sum up the prices in Currency. Total is the converted amount, which is null if no exchange rate is
+// known for Currency.
+type CurrencyCosts struct {
+	Currency string   `json:"currency"`
+	Amount   float64  `json:"amount"`
+	Total    *float64 `json:"total" extensions:"nullable"`
+}
@@ -0,0 +1,48 @@
+package entity
+
+// EmissionFactors is the table used to estimate emissions. All factors are kg CO2e per passenger kilometer, distances
+// are in kilometers.
+type EmissionFactors struct {
+	Flight FlightEmissionFactors `json:"flight"`
+	Train  TrainEmissionFactors  `json:"train"`
+	// Types contains the factors of all other transportation types, types without factor are not estimated.
+	Types map[TransportationType]float64 `json:"types"`
+	// DetourFactor is applied to the great-circle distance if there is no route to measure.
+	DetourFactor float64 `json:"detourFactor"`
+}
+
+type FlightEmissionFactors struct {
+	// DistanceUplift accounts for holding patterns and flights not taking the great-circle route.
+	DistanceUplift float64 `json:"distanceUplift"`
+	// Bands are ordered by distance, the last band has no maximum.
+	Bands        []FlightDistanceBand   `json:"bands"`
+	CabinClasses map[CabinClass]float64 `json:"cabinClasses"`
+	// Aircraft contains multipliers for aircraft whose name contains the key, e.g. "A380".
+	Aircraft map[string]float64 `json:"aircraft"`
+}
+
+type FlightDistanceBand struct {
+	MaxDistance *float64 `json:"maxDistance"`
+	Factor      float64  `json:"factor"`
+}
+
+type TrainEmissionFactors struct {
+	Default      float64 `json:"default"`
+	DetourFactor float64 `json:"detourFactor"`
+	// Operators take precedence over Countries, which are looked up by the ISO 3166-1 alpha-2 code of the departure.
+	Operators map[string]float64 `json:"operators"`
+	Countries map[string]float64 `json:"countries"`
+}
+
+// TripEmissions sums up the estimated kg CO2e per passenger of a trip. Unestimated counts the transportation
+// without emission factor, which is missing in the totals.
+type TripEmissions struct {
+	Total       float64                   `json:"total"`
+	Types       []TransportationEmissions `json:"types"`
+	Unestimated int32                     `json:"unestimated"`
+}
+
+type TransportationEmissions struct {
+	Type TransportationType `json:"type"`
+	Co2e float64            `json:"co2e"`
+}
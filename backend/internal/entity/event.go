@@ -0,0 +1,32 @@
+package entity
+
+// ChangeType is what happened to an entity of a trip.
+type ChangeType string
+
+const (
+	CREATED ChangeType = "created"
+	UPDATED ChangeType = "updated"
+	DELETED ChangeType = "deleted"
+)
+
+// EntityKind is the kind of entity that changed. Flights and train journeys are transportation.
+type EntityKind string
+
+const (
+	TRIP_KIND           EntityKind = "trip"
+	TRANSPORTATION_KIND EntityKind = "transportation"
+	ACTIVITY_KIND       EntityKind = "activity"
+	ACCOMMODATION_KIND  EntityKind = "accommodation"
+	ATTACHMENT_KIND     EntityKind = "attachment"
+	EXPENSE_KIND        EntityKind = "expense"
+	BUDGET_KIND         EntityKind = "budget"
+)
+
+// TripEvent notifies collaborators that an entity of a trip changed. It only identifies the entity, clients are
+// expected to fetch it again.
+type TripEvent struct {
+	TripID int32      `json:"tripId"`
+	Type   ChangeType `json:"type"`
+	Kind   EntityKind `json:"kind"`
+	ID     int32      `json:"id"`
+}
@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"cloud.google.com/go/civil"
+)
+
+type SplitMode string
+
+const (
+	EQUAL_SPLIT  SplitMode = "EQUAL"
+	SHARES_SPLIT SplitMode = "SHARES"
+	EXACT_SPLIT  SplitMode = "EXACT"
+)
+
+func (m SplitMode) IsValid() bool {
+	switch m {
+	case EQUAL_SPLIT, SHARES_SPLIT, EXACT_SPLIT:
+		return true
+	}
+	return false
+}
+
+type Expense struct {
+	ID               int32                `json:"id"`
+	TripID           int32                `json:"tripId"`
+	Revision         int64                `json:"revision"`
+	Name             string               `json:"name"`
+	Date             civil.Date           `json:"date"`
+	Amount           float64              `json:"amount"`
+	Currency         string               `json:"currency" example:"EUR"`
+	PayerID          int32                `json:"payerId"`
+	SplitMode        SplitMode            `json:"splitMode"`
+	Category         CostCategory         `json:"category"`
+	Participants     []ExpenseParticipant `json:"participants"`
+	TransportationID *int32               `json:"transportationId" extensions:"nullable"`
+	ActivityID       *int32               `json:"activityId" extensions:"nullable"`
+	AccommodationID  *int32               `json:"accommodationId" extensions:"nullable"`
+	AttachmentID     *int32               `json:"attachmentId" extensions:"nullable"`
+}
+
+// ExpenseParticipant owes Amount of the expense. Share is the weight of the participant for SHARES and the
+// requested amount for EXACT splits.
+type ExpenseParticipant struct {
+	UserID int32    `json:"userId"`
+	Share  *float64 `json:"share" extensions:"nullable"`
+	Amount float64  `json:"amount"`
+}
+
+// Settlement balances all expenses of a trip in its home currency. A positive balance is owed to the member.
+type Settlement struct {
+	HomeCurrency string               `json:"homeCurrency"`
+	Balances     []MemberBalance      `json:"balances"`
+	Transfers    []SettlementTransfer `json:"transfers"`
+}
+
+type MemberBalance struct {
+	UserID  int32   `json:"userId"`
+	Paid    float64 `json:"paid"`
+	Owed    float64 `json:"owed"`
+	Balance float64 `json:"balance"`
+}
+
+type SettlementTransfer struct {
+	FromUserID int32   `json:"fromUserId"`
+	ToUserID   int32   `json:"toUserId"`
+	Amount     float64 `json:"amount"`
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"fmt"
+	"strings"
 
 	"cloud.google.com/go/civil"
 )
@@ -11,6 +12,15 @@ type FlightDetail struct {
 	PNRs []PNR       `json:"pnrs"`
 }
 
+// Name lists the flight numbers of the legs, it names the flight if it is kept as generic transportation.
+func (d FlightDetail) Name() string {
+	numbers := []string{}
+	for _, leg := range d.Legs {
+		numbers = append(numbers, leg.FlightNumber)
+	}
+	return strings.Join(numbers, ", ")
+}
+
 type Airport struct {
 	Iata         string   `json:"iata"`
 	Name         string   `json:"name"`
@@ -24,16 +34,32 @@ type AirportWithTimezone struct {
 }
 
 type FlightLeg struct {
-	ID                int32          `json:"id"`
-	Origin            Airport        `json:"origin"`
-	Destination       Airport        `json:"destination"`
-	Airline           string         `json:"airline"`
-	FlightNumber      string         `json:"flightNumber"`
-	DepartureDateTime civil.DateTime `json:"departureDateTime"`
-	ArrivalDateTime   civil.DateTime `json:"arrivalDateTime"`
-	AmadeusFlightDate *civil.Date    `json:"amadeusFlightDate" extensions:"nullable"`
-	DurationInMinutes int32          `json:"durationInMinutes"`
-	Aircraft          *string        `json:"aircraft" extensions:"nullable"`
+	ID                 int32          `json:"id"`
+	Origin             Airport        `json:"origin"`
+	Destination        Airport        `json:"destination"`
+	Airline            string         `json:"airline"`
+	FlightNumber       string         `json:"flightNumber"`
+	DepartureDateTime  civil.DateTime `json:"departureDateTime"`
+	ArrivalDateTime    civil.DateTime `json:"arrivalDateTime"`
+	DepartureUtcOffset *string        `json:"departureUtcOffset" extensions:"nullable"`
+	ArrivalUtcOffset   *string        `json:"arrivalUtcOffset" extensions:"nullable"`
+	AmadeusFlightDate  *civil.Date    `json:"amadeusFlightDate" extensions:"nullable"`
+	DurationInMinutes  int32          `json:"durationInMinutes"`
+	Aircraft           *string        `json:"aircraft" extensions:"nullable"`
+	CabinClass         *CabinClass    `json:"cabinClass" extensions:"nullable"`
+}
+
+type CabinClass string
+
+const (
+	ECONOMY         CabinClass = "ECONOMY"
+	PREMIUM_ECONOMY CabinClass = "PREMIUM_ECONOMY"
+	BUSINESS        CabinClass = "BUSINESS"
+	FIRST           CabinClass = "FIRST"
+)
+
+func (c CabinClass) IsValid() bool {
+	return c == ECONOMY || c == PREMIUM_ECONOMY || c == BUSINESS || c == FIRST
 }
 
 type PNR struct {
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// HistoryEntry records a change of an entity of a trip. The snapshots contain the entity as returned by the API before
+// and after the change, creations have no snapshot before and deletions none after the change.
+type HistoryEntry struct {
+	ID        int32           `json:"id"`
+	TripID    int32           `json:"tripId"`
+	UserID    *int32          `json:"userId" extensions:"nullable"`
+	Type      ChangeType      `json:"type"`
+	Kind      EntityKind      `json:"kind"`
+	EntityID  int32           `json:"entityId"`
+	Before    json.RawMessage `json:"before,omitempty" validate:"optional" swaggertype:"object"`
+	After     json.RawMessage `json:"after,omitempty" validate:"optional" swaggertype:"object"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
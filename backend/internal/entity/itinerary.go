@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"cloud.google.com/go/civil"
+)
+
+type ItineraryEventType string
+
+const (
+	DEPARTURE ItineraryEventType = "DEPARTURE"
+	ARRIVAL   ItineraryEventType = "ARRIVAL"
+	ACTIVITY  ItineraryEventType = "ACTIVITY"
+	CHECK_IN  ItineraryEventType = "CHECK_IN"
+	CHECK_OUT ItineraryEventType = "CHECK_OUT"
+)
+
+// ItineraryEvent references exactly one of Transportation, Activity or Accommodation depending on its type.
+// Transportation arriving on the day of departure only results in a DEPARTURE event, ARRIVAL events are
+// added for transfers spanning midnight.
+type ItineraryEvent struct {
+	Type           ItineraryEventType `json:"type"`
+	Time           *civil.Time        `json:"time" extensions:"nullable"`
+	UtcOffset      *string            `json:"utcOffset" extensions:"nullable"`
+	Transportation *Transportation    `json:"transportation,omitempty" validate:"optional"`
+	Activity       *Activity          `json:"activity,omitempty" validate:"optional"`
+	Accommodation  *Accommodation     `json:"accommodation,omitempty" validate:"optional"`
+}
+
+type ItineraryDay struct {
+	Date   civil.Date       `json:"date"`
+	Events []ItineraryEvent `json:"events"`
+	// Accommodation is where we sleep in the night following Date.
+	Accommodation *Accommodation `json:"accommodation,omitempty" validate:"optional"`
+	// OvernightTransportation is still underway at midnight following Date.
+	OvernightTransportation []Transportation `json:"overnightTransportation"`
+	// MissingAccommodation is set if the night is neither covered by accommodation nor spent travelling.
+	// It is never set for the last day.
+	MissingAccommodation bool `json:"missingAccommodation"`
+}
+
+// Redacted hides the sensitive descriptions of the activities and accommodation of the day.
+func (d ItineraryDay) Redacted() ItineraryDay {
+	events := make([]ItineraryEvent, len(d.Events))
+	for i, event := range d.Events {
+		if event.Activity != nil {
+			activity := event.Activity.Redacted()
+			event.Activity = &activity
+		}
+		if event.Accommodation != nil {
+			accommodation := event.Accommodation.Redacted()
+			event.Accommodation = &accommodation
+		}
+		events[i] = event
+	}
+	d.Events = events
+	if d.Accommodation != nil {
+		accommodation := d.Accommodation.Redacted()
+		d.Accommodation = &accommodation
+	}
+	return d
+}
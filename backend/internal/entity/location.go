@@ -1,9 +1,60 @@
 package entity
 
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
 type Location struct {
 	ID        int32   `json:"id"`
 	Latitude  float32 `json:"latitude"`
 	Longitude float32 `json:"longitude"`
+	// Timezone is the IANA name of the timezone at the location, e.g. "Europe/Berlin".
+	Timezone *string `json:"timezone,omitempty" binding:"optional"`
+}
+
+// TimeLocation returns the timezone of the location, or nil if it is unknown or invalid.
+func (l Location) TimeLocation() *time.Location {
+	if l.Timezone == nil {
+		return nil
+	}
+	location, err := time.LoadLocation(*l.Timezone)
+	if err != nil {
+		return nil
+	}
+	return location
+}
+
+// Instant interprets dateTime as local time at the location. Without a timezone, UTC is assumed.
+func (l Location) Instant(dateTime civil.DateTime) time.Time {
+	if location := l.TimeLocation(); location != nil {
+		return dateTime.In(location)
+	}
+	return dateTime.In(time.UTC)
+}
+
+// Point returns the location as longitude and latitude.
+func (l Location) Point() orb.Point {
+	return orb.Point{float64(l.Longitude), float64(l.Latitude)}
+}
+
+// Distance returns the great-circle distance to another location in meters.
+func (l Location) Distance(to Location) float64 {
+	return geo.Distance(l.Point(), to.Point())
+}
+
+// UtcOffset returns the offset of dateTime at the location formatted like "+05:30", or nil if the timezone
+// is unknown.
+func (l Location) UtcOffset(dateTime civil.DateTime) *string {
+	location := l.TimeLocation()
+	if location == nil {
+		return nil
+	}
+	offset := dateTime.In(location).Format("-07:00")
+	return &offset
 }
 
 type GeocodeLocation struct {
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"cloud.google.com/go/civil"
+)
+
+// PhotoImport groups imported photos into clusters that were taken close to each other in time and place.
+type PhotoImport struct {
+	Clusters []PhotoCluster `json:"clusters"`
+	// Unplaced are photos without a timestamp, which cannot be assigned to a day.
+	Unplaced []AttachmentMetadata `json:"unplaced"`
+}
+
+// PhotoCluster is either attached to an existing activity or comes with a proposal for a new one, unless it was taken
+// outside of the trip.
+type PhotoCluster struct {
+	Date     civil.Date           `json:"date"`
+	Start    civil.Time           `json:"start"`
+	End      civil.Time           `json:"end"`
+	Location *Location            `json:"location" extensions:"nullable"`
+	Photos   []AttachmentMetadata `json:"photos"`
+	// ActivityID is the existing activity the photos were linked to.
+	ActivityID *int32 `json:"activityId" extensions:"nullable"`
+	// Proposal is an activity that has not been saved yet.
+	Proposal *Activity `json:"proposal,omitempty" validate:"optional"`
+}
@@ -0,0 +1,26 @@
+package entity
+
+// TravelStats aggregates the transportation of all trips of a user, optionally limited to a single year.
+type TravelStats struct {
+	Year              *int32                   `json:"year" extensions:"nullable"`
+	Trips             int32                    `json:"trips"`
+	Distances         []TransportationDistance `json:"distances"`
+	Flights           int32                    `json:"flights"`
+	FlightHours       float64                  `json:"flightHours"`
+	TrainHours        float64                  `json:"trainHours"`
+	TopAirports       []StatsCount             `json:"topAirports"`
+	TopAirlines       []StatsCount             `json:"topAirlines"`
+	TopAircraft       []StatsCount             `json:"topAircraft"`
+	TopTrainOperators []StatsCount             `json:"topTrainOperators"`
+}
+
+// TransportationDistance is the great-circle distance in kilometers travelled by one type of transportation.
+type TransportationDistance struct {
+	Type       TransportationType `json:"type"`
+	Kilometers float64            `json:"kilometers"`
+}
+
+type StatsCount struct {
+	Name  string `json:"name"`
+	Count int32  `json:"count"`
+}
@@ -0,0 +1,56 @@
+package entity
+
+// Changes contains everything of a trip that changed after a cursor. Entities are complete, clients replace their
+// cached copies. Passing the cursor to the next request returns only what changed in the meantime.
+type Changes struct {
+	Cursor         int64            `json:"cursor"`
+	Trip           *Trip            `json:"trip,omitempty" validate:"optional"`
+	Transportation []Transportation `json:"transportation"`
+	Activities     []Activity       `json:"activities"`
+	Accommodation  []Accommodation  `json:"accommodation"`
+	Expenses       []Expense        `json:"expenses"`
+	Attachments    []Attachment     `json:"attachments"`
+	Deleted        []Tombstone      `json:"deleted"`
+}
+
+// Redacted hides the sensitive descriptions of the changed activities and accommodation.
+func (c Changes) Redacted() Changes {
+	activities := make([]Activity, len(c.Activities))
+	for i, activity := range c.Activities {
+		activities[i] = activity.Redacted()
+	}
+	accommodation := make([]Accommodation, len(c.Accommodation))
+	for i, a := range c.Accommodation {
+		accommodation[i] = a.Redacted()
+	}
+	c.Activities = activities
+	c.Accommodation = accommodation
+	return c
+}
+
+// Tombstone records that an entity of a trip was deleted at a revision.
+type Tombstone struct {
+	Kind     EntityKind `json:"kind"`
+	ID       int32      `json:"id"`
+	Revision int64      `json:"revision"`
+}
+
+// ChangeStatus is the outcome of applying a single change pushed by a client.
+type ChangeStatus string
+
+const (
+	// CHANGE_APPLIED changes were written, or in case of deletions the entity was already gone.
+	CHANGE_APPLIED ChangeStatus = "applied"
+	// CHANGE_CONFLICT changes were based on an outdated revision or target an entity that was deleted.
+	CHANGE_CONFLICT ChangeStatus = "conflict"
+	// CHANGE_REJECTED changes are invalid and will never apply.
+	CHANGE_REJECTED ChangeStatus = "rejected"
+)
+
+type ChangeResult struct {
+	Status   ChangeStatus `json:"status"`
+	Kind     EntityKind   `json:"kind"`
+	ID       *int32       `json:"id" extensions:"nullable"`
+	Revision *int64       `json:"revision" extensions:"nullable"`
+	Error    *string      `json:"error,omitempty" validate:"optional"`
+}
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"cloud.google.com/go/civil"
+)
+
+// TrackDisplay determines whether the planned route, the recorded track or both are shown on the map.
+type TrackDisplay string
+
+const (
+	PLANNED TrackDisplay = "PLANNED"
+	ACTUAL  TrackDisplay = "ACTUAL"
+	BOTH    TrackDisplay = "BOTH"
+)
+
+func (d TrackDisplay) IsValid() bool {
+	return d == PLANNED || d == ACTUAL || d == BOTH
+}
+
+type Track struct {
+	Display               TrackDisplay    `json:"display"`
+	DistanceInMeters      float64         `json:"distanceInMeters"`
+	ElevationGainInMeters *float64        `json:"elevationGainInMeters" extensions:"nullable"`
+	StartDateTime         *civil.DateTime `json:"startDateTime" extensions:"nullable"`
+	EndDateTime           *civil.DateTime `json:"endDateTime" extensions:"nullable"`
+}
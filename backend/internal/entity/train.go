@@ -1,6 +1,10 @@
 package entity
 
-import "cloud.google.com/go/civil"
+import (
+	"strings"
+
+	"cloud.google.com/go/civil"
+)
 
 type TrainStation struct {
 	ID       string   `json:"id"`
@@ -9,17 +13,28 @@ type TrainStation struct {
 }
 
 type TrainLeg struct {
-	ID                int32          `json:"id"`
-	Origin            TrainStation   `json:"origin"`
-	Destination       TrainStation   `json:"destination"`
-	DepartureDateTime civil.DateTime `json:"departureDateTime"`
-	ArrivalDateTime   civil.DateTime `json:"arrivalDateTime"`
-	DurationInMinutes int32          `json:"durationInMinutes"`
-	LineName          string         `json:"lineName"`
-	OperatorName      string         `json:"operatorName"`
+	ID                 int32          `json:"id"`
+	Origin             TrainStation   `json:"origin"`
+	Destination        TrainStation   `json:"destination"`
+	DepartureDateTime  civil.DateTime `json:"departureDateTime"`
+	ArrivalDateTime    civil.DateTime `json:"arrivalDateTime"`
+	DepartureUtcOffset *string        `json:"departureUtcOffset" extensions:"nullable"`
+	ArrivalUtcOffset   *string        `json:"arrivalUtcOffset" extensions:"nullable"`
+	DurationInMinutes  int32          `json:"durationInMinutes"`
+	LineName           string         `json:"lineName"`
+	OperatorName       string         `json:"operatorName"`
 }
 
 type TrainDetail struct {
 	RefreshToken string     `json:"refreshToken"`
 	Legs         []TrainLeg `json:"legs"`
 }
+
+// Name lists the line names of the legs, it names the train journey if it is kept as generic transportation.
+func (d TrainDetail) Name() string {
+	lineNames := []string{}
+	for _, leg := range d.Legs {
+		lineNames = append(lineNames, leg.LineName)
+	}
+	return strings.Join(lineNames, ", ")
+}
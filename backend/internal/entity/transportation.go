@@ -23,17 +23,26 @@ func (t TransportationType) String() string {
 }
 
 type Transportation struct {
-	ID                int32              `json:"id"`
-	TripID            int32              `json:"tripId"`
-	Type              TransportationType `json:"type"`
-	Origin            Location           `json:"origin"`
-	Destination       Location           `json:"destination"`
-	DepartureDateTime civil.DateTime     `json:"departureDateTime"`
-	ArrivalDateTime   civil.DateTime     `json:"arrivalDateTime"`
-	Price             *int32             `json:"price" extensions:"nullable"`
-	FlightDetail      *FlightDetail      `json:"flightDetail,omitempty" validate:"optional" extensions:"nullable"`
-	TrainDetail       *TrainDetail       `json:"trainDetail,omitempty" validate:"optional" extensions:"nullable"`
-	GenericDetail     *GenericDetail     `json:"genericDetail,omitempty" validate:"optional" extensions:"nullable"`
+	ID                 int32                `json:"id"`
+	TripID             int32                `json:"tripId"`
+	Revision           int64                `json:"revision"`
+	Type               TransportationType   `json:"type"`
+	Origin             Location             `json:"origin"`
+	Destination        Location             `json:"destination"`
+	DepartureDateTime  civil.DateTime       `json:"departureDateTime"`
+	ArrivalDateTime    civil.DateTime       `json:"arrivalDateTime"`
+	DepartureUtcOffset *string              `json:"departureUtcOffset" extensions:"nullable"`
+	ArrivalUtcOffset   *string              `json:"arrivalUtcOffset" extensions:"nullable"`
+	Price              *int32               `json:"price" extensions:"nullable"`
+	Currency           string               `json:"currency" example:"EUR"`
+	FlightDetail       *FlightDetail        `json:"flightDetail,omitempty" validate:"optional" extensions:"nullable"`
+	TrainDetail        *TrainDetail         `json:"trainDetail,omitempty" validate:"optional" extensions:"nullable"`
+	GenericDetail      *GenericDetail       `json:"genericDetail,omitempty" validate:"optional" extensions:"nullable"`
+	Attachments        []AttachmentMetadata `json:"attachments"`
+	Track              *Track               `json:"track,omitempty" validate:"optional" extensions:"nullable"`
+	BudgetAlert        *BudgetAlert         `json:"budgetAlert,omitempty" validate:"optional" extensions:"nullable"`
+	// Co2e is the estimated emission in kg CO2e per passenger, nil if there is no emission factor for the type.
+	Co2e *float64 `json:"co2e,omitempty" validate:"optional" extensions:"nullable"`
 }
 
 type GenericDetail struct {
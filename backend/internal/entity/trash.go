@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// TrashItem is a trip or an entity of a trip that was deleted and can be restored until it is purged. Entities of a
+// deleted trip are restored with the trip and are not listed separately.
+type TrashItem struct {
+	Kind      EntityKind `json:"kind"`
+	ID        int32      `json:"id"`
+	TripID    int32      `json:"tripId"`
+	Name      string     `json:"name"`
+	DeletedAt time.Time  `json:"deletedAt"`
+	PurgeAt   *time.Time `json:"purgeAt" extensions:"nullable"`
+}
@@ -1,15 +1,50 @@
 package entity
 
 import (
+	"fmt"
+
 	"cloud.google.com/go/civil"
 )
 
 type Trip struct {
-	ID          int32      `json:"id"`
-	OwnerID     int32      `json:"owner_id"`
-	Name        string     `json:"name"`
-	StartDate   civil.Date `json:"startDate"`
-	EndDate     civil.Date `json:"endDate"`
-	Description *string    `json:"description" extensions:"nullable"`
-	ImageUrl    *string    `json:"imageUrl" extensions:"nullable"`
+	ID           int32      `json:"id"`
+	Revision     int64      `json:"revision"`
+	OwnerID      int32      `json:"owner_id"`
+	Name         string     `json:"name"`
+	StartDate    civil.Date `json:"startDate"`
+	EndDate      civil.Date `json:"endDate"`
+	Description  *string    `json:"description" extensions:"nullable"`
+	ImageUrl     *string    `json:"imageUrl" extensions:"nullable"`
+	HomeCurrency string     `json:"homeCurrency" example:"EUR"`
+	Budget       *float64   `json:"budget" extensions:"nullable"`
+	Template     bool       `json:"template"`
+}
+
+// DateChange determines how the entities of a trip are handled when the dates of the trip change. VALIDATE_DATES
+// rejects the change if entities would be outside of the new dates. SHIFT_DATES moves the entities by the days the
+// start date moved, which only fails if the trip gets too short for them.
+type DateChange string
+
+const (
+	VALIDATE_DATES DateChange = "VALIDATE"
+	SHIFT_DATES    DateChange = "SHIFT"
+)
+
+func (d DateChange) IsValid() bool {
+	return d == VALIDATE_DATES || d == SHIFT_DATES
+}
+
+// OutOfRangeEntity is an entity that would be outside of the dates of its trip. The dates are those after the change.
+type OutOfRangeEntity struct {
+	Kind      EntityKind `json:"kind"`
+	ID        int32      `json:"id"`
+	Name      string     `json:"name"`
+	StartDate civil.Date `json:"startDate"`
+	EndDate   civil.Date `json:"endDate"`
+}
+
+type ErrDatesOutOfRange []OutOfRangeEntity
+
+func (e ErrDatesOutOfRange) Error() string {
+	return fmt.Sprintf("%d entities are outside of the trip dates", len(e))
 }
@@ -92,8 +92,8 @@ func (a *AmadeusWebAPI) mapDatedFlight(flightLeg legOfDatedFlight) (entity.Fligh
 	)
 
 	return entity.FlightLeg{
-		Origin:            originAirport.Airport,
-		Destination:       destinationAirport.Airport,
+		Origin:            withTimezone(originAirport),
+		Destination:       withTimezone(destinationAirport),
 		Airline:           airlineName,
 		FlightNumber:      flightNumber,
 		DepartureDateTime: departureDateTime,
@@ -118,6 +118,14 @@ func (a *AmadeusWebAPI) lookupAirports(leg Leg) (entity.AirportWithTimezone, ent
 	return originAirport, destinationAirport, nil
 }
 
+func withTimezone(airport entity.AirportWithTimezone) entity.Airport {
+	result := airport.Airport
+	if airport.Timezone != "" {
+		result.Location.Timezone = &airport.Timezone
+	}
+	return result
+}
+
 func determineTimestamps(
 	flightLeg legOfDatedFlight, originAirport entity.AirportWithTimezone, destinationAirport entity.AirportWithTimezone, duration goiso8601duration.Duration,
 ) (civil.DateTime, civil.DateTime, error) {
@@ -0,0 +1,80 @@
+// Package blobstorage stores attachment contents outside of postgres.
+package blobstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Filesystem stores every blob in its own file below root, sharded by the first two characters of the key.
+type Filesystem struct {
+	root string
+}
+
+func NewFilesystem(root string) (*Filesystem, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("create storage directory: %w", err)
+	}
+	return &Filesystem{root: root}, nil
+}
+
+func (f *Filesystem) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := os.Stat(f.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Put writes to a temporary file first, so that partially written blobs are never visible.
+func (f *Filesystem) Put(ctx context.Context, key string, size int64, content io.Reader) error {
+	path := f.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("create directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), key+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	written, err := io.Copy(tmp, content)
+	if err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+	if written != size {
+		return fmt.Errorf("write file: expected %d bytes but got %d", size, written)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close file: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (f *Filesystem) Open(ctx context.Context, key string, size int64) (io.ReadSeekCloser, error) {
+	file, err := os.Open(f.path(key))
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+func (f *Filesystem) Delete(ctx context.Context, key string) error {
+	err := os.Remove(f.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (f *Filesystem) path(key string) string {
+	return filepath.Join(f.root, key[:2], key)
+}
@@ -0,0 +1,99 @@
+// Package boundary resolves the timezone and country of coordinates without calling out to a web api.
+package boundary
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ringsaturn/tzf"
+)
+
+// zoneTab is the zone.tab of tzdb 2025b. It assigns exactly one country to every timezone and is used to derive
+// the country from the timezone, as timezone-boundary-builder follows country borders.
+//
+//go:embed zone.tab
+var zoneTab string
+
+// Boundaries looks up coordinates in the timezone boundaries of timezone-boundary-builder, which are embedded
+// together with a spatial index by tzf. The dataset is loaded on first use, as this takes about a second.
+type Boundaries struct {
+	once      sync.Once
+	finder    tzf.F
+	countries map[string]string
+	err       error
+}
+
+func New() *Boundaries {
+	return &Boundaries{}
+}
+
+// TimezoneAt returns the IANA timezone at the coordinates. At sea, the nautical zone like "Etc/GMT-1" is returned.
+func (b *Boundaries) TimezoneAt(latitude float32, longitude float32) (string, error) {
+	if err := b.load(); err != nil {
+		return "", err
+	}
+	if err := validateCoordinates(latitude, longitude); err != nil {
+		return "", err
+	}
+
+	timezone := b.finder.GetTimezoneName(float64(longitude), float64(latitude))
+	if timezone == "" {
+		return "", fmt.Errorf("no timezone found [lat=%f, lon=%f]", latitude, longitude)
+	}
+	return timezone, nil
+}
+
+// CountryAt returns the ISO 3166-1 alpha-2 code of the country at the coordinates, or an empty string if they are
+// not within any country, e.g. at sea.
+//
+// There are no country polygons embedded, the country is the one zone.tab assigns to the timezone at the coordinates.
+// This is exact as long as a timezone boundary follows the border, which timezone-boundary-builder does for most
+// countries including micro states. It is wrong where a timezone covers parts of several countries, e.g. enclaves
+// too small to survive the simplification of the boundaries like Baarle-Hertog, which is reported as NL instead of BE,
+// and disputed areas, which get the country of their tzdb zone.
+func (b *Boundaries) CountryAt(latitude float32, longitude float32) (string, error) {
+	timezone, err := b.TimezoneAt(latitude, longitude)
+	if err != nil {
+		return "", err
+	}
+	return b.countries[timezone], nil
+}
+
+func (b *Boundaries) load() error {
+	b.once.Do(func() {
+		b.countries = parseZoneTab(zoneTab)
+		b.finder, b.err = tzf.NewDefaultFinder()
+		if b.err != nil {
+			b.err = fmt.Errorf("load timezone boundaries: %w", b.err)
+		}
+	})
+	return b.err
+}
+
+func parseZoneTab(data string) map[string]string {
+	countries := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		// country code, coordinates, timezone and optional comments
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			continue
+		}
+		countries[fields[2]] = fields[0]
+	}
+	return countries
+}
+
+func validateCoordinates(latitude float32, longitude float32) error {
+	if latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+		return fmt.Errorf("invalid coordinates [lat=%f, lon=%f]", latitude, longitude)
+	}
+	return nil
+}
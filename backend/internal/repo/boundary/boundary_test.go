@@ -0,0 +1,33 @@
+package boundary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountryAt(t *testing.T) {
+	boundaries := New()
+	tests := []struct {
+		name      string
+		latitude  float32
+		longitude float32
+		country   string
+	}{
+		{"country", -41.29439, 174.00670, "NZ"},
+		{"micro state", 47.14, 9.52, "LI"},
+		{"enclave with own timezone", 47.6967, 8.6903, "DE"},
+		{"sea", 54.0, 3.0, ""},
+		// limitation: the country is derived from the timezone, which Baarle-Hertog (BE) shares with NL
+		{"enclave without own timezone", 51.4393, 4.9283, "NL"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			country, err := boundaries.CountryAt(test.latitude, test.longitude)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.country, country)
+		})
+	}
+}
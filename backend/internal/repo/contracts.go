@@ -6,9 +6,11 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"github.com/paulmach/orb/geojson"
+	"io"
 	"kompass/internal/controller/http/v1/request"
 	"kompass/internal/entity"
 	"kompass/pkg/sqlc"
+	"time"
 )
 
 //go:generate mockgen -source=contracts.go -destination=../usecase/mocks_repo_test.go -package=usecase_test
@@ -18,56 +20,138 @@ type (
 		GetUsers(ctx context.Context) ([]entity.User, error)
 		GetUserByID(ctx context.Context, id int32) (entity.User, error)
 		GetUserByJwtSub(ctx context.Context, sub uuid.UUID) (entity.User, error)
+		GetTripMembers(ctx context.Context, tripID int32) ([]entity.User, error)
 		CreateUser(ctx context.Context, user entity.User) (entity.User, error)
 		HasReadPermission(ctx context.Context, userID, tripID int32) (bool, error)
 		HasWritePermission(ctx context.Context, userID, tripID int32) (bool, error)
+		HasReadSensitivePermission(ctx context.Context, userID, tripID int32) (bool, error)
 		IsTripOwner(ctx context.Context, userID int32, tripID int32) (bool, error)
 	}
 
 	TripsRepo interface {
 		GetTrips(ctx context.Context, userID int32) ([]entity.Trip, error)
 		GetTripByID(ctx context.Context, id int32) (entity.Trip, error)
+		GetTemplates(ctx context.Context) ([]entity.Trip, error)
 		CreateTrip(ctx context.Context, trip entity.Trip) (entity.Trip, error)
-		UpdateTrip(ctx context.Context, trip entity.Trip) error
-		DeleteTrip(ctx context.Context, tripID int32) error
+		UpdateTrip(ctx context.Context, trip entity.Trip, revision *int64) error
+		ShiftTrip(ctx context.Context, trip entity.Trip, days int32, revision *int64) (map[entity.EntityKind][]int32, error)
+		GetDatedEntityIDs(ctx context.Context, tripID int32) (map[entity.EntityKind][]int32, error)
+		GetEntitiesOutOfRange(ctx context.Context, tripID int32, days int32, startDate civil.Date, endDate civil.Date) ([]entity.OutOfRangeEntity, error)
+		DeleteTrip(ctx context.Context, tripID int32, revision *int64) error
 	}
 
 	TransportationRepo interface {
 		GetAllTransportation(ctx context.Context, tripID int32) ([]entity.Transportation, error)
+		GetAllTransportationSince(ctx context.Context, tripID int32, since int64) ([]entity.Transportation, error)
 		GetTransportationByID(ctx context.Context, tripID int32, id int32) (entity.Transportation, error)
-		SaveTransportation(ctx context.Context, transportation entity.Transportation) (entity.Transportation, error)
-		DeleteTransportation(ctx context.Context, tripID int32, flightID int32) error
+		SaveTransportation(ctx context.Context, transportation entity.Transportation, revision *int64) (entity.Transportation, error)
+		DeleteTransportation(ctx context.Context, tripID int32, flightID int32, revision *int64) error
 		GetAllGeoJson(ctx context.Context, tripID int32) ([]geojson.FeatureCollection, error)
+		GetRouteGeoJson(ctx context.Context, tripID int32, transportationIDs []int32) (map[int32]geojson.FeatureCollection, error)
 		SaveGeoJson(ctx context.Context, transportationID int32, geoJson *geojson.FeatureCollection) error
+		SaveTrack(ctx context.Context, transportationID int32, track entity.Track, geoJson *geojson.FeatureCollection) error
+		UpdateTrackDisplay(ctx context.Context, tripID int32, transportationID int32, display entity.TrackDisplay, revision *int64) error
+		DeleteTrack(ctx context.Context, tripID int32, transportationID int32, revision *int64) error
 	}
 
 	FlightsRepo interface {
-		GetFlightDetail(ctx context.Context, transportationID int32) (entity.FlightDetail, error)
-		CreateFlightDetail(ctx context.Context, qtx *sqlc.Queries, transportationID int32, flight entity.FlightDetail) error
+		GetFlightDetail(ctx context.Context, tripID int32, transportationID int32) (entity.FlightDetail, error)
+		CreateFlightDetail(ctx context.Context, qtx *sqlc.Queries, tripID int32, transportationID int32, flight entity.FlightDetail) error
 		UpdateFlightLegs(ctx context.Context, flightLegs []entity.FlightLeg) error
 	}
 
 	ActivitiesRepo interface {
 		GetActivities(ctx context.Context, tripID int32) ([]entity.Activity, error)
+		GetActivitiesSince(ctx context.Context, tripID int32, since int64) ([]entity.Activity, error)
 		GetActivityByID(ctx context.Context, tripID int32, activityID int32) (entity.Activity, error)
 		CreateActivity(ctx context.Context, activity entity.Activity) (entity.Activity, error)
-		UpdateActivity(ctx context.Context, activity entity.Activity) error
-		DeleteActivity(ctx context.Context, tripID int32, activityID int32) error
+		UpdateActivity(ctx context.Context, activity entity.Activity, revision *int64) error
+		DeleteActivity(ctx context.Context, tripID int32, activityID int32, revision *int64) error
 	}
 
 	AccommodationRepo interface {
 		GetAllAccommodation(ctx context.Context, tripID int32) ([]entity.Accommodation, error)
+		GetAllAccommodationSince(ctx context.Context, tripID int32, since int64) ([]entity.Accommodation, error)
 		GetAccommodationByID(ctx context.Context, tripID int32, id int32) (entity.Accommodation, error)
 		CreateAccommodation(ctx context.Context, accommodation entity.Accommodation) (entity.Accommodation, error)
-		UpdateAccommodation(ctx context.Context, accommodation entity.Accommodation) error
-		DeleteAccommodation(ctx context.Context, tripID int32, accommodationID int32) error
+		UpdateAccommodation(ctx context.Context, accommodation entity.Accommodation, revision *int64) error
+		DeleteAccommodation(ctx context.Context, tripID int32, accommodationID int32, revision *int64) error
+	}
+
+	ExpensesRepo interface {
+		GetExpenses(ctx context.Context, tripID int32) ([]entity.Expense, error)
+		GetExpensesSince(ctx context.Context, tripID int32, since int64) ([]entity.Expense, error)
+		GetExpenseByID(ctx context.Context, tripID int32, expenseID int32) (entity.Expense, error)
+		CreateExpense(ctx context.Context, expense entity.Expense) (entity.Expense, error)
+		UpdateExpense(ctx context.Context, expense entity.Expense, revision *int64) error
+		DeleteExpense(ctx context.Context, tripID int32, expenseID int32, revision *int64) error
+	}
+
+	BudgetsRepo interface {
+		GetBudget(ctx context.Context, tripID int32) (entity.Budget, error)
+		SaveBudget(ctx context.Context, tripID int32, budget entity.Budget) error
+	}
+
+	ExchangeRatesRepo interface {
+		SaveExchangeRates(ctx context.Context, rates []entity.ExchangeRate) error
+		GetLatestExchangeRateDate(ctx context.Context) (*civil.Date, error)
+		GetExchangeRate(ctx context.Context, currency string, date civil.Date) (*entity.ExchangeRate, error)
+	}
+
+	EventsRepo interface {
+		Publish(ctx context.Context, event entity.TripEvent) error
+		Listen(ctx context.Context, handle func(entity.TripEvent)) error
+	}
+
+	HistoryRepo interface {
+		AddHistoryEntry(ctx context.Context, entry entity.HistoryEntry) error
+		GetHistory(ctx context.Context, tripID int32, filter request.HistoryFilter) ([]entity.HistoryEntry, error)
+		GetHistoryEntry(ctx context.Context, tripID int32, entryID int32) (entity.HistoryEntry, error)
+	}
+
+	SyncRepo interface {
+		GetCursor(ctx context.Context, tripID int32) (int64, error)
+		GetTombstones(ctx context.Context, tripID int32, since int64) ([]entity.Tombstone, error)
+		ClaimClientID(ctx context.Context, tripID int32, clientID string, kind entity.EntityKind) (bool, error)
+		GetCreation(ctx context.Context, tripID int32, clientID string) (entity.EntityKind, *int32, error)
+		SetCreatedID(ctx context.Context, tripID int32, clientID string, id int32) error
+		ReleaseClientID(ctx context.Context, tripID int32, clientID string) error
+	}
+
+	TrashRepo interface {
+		GetTrash(ctx context.Context, userID int32, tripID *int32) ([]entity.TrashItem, error)
+		RestoreTrip(ctx context.Context, userID int32, tripID int32) error
+		Restore(ctx context.Context, tripID int32, kind entity.EntityKind, id int32) error
+		Purge(ctx context.Context, before time.Time) (int64, error)
 	}
 
 	AttachmentsRepo interface {
 		GetAttachments(ctx context.Context, tripID int32) ([]entity.Attachment, error)
+		GetAttachmentsSince(ctx context.Context, tripID int32, since int64) ([]entity.Attachment, error)
 		GetAttachmentByID(ctx context.Context, tripID int32, attachmentID int32) (entity.Attachment, error)
-		CreateAttachment(ctx context.Context, attachment entity.Attachment) (entity.Attachment, error)
-		DeleteAttachment(ctx context.Context, tripID int32, attachmentID int32) error
+		CreateAttachment(ctx context.Context, attachment entity.Attachment, content io.Reader) (entity.Attachment, error)
+		OpenAttachment(ctx context.Context, tripID int32, attachmentID int32) (entity.Attachment, io.ReadSeekCloser, error)
+		PresignAttachment(ctx context.Context, tripID int32, attachmentID int32, expiry time.Duration) (entity.AttachmentURL, error)
+		GetBlobs(ctx context.Context) ([]entity.AttachmentBlob, error)
+		GetThumbnail(ctx context.Context, tripID int32, attachmentID int32, size entity.ThumbnailSize) ([]byte, error)
+		SaveThumbnail(ctx context.Context, tripID int32, attachmentID int32, size entity.ThumbnailSize, thumbnail []byte) error
+		DeleteAttachment(ctx context.Context, tripID int32, attachmentID int32, revision *int64) error
+		LinkAttachment(ctx context.Context, tripID int32, attachmentID int32, target entity.AttachmentLinkTarget, targetID int32) error
+		UnlinkAttachment(ctx context.Context, tripID int32, attachmentID int32, target entity.AttachmentLinkTarget, targetID int32) error
+	}
+
+	// BlobStorage holds the content of attachments under a hex-encoded key, which is the SHA-256 hash of the content
+	// unless the attachment is encrypted.
+	BlobStorage interface {
+		Exists(ctx context.Context, key string) (bool, error)
+		Put(ctx context.Context, key string, size int64, content io.Reader) error
+		Open(ctx context.Context, key string, size int64) (io.ReadSeekCloser, error)
+		Delete(ctx context.Context, key string) error
+	}
+
+	// BlobPresigner is implemented by storage backends that can hand out temporary download URLs.
+	BlobPresigner interface {
+		PresignGet(ctx context.Context, key string, filename string, contentType string, expiry time.Duration) (string, error)
 	}
 
 	FlightInformationWebAPI interface {
@@ -82,9 +166,23 @@ type (
 
 	OpenRouteServiceWebAPI interface {
 		LookupLocation(ctx context.Context, query string) (entity.GeocodeLocation, error)
+		ReverseGeocode(ctx context.Context, latitude float32, longitude float32) (string, error)
 		LookupDirections(ctx context.Context, start entity.Location, end entity.Location, transportatinoType entity.TransportationType) (*geojson.FeatureCollection, error)
 	}
 
+	ExchangeRateProvider interface {
+		RetrieveExchangeRates(ctx context.Context) ([]entity.ExchangeRate, error)
+	}
+
+	BoundaryLookup interface {
+		TimezoneAt(latitude float32, longitude float32) (string, error)
+		CountryAt(latitude float32, longitude float32) (string, error)
+	}
+
+	EmissionFactorTable interface {
+		Factors() (entity.EmissionFactors, error)
+	}
+
 	IataLookup interface {
 		LookupAirport(iata string) (entity.AirportWithTimezone, error)
 		LookupAircraftName(iata string) (string, error)
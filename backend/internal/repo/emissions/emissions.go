@@ -0,0 +1,56 @@
+// Package emissions provides the emission factors used to estimate the CO2e of transportation.
+package emissions
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"kompass/internal/entity"
+	"os"
+	"sync"
+)
+
+// defaultFactors is based on the UK government conversion factors for company reporting. Flights include radiative
+// forcing, cars are per vehicle as the number of passengers is unknown.
+//
+//go:embed factors.json
+var defaultFactors []byte
+
+// Table reads the emission factors from the file at path, or the embedded defaults if path is empty. The file is
+// read on first use.
+type Table struct {
+	path    string
+	once    sync.Once
+	factors entity.EmissionFactors
+	err     error
+}
+
+func New(path string) *Table {
+	return &Table{path: path}
+}
+
+func (t *Table) Factors() (entity.EmissionFactors, error) {
+	t.once.Do(func() {
+		t.factors, t.err = t.load()
+	})
+	return t.factors, t.err
+}
+
+func (t *Table) load() (entity.EmissionFactors, error) {
+	data := defaultFactors
+	if t.path != "" {
+		var err error
+		if data, err = os.ReadFile(t.path); err != nil {
+			return entity.EmissionFactors{}, fmt.Errorf("read %s: %w", t.path, err)
+		}
+	}
+
+	factors := entity.EmissionFactors{}
+	if err := json.Unmarshal(data, &factors); err != nil {
+		return entity.EmissionFactors{}, fmt.Errorf("parse emission factors: %w", err)
+	}
+	if len(factors.Flight.Bands) == 0 {
+		return entity.EmissionFactors{}, fmt.Errorf("emission factors need at least one flight distance band")
+	}
+	return factors, nil
+}
@@ -0,0 +1,110 @@
+// Package exchangerates retrieves the euro foreign exchange reference rates published by the European Central Bank.
+package exchangerates
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"kompass/config"
+	"kompass/internal/entity"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+const dataset = "eurofxref-hist-90d.xml"
+
+// timeout bounds the download, as the stored rates are used while the ECB is unavailable
+const timeout = 10 * time.Second
+
+type ECB struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewECB(config config.WebApi) *ECB {
+	return &ECB{
+		baseURL: config.EcbBaseURL,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+func (e *ECB) RetrieveExchangeRates(ctx context.Context) ([]entity.ExchangeRate, error) {
+	datasetUrl, err := url.JoinPath(e.baseURL, dataset)
+	if err != nil {
+		return nil, fmt.Errorf("join path: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, datasetUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("download %s: %w", dataset, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad status: %s", resp.Status)
+	}
+
+	return parse(resp.Body)
+}
+
+// File reads the rates from a local copy of the dataset, for deployments without internet access.
+type File struct {
+	path string
+}
+
+func NewFile(path string) *File {
+	return &File{path: path}
+}
+
+func (f *File) RetrieveExchangeRates(_ context.Context) ([]entity.ExchangeRate, error) {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", f.path, err)
+	}
+	defer file.Close()
+
+	return parse(file)
+}
+
+type envelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string  `xml:"currency,attr"`
+			Rate     float64 `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+func parse(reader io.Reader) ([]entity.ExchangeRate, error) {
+	var data envelope
+	if err := xml.NewDecoder(reader).Decode(&data); err != nil {
+		return nil, fmt.Errorf("decode exchange rates: %w", err)
+	}
+
+	var rates []entity.ExchangeRate
+	for _, day := range data.Days {
+		date, err := civil.ParseDate(day.Time)
+		if err != nil {
+			return nil, fmt.Errorf("parse date %s: %w", day.Time, err)
+		}
+		for _, rate := range day.Rates {
+			rates = append(rates, entity.ExchangeRate{
+				Date:     date,
+				Currency: rate.Currency,
+				Rate:     rate.Rate,
+			})
+		}
+	}
+
+	return rates, nil
+}
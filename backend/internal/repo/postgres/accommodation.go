@@ -15,22 +15,40 @@ import (
 type AccommodationRepo struct {
 	Db      *pgxpool.Pool
 	Queries *sqlc.Queries
+	keys    *TripKeys
 }
 
-func NewAccommodationRepo(pg *postgres.Postgres) *AccommodationRepo {
+func NewAccommodationRepo(pg *postgres.Postgres, keys *TripKeys) *AccommodationRepo {
 	return &AccommodationRepo{
 		pg.Pool,
 		sqlc.New(pg.Pool),
+		keys,
 	}
 }
 
 func (r *AccommodationRepo) GetAllAccommodation(ctx context.Context, tripID int32) ([]entity.Accommodation, error) {
-	rows, err := r.Queries.GetAllAccommodation(ctx, tripID)
+	return r.GetAllAccommodationSince(ctx, tripID, 0)
+}
+
+// GetAllAccommodationSince returns the accommodation of the trip written after the given revision.
+func (r *AccommodationRepo) GetAllAccommodationSince(ctx context.Context, tripID int32, since int64) ([]entity.Accommodation, error) {
+	rows, err := r.Queries.GetAllAccommodation(ctx, sqlc.GetAllAccommodationParams{TripID: tripID, Since: since})
 	if err != nil {
 		return nil, fmt.Errorf("get all accommodation [tripID=%d]: %w", tripID, err)
 	}
 
-	return mapAllAccommodation(rows), nil
+	attachments, err := getAccommodationAttachments(ctx, r.Queries, tripID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := mapAllAccommodation(rows, attachments)
+	for i := range result {
+		if err := r.decryptDescription(ctx, &result[i]); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
 }
 
 func (r *AccommodationRepo) GetAccommodationByID(ctx context.Context, tripID int32, accommodationID int32) (entity.Accommodation, error) {
@@ -42,7 +60,13 @@ func (r *AccommodationRepo) GetAccommodationByID(ctx context.Context, tripID int
 		return entity.Accommodation{}, fmt.Errorf("get accommodation [accommodationID=%d]: %w", accommodationID, err)
 	}
 
-	return mapAccommodation(row.Accommodation, mapLocationLeftJoin(row.ID, row.Latitude, row.Longitude)), nil
+	attachments, err := getAccommodationAttachments(ctx, r.Queries, tripID, &accommodationID)
+	if err != nil {
+		return entity.Accommodation{}, err
+	}
+
+	accommodation := mapAccommodation(row.Accommodation, mapLocationLeftJoin(row.ID, row.Latitude, row.Longitude, row.Timezone), attachments[accommodationID])
+	return accommodation, r.decryptDescription(ctx, &accommodation)
 }
 
 func (r *AccommodationRepo) CreateAccommodation(ctx context.Context, accommodation entity.Accommodation) (entity.Accommodation, error) {
@@ -62,6 +86,11 @@ func (r *AccommodationRepo) CreateAccommodation(ctx context.Context, accommodati
 		locationId = &persistedLocationId
 	}
 
+	description, err := r.keys.encryptSensitive(ctx, qtx, accommodation.TripID, accommodation.Sensitive, accommodation.Description)
+	if err != nil {
+		return entity.Accommodation{}, fmt.Errorf("encrypt description: %w", err)
+	}
+
 	accommodationID, err := qtx.InsertAccommodation(ctx, sqlc.InsertAccommodationParams{
 		TripID:        accommodation.TripID,
 		LocationID:    locationId,
@@ -71,8 +100,10 @@ func (r *AccommodationRepo) CreateAccommodation(ctx context.Context, accommodati
 		CheckInTime:   accommodation.CheckInTime,
 		CheckOutTime:  accommodation.CheckOutTime,
 		Address:       accommodation.Address,
-		Description:   accommodation.Description,
+		Description:   description,
 		Price:         accommodation.Price,
+		Sensitive:     accommodation.Sensitive,
+		Currency:      currencyOrNil(accommodation.Currency),
 	})
 	if err != nil {
 		return entity.Accommodation{}, fmt.Errorf("insert accommodation: %w", err)
@@ -86,7 +117,7 @@ func (r *AccommodationRepo) CreateAccommodation(ctx context.Context, accommodati
 	return r.GetAccommodationByID(ctx, accommodation.TripID, accommodationID)
 }
 
-func (r *AccommodationRepo) UpdateAccommodation(ctx context.Context, accommodation entity.Accommodation) error {
+func (r *AccommodationRepo) UpdateAccommodation(ctx context.Context, accommodation entity.Accommodation, revision *int64) error {
 	tx, err := r.Db.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("begin tx: %w", err)
@@ -104,8 +135,14 @@ func (r *AccommodationRepo) UpdateAccommodation(ctx context.Context, accommodati
 		return fmt.Errorf("upsert location [existing=%d]: %w", existingLocationId, err)
 	}
 
-	err = qtx.UpdateAccommodation(ctx, sqlc.UpdateAccommodationParams{
+	description, err := r.keys.encryptSensitive(ctx, qtx, accommodation.TripID, accommodation.Sensitive, accommodation.Description)
+	if err != nil {
+		return fmt.Errorf("encrypt description: %w", err)
+	}
+
+	rows, err := qtx.UpdateAccommodation(ctx, sqlc.UpdateAccommodationParams{
 		ID:            accommodation.ID,
+		TripID:        accommodation.TripID,
 		LocationID:    locationId,
 		Name:          accommodation.Name,
 		ArrivalDate:   accommodation.ArrivalDate,
@@ -113,15 +150,18 @@ func (r *AccommodationRepo) UpdateAccommodation(ctx context.Context, accommodati
 		CheckInTime:   accommodation.CheckInTime,
 		CheckOutTime:  accommodation.CheckOutTime,
 		Address:       accommodation.Address,
-		Description:   accommodation.Description,
+		Description:   description,
 		Price:         accommodation.Price,
+		Sensitive:     accommodation.Sensitive,
+		Currency:      currencyOrNil(accommodation.Currency),
+		Revision:      revision,
 	})
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return fiber.NewError(fiber.StatusNotFound, "accommodation not found")
-		}
 		return fmt.Errorf("update accommodation [id=%d]: %w", accommodation.ID, err)
 	}
+	if rows == 0 {
+		return notWritten("accommodation", revision)
+	}
 
 	err = tx.Commit(ctx)
 	if err != nil {
@@ -131,37 +171,53 @@ func (r *AccommodationRepo) UpdateAccommodation(ctx context.Context, accommodati
 	return nil
 }
 
-func (r *AccommodationRepo) DeleteAccommodation(ctx context.Context, tripID int32, accommodationID int32) error {
-	_, err := r.Queries.DeleteAccommodationByID(ctx, sqlc.DeleteAccommodationByIDParams{TripID: tripID, ID: accommodationID})
+func (r *AccommodationRepo) DeleteAccommodation(ctx context.Context, tripID int32, accommodationID int32, revision *int64) error {
+	_, err := r.Queries.TrashAccommodationByID(ctx, sqlc.TrashAccommodationByIDParams{TripID: tripID, ID: accommodationID, Revision: revision})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return fiber.NewError(fiber.StatusNotFound, "accommodation not found")
+			return notWritten("accommodation", revision)
 		}
 		return fmt.Errorf("delete accommodation: %w", err)
 	}
 	return nil
 }
 
-func mapAllAccommodation(accommodation []sqlc.GetAllAccommodationRow) []entity.Accommodation {
+// decryptDescription is applied after mapping, because the data key depends on the trip.
+func (r *AccommodationRepo) decryptDescription(ctx context.Context, accommodation *entity.Accommodation) error {
+	description, err := r.keys.decryptSensitive(ctx, r.Queries, accommodation.TripID, accommodation.Sensitive, accommodation.Description)
+	if err != nil {
+		return fmt.Errorf("decrypt description of accommodation [id=%d]: %w", accommodation.ID, err)
+	}
+	accommodation.Description = description
+	return nil
+}
+
+func mapAllAccommodation(accommodation []sqlc.GetAllAccommodationRow, attachments map[int32][]entity.AttachmentMetadata) []entity.Accommodation {
 	result := []entity.Accommodation{}
 	for _, row := range accommodation {
-		result = append(result, mapAccommodation(row.Accommodation, mapLocationLeftJoin(row.ID, row.Latitude, row.Longitude)))
+		result = append(result, mapAccommodation(row.Accommodation, mapLocationLeftJoin(row.ID, row.Latitude, row.Longitude, row.Timezone), attachments[row.Accommodation.ID]))
 	}
 	return result
 }
 
-func mapAccommodation(accommodation sqlc.Accommodation, location *entity.Location) entity.Accommodation {
+func mapAccommodation(accommodation sqlc.Accommodation, location *entity.Location, attachments []entity.AttachmentMetadata) entity.Accommodation {
 	return entity.Accommodation{
-		ID:            accommodation.ID,
-		TripID:        accommodation.TripID,
-		Name:          accommodation.Name,
-		ArrivalDate:   accommodation.ArrivalDate,
-		DepartureDate: accommodation.DepartureDate,
-		CheckInTime:   accommodation.CheckInTime,
-		CheckOutTime:  accommodation.CheckOutTime,
-		Address:       accommodation.Address,
-		Description:   accommodation.Description,
-		Price:         accommodation.Price,
-		Location:      location,
+		ID:                accommodation.ID,
+		TripID:            accommodation.TripID,
+		Revision:          accommodation.Revision,
+		Name:              accommodation.Name,
+		ArrivalDate:       accommodation.ArrivalDate,
+		DepartureDate:     accommodation.DepartureDate,
+		CheckInTime:       accommodation.CheckInTime,
+		CheckOutTime:      accommodation.CheckOutTime,
+		CheckInUtcOffset:  utcOffsetAt(location, accommodation.ArrivalDate, accommodation.CheckInTime),
+		CheckOutUtcOffset: utcOffsetAt(location, accommodation.DepartureDate, accommodation.CheckOutTime),
+		Address:           accommodation.Address,
+		Description:       accommodation.Description,
+		Sensitive:         accommodation.Sensitive,
+		Price:             accommodation.Price,
+		Currency:          accommodation.Currency,
+		Location:          location,
+		Attachments:       attachmentsOrEmpty(attachments),
 	}
 }
@@ -15,22 +15,40 @@ import (
 type ActivitiesRepo struct {
 	Db      *pgxpool.Pool
 	Queries *sqlc.Queries
+	keys    *TripKeys
 }
 
-func NewActivitiesRepo(pg *postgres.Postgres) *ActivitiesRepo {
+func NewActivitiesRepo(pg *postgres.Postgres, keys *TripKeys) *ActivitiesRepo {
 	return &ActivitiesRepo{
 		pg.Pool,
 		sqlc.New(pg.Pool),
+		keys,
 	}
 }
 
 func (r *ActivitiesRepo) GetActivities(ctx context.Context, tripID int32) ([]entity.Activity, error) {
-	activities, err := r.Queries.GetActivities(ctx, tripID)
+	return r.GetActivitiesSince(ctx, tripID, 0)
+}
+
+// GetActivitiesSince returns the activities of the trip written after the given revision.
+func (r *ActivitiesRepo) GetActivitiesSince(ctx context.Context, tripID int32, since int64) ([]entity.Activity, error) {
+	activities, err := r.Queries.GetActivities(ctx, sqlc.GetActivitiesParams{TripID: tripID, Since: since})
 	if err != nil {
 		return nil, fmt.Errorf("get all activities [tripID=%d]: %w", tripID, err)
 	}
 
-	return mapActivities(activities), nil
+	attachments, err := getActivityAttachments(ctx, r.Queries, tripID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := mapActivities(activities, attachments)
+	for i := range result {
+		if err := r.decryptDescription(ctx, &result[i]); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
 }
 
 func (r *ActivitiesRepo) GetActivityByID(ctx context.Context, tripID int32, activityID int32) (entity.Activity, error) {
@@ -42,7 +60,13 @@ func (r *ActivitiesRepo) GetActivityByID(ctx context.Context, tripID int32, acti
 		return entity.Activity{}, fmt.Errorf("get activity [id=%d]: %w", activityID, err)
 	}
 
-	return mapActivity(row.Activity, mapLocationLeftJoin(row.ID, row.Latitude, row.Longitude)), nil
+	attachments, err := getActivityAttachments(ctx, r.Queries, tripID, &activityID)
+	if err != nil {
+		return entity.Activity{}, err
+	}
+
+	activity := mapActivity(row.Activity, mapLocationLeftJoin(row.ID, row.Latitude, row.Longitude, row.Timezone), attachments[activityID])
+	return activity, r.decryptDescription(ctx, &activity)
 }
 
 func (r *ActivitiesRepo) CreateActivity(ctx context.Context, activity entity.Activity) (entity.Activity, error) {
@@ -62,6 +86,11 @@ func (r *ActivitiesRepo) CreateActivity(ctx context.Context, activity entity.Act
 		locationId = &persistedLocationId
 	}
 
+	description, err := r.keys.encryptSensitive(ctx, qtx, activity.TripID, activity.Sensitive, activity.Description)
+	if err != nil {
+		return entity.Activity{}, fmt.Errorf("encrypt description: %w", err)
+	}
+
 	activityId, err := qtx.InsertActivity(ctx, sqlc.InsertActivityParams{
 		TripID:      activity.TripID,
 		LocationID:  locationId,
@@ -69,8 +98,10 @@ func (r *ActivitiesRepo) CreateActivity(ctx context.Context, activity entity.Act
 		Date:        activity.Date,
 		Time:        activity.Time,
 		Address:     activity.Address,
-		Description: activity.Description,
+		Description: description,
 		Price:       activity.Price,
+		Sensitive:   activity.Sensitive,
+		Currency:    currencyOrNil(activity.Currency),
 	})
 	if err != nil {
 		return entity.Activity{}, fmt.Errorf("insert activity: %w", err)
@@ -84,7 +115,7 @@ func (r *ActivitiesRepo) CreateActivity(ctx context.Context, activity entity.Act
 	return r.GetActivityByID(ctx, activity.TripID, activityId)
 }
 
-func (r *ActivitiesRepo) UpdateActivity(ctx context.Context, activity entity.Activity) error {
+func (r *ActivitiesRepo) UpdateActivity(ctx context.Context, activity entity.Activity, revision *int64) error {
 	tx, err := r.Db.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("begin tx: %w", err)
@@ -102,22 +133,31 @@ func (r *ActivitiesRepo) UpdateActivity(ctx context.Context, activity entity.Act
 		return fmt.Errorf("upsert location [existing=%d]: %w", existingLocationId, err)
 	}
 
-	err = qtx.UpdateActivity(ctx, sqlc.UpdateActivityParams{
+	description, err := r.keys.encryptSensitive(ctx, qtx, activity.TripID, activity.Sensitive, activity.Description)
+	if err != nil {
+		return fmt.Errorf("encrypt description: %w", err)
+	}
+
+	rows, err := qtx.UpdateActivity(ctx, sqlc.UpdateActivityParams{
 		ID:          activity.ID,
+		TripID:      activity.TripID,
 		LocationID:  locationId,
 		Name:        activity.Name,
 		Date:        activity.Date,
 		Time:        activity.Time,
 		Address:     activity.Address,
-		Description: activity.Description,
+		Description: description,
 		Price:       activity.Price,
+		Sensitive:   activity.Sensitive,
+		Currency:    currencyOrNil(activity.Currency),
+		Revision:    revision,
 	})
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return fiber.NewError(fiber.StatusNotFound, "activity not found")
-		}
 		return fmt.Errorf("update activity [id=%d]: %w", activity.ID, err)
 	}
+	if rows == 0 {
+		return notWritten("activity", revision)
+	}
 
 	err = tx.Commit(ctx)
 	if err != nil {
@@ -127,35 +167,50 @@ func (r *ActivitiesRepo) UpdateActivity(ctx context.Context, activity entity.Act
 	return nil
 }
 
-func (r *ActivitiesRepo) DeleteActivity(ctx context.Context, tripID int32, activityID int32) error {
-	_, err := r.Queries.DeleteActivityByID(ctx, sqlc.DeleteActivityByIDParams{TripID: tripID, ID: activityID})
+func (r *ActivitiesRepo) DeleteActivity(ctx context.Context, tripID int32, activityID int32, revision *int64) error {
+	_, err := r.Queries.TrashActivityByID(ctx, sqlc.TrashActivityByIDParams{TripID: tripID, ID: activityID, Revision: revision})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return fiber.NewError(fiber.StatusNotFound, "activity not found")
+			return notWritten("activity", revision)
 		}
 		return fmt.Errorf("delete activity: %w", err)
 	}
 	return nil
 }
 
-func mapActivities(rows []sqlc.GetActivitiesRow) []entity.Activity {
+// decryptDescription is applied after mapping, because the data key depends on the trip.
+func (r *ActivitiesRepo) decryptDescription(ctx context.Context, activity *entity.Activity) error {
+	description, err := r.keys.decryptSensitive(ctx, r.Queries, activity.TripID, activity.Sensitive, activity.Description)
+	if err != nil {
+		return fmt.Errorf("decrypt description of activity [id=%d]: %w", activity.ID, err)
+	}
+	activity.Description = description
+	return nil
+}
+
+func mapActivities(rows []sqlc.GetActivitiesRow, attachments map[int32][]entity.AttachmentMetadata) []entity.Activity {
 	result := []entity.Activity{}
 	for _, row := range rows {
-		result = append(result, mapActivity(row.Activity, mapLocationLeftJoin(row.ID, row.Latitude, row.Longitude)))
+		result = append(result, mapActivity(row.Activity, mapLocationLeftJoin(row.ID, row.Latitude, row.Longitude, row.Timezone), attachments[row.Activity.ID]))
 	}
 	return result
 }
 
-func mapActivity(activity sqlc.Activity, location *entity.Location) entity.Activity {
+func mapActivity(activity sqlc.Activity, location *entity.Location, attachments []entity.AttachmentMetadata) entity.Activity {
 	return entity.Activity{
 		ID:          activity.ID,
 		TripID:      activity.TripID,
+		Revision:    activity.Revision,
 		Name:        activity.Name,
 		Date:        activity.Date,
 		Time:        activity.Time,
+		UtcOffset:   utcOffsetAt(location, activity.Date, activity.Time),
 		Description: activity.Description,
+		Sensitive:   activity.Sensitive,
 		Address:     activity.Address,
 		Location:    location,
 		Price:       activity.Price,
+		Currency:    activity.Currency,
+		Attachments: attachmentsOrEmpty(attachments),
 	}
 }
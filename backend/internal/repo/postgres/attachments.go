@@ -2,86 +2,504 @@ package postgres
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"github.com/gofiber/fiber/v2"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"io"
 	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/pkg/envelope"
 	"kompass/pkg/postgres"
 	"kompass/pkg/sqlc"
+	"time"
 )
 
+// AttachmentsRepo keeps the metadata of attachments in postgres and their content in storage. Attachments with the
+// same content share one blob. If encryption is enabled, blobs are encrypted with the data key of the trip and can only
+// be shared within the trip.
 type AttachmentsRepo struct {
 	Db      *pgxpool.Pool
 	Queries *sqlc.Queries
+	storage repo.BlobStorage
+	keys    *TripKeys
 }
 
-func NewAttachmentsRepo(pg *postgres.Postgres) *AttachmentsRepo {
+func NewAttachmentsRepo(pg *postgres.Postgres, storage repo.BlobStorage, keys *TripKeys) *AttachmentsRepo {
 	return &AttachmentsRepo{
 		pg.Pool,
 		sqlc.New(pg.Pool),
+		storage,
+		keys,
 	}
 }
 
 func (r *AttachmentsRepo) GetAttachments(ctx context.Context, tripID int32) ([]entity.Attachment, error) {
-	attachments, err := r.Queries.GetAttachments(ctx, tripID)
+	return r.GetAttachmentsSince(ctx, tripID, 0)
+}
+
+// GetAttachmentsSince returns the attachments of the trip written after the given revision.
+func (r *AttachmentsRepo) GetAttachmentsSince(ctx context.Context, tripID int32, since int64) ([]entity.Attachment, error) {
+	attachments, err := r.Queries.GetAttachments(ctx, sqlc.GetAttachmentsParams{TripID: tripID, Since: since})
 	if err != nil {
 		return nil, fmt.Errorf("get all attachments [tripID=%d]: %w", tripID, err)
 	}
 
-	return mapAttachments(attachments), nil
+	result := []entity.Attachment{}
+	for _, attachment := range attachments {
+		result = append(result, mapAttachment(attachment))
+	}
+	return result, nil
 }
 
 func (r *AttachmentsRepo) GetAttachmentByID(ctx context.Context, tripID int32, attachmentID int32) (entity.Attachment, error) {
+	attachment, err := r.getAttachment(ctx, tripID, attachmentID)
+	if err != nil {
+		return entity.Attachment{}, err
+	}
+	return mapAttachment(attachment), nil
+}
+
+func (r *AttachmentsRepo) getAttachment(ctx context.Context, tripID int32, attachmentID int32) (sqlc.Attachment, error) {
 	attachment, err := r.Queries.GetAttachmentByID(ctx, sqlc.GetAttachmentByIDParams{TripID: tripID, ID: attachmentID})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return entity.Attachment{}, fiber.NewError(fiber.StatusNotFound, "attachment not found")
+			return sqlc.Attachment{}, fiber.NewError(fiber.StatusNotFound, "attachment not found")
 		}
-		return entity.Attachment{}, fmt.Errorf("get attachment [id=%d]: %w", attachmentID, err)
+		return sqlc.Attachment{}, fmt.Errorf("get attachment [id=%d]: %w", attachmentID, err)
 	}
-
-	return mapAttachment(attachment), nil
+	return attachment, nil
 }
 
-func (r *AttachmentsRepo) CreateAttachment(ctx context.Context, attachment entity.Attachment) (entity.Attachment, error) {
-	attachmentId, err := r.Queries.InsertAttachment(ctx, sqlc.InsertAttachmentParams{
-		TripID: attachment.TripID,
-		Name:   attachment.Name,
-		Blob:   attachment.Blob,
+// CreateAttachment only stores the content if no other attachment has the same blob key. The blob key stays locked
+// until the attachment referencing it is committed, so a concurrent purge cannot delete the blob in between.
+func (r *AttachmentsRepo) CreateAttachment(ctx context.Context, attachment entity.Attachment, content io.Reader) (entity.Attachment, error) {
+	tx, err := r.Db.Begin(ctx)
+	if err != nil {
+		return entity.Attachment{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := r.Queries.WithTx(tx)
+
+	blobKey, keyVersion, err := r.putBlob(ctx, qtx, attachment.TripID, attachment.Sha256, attachment.Size, content)
+	if err != nil {
+		return entity.Attachment{}, err
+	}
+
+	attachmentID, err := qtx.InsertAttachment(ctx, sqlc.InsertAttachmentParams{
+		TripID:      attachment.TripID,
+		Name:        attachment.Name,
+		Size:        attachment.Size,
+		ContentType: attachment.ContentType,
+		Sha256:      attachment.Sha256,
+		UploadedBy:  attachment.UploadedBy,
+		BlobKey:     blobKey,
+		KeyVersion:  keyVersion,
 	})
 	if err != nil {
 		return entity.Attachment{}, fmt.Errorf("insert attachment: %w", err)
 	}
 
-	return r.GetAttachmentByID(ctx, attachment.TripID, attachmentId)
+	if err := tx.Commit(ctx); err != nil {
+		return entity.Attachment{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return r.GetAttachmentByID(ctx, attachment.TripID, attachmentID)
+}
+
+// putBlob encrypts the content with the current data key of the trip if encryption is enabled and returns the key
+// under which it is stored. The key is locked until the transaction of qtx ends, which has to reference the blob.
+func (r *AttachmentsRepo) putBlob(ctx context.Context, qtx *sqlc.Queries, tripID int32, hash string, size int64, content io.Reader) (string, *int32, error) {
+	blobKey := hash
+	var keyVersion *int32
+	var dataKey []byte
+	if r.keys.Enabled() {
+		version, key, err := r.keys.current(ctx, r.Queries, tripID)
+		if err != nil {
+			return "", nil, err
+		}
+		blobKey = encryptedBlobKey(tripID, version, hash)
+		keyVersion = &version
+		dataKey = key
+	}
+
+	if err := qtx.LockBlobKey(ctx, blobKey); err != nil {
+		return "", nil, fmt.Errorf("lock blob [key=%s]: %w", blobKey, err)
+	}
+	exists, err := r.storage.Exists(ctx, blobKey)
+	if err != nil {
+		return "", nil, fmt.Errorf("check blob [key=%s]: %w", blobKey, err)
+	}
+	if exists {
+		return blobKey, keyVersion, nil
+	}
+
+	storedSize := size
+	if dataKey != nil {
+		content, err = envelope.NewSealingReader(dataKey, content)
+		if err != nil {
+			return "", nil, fmt.Errorf("encrypt blob [key=%s]: %w", blobKey, err)
+		}
+		storedSize = envelope.SealedSize(size)
+	}
+	if err := r.storage.Put(ctx, blobKey, storedSize, content); err != nil {
+		return "", nil, fmt.Errorf("put blob [key=%s]: %w", blobKey, err)
+	}
+	return blobKey, keyVersion, nil
+}
+
+// encryptedBlobKey must not reveal the hash of the content, which would allow to confirm guesses of it.
+func encryptedBlobKey(tripID int32, version int32, hash string) string {
+	key := sha256.Sum256([]byte(fmt.Sprintf("%d:%d:%s", tripID, version, hash)))
+	return hex.EncodeToString(key[:])
+}
+
+func (r *AttachmentsRepo) OpenAttachment(ctx context.Context, tripID int32, attachmentID int32) (entity.Attachment, io.ReadSeekCloser, error) {
+	attachment, err := r.getAttachment(ctx, tripID, attachmentID)
+	if err != nil {
+		return entity.Attachment{}, nil, err
+	}
+
+	content, err := r.openBlob(ctx, attachment)
+	if err != nil {
+		return entity.Attachment{}, nil, err
+	}
+	return mapAttachment(attachment), content, nil
+}
+
+func (r *AttachmentsRepo) openBlob(ctx context.Context, attachment sqlc.Attachment) (io.ReadSeekCloser, error) {
+	if attachment.KeyVersion == nil {
+		content, err := r.storage.Open(ctx, attachment.BlobKey, attachment.Size)
+		if err != nil {
+			return nil, fmt.Errorf("open blob [key=%s]: %w", attachment.BlobKey, err)
+		}
+		return content, nil
+	}
+
+	if !r.keys.Enabled() {
+		return nil, fmt.Errorf("attachment [id=%d] is encrypted, but no master key is configured", attachment.ID)
+	}
+	dataKey, err := r.keys.get(ctx, r.Queries, attachment.TripID, *attachment.KeyVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := r.storage.Open(ctx, attachment.BlobKey, envelope.SealedSize(attachment.Size))
+	if err != nil {
+		return nil, fmt.Errorf("open blob [key=%s]: %w", attachment.BlobKey, err)
+	}
+	content, err := envelope.NewOpeningReader(dataKey, sealed, attachment.Size)
+	if err != nil {
+		sealed.Close()
+		return nil, fmt.Errorf("decrypt blob [key=%s]: %w", attachment.BlobKey, err)
+	}
+	return content, nil
+}
+
+func (r *AttachmentsRepo) PresignAttachment(ctx context.Context, tripID int32, attachmentID int32, expiry time.Duration) (entity.AttachmentURL, error) {
+	presigner, ok := r.storage.(repo.BlobPresigner)
+	if !ok {
+		return entity.AttachmentURL{}, fiber.NewError(fiber.StatusNotImplemented, "storage backend does not support pre-signed urls")
+	}
+
+	attachment, err := r.getAttachment(ctx, tripID, attachmentID)
+	if err != nil {
+		return entity.AttachmentURL{}, err
+	}
+	if attachment.KeyVersion != nil {
+		return entity.AttachmentURL{}, fiber.NewError(fiber.StatusNotImplemented, "encrypted attachments cannot be downloaded from the storage backend")
+	}
+
+	expiresAt := time.Now().Add(expiry)
+	url, err := presigner.PresignGet(ctx, attachment.BlobKey, attachment.Name, attachment.ContentType, expiry)
+	if err != nil {
+		return entity.AttachmentURL{}, fmt.Errorf("presign blob [key=%s]: %w", attachment.BlobKey, err)
+	}
+	return entity.AttachmentURL{URL: url, ExpiresAt: expiresAt}, nil
+}
+
+func (r *AttachmentsRepo) GetBlobs(ctx context.Context) ([]entity.AttachmentBlob, error) {
+	rows, err := r.Queries.GetAttachmentBlobs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get attachment blobs: %w", err)
+	}
+
+	result := []entity.AttachmentBlob{}
+	for _, row := range rows {
+		size := row.Size
+		if row.Encrypted {
+			size = envelope.SealedSize(size)
+		}
+		result = append(result, entity.AttachmentBlob{Key: row.BlobKey, Size: size})
+	}
+	return result, nil
+}
+
+func (r *AttachmentsRepo) GetThumbnail(ctx context.Context, tripID int32, attachmentID int32, size entity.ThumbnailSize) ([]byte, error) {
+	row, err := r.Queries.GetAttachmentThumbnail(ctx, sqlc.GetAttachmentThumbnailParams{TripID: tripID, AttachmentID: attachmentID, Size: string(size)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "thumbnail not found")
+		}
+		return nil, fmt.Errorf("get thumbnail [id=%d, size=%s]: %w", attachmentID, size, err)
+	}
+	if row.KeyVersion == nil {
+		return row.Data, nil
+	}
+
+	if !r.keys.Enabled() {
+		return nil, fmt.Errorf("thumbnail [id=%d] is encrypted, but no master key is configured", attachmentID)
+	}
+	dataKey, err := r.keys.get(ctx, r.Queries, tripID, *row.KeyVersion)
+	if err != nil {
+		return nil, err
+	}
+	thumbnail, err := envelope.Open(dataKey, row.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt thumbnail [id=%d, size=%s]: %w", attachmentID, size, err)
+	}
+	return thumbnail, nil
+}
+
+// SaveThumbnail keeps an existing thumbnail, so that concurrent generation does not fail.
+func (r *AttachmentsRepo) SaveThumbnail(ctx context.Context, tripID int32, attachmentID int32, size entity.ThumbnailSize, thumbnail []byte) error {
+	var keyVersion *int32
+	if r.keys.Enabled() {
+		version, dataKey, err := r.keys.current(ctx, r.Queries, tripID)
+		if err != nil {
+			return err
+		}
+		thumbnail, err = envelope.Seal(dataKey, thumbnail)
+		if err != nil {
+			return fmt.Errorf("encrypt thumbnail [id=%d, size=%s]: %w", attachmentID, size, err)
+		}
+		keyVersion = &version
+	}
+
+	err := r.Queries.InsertAttachmentThumbnail(ctx, sqlc.InsertAttachmentThumbnailParams{
+		AttachmentID: attachmentID,
+		Size:         string(size),
+		Data:         thumbnail,
+		KeyVersion:   keyVersion,
+	})
+	if err != nil {
+		return fmt.Errorf("insert thumbnail [id=%d, size=%s]: %w", attachmentID, size, err)
+	}
+	return nil
 }
 
-func (r *AttachmentsRepo) DeleteAttachment(ctx context.Context, tripID int32, attachmentID int32) error {
-	_, err := r.Queries.DeleteAttachmentByID(ctx, sqlc.DeleteAttachmentByIDParams{TripID: tripID, ID: attachmentID})
+// DeleteAttachment moves the attachment to the trash, its blob is deleted once the attachment is purged. The attachment
+// is only moved if it still has the expected revision, unless none is given.
+func (r *AttachmentsRepo) DeleteAttachment(ctx context.Context, tripID int32, attachmentID int32, revision *int64) error {
+	_, err := r.Queries.TrashAttachmentByID(ctx, sqlc.TrashAttachmentByIDParams{TripID: tripID, ID: attachmentID, Revision: revision})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return fiber.NewError(fiber.StatusNotFound, "attachment not found")
+			return notWritten("attachment", revision)
 		}
 		return fmt.Errorf("delete attachment: %w", err)
 	}
 	return nil
 }
 
-func mapAttachments(attachments []sqlc.Attachment) []entity.Attachment {
-	result := []entity.Attachment{}
+// deleteUnreferencedBlob holds the lock of the blob key while checking the references, so that no attachment
+// reusing the blob can be created until it is deleted.
+func (r *AttachmentsRepo) deleteUnreferencedBlob(ctx context.Context, blobKey string) error {
+	tx, err := r.Db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := r.Queries.WithTx(tx)
+
+	if err := qtx.LockBlobKey(ctx, blobKey); err != nil {
+		return fmt.Errorf("lock blob [key=%s]: %w", blobKey, err)
+	}
+	referenced, err := qtx.IsBlobReferenced(ctx, blobKey)
+	if err != nil {
+		return fmt.Errorf("check blob references [key=%s]: %w", blobKey, err)
+	}
+	if !referenced {
+		if err := r.storage.Delete(ctx, blobKey); err != nil {
+			return fmt.Errorf("delete blob [key=%s]: %w", blobKey, err)
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// reencryptAttachments stores the blobs of all attachments of a trip again with the current data key and returns
+// how many were re-encrypted. Attachments that are already encrypted with it are skipped. Thumbnails are deleted
+// instead, they are generated again when they are requested.
+func (r *AttachmentsRepo) reencryptAttachments(ctx context.Context, tripID int32) (int, error) {
+	attachments, err := r.Queries.GetAttachmentsIncludingTrash(ctx, tripID)
+	if err != nil {
+		return 0, fmt.Errorf("get all attachments [tripID=%d]: %w", tripID, err)
+	}
+	if err := r.Queries.DeleteTripThumbnails(ctx, tripID); err != nil {
+		return 0, fmt.Errorf("delete thumbnails [tripID=%d]: %w", tripID, err)
+	}
+
+	version, _, err := r.keys.current(ctx, r.Queries, tripID)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
 	for _, attachment := range attachments {
-		result = append(result, mapAttachment(attachment))
+		if attachment.KeyVersion != nil && *attachment.KeyVersion == version {
+			continue
+		}
+
+		if err := r.reencryptAttachment(ctx, attachment); err != nil {
+			return count, fmt.Errorf("re-encrypt attachment [id=%d]: %w", attachment.ID, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+func (r *AttachmentsRepo) reencryptAttachment(ctx context.Context, attachment sqlc.Attachment) error {
+	content, err := r.openBlob(ctx, attachment)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	tx, err := r.Db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := r.Queries.WithTx(tx)
+
+	blobKey, keyVersion, err := r.putBlob(ctx, qtx, attachment.TripID, attachment.Sha256, attachment.Size, content)
+	if err != nil {
+		return err
+	}
+	if err := qtx.UpdateAttachmentBlob(ctx, sqlc.UpdateAttachmentBlobParams{
+		ID:         attachment.ID,
+		BlobKey:    blobKey,
+		KeyVersion: keyVersion,
+	}); err != nil {
+		return fmt.Errorf("update blob key: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	return r.deleteUnreferencedBlob(ctx, attachment.BlobKey)
+}
+
+func (r *AttachmentsRepo) LinkAttachment(ctx context.Context, tripID int32, attachmentID int32, target entity.AttachmentLinkTarget, targetID int32) error {
+	var err error
+	switch target {
+	case entity.TRANSPORTATION_LINK:
+		_, err = r.Queries.LinkAttachmentToTransportation(ctx, sqlc.LinkAttachmentToTransportationParams{TripID: tripID, AttachmentID: attachmentID, TransportationID: targetID})
+	case entity.ACTIVITY_LINK:
+		_, err = r.Queries.LinkAttachmentToActivity(ctx, sqlc.LinkAttachmentToActivityParams{TripID: tripID, AttachmentID: attachmentID, ActivityID: targetID})
+	case entity.ACCOMMODATION_LINK:
+		_, err = r.Queries.LinkAttachmentToAccommodation(ctx, sqlc.LinkAttachmentToAccommodationParams{TripID: tripID, AttachmentID: attachmentID, AccommodationID: targetID})
+	default:
+		return fiber.NewError(fiber.StatusBadRequest, "invalid link target")
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fiber.NewError(fiber.StatusNotFound, "attachment or "+string(target)+" not found")
+		}
+		return fmt.Errorf("link attachment [id=%d] to %s [id=%d]: %w", attachmentID, target, targetID, err)
+	}
+	return nil
+}
+
+func (r *AttachmentsRepo) UnlinkAttachment(ctx context.Context, tripID int32, attachmentID int32, target entity.AttachmentLinkTarget, targetID int32) error {
+	var err error
+	switch target {
+	case entity.TRANSPORTATION_LINK:
+		_, err = r.Queries.UnlinkAttachmentFromTransportation(ctx, sqlc.UnlinkAttachmentFromTransportationParams{TripID: tripID, AttachmentID: attachmentID, TransportationID: targetID})
+	case entity.ACTIVITY_LINK:
+		_, err = r.Queries.UnlinkAttachmentFromActivity(ctx, sqlc.UnlinkAttachmentFromActivityParams{TripID: tripID, AttachmentID: attachmentID, ActivityID: targetID})
+	case entity.ACCOMMODATION_LINK:
+		_, err = r.Queries.UnlinkAttachmentFromAccommodation(ctx, sqlc.UnlinkAttachmentFromAccommodationParams{TripID: tripID, AttachmentID: attachmentID, AccommodationID: targetID})
+	default:
+		return fiber.NewError(fiber.StatusBadRequest, "invalid link target")
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fiber.NewError(fiber.StatusNotFound, "link not found")
+		}
+		return fmt.Errorf("unlink attachment [id=%d] from %s [id=%d]: %w", attachmentID, target, targetID, err)
+	}
+	return nil
+}
+
+// getActivityAttachments returns the attachments of all activities of the trip, or only of activityID, by activity.
+func getActivityAttachments(ctx context.Context, queries *sqlc.Queries, tripID int32, activityID *int32) (map[int32][]entity.AttachmentMetadata, error) {
+	rows, err := queries.GetActivityAttachments(ctx, sqlc.GetActivityAttachmentsParams{TripID: tripID, ActivityID: activityID})
+	if err != nil {
+		return nil, fmt.Errorf("get activity attachments: %w", err)
+	}
+
+	result := map[int32][]entity.AttachmentMetadata{}
+	for _, row := range rows {
+		result[row.ActivityID] = append(result[row.ActivityID], mapAttachmentMetadata(row.ID, row.Name, row.Size, row.ContentType))
+	}
+	return result, nil
+}
+
+func getAccommodationAttachments(ctx context.Context, queries *sqlc.Queries, tripID int32, accommodationID *int32) (map[int32][]entity.AttachmentMetadata, error) {
+	rows, err := queries.GetAccommodationAttachments(ctx, sqlc.GetAccommodationAttachmentsParams{TripID: tripID, AccommodationID: accommodationID})
+	if err != nil {
+		return nil, fmt.Errorf("get accommodation attachments: %w", err)
+	}
+
+	result := map[int32][]entity.AttachmentMetadata{}
+	for _, row := range rows {
+		result[row.AccommodationID] = append(result[row.AccommodationID], mapAttachmentMetadata(row.ID, row.Name, row.Size, row.ContentType))
+	}
+	return result, nil
+}
+
+func getTransportationAttachments(ctx context.Context, queries *sqlc.Queries, tripID int32, transportationID *int32) (map[int32][]entity.AttachmentMetadata, error) {
+	rows, err := queries.GetTransportationAttachments(ctx, sqlc.GetTransportationAttachmentsParams{TripID: tripID, TransportationID: transportationID})
+	if err != nil {
+		return nil, fmt.Errorf("get transportation attachments: %w", err)
+	}
+
+	result := map[int32][]entity.AttachmentMetadata{}
+	for _, row := range rows {
+		result[row.TransportationID] = append(result[row.TransportationID], mapAttachmentMetadata(row.ID, row.Name, row.Size, row.ContentType))
+	}
+	return result, nil
+}
+
+// attachmentsOrEmpty makes sure that bookings without attachments have an empty list instead of null.
+func attachmentsOrEmpty(attachments []entity.AttachmentMetadata) []entity.AttachmentMetadata {
+	if attachments == nil {
+		return []entity.AttachmentMetadata{}
+	}
+	return attachments
+}
+
+func mapAttachmentMetadata(id int32, name string, size int64, contentType string) entity.AttachmentMetadata {
+	return entity.AttachmentMetadata{
+		ID:          id,
+		Name:        name,
+		Size:        size,
+		ContentType: contentType,
 	}
-	return result
 }
 
 func mapAttachment(attachment sqlc.Attachment) entity.Attachment {
 	return entity.Attachment{
-		ID:     attachment.ID,
-		TripID: attachment.TripID,
-		Name:   attachment.Name,
-		Blob:   attachment.Blob,
+		ID:          attachment.ID,
+		TripID:      attachment.TripID,
+		Revision:    attachment.Revision,
+		Name:        attachment.Name,
+		Size:        attachment.Size,
+		ContentType: attachment.ContentType,
+		Sha256:      attachment.Sha256,
+		CreatedAt:   attachment.CreatedAt,
+		UploadedBy:  attachment.UploadedBy,
 	}
 }
@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"io"
+	"kompass/pkg/postgres"
+	"kompass/pkg/sqlc"
+)
+
+const blobChunkSize = 4 << 20
+
+// BlobsRepo stores attachment contents in the attachment_blob_chunk table, one row per chunk. Contents are written and
+// read in chunks, so that at most one chunk is held in memory.
+type BlobsRepo struct {
+	Db      *pgxpool.Pool
+	Queries *sqlc.Queries
+}
+
+func NewBlobsRepo(pg *postgres.Postgres) *BlobsRepo {
+	return &BlobsRepo{
+		pg.Pool,
+		sqlc.New(pg.Pool),
+	}
+}
+
+func (r *BlobsRepo) Exists(ctx context.Context, key string) (bool, error) {
+	return r.Queries.BlobExists(ctx, key)
+}
+
+func (r *BlobsRepo) Put(ctx context.Context, key string, size int64, content io.Reader) error {
+	tx, err := r.Db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := r.Queries.WithTx(tx)
+
+	inserted, err := qtx.InsertBlob(ctx, key)
+	if err != nil {
+		return fmt.Errorf("insert blob: %w", err)
+	}
+	if inserted == 0 {
+		return nil
+	}
+
+	chunk := make([]byte, blobChunkSize)
+	for start := int64(0); ; {
+		n, err := io.ReadFull(content, chunk)
+		if n > 0 {
+			if err := qtx.InsertBlobChunk(ctx, sqlc.InsertBlobChunkParams{BlobKey: key, Start: start, Data: chunk[:n]}); err != nil {
+				return fmt.Errorf("insert blob chunk: %w", err)
+			}
+			start += int64(n)
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read content: %w", err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *BlobsRepo) Open(ctx context.Context, key string, size int64) (io.ReadSeekCloser, error) {
+	return &blobReader{ctx: ctx, queries: r.Queries, key: key, size: size}, nil
+}
+
+func (r *BlobsRepo) Delete(ctx context.Context, key string) error {
+	return r.Queries.DeleteBlob(ctx, key)
+}
+
+// blobReader reads a blob chunk by chunk, loading the chunk that contains the current offset.
+type blobReader struct {
+	ctx     context.Context
+	queries *sqlc.Queries
+	key     string
+	size    int64
+	offset  int64
+	chunk   []byte
+	// chunkOffset is the offset of chunk within the blob
+	chunkOffset int64
+}
+
+func (b *blobReader) Read(p []byte) (int, error) {
+	if b.offset >= b.size {
+		return 0, io.EOF
+	}
+
+	if b.offset < b.chunkOffset || b.offset >= b.chunkOffset+int64(len(b.chunk)) {
+		chunk, err := b.queries.GetBlobChunk(b.ctx, sqlc.GetBlobChunkParams{BlobKey: b.key, Position: b.offset})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return 0, fmt.Errorf("get blob chunk [key=%s, offset=%d]: %w", b.key, b.offset, err)
+		}
+		if b.offset >= chunk.Start+int64(len(chunk.Data)) {
+			return 0, io.ErrUnexpectedEOF
+		}
+		b.chunk = chunk.Data
+		b.chunkOffset = chunk.Start
+	}
+
+	n := copy(p, b.chunk[b.offset-b.chunkOffset:])
+	b.offset += int64(n)
+	return n, nil
+}
+
+func (b *blobReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += b.offset
+	case io.SeekEnd:
+		offset += b.size
+	default:
+		return 0, errors.New("seek: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("seek: negative position")
+	}
+
+	b.offset = offset
+	return offset, nil
+}
+
+func (b *blobReader) Close() error {
+	return nil
+}
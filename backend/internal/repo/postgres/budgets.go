@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"kompass/internal/entity"
+	"kompass/pkg/postgres"
+	"kompass/pkg/sqlc"
+)
+
+type BudgetsRepo struct {
+	Db      *pgxpool.Pool
+	Queries *sqlc.Queries
+}
+
+func NewBudgetsRepo(pg *postgres.Postgres) *BudgetsRepo {
+	return &BudgetsRepo{
+		pg.Pool,
+		sqlc.New(pg.Pool),
+	}
+}
+
+func (r *BudgetsRepo) GetBudget(ctx context.Context, tripID int32) (entity.Budget, error) {
+	trip, err := r.Queries.GetTripByID(ctx, tripID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Budget{}, fiber.NewError(fiber.StatusNotFound, "trip not found")
+		}
+		return entity.Budget{}, fmt.Errorf("get trip [tripID=%d]: %w", tripID, err)
+	}
+
+	categoryBudgets, err := r.Queries.GetCategoryBudgets(ctx, tripID)
+	if err != nil {
+		return entity.Budget{}, fmt.Errorf("get category budgets [tripID=%d]: %w", tripID, err)
+	}
+
+	budget := entity.Budget{
+		Total:      trip.Budget,
+		Categories: []entity.CategoryBudget{},
+	}
+	for _, categoryBudget := range categoryBudgets {
+		budget.Categories = append(budget.Categories, entity.CategoryBudget{
+			Category: entity.CostCategory(categoryBudget.Category),
+			Amount:   categoryBudget.Amount,
+		})
+	}
+	return budget, nil
+}
+
+func (r *BudgetsRepo) SaveBudget(ctx context.Context, tripID int32, budget entity.Budget) error {
+	tx, err := r.Db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := r.Queries.WithTx(tx)
+
+	_, err = qtx.UpdateTripBudget(ctx, sqlc.UpdateTripBudgetParams{ID: tripID, Budget: budget.Total})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fiber.NewError(fiber.StatusNotFound, "trip not found")
+		}
+		return fmt.Errorf("update trip budget [tripID=%d]: %w", tripID, err)
+	}
+
+	if err := qtx.DeleteCategoryBudgets(ctx, tripID); err != nil {
+		return fmt.Errorf("delete category budgets [tripID=%d]: %w", tripID, err)
+	}
+	for _, categoryBudget := range budget.Categories {
+		err := qtx.InsertCategoryBudget(ctx, sqlc.InsertCategoryBudgetParams{
+			TripID:   tripID,
+			Category: string(categoryBudget.Category),
+			Amount:   categoryBudget.Amount,
+		})
+		if err != nil {
+			return fmt.Errorf("insert category budget [category=%s]: %w", categoryBudget.Category, err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
@@ -15,11 +15,13 @@ type ConvertTransportationParams struct {
 type TransportationConverter interface {
 	// goverter:map Transportation.ID ID
 	// goverter:map Transportation.TripID TripID
+	// goverter:map Transportation.Revision Revision
 	// goverter:map Transportation.Type Type
 	// goverter:map Transportation.DepartureTime DepartureDateTime
 	// goverter:map Transportation.ArrivalTime ArrivalDateTime
 	// goverter:map Transportation.Price Price
-	// goverter:ignore FlightDetail TrainDetail GenericDetail
+	// goverter:map Transportation.Currency Currency
+	// goverter:ignore DepartureUtcOffset ArrivalUtcOffset FlightDetail TrainDetail GenericDetail Attachments Track BudgetAlert Co2e
 	ConvertTransportation(source ConvertTransportationParams) entity.Transportation
 }
 
@@ -41,17 +43,22 @@ type FlightConverter interface {
 }
 
 func ConvertFlightLeg(c FlightConverter, leg sqlc.GetFlightLegsByTransportationIDRow) entity.FlightLeg {
+	origin := ConvertAirport(c, leg.Airport, leg.Location)
+	destination := ConvertAirport(c, leg.Airport_2, leg.Location_2)
 	return entity.FlightLeg{
-		ID:                leg.FlightLeg.ID,
-		Origin:            ConvertAirport(c, leg.Airport, leg.Location),
-		Destination:       ConvertAirport(c, leg.Airport_2, leg.Location_2),
-		Airline:           leg.FlightLeg.Airline,
-		FlightNumber:      leg.FlightLeg.FlightNumber,
-		DepartureDateTime: leg.FlightLeg.DepartureTime,
-		ArrivalDateTime:   leg.FlightLeg.ArrivalTime,
-		AmadeusFlightDate: leg.FlightLeg.AmadeusDate,
-		DurationInMinutes: leg.FlightLeg.DurationInMinutes,
-		Aircraft:          leg.FlightLeg.Aircraft,
+		ID:                 leg.FlightLeg.ID,
+		Origin:             origin,
+		Destination:        destination,
+		Airline:            leg.FlightLeg.Airline,
+		FlightNumber:       leg.FlightLeg.FlightNumber,
+		DepartureDateTime:  leg.FlightLeg.DepartureTime,
+		ArrivalDateTime:    leg.FlightLeg.ArrivalTime,
+		DepartureUtcOffset: origin.Location.UtcOffset(leg.FlightLeg.DepartureTime),
+		ArrivalUtcOffset:   destination.Location.UtcOffset(leg.FlightLeg.ArrivalTime),
+		AmadeusFlightDate:  leg.FlightLeg.AmadeusDate,
+		DurationInMinutes:  leg.FlightLeg.DurationInMinutes,
+		Aircraft:           leg.FlightLeg.Aircraft,
+		CabinClass:         (*entity.CabinClass)(leg.FlightLeg.CabinClass),
 	}
 }
 
@@ -73,15 +80,19 @@ type TrainConverter interface {
 }
 
 func ConvertTrainLeg(c TrainConverter, leg sqlc.GetTrainLegsByTransportationIDRow) entity.TrainLeg {
+	origin := ConvertTrainStation(c, leg.TrainStation, leg.Location)
+	destination := ConvertTrainStation(c, leg.TrainStation_2, leg.Location_2)
 	return entity.TrainLeg{
-		ID:                leg.TrainLeg.ID,
-		Origin:            ConvertTrainStation(c, leg.TrainStation, leg.Location),
-		Destination:       ConvertTrainStation(c, leg.TrainStation_2, leg.Location_2),
-		DepartureDateTime: leg.TrainLeg.DepartureTime,
-		ArrivalDateTime:   leg.TrainLeg.ArrivalTime,
-		DurationInMinutes: leg.TrainLeg.DurationInMinutes,
-		LineName:          leg.TrainLeg.LineName,
-		OperatorName:      leg.TrainLeg.OperatorName,
+		ID:                 leg.TrainLeg.ID,
+		Origin:             origin,
+		Destination:        destination,
+		DepartureDateTime:  leg.TrainLeg.DepartureTime,
+		ArrivalDateTime:    leg.TrainLeg.ArrivalTime,
+		DepartureUtcOffset: origin.Location.UtcOffset(leg.TrainLeg.DepartureTime),
+		ArrivalUtcOffset:   destination.Location.UtcOffset(leg.TrainLeg.ArrivalTime),
+		DurationInMinutes:  leg.TrainLeg.DurationInMinutes,
+		LineName:           leg.TrainLeg.LineName,
+		OperatorName:       leg.TrainLeg.OperatorName,
 	}
 }
 
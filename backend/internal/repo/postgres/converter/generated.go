@@ -26,6 +26,7 @@ func (c *FlightConverterImpl) ConvertLocation(source sqlc.Location) entity.Locat
 	entityLocation.ID = source.ID
 	entityLocation.Latitude = source.Latitude
 	entityLocation.Longitude = source.Longitude
+	entityLocation.Timezone = source.Timezone
 	return entityLocation
 }
 func (c *FlightConverterImpl) ConvertPnr(source sqlc.FlightPnr) entity.PNR {
@@ -53,6 +54,7 @@ func (c *TrainConverterImpl) ConvertLocation(source sqlc.Location) entity.Locati
 	entityLocation.ID = source.ID
 	entityLocation.Latitude = source.Latitude
 	entityLocation.Longitude = source.Longitude
+	entityLocation.Timezone = source.Timezone
 	return entityLocation
 }
 func (c *TrainConverterImpl) ConvertTrainLegs(source []sqlc.GetTrainLegsByTransportationIDRow) []entity.TrainLeg {
@@ -72,12 +74,14 @@ func (c *TransportationConverterImpl) ConvertTransportation(source ConvertTransp
 	var entityTransportation entity.Transportation
 	entityTransportation.ID = source.Transportation.ID
 	entityTransportation.TripID = source.Transportation.TripID
+	entityTransportation.Revision = source.Transportation.Revision
 	entityTransportation.Type = entity.TransportationType(source.Transportation.Type)
 	entityTransportation.Origin = c.sqlcLocationToEntityLocation(source.Origin)
 	entityTransportation.Destination = c.sqlcLocationToEntityLocation(source.Destination)
 	entityTransportation.DepartureDateTime = c.civilDateTimeToCivilDateTime(source.Transportation.DepartureTime)
 	entityTransportation.ArrivalDateTime = c.civilDateTimeToCivilDateTime(source.Transportation.ArrivalTime)
 	entityTransportation.Price = source.Transportation.Price
+	entityTransportation.Currency = source.Transportation.Currency
 	return entityTransportation
 }
 func (c *TransportationConverterImpl) civilDateTimeToCivilDateTime(source civil.DateTime) civil.DateTime {
@@ -88,5 +92,6 @@ func (c *TransportationConverterImpl) sqlcLocationToEntityLocation(source sqlc.L
 	entityLocation.ID = source.ID
 	entityLocation.Latitude = source.Latitude
 	entityLocation.Longitude = source.Longitude
+	entityLocation.Timezone = source.Timezone
 	return entityLocation
 }
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"kompass/internal/entity"
+	"kompass/pkg/postgres"
+	"kompass/pkg/sqlc"
+)
+
+// eventsChannel must match the channel in the NotifyTripEvent query.
+const eventsChannel = "trip_events"
+
+// EventsRepo distributes trip events to all instances of the backend with LISTEN/NOTIFY.
+type EventsRepo struct {
+	Db      *pgxpool.Pool
+	Queries *sqlc.Queries
+}
+
+func NewEventsRepo(pg *postgres.Postgres) *EventsRepo {
+	return &EventsRepo{
+		pg.Pool,
+		sqlc.New(pg.Pool),
+	}
+}
+
+func (r *EventsRepo) Publish(ctx context.Context, event entity.TripEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	if err := r.Queries.NotifyTripEvent(ctx, string(payload)); err != nil {
+		return fmt.Errorf("notify trip event [tripID=%d]: %w", event.TripID, err)
+	}
+	return nil
+}
+
+// Listen calls handle for every published event until the context is done or the connection fails. It uses its own
+// connection instead of one from the pool, as the connection is blocked while waiting for notifications.
+func (r *EventsRepo) Listen(ctx context.Context, handle func(entity.TripEvent)) error {
+	conn, err := pgx.ConnectConfig(ctx, r.Db.Config().ConnConfig.Copy())
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+eventsChannel); err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+
+		var event entity.TripEvent
+		if err := json.Unmarshal([]byte(notification.Payload), &event); err != nil {
+			return fmt.Errorf("unmarshal event: %w", err)
+		}
+		handle(event)
+	}
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"cloud.google.com/go/civil"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"kompass/internal/entity"
+	"kompass/pkg/postgres"
+	"kompass/pkg/sqlc"
+)
+
+type ExchangeRatesRepo struct {
+	Db      *pgxpool.Pool
+	Queries *sqlc.Queries
+}
+
+func NewExchangeRatesRepo(pg *postgres.Postgres) *ExchangeRatesRepo {
+	return &ExchangeRatesRepo{pg.Pool, sqlc.New(pg.Pool)}
+}
+
+func (r *ExchangeRatesRepo) SaveExchangeRates(ctx context.Context, rates []entity.ExchangeRate) error {
+	tx, err := r.Db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := r.Queries.WithTx(tx)
+
+	for _, rate := range rates {
+		err := qtx.UpsertExchangeRate(ctx, sqlc.UpsertExchangeRateParams{
+			Date:     rate.Date,
+			Currency: rate.Currency,
+			Rate:     rate.Rate,
+		})
+		if err != nil {
+			return fmt.Errorf("upsert exchange rate [currency=%s, date=%s]: %w", rate.Currency, rate.Date, err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *ExchangeRatesRepo) GetLatestExchangeRateDate(ctx context.Context) (*civil.Date, error) {
+	date, err := r.Queries.GetLatestExchangeRateDate(ctx)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get latest exchange rate date: %w", err)
+	}
+	return &date, nil
+}
+
+// GetExchangeRate returns the rate closest to date, preferring earlier ones, or nil if the currency is unknown.
+func (r *ExchangeRatesRepo) GetExchangeRate(ctx context.Context, currency string, date civil.Date) (*entity.ExchangeRate, error) {
+	rate, err := r.Queries.GetExchangeRate(ctx, sqlc.GetExchangeRateParams{Currency: currency, Date: date})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get exchange rate [currency=%s, date=%s]: %w", currency, date, err)
+	}
+
+	return &entity.ExchangeRate{
+		Date:     rate.Date,
+		Currency: rate.Currency,
+		Rate:     rate.Rate,
+	}, nil
+}
+
+// currencyOrNil maps an unset currency to nil, so the query falls back to the home currency of the trip on insert
+// and keeps the current currency on update.
+func currencyOrNil(currency string) *string {
+	if currency == "" {
+		return nil
+	}
+	return &currency
+}
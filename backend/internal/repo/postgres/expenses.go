@@ -0,0 +1,232 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"kompass/internal/entity"
+	"kompass/pkg/postgres"
+	"kompass/pkg/sqlc"
+)
+
+type ExpensesRepo struct {
+	Db      *pgxpool.Pool
+	Queries *sqlc.Queries
+}
+
+func NewExpensesRepo(pg *postgres.Postgres) *ExpensesRepo {
+	return &ExpensesRepo{
+		pg.Pool,
+		sqlc.New(pg.Pool),
+	}
+}
+
+func (r *ExpensesRepo) GetExpenses(ctx context.Context, tripID int32) ([]entity.Expense, error) {
+	return r.GetExpensesSince(ctx, tripID, 0)
+}
+
+// GetExpensesSince returns the expenses of the trip written after the given revision.
+func (r *ExpensesRepo) GetExpensesSince(ctx context.Context, tripID int32, since int64) ([]entity.Expense, error) {
+	expenses, err := r.Queries.GetExpenses(ctx, sqlc.GetExpensesParams{TripID: tripID, Since: since})
+	if err != nil {
+		return nil, fmt.Errorf("get all expenses [tripID=%d]: %w", tripID, err)
+	}
+
+	participants, err := r.Queries.GetExpenseParticipants(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get expense participants [tripID=%d]: %w", tripID, err)
+	}
+
+	participantsByExpense := map[int32][]sqlc.ExpenseParticipant{}
+	for _, participant := range participants {
+		participantsByExpense[participant.ExpenseID] = append(participantsByExpense[participant.ExpenseID], participant)
+	}
+
+	result := []entity.Expense{}
+	for _, expense := range expenses {
+		result = append(result, mapExpense(expense, participantsByExpense[expense.ID]))
+	}
+	return result, nil
+}
+
+func (r *ExpensesRepo) GetExpenseByID(ctx context.Context, tripID int32, expenseID int32) (entity.Expense, error) {
+	expense, err := r.Queries.GetExpenseByID(ctx, sqlc.GetExpenseByIDParams{TripID: tripID, ID: expenseID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Expense{}, fiber.NewError(fiber.StatusNotFound, "expense not found")
+		}
+		return entity.Expense{}, fmt.Errorf("get expense [id=%d]: %w", expenseID, err)
+	}
+
+	participants, err := r.Queries.GetExpenseParticipantsByExpenseID(ctx, expenseID)
+	if err != nil {
+		return entity.Expense{}, fmt.Errorf("get participants of expense [id=%d]: %w", expenseID, err)
+	}
+
+	return mapExpense(expense, participants), nil
+}
+
+func (r *ExpensesRepo) CreateExpense(ctx context.Context, expense entity.Expense) (entity.Expense, error) {
+	tx, err := r.Db.Begin(ctx)
+	if err != nil {
+		return entity.Expense{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := r.Queries.WithTx(tx)
+
+	if err := verifyExpenseLinks(ctx, qtx, expense); err != nil {
+		return entity.Expense{}, err
+	}
+
+	expenseID, err := qtx.InsertExpense(ctx, sqlc.InsertExpenseParams{
+		TripID:           expense.TripID,
+		Name:             expense.Name,
+		Date:             expense.Date,
+		Amount:           expense.Amount,
+		Currency:         expense.Currency,
+		PayerID:          expense.PayerID,
+		SplitMode:        string(expense.SplitMode),
+		TransportationID: expense.TransportationID,
+		ActivityID:       expense.ActivityID,
+		AccommodationID:  expense.AccommodationID,
+		AttachmentID:     expense.AttachmentID,
+		Category:         string(expense.Category),
+	})
+	if err != nil {
+		return entity.Expense{}, fmt.Errorf("insert expense: %w", err)
+	}
+
+	if err := insertExpenseParticipants(ctx, qtx, expenseID, expense.Participants); err != nil {
+		return entity.Expense{}, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return entity.Expense{}, fmt.Errorf("commit tx: %w", err)
+	}
+
+	return r.GetExpenseByID(ctx, expense.TripID, expenseID)
+}
+
+func (r *ExpensesRepo) UpdateExpense(ctx context.Context, expense entity.Expense, revision *int64) error {
+	tx, err := r.Db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := r.Queries.WithTx(tx)
+
+	if err := verifyExpenseLinks(ctx, qtx, expense); err != nil {
+		return err
+	}
+
+	rows, err := qtx.UpdateExpense(ctx, sqlc.UpdateExpenseParams{
+		TripID:           expense.TripID,
+		ID:               expense.ID,
+		Name:             expense.Name,
+		Date:             expense.Date,
+		Amount:           expense.Amount,
+		Currency:         expense.Currency,
+		PayerID:          expense.PayerID,
+		SplitMode:        string(expense.SplitMode),
+		TransportationID: expense.TransportationID,
+		ActivityID:       expense.ActivityID,
+		AccommodationID:  expense.AccommodationID,
+		AttachmentID:     expense.AttachmentID,
+		Category:         string(expense.Category),
+		Revision:         revision,
+	})
+	if err != nil {
+		return fmt.Errorf("update expense [id=%d]: %w", expense.ID, err)
+	}
+	if rows == 0 {
+		return notWritten("expense", revision)
+	}
+
+	if err := qtx.DeleteExpenseParticipants(ctx, expense.ID); err != nil {
+		return fmt.Errorf("delete participants of expense [id=%d]: %w", expense.ID, err)
+	}
+	if err := insertExpenseParticipants(ctx, qtx, expense.ID, expense.Participants); err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ExpensesRepo) DeleteExpense(ctx context.Context, tripID int32, expenseID int32, revision *int64) error {
+	_, err := r.Queries.TrashExpenseByID(ctx, sqlc.TrashExpenseByIDParams{TripID: tripID, ID: expenseID, Revision: revision})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return notWritten("expense", revision)
+		}
+		return fmt.Errorf("delete expense: %w", err)
+	}
+	return nil
+}
+
+// verifyExpenseLinks makes sure that linked bookings and receipts belong to the trip of the expense.
+func verifyExpenseLinks(ctx context.Context, qtx *sqlc.Queries, expense entity.Expense) error {
+	valid, err := qtx.ExpenseLinksBelongToTrip(ctx, sqlc.ExpenseLinksBelongToTripParams{
+		TripID:           expense.TripID,
+		TransportationID: expense.TransportationID,
+		ActivityID:       expense.ActivityID,
+		AccommodationID:  expense.AccommodationID,
+		AttachmentID:     expense.AttachmentID,
+	})
+	if err != nil {
+		return fmt.Errorf("verify expense links: %w", err)
+	}
+	if valid == nil || !*valid {
+		return fiber.NewError(fiber.StatusBadRequest, "linked transportation, activity, accommodation or attachment not found")
+	}
+	return nil
+}
+
+func insertExpenseParticipants(ctx context.Context, qtx *sqlc.Queries, expenseID int32, participants []entity.ExpenseParticipant) error {
+	for _, participant := range participants {
+		err := qtx.InsertExpenseParticipant(ctx, sqlc.InsertExpenseParticipantParams{
+			ExpenseID: expenseID,
+			UserID:    participant.UserID,
+			Share:     participant.Share,
+		})
+		if err != nil {
+			return fmt.Errorf("insert participant [userID=%d]: %w", participant.UserID, err)
+		}
+	}
+	return nil
+}
+
+func mapExpense(expense sqlc.Expense, participants []sqlc.ExpenseParticipant) entity.Expense {
+	result := entity.Expense{
+		ID:               expense.ID,
+		TripID:           expense.TripID,
+		Revision:         expense.Revision,
+		Name:             expense.Name,
+		Date:             expense.Date,
+		Amount:           expense.Amount,
+		Currency:         expense.Currency,
+		PayerID:          expense.PayerID,
+		SplitMode:        entity.SplitMode(expense.SplitMode),
+		Category:         entity.CostCategory(expense.Category),
+		Participants:     []entity.ExpenseParticipant{},
+		TransportationID: expense.TransportationID,
+		ActivityID:       expense.ActivityID,
+		AccommodationID:  expense.AccommodationID,
+		AttachmentID:     expense.AttachmentID,
+	}
+	for _, participant := range participants {
+		result.Participants = append(result.Participants, entity.ExpenseParticipant{
+			UserID: participant.UserID,
+			Share:  participant.Share,
+		})
+	}
+	return result
+}
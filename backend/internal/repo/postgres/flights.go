@@ -15,17 +15,19 @@ type FlightsRepo struct {
 	Db      *pgxpool.Pool
 	Queries *sqlc.Queries
 	c       converter.FlightConverter
+	keys    *TripKeys
 }
 
-func NewFlightsRepo(pg *postgres.Postgres) *FlightsRepo {
+func NewFlightsRepo(pg *postgres.Postgres, keys *TripKeys) *FlightsRepo {
 	return &FlightsRepo{
 		pg.Pool,
 		sqlc.New(pg.Pool),
 		&converter.FlightConverterImpl{},
+		keys,
 	}
 }
 
-func (r *FlightsRepo) GetFlightDetail(ctx context.Context, transportationID int32) (entity.FlightDetail, error) {
+func (r *FlightsRepo) GetFlightDetail(ctx context.Context, tripID int32, transportationID int32) (entity.FlightDetail, error) {
 	legs, err := r.Queries.GetFlightLegsByTransportationID(ctx, transportationID)
 	if err != nil {
 		return entity.FlightDetail{}, fmt.Errorf("get flight legs [t.id=%d] from db: %w", transportationID, err)
@@ -35,6 +37,12 @@ func (r *FlightsRepo) GetFlightDetail(ctx context.Context, transportationID int3
 	if err != nil {
 		return entity.FlightDetail{}, fmt.Errorf("get PNRs [t.id=%d] from db: %w", transportationID, err)
 	}
+	for i := range pnrs {
+		pnrs[i].Pnr, err = r.keys.decrypt(ctx, r.Queries, tripID, pnrs[i].Pnr)
+		if err != nil {
+			return entity.FlightDetail{}, fmt.Errorf("decrypt PNR [id=%d]: %w", pnrs[i].ID, err)
+		}
+	}
 
 	return entity.FlightDetail{
 		Legs: r.c.ConvertFlightLegs(legs),
@@ -42,7 +50,7 @@ func (r *FlightsRepo) GetFlightDetail(ctx context.Context, transportationID int3
 	}, nil
 }
 
-func (r *FlightsRepo) CreateFlightDetail(ctx context.Context, qtx *sqlc.Queries, transportationID int32, flight entity.FlightDetail) error {
+func (r *FlightsRepo) CreateFlightDetail(ctx context.Context, qtx *sqlc.Queries, tripID int32, transportationID int32, flight entity.FlightDetail) error {
 	err := r.saveAirports(ctx, qtx, flight.Legs)
 	if err != nil {
 		return fmt.Errorf("save airports: %w", err)
@@ -53,7 +61,7 @@ func (r *FlightsRepo) CreateFlightDetail(ctx context.Context, qtx *sqlc.Queries,
 		return fmt.Errorf("save flight legs: %w", err)
 	}
 
-	err = r.createPNRs(ctx, qtx, transportationID, flight)
+	err = r.createPNRs(ctx, qtx, tripID, transportationID, flight)
 	if err != nil {
 		return fmt.Errorf("save pnrs: %w", err)
 	}
@@ -86,6 +94,7 @@ func (r *FlightsRepo) UpdateFlightLegs(ctx context.Context, flightLegs []entity.
 			ArrivalTime:       leg.ArrivalDateTime,
 			DurationInMinutes: leg.DurationInMinutes,
 			Aircraft:          leg.Aircraft,
+			CabinClass:        (*string)(leg.CabinClass),
 		})
 		if err != nil {
 			return fmt.Errorf("update flight leg [id=%d]: %w", leg.ID, err)
@@ -115,6 +124,13 @@ func (r *FlightsRepo) saveAirports(ctx context.Context, qtx *sqlc.Queries, fligh
 			return fmt.Errorf("check airport exists: %w", err)
 		}
 		if exists {
+			// airports stored before timezones were tracked are completed on their next use
+			if err := qtx.UpdateAirportTimezone(ctx, sqlc.UpdateAirportTimezoneParams{
+				Iata:     airport.Iata,
+				Timezone: airport.Location.Timezone,
+			}); err != nil {
+				return fmt.Errorf("update airport timezone: %w", err)
+			}
 			continue
 		}
 
@@ -149,6 +165,7 @@ func (r *FlightsRepo) createFlightLegs(ctx context.Context, qtx *sqlc.Queries, t
 			AmadeusDate:       leg.AmadeusFlightDate,
 			DurationInMinutes: leg.DurationInMinutes,
 			Aircraft:          leg.Aircraft,
+			CabinClass:        (*string)(leg.CabinClass),
 		})
 		if err != nil {
 			return fmt.Errorf("insert leg: %w", err)
@@ -158,12 +175,17 @@ func (r *FlightsRepo) createFlightLegs(ctx context.Context, qtx *sqlc.Queries, t
 	return nil
 }
 
-func (r *FlightsRepo) createPNRs(ctx context.Context, qtx *sqlc.Queries, transportationID int32, flight entity.FlightDetail) error {
+func (r *FlightsRepo) createPNRs(ctx context.Context, qtx *sqlc.Queries, tripID int32, transportationID int32, flight entity.FlightDetail) error {
 	for _, pnr := range flight.PNRs {
-		_, err := qtx.InsertPNR(ctx, sqlc.InsertPNRParams{
+		encrypted, err := r.keys.encrypt(ctx, qtx, tripID, pnr.PNR)
+		if err != nil {
+			return fmt.Errorf("encrypt pnr: %w", err)
+		}
+
+		_, err = qtx.InsertPNR(ctx, sqlc.InsertPNRParams{
 			TransportationID: transportationID,
 			Airline:          pnr.Airline,
-			Pnr:              pnr.PNR,
+			Pnr:              encrypted,
 		})
 		if err != nil {
 			return fmt.Errorf("insert pnr: %w", err)
@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/pkg/postgres"
+	"kompass/pkg/sqlc"
+)
+
+// HistoryRepo stores snapshots encrypted, as they contain sensitive descriptions and PNRs in plain text.
+type HistoryRepo struct {
+	Db      *pgxpool.Pool
+	Queries *sqlc.Queries
+	keys    *TripKeys
+}
+
+func NewHistoryRepo(pg *postgres.Postgres, keys *TripKeys) *HistoryRepo {
+	return &HistoryRepo{
+		pg.Pool,
+		sqlc.New(pg.Pool),
+		keys,
+	}
+}
+
+func (r *HistoryRepo) AddHistoryEntry(ctx context.Context, entry entity.HistoryEntry) error {
+	before, err := r.encryptSnapshot(ctx, entry.TripID, entry.Before)
+	if err != nil {
+		return err
+	}
+	after, err := r.encryptSnapshot(ctx, entry.TripID, entry.After)
+	if err != nil {
+		return err
+	}
+
+	err = r.Queries.InsertHistoryEntry(ctx, sqlc.InsertHistoryEntryParams{
+		TripID:   entry.TripID,
+		UserID:   entry.UserID,
+		Kind:     string(entry.Kind),
+		EntityID: entry.EntityID,
+		Change:   string(entry.Type),
+		Before:   before,
+		After:    after,
+	})
+	if err != nil {
+		return fmt.Errorf("insert history entry [tripID=%d]: %w", entry.TripID, err)
+	}
+	return nil
+}
+
+func (r *HistoryRepo) GetHistory(ctx context.Context, tripID int32, filter request.HistoryFilter) ([]entity.HistoryEntry, error) {
+	rows, err := r.Queries.GetHistory(ctx, sqlc.GetHistoryParams{
+		TripID:     tripID,
+		Kind:       (*string)(filter.Kind),
+		EntityID:   filter.EntityID,
+		UserID:     filter.UserID,
+		BeforeID:   filter.Before,
+		MaxEntries: filter.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get history [tripID=%d]: %w", tripID, err)
+	}
+
+	entries := []entity.HistoryEntry{}
+	for _, row := range rows {
+		entry, err := r.mapHistoryEntry(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (r *HistoryRepo) GetHistoryEntry(ctx context.Context, tripID int32, entryID int32) (entity.HistoryEntry, error) {
+	row, err := r.Queries.GetHistoryEntry(ctx, sqlc.GetHistoryEntryParams{
+		TripID: tripID,
+		ID:     entryID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.HistoryEntry{}, fiber.NewError(fiber.StatusNotFound, "history entry not found")
+		}
+		return entity.HistoryEntry{}, fmt.Errorf("get history entry [id=%d]: %w", entryID, err)
+	}
+
+	return r.mapHistoryEntry(ctx, row)
+}
+
+func (r *HistoryRepo) mapHistoryEntry(ctx context.Context, row sqlc.History) (entity.HistoryEntry, error) {
+	before, err := r.decryptSnapshot(ctx, row.TripID, row.Before)
+	if err != nil {
+		return entity.HistoryEntry{}, fmt.Errorf("decrypt snapshot of history entry [id=%d]: %w", row.ID, err)
+	}
+	after, err := r.decryptSnapshot(ctx, row.TripID, row.After)
+	if err != nil {
+		return entity.HistoryEntry{}, fmt.Errorf("decrypt snapshot of history entry [id=%d]: %w", row.ID, err)
+	}
+
+	return entity.HistoryEntry{
+		ID:        row.ID,
+		TripID:    row.TripID,
+		UserID:    row.UserID,
+		Type:      entity.ChangeType(row.Change),
+		Kind:      entity.EntityKind(row.Kind),
+		EntityID:  row.EntityID,
+		Before:    before,
+		After:     after,
+		CreatedAt: row.CreatedAt,
+	}, nil
+}
+
+func (r *HistoryRepo) encryptSnapshot(ctx context.Context, tripID int32, snapshot json.RawMessage) (*string, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	encrypted, err := r.keys.encrypt(ctx, r.Queries, tripID, string(snapshot))
+	if err != nil {
+		return nil, fmt.Errorf("encrypt snapshot: %w", err)
+	}
+	return &encrypted, nil
+}
+
+func (r *HistoryRepo) decryptSnapshot(ctx context.Context, tripID int32, snapshot *string) (json.RawMessage, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	decrypted, err := r.keys.decrypt(ctx, r.Queries, tripID, *snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(decrypted), nil
+}
@@ -0,0 +1,197 @@
+package postgres
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"kompass/pkg/envelope"
+	"kompass/pkg/postgres"
+	"kompass/pkg/sqlc"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// encryptedPrefix marks encrypted values, followed by the version of the data key and the sealed value.
+const encryptedPrefix = "enc:v"
+
+// TripKeys manages the data keys of trips. Every trip has its own data key, which is stored wrapped with the master
+// key. Rotating a data key creates a new version, older versions are kept until everything is re-encrypted.
+type TripKeys struct {
+	Db      *pgxpool.Pool
+	Queries *sqlc.Queries
+	keyring *envelope.Keyring
+
+	mu       sync.Mutex
+	dataKeys map[tripKeyVersion][]byte
+}
+
+type tripKeyVersion struct {
+	tripID  int32
+	version int32
+}
+
+// NewTripKeys disables encryption if keyring is nil. Values that are already encrypted cannot be read then.
+func NewTripKeys(pg *postgres.Postgres, keyring *envelope.Keyring) *TripKeys {
+	return &TripKeys{
+		Db:       pg.Pool,
+		Queries:  sqlc.New(pg.Pool),
+		keyring:  keyring,
+		dataKeys: map[tripKeyVersion][]byte{},
+	}
+}
+
+func (k *TripKeys) Enabled() bool {
+	return k.keyring != nil
+}
+
+// current returns the latest data key of a trip and creates the first one if the trip has none.
+func (k *TripKeys) current(ctx context.Context, queries *sqlc.Queries, tripID int32) (int32, []byte, error) {
+	tripKey, err := queries.GetCurrentTripKey(ctx, tripID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return k.create(ctx, queries, tripID, 1)
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("get current data key [tripID=%d]: %w", tripID, err)
+	}
+
+	dataKey, err := k.unwrap(tripKey)
+	return tripKey.Version, dataKey, err
+}
+
+// create adds a data key version. If another request created it concurrently, that key is used instead.
+func (k *TripKeys) create(ctx context.Context, queries *sqlc.Queries, tripID int32, version int32) (int32, []byte, error) {
+	dataKey, err := envelope.NewDataKey()
+	if err != nil {
+		return 0, nil, err
+	}
+	masterKeyID, wrapped, err := k.keyring.Wrap(dataKey)
+	if err != nil {
+		return 0, nil, fmt.Errorf("wrap data key [tripID=%d]: %w", tripID, err)
+	}
+
+	inserted, err := queries.InsertTripKey(ctx, sqlc.InsertTripKeyParams{
+		TripID:      tripID,
+		Version:     version,
+		MasterKeyID: masterKeyID,
+		WrappedKey:  wrapped,
+	})
+	if err != nil {
+		return 0, nil, fmt.Errorf("insert data key [tripID=%d, version=%d]: %w", tripID, version, err)
+	}
+	if inserted == 0 {
+		dataKey, err = k.get(ctx, queries, tripID, version)
+	}
+	return version, dataKey, err
+}
+
+func (k *TripKeys) get(ctx context.Context, queries *sqlc.Queries, tripID int32, version int32) ([]byte, error) {
+	k.mu.Lock()
+	dataKey, ok := k.dataKeys[tripKeyVersion{tripID, version}]
+	k.mu.Unlock()
+	if ok {
+		return dataKey, nil
+	}
+
+	tripKey, err := queries.GetTripKey(ctx, sqlc.GetTripKeyParams{TripID: tripID, Version: version})
+	if err != nil {
+		return nil, fmt.Errorf("get data key [tripID=%d, version=%d]: %w", tripID, version, err)
+	}
+	return k.unwrap(tripKey)
+}
+
+func (k *TripKeys) unwrap(tripKey sqlc.TripKey) ([]byte, error) {
+	dataKey, err := k.keyring.Unwrap(tripKey.MasterKeyID, tripKey.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key [tripID=%d, version=%d]: %w", tripKey.TripID, tripKey.Version, err)
+	}
+
+	k.mu.Lock()
+	k.dataKeys[tripKeyVersion{tripKey.TripID, tripKey.Version}] = dataKey
+	k.mu.Unlock()
+	return dataKey, nil
+}
+
+// encrypt seals value with the current data key of the trip. Without a master key, values are stored in plain text.
+func (k *TripKeys) encrypt(ctx context.Context, queries *sqlc.Queries, tripID int32, value string) (string, error) {
+	if !k.Enabled() {
+		return value, nil
+	}
+
+	version, dataKey, err := k.current(ctx, queries, tripID)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := envelope.Seal(dataKey, []byte(value))
+	if err != nil {
+		return "", fmt.Errorf("encrypt [tripID=%d]: %w", tripID, err)
+	}
+	return encryptedPrefix + strconv.Itoa(int(version)) + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decrypt opens values sealed by encrypt and returns values that were stored in plain text unchanged.
+func (k *TripKeys) decrypt(ctx context.Context, queries *sqlc.Queries, tripID int32, value string) (string, error) {
+	version, sealed, ok := parseEncrypted(value)
+	if !ok {
+		return value, nil
+	}
+	if !k.Enabled() {
+		return "", errors.New("value is encrypted, but no master key is configured")
+	}
+
+	dataKey, err := k.get(ctx, queries, tripID, version)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := envelope.Open(dataKey, sealed)
+	if err != nil {
+		return "", fmt.Errorf("decrypt [tripID=%d, version=%d]: %w", tripID, version, err)
+	}
+	return string(plaintext), nil
+}
+
+func parseEncrypted(value string) (int32, []byte, bool) {
+	rest, ok := strings.CutPrefix(value, encryptedPrefix)
+	if !ok {
+		return 0, nil, false
+	}
+	versionString, encoded, ok := strings.Cut(rest, ":")
+	if !ok {
+		return 0, nil, false
+	}
+	version, err := strconv.Atoi(versionString)
+	if err != nil {
+		return 0, nil, false
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, nil, false
+	}
+	return int32(version), sealed, true
+}
+
+// encryptSensitive encrypts free-text that was marked sensitive, which requires a master key.
+func (k *TripKeys) encryptSensitive(ctx context.Context, queries *sqlc.Queries, tripID int32, sensitive bool, value *string) (*string, error) {
+	if !sensitive || value == nil {
+		return value, nil
+	}
+	if !k.Enabled() {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "sensitive descriptions require encryption to be configured")
+	}
+
+	encrypted, err := k.encrypt(ctx, queries, tripID, *value)
+	return &encrypted, err
+}
+
+func (k *TripKeys) decryptSensitive(ctx context.Context, queries *sqlc.Queries, tripID int32, sensitive bool, value *string) (*string, error) {
+	if !sensitive || value == nil {
+		return value, nil
+	}
+
+	decrypted, err := k.decrypt(ctx, queries, tripID, *value)
+	return &decrypted, err
+}
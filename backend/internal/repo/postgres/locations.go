@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"cloud.google.com/go/civil"
 	"context"
 	"errors"
 	"fmt"
@@ -16,11 +17,13 @@ func SaveLocation(ctx context.Context, queries *sqlc.Queries, location entity.Lo
 			ID:        location.ID,
 			Latitude:  location.Latitude,
 			Longitude: location.Longitude,
+			Timezone:  location.Timezone,
 		})
 	} else {
 		return queries.InsertLocation(ctx, sqlc.InsertLocationParams{
 			Latitude:  location.Latitude,
 			Longitude: location.Longitude,
+			Timezone:  location.Timezone,
 		})
 	}
 }
@@ -71,7 +74,7 @@ func DeleteLocation(ctx context.Context, queries *sqlc.Queries, locationID int32
 	return nil
 }
 
-func mapLocationLeftJoin(id *int32, latitude *float32, longitude *float32) *entity.Location {
+func mapLocationLeftJoin(id *int32, latitude *float32, longitude *float32, timezone *string) *entity.Location {
 	if id == nil || latitude == nil || longitude == nil {
 		return nil
 	}
@@ -80,5 +83,14 @@ func mapLocationLeftJoin(id *int32, latitude *float32, longitude *float32) *enti
 		ID:        *id,
 		Latitude:  *latitude,
 		Longitude: *longitude,
+		Timezone:  timezone,
 	}
 }
+
+// utcOffsetAt returns the offset at the optional location, as long as both time and timezone are known.
+func utcOffsetAt(location *entity.Location, date civil.Date, time *civil.Time) *string {
+	if location == nil || time == nil {
+		return nil
+	}
+	return location.UtcOffset(civil.DateTime{Date: date, Time: *time})
+}
@@ -2,22 +2,25 @@
 SELECT sqlc.embed(accommodation), location.*
 FROM accommodation
          LEFT JOIN location on location_id = location.id
-WHERE trip_id = $1;
+WHERE trip_id = $1
+  AND accommodation.deleted_at IS NULL
+  AND accommodation.revision > sqlc.arg(since);
 
 -- name: GetAccommodationByID :one
 SELECT sqlc.embed(accommodation), location.*
 FROM accommodation
          LEFT JOIN location on location_id = location.id
 WHERE trip_id = $1
-  AND accommodation.id = $2;
+  AND accommodation.id = $2
+  AND accommodation.deleted_at IS NULL;
 
 -- name: InsertAccommodation :one
-INSERT INTO accommodation (trip_id, location_id, name, arrival_date, departure_date, check_in_time, check_out_time, description, address, price)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+INSERT INTO accommodation (trip_id, location_id, name, arrival_date, departure_date, check_in_time, check_out_time, description, address, price, sensitive, currency)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11,
+        coalesce(sqlc.narg(currency)::varchar, (SELECT home_currency FROM trip WHERE trip.id = $1)))
 RETURNING id;
 
--- TODO: check tripID!
--- name: UpdateAccommodation :exec
+-- name: UpdateAccommodation :execrows
 UPDATE accommodation
 SET location_id    = $2,
     name           = $3,
@@ -27,12 +30,19 @@ SET location_id    = $2,
     check_out_time = $7,
     description    = $8,
     address        = $9,
-    price          = $10
-WHERE id = $1;
+    price          = $10,
+    sensitive      = $11,
+    currency       = coalesce(sqlc.narg(currency)::varchar, currency)
+WHERE id = $1
+  AND trip_id = sqlc.arg(trip_id)
+  AND deleted_at IS NULL
+  AND (sqlc.narg(revision)::bigint IS NULL OR revision = sqlc.narg(revision));
 
--- name: DeleteAccommodationByID :one
-DELETE
-FROM accommodation
+-- name: TrashAccommodationByID :one
+UPDATE accommodation
+SET deleted_at = now()
 WHERE trip_id = $1
-  AND accommodation.id = $2
+  AND id = $2
+  AND deleted_at IS NULL
+  AND (sqlc.narg(revision)::bigint IS NULL OR revision = sqlc.narg(revision))
 RETURNING id;
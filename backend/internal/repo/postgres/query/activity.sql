@@ -2,21 +2,24 @@
 SELECT sqlc.embed(activity), location.*
 FROM activity
          LEFT JOIN location on activity.location_id = location.id
-WHERE trip_id = $1;
+WHERE trip_id = $1
+  AND activity.deleted_at IS NULL
+  AND activity.revision > sqlc.arg(since);
 
 -- name: GetActivityByID :one
 SELECT sqlc.embed(activity), location.*
 FROM activity
          LEFT JOIN location on activity.location_id = location.id
 WHERE trip_id = $1
-  AND activity.id = $2;
+  AND activity.id = $2
+  AND activity.deleted_at IS NULL;
 
 -- name: InsertActivity :one
-INSERT INTO activity (trip_id, location_id, name, date, time, address, description, price)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id;
+INSERT INTO activity (trip_id, location_id, name, date, time, address, description, price, sensitive, currency)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9,
+        coalesce(sqlc.narg(currency)::varchar, (SELECT home_currency FROM trip WHERE trip.id = $1))) RETURNING id;
 
--- TODO: check tripID!
--- name: UpdateActivity :exec
+-- name: UpdateActivity :execrows
 UPDATE activity
 SET location_id = $2,
     name        = $3,
@@ -24,12 +27,19 @@ SET location_id = $2,
     time        = $5,
     address     = $6,
     description = $7,
-    price       = $8
-WHERE id = $1;
+    price       = $8,
+    sensitive   = $9,
+    currency    = coalesce(sqlc.narg(currency)::varchar, currency)
+WHERE id = $1
+  AND trip_id = sqlc.arg(trip_id)
+  AND deleted_at IS NULL
+  AND (sqlc.narg(revision)::bigint IS NULL OR revision = sqlc.narg(revision));
 
--- name: DeleteActivityByID :one
-DELETE
-FROM activity
+-- name: TrashActivityByID :one
+UPDATE activity
+SET deleted_at = now()
 WHERE trip_id = $1
-  AND activity.id = $2
+  AND id = $2
+  AND deleted_at IS NULL
+  AND (sqlc.narg(revision)::bigint IS NULL OR revision = sqlc.narg(revision))
 RETURNING id;
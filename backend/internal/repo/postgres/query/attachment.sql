@@ -1,22 +1,171 @@
 -- name: GetAttachments :many
-SELECT *
+SELECT id, trip_id, name, size, content_type, sha256, created_at, uploaded_by, blob_key, key_version, revision, updated_at, deleted_at
 FROM attachment
-WHERE trip_id = $1;
+WHERE trip_id = $1
+  AND deleted_at IS NULL
+  AND revision > sqlc.arg(since)
+ORDER BY id;
+
+-- name: GetAttachmentsIncludingTrash :many
+SELECT id, trip_id, name, size, content_type, sha256, created_at, uploaded_by, blob_key, key_version, revision, updated_at, deleted_at
+FROM attachment
+WHERE trip_id = $1
+ORDER BY id;
 
 -- name: GetAttachmentByID :one
-SELECT *
+SELECT id, trip_id, name, size, content_type, sha256, created_at, uploaded_by, blob_key, key_version, revision, updated_at, deleted_at
 FROM attachment
 WHERE trip_id = $1
-  AND id = $2;
+  AND id = $2
+  AND deleted_at IS NULL;
 
 -- name: InsertAttachment :one
-INSERT INTO attachment (trip_id, name, blob)
-VALUES ($1, $2, $3)
+INSERT INTO attachment (trip_id, name, size, content_type, sha256, uploaded_by, blob_key, key_version)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING id;
 
--- name: DeleteAttachmentByID :one
-DELETE
-FROM attachment
+-- name: TrashAttachmentByID :one
+UPDATE attachment
+SET deleted_at = now()
 WHERE trip_id = $1
   AND id = $2
+  AND deleted_at IS NULL
+  AND (sqlc.narg(revision)::bigint IS NULL OR revision = sqlc.narg(revision))
 RETURNING id;
+
+-- name: GetAttachmentBlobs :many
+SELECT DISTINCT blob_key, size, (key_version IS NOT NULL)::boolean AS encrypted
+FROM attachment
+ORDER BY blob_key;
+
+-- name: IsBlobReferenced :one
+SELECT EXISTS(SELECT 1 FROM attachment WHERE blob_key = $1);
+
+-- name: LockBlobKey :exec
+SELECT pg_advisory_xact_lock(hashtextextended(sqlc.arg(blob_key)::text, 0));
+
+-- name: UpdateAttachmentBlob :exec
+UPDATE attachment
+SET blob_key    = $2,
+    key_version = $3
+WHERE id = $1;
+
+-- name: GetActivityAttachments :many
+SELECT attachment_activity.activity_id, attachment.id, attachment.name, attachment.size, attachment.content_type
+FROM attachment_activity
+         JOIN attachment ON attachment.id = attachment_activity.attachment_id
+WHERE attachment.trip_id = sqlc.arg(trip_id)
+  AND attachment.deleted_at IS NULL
+  AND (sqlc.narg(activity_id)::integer IS NULL OR attachment_activity.activity_id = sqlc.narg(activity_id))
+ORDER BY attachment.id;
+
+-- name: LinkAttachmentToActivity :one
+INSERT INTO attachment_activity (attachment_id, activity_id)
+SELECT attachment.id, activity.id
+FROM attachment,
+     activity
+WHERE attachment.id = sqlc.arg(attachment_id)
+  AND activity.id = sqlc.arg(activity_id)
+  AND attachment.trip_id = sqlc.arg(trip_id)
+  AND activity.trip_id = sqlc.arg(trip_id)
+  AND attachment.deleted_at IS NULL
+  AND activity.deleted_at IS NULL
+ON CONFLICT (attachment_id, activity_id) DO UPDATE SET attachment_id = excluded.attachment_id
+RETURNING attachment_id;
+
+-- name: UnlinkAttachmentFromActivity :one
+DELETE
+FROM attachment_activity
+    USING attachment
+WHERE attachment.id = attachment_activity.attachment_id
+  AND attachment.trip_id = sqlc.arg(trip_id)
+  AND attachment_activity.attachment_id = sqlc.arg(attachment_id)
+  AND attachment_activity.activity_id = sqlc.arg(activity_id)
+RETURNING attachment_activity.attachment_id;
+
+-- name: GetAccommodationAttachments :many
+SELECT attachment_accommodation.accommodation_id, attachment.id, attachment.name, attachment.size, attachment.content_type
+FROM attachment_accommodation
+         JOIN attachment ON attachment.id = attachment_accommodation.attachment_id
+WHERE attachment.trip_id = sqlc.arg(trip_id)
+  AND attachment.deleted_at IS NULL
+  AND (sqlc.narg(accommodation_id)::integer IS NULL OR attachment_accommodation.accommodation_id = sqlc.narg(accommodation_id))
+ORDER BY attachment.id;
+
+-- name: LinkAttachmentToAccommodation :one
+INSERT INTO attachment_accommodation (attachment_id, accommodation_id)
+SELECT attachment.id, accommodation.id
+FROM attachment,
+     accommodation
+WHERE attachment.id = sqlc.arg(attachment_id)
+  AND accommodation.id = sqlc.arg(accommodation_id)
+  AND attachment.trip_id = sqlc.arg(trip_id)
+  AND accommodation.trip_id = sqlc.arg(trip_id)
+  AND attachment.deleted_at IS NULL
+  AND accommodation.deleted_at IS NULL
+ON CONFLICT (attachment_id, accommodation_id) DO UPDATE SET attachment_id = excluded.attachment_id
+RETURNING attachment_id;
+
+-- name: UnlinkAttachmentFromAccommodation :one
+DELETE
+FROM attachment_accommodation
+    USING attachment
+WHERE attachment.id = attachment_accommodation.attachment_id
+  AND attachment.trip_id = sqlc.arg(trip_id)
+  AND attachment_accommodation.attachment_id = sqlc.arg(attachment_id)
+  AND attachment_accommodation.accommodation_id = sqlc.arg(accommodation_id)
+RETURNING attachment_accommodation.attachment_id;
+
+-- name: GetTransportationAttachments :many
+SELECT attachment_transportation.transportation_id, attachment.id, attachment.name, attachment.size, attachment.content_type
+FROM attachment_transportation
+         JOIN attachment ON attachment.id = attachment_transportation.attachment_id
+WHERE attachment.trip_id = sqlc.arg(trip_id)
+  AND attachment.deleted_at IS NULL
+  AND (sqlc.narg(transportation_id)::integer IS NULL OR attachment_transportation.transportation_id = sqlc.narg(transportation_id))
+ORDER BY attachment.id;
+
+-- name: LinkAttachmentToTransportation :one
+INSERT INTO attachment_transportation (attachment_id, transportation_id)
+SELECT attachment.id, transportation.id
+FROM attachment,
+     transportation
+WHERE attachment.id = sqlc.arg(attachment_id)
+  AND transportation.id = sqlc.arg(transportation_id)
+  AND attachment.trip_id = sqlc.arg(trip_id)
+  AND transportation.trip_id = sqlc.arg(trip_id)
+  AND attachment.deleted_at IS NULL
+  AND transportation.deleted_at IS NULL
+ON CONFLICT (attachment_id, transportation_id) DO UPDATE SET attachment_id = excluded.attachment_id
+RETURNING attachment_id;
+
+-- name: UnlinkAttachmentFromTransportation :one
+DELETE
+FROM attachment_transportation
+    USING attachment
+WHERE attachment.id = attachment_transportation.attachment_id
+  AND attachment.trip_id = sqlc.arg(trip_id)
+  AND attachment_transportation.attachment_id = sqlc.arg(attachment_id)
+  AND attachment_transportation.transportation_id = sqlc.arg(transportation_id)
+RETURNING attachment_transportation.attachment_id;
+
+-- name: GetAttachmentThumbnail :one
+SELECT attachment_thumbnail.data, attachment_thumbnail.key_version
+FROM attachment_thumbnail
+         JOIN attachment ON attachment.id = attachment_thumbnail.attachment_id
+WHERE attachment.trip_id = $1
+  AND attachment.deleted_at IS NULL
+  AND attachment_thumbnail.attachment_id = $2
+  AND attachment_thumbnail.size = $3;
+
+-- name: InsertAttachmentThumbnail :exec
+INSERT INTO attachment_thumbnail (attachment_id, size, data, key_version)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (attachment_id, size) DO NOTHING;
+
+-- name: DeleteTripThumbnails :exec
+DELETE
+FROM attachment_thumbnail
+    USING attachment
+WHERE attachment.id = attachment_thumbnail.attachment_id
+  AND attachment.trip_id = $1;
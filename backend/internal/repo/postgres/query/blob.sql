@@ -0,0 +1,24 @@
+-- name: BlobExists :one
+SELECT EXISTS(SELECT 1 FROM attachment_blob WHERE blob_key = $1);
+
+-- name: InsertBlob :execrows
+INSERT INTO attachment_blob (blob_key)
+VALUES ($1)
+ON CONFLICT (blob_key) DO NOTHING;
+
+-- name: InsertBlobChunk :exec
+INSERT INTO attachment_blob_chunk (blob_key, start, data)
+VALUES ($1, $2, $3);
+
+-- name: GetBlobChunk :one
+SELECT start, data
+FROM attachment_blob_chunk
+WHERE blob_key = sqlc.arg(blob_key)
+  AND start <= sqlc.arg(position)::bigint
+ORDER BY start DESC
+LIMIT 1;
+
+-- name: DeleteBlob :exec
+DELETE
+FROM attachment_blob
+WHERE blob_key = $1;
@@ -0,0 +1,20 @@
+-- name: GetCategoryBudgets :many
+SELECT *
+FROM category_budget
+WHERE trip_id = $1
+ORDER BY category;
+
+-- name: DeleteCategoryBudgets :exec
+DELETE
+FROM category_budget
+WHERE trip_id = $1;
+
+-- name: InsertCategoryBudget :exec
+INSERT INTO category_budget (trip_id, category, amount)
+VALUES ($1, $2, $3);
+
+-- name: UpdateTripBudget :one
+UPDATE trip
+SET budget = $2
+WHERE id = $1
+RETURNING id;
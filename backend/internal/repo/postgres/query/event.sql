@@ -0,0 +1,2 @@
+-- name: NotifyTripEvent :exec
+SELECT pg_notify('trip_events', @payload::text);
@@ -0,0 +1,20 @@
+-- name: UpsertExchangeRate :exec
+INSERT INTO exchange_rate (date, currency, rate)
+VALUES ($1, $2, $3)
+ON CONFLICT (date, currency) DO UPDATE
+    SET rate = excluded.rate;
+
+-- name: GetLatestExchangeRateDate :one
+SELECT date
+FROM exchange_rate
+ORDER BY date DESC
+LIMIT 1;
+
+-- name: GetExchangeRate :one
+-- Returns the rate published on or before the given date. For dates before the first stored rate, the earliest
+-- rate is used instead.
+SELECT *
+FROM exchange_rate
+WHERE currency = $1
+ORDER BY date > sqlc.arg(date), abs(date - sqlc.arg(date))
+LIMIT 1;
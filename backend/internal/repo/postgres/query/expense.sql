@@ -0,0 +1,92 @@
+-- name: GetExpenses :many
+SELECT *
+FROM expense
+WHERE trip_id = $1
+  AND deleted_at IS NULL
+  AND revision > sqlc.arg(since)
+ORDER BY date, id;
+
+-- name: GetExpenseByID :one
+SELECT *
+FROM expense
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NULL;
+
+-- name: GetExpenseParticipants :many
+SELECT expense_participant.*
+FROM expense_participant
+         JOIN expense ON expense_participant.expense_id = expense.id
+WHERE expense.trip_id = $1
+  AND expense.deleted_at IS NULL
+ORDER BY expense_id, user_id;
+
+-- name: GetExpenseParticipantsByExpenseID :many
+SELECT *
+FROM expense_participant
+WHERE expense_id = $1
+ORDER BY user_id;
+
+-- name: InsertExpense :one
+INSERT INTO expense (trip_id, name, date, amount, currency, payer_id, split_mode, transportation_id, activity_id,
+                     accommodation_id, attachment_id, category)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id;
+
+-- name: UpdateExpense :execrows
+UPDATE expense
+SET name              = $3,
+    date              = $4,
+    amount            = $5,
+    currency          = $6,
+    payer_id          = $7,
+    split_mode        = $8,
+    transportation_id = $9,
+    activity_id       = $10,
+    accommodation_id  = $11,
+    attachment_id     = $12,
+    category          = $13
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NULL
+  AND (sqlc.narg(revision)::bigint IS NULL OR revision = sqlc.narg(revision));
+
+-- name: TrashExpenseByID :one
+UPDATE expense
+SET deleted_at = now()
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NULL
+  AND (sqlc.narg(revision)::bigint IS NULL OR revision = sqlc.narg(revision))
+RETURNING id;
+
+-- name: InsertExpenseParticipant :exec
+INSERT INTO expense_participant (expense_id, user_id, share)
+VALUES ($1, $2, $3);
+
+-- name: DeleteExpenseParticipants :exec
+DELETE
+FROM expense_participant
+WHERE expense_id = $1;
+
+-- name: ExpenseLinksBelongToTrip :one
+SELECT (sqlc.narg(transportation_id)::integer IS NULL OR EXISTS (SELECT 1
+                                                               FROM transportation
+                                                               WHERE id = sqlc.narg(transportation_id)::integer
+                                                                 AND trip_id = sqlc.arg(trip_id)::integer
+                                                                 AND deleted_at IS NULL))
+           AND (sqlc.narg(activity_id)::integer IS NULL OR EXISTS (SELECT 1
+                                                                  FROM activity
+                                                                  WHERE id = sqlc.narg(activity_id)::integer
+                                                                    AND trip_id = sqlc.arg(trip_id)::integer
+                                                                    AND deleted_at IS NULL))
+           AND (sqlc.narg(accommodation_id)::integer IS NULL OR EXISTS (SELECT 1
+                                                                       FROM accommodation
+                                                                       WHERE id = sqlc.narg(accommodation_id)::integer
+                                                                         AND trip_id = sqlc.arg(trip_id)::integer
+                                                                         AND deleted_at IS NULL))
+           AND (sqlc.narg(attachment_id)::integer IS NULL OR EXISTS (SELECT 1
+                                                                    FROM attachment
+                                                                    WHERE id = sqlc.narg(attachment_id)::integer
+                                                                      AND trip_id = sqlc.arg(trip_id)::integer
+                                                                      AND deleted_at IS NULL));
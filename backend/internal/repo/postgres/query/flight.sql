@@ -18,8 +18,8 @@ FROM flight_pnr
 WHERE transportation_id = $1;
 
 -- name: InsertFlightLeg :one
-INSERT INTO flight_leg (transportation_id, origin, destination, airline, flight_number, departure_time, arrival_time, amadeus_date, duration_in_minutes, aircraft)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+INSERT INTO flight_leg (transportation_id, origin, destination, airline, flight_number, departure_time, arrival_time, amadeus_date, duration_in_minutes, aircraft, cabin_class)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 RETURNING id;
 
 -- name: UpdateFlightLeg :exec
@@ -32,7 +32,8 @@ SET origin              = $2,
     arrival_time        = $7,
     amadeus_date        = $8,
     duration_in_minutes = $9,
-    aircraft            = $10
+    aircraft            = $10,
+    cabin_class         = $11
 WHERE id = $1;
 
 -- name: AirportExists :one
@@ -42,6 +43,14 @@ SELECT EXISTS (
     WHERE iata = $1
 );
 
+-- name: UpdateAirportTimezone :exec
+UPDATE location
+SET timezone = $2
+FROM airport
+WHERE airport.location_id = location.id
+  AND airport.iata = $1
+  AND location.timezone IS NULL;
+
 -- name: InsertAirport :exec
 INSERT INTO airport (iata, name, municipality, location_id)
 VALUES ($1, $2, $3, $4);
@@ -0,0 +1,20 @@
+-- name: InsertHistoryEntry :exec
+INSERT INTO history (trip_id, user_id, kind, entity_id, change, before, after)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetHistory :many
+SELECT *
+FROM history
+WHERE trip_id = sqlc.arg(trip_id)
+  AND (sqlc.narg(kind)::varchar IS NULL OR kind = sqlc.narg(kind))
+  AND (sqlc.narg(entity_id)::integer IS NULL OR entity_id = sqlc.narg(entity_id))
+  AND (sqlc.narg(user_id)::integer IS NULL OR user_id = sqlc.narg(user_id))
+  AND (sqlc.narg(before_id)::integer IS NULL OR id < sqlc.narg(before_id))
+ORDER BY id DESC
+LIMIT sqlc.arg(max_entries);
+
+-- name: GetHistoryEntry :one
+SELECT *
+FROM history
+WHERE trip_id = $1
+  AND id = $2;
@@ -1,12 +1,13 @@
 -- name: InsertLocation :one
-INSERT INTO location (latitude, longitude)
-VALUES ($1, $2)
+INSERT INTO location (latitude, longitude, timezone)
+VALUES ($1, $2, $3)
 RETURNING id;
 
 -- name: UpdateLocation :exec
 UPDATE location
 SET latitude  = $2,
-    longitude = $3
+    longitude = $3,
+    timezone  = $4
 WHERE id = $1;
 
 -- name: GetLocationIDByActivityID :one
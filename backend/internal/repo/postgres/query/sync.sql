@@ -0,0 +1,34 @@
+-- name: GetTripRevision :one
+SELECT revision
+FROM trip_revision
+WHERE trip_id = $1;
+
+-- name: GetTombstones :many
+SELECT kind, entity_id, revision
+FROM tombstone
+WHERE trip_id = $1
+  AND revision > $2
+ORDER BY revision;
+
+-- name: ClaimClientID :execrows
+INSERT INTO sync_creation (trip_id, client_id, kind)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: GetSyncCreation :one
+SELECT kind, entity_id
+FROM sync_creation
+WHERE trip_id = $1
+  AND client_id = $2;
+
+-- name: SetSyncCreationEntity :exec
+UPDATE sync_creation
+SET entity_id = $3
+WHERE trip_id = $1
+  AND client_id = $2;
+
+-- name: ReleaseClientID :exec
+DELETE FROM sync_creation
+WHERE trip_id = $1
+  AND client_id = $2
+  AND entity_id IS NULL;
@@ -28,6 +28,14 @@ SELECT EXISTS (
     WHERE id = $1
 );
 
+-- name: UpdateTrainStationTimezone :exec
+UPDATE location
+SET timezone = $2
+FROM train_station
+WHERE train_station.location_id = location.id
+  AND train_station.id = $1
+  AND location.timezone IS NULL;
+
 -- name: InsertTrainStation :exec
 INSERT INTO train_station (id, name, location_id)
 VALUES ($1, $2, $3);
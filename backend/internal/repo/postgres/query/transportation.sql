@@ -5,7 +5,9 @@ SELECT sqlc.embed(transportation),
 FROM transportation
          JOIN location origin on transportation.origin_id = origin.id
          JOIN location destination on transportation.destination_id = destination.id
-WHERE transportation.trip_id = $1;
+WHERE transportation.trip_id = $1
+  AND transportation.deleted_at IS NULL
+  AND transportation.revision > sqlc.arg(since);
 
 -- name: GetTransportationByID :one
 SELECT sqlc.embed(transportation),
@@ -15,23 +17,28 @@ FROM transportation
          JOIN location origin on transportation.origin_id = origin.id
          JOIN location destination on transportation.destination_id = destination.id
 WHERE transportation.trip_id = $1
-  AND transportation.id = $2;
+  AND transportation.id = $2
+  AND transportation.deleted_at IS NULL;
 
 -- name: InsertTransportation :one
-INSERT INTO transportation (trip_id, type, origin_id, destination_id, departure_time, arrival_time, price)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO transportation (trip_id, type, origin_id, destination_id, departure_time, arrival_time, price, currency)
+VALUES ($1, $2, $3, $4, $5, $6, $7,
+        coalesce(sqlc.narg(currency)::varchar, (SELECT home_currency FROM trip WHERE trip.id = $1)))
 RETURNING id;
 
--- TODO: check tripID!
--- name: UpdateTransportation :exec
+-- name: UpdateTransportation :execrows
 UPDATE transportation
 SET type           = $2,
     origin_id      = $3,
     destination_id = $4,
     departure_time = $5,
     arrival_time   = $6,
-    price          = $7
-WHERE id = $1;
+    price          = $7,
+    currency       = coalesce(sqlc.narg(currency)::varchar, currency)
+WHERE id = $1
+  AND trip_id = sqlc.arg(trip_id)
+  AND deleted_at IS NULL
+  AND (sqlc.narg(revision)::bigint IS NULL OR revision = sqlc.narg(revision));
 
 -- name: UpsertGenericTransportationDetail :exec
 INSERT INTO transportation_generic (transportation_id, name, origin_address, destination_address)
@@ -46,21 +53,79 @@ SELECT *
 FROM transportation_generic
 WHERE transportation_id = $1;
 
--- name: DeleteTransportationByID :one
-DELETE
-FROM transportation
+-- name: TrashTransportationByID :one
+UPDATE transportation
+SET deleted_at = now()
 WHERE trip_id = $1
   AND id = $2
+  AND deleted_at IS NULL
+  AND (sqlc.narg(revision)::bigint IS NULL OR revision = sqlc.narg(revision))
 RETURNING id;
 
 -- name: GetAllGeoJson :many
 SELECT transportation_geojson.geojson
 FROM transportation_geojson
          JOIN transportation on transportation_geojson.transportation_id = transportation.id
-WHERE transportation.trip_id = $1;
+         LEFT JOIN transportation_track on transportation_track.transportation_id = transportation.id
+WHERE transportation.trip_id = $1
+  AND transportation.deleted_at IS NULL
+  AND (transportation_track.display IS NULL OR transportation_track.display != 'ACTUAL')
+UNION ALL
+SELECT transportation_track.geojson
+FROM transportation_track
+         JOIN transportation on transportation_track.transportation_id = transportation.id
+WHERE transportation.trip_id = $1
+  AND transportation.deleted_at IS NULL
+  AND transportation_track.display != 'PLANNED';
+
+-- name: GetRouteGeoJson :many
+SELECT transportation_geojson.transportation_id, transportation_geojson.geojson
+FROM transportation_geojson
+         JOIN transportation on transportation_geojson.transportation_id = transportation.id
+WHERE transportation.trip_id = sqlc.arg(trip_id)
+  AND transportation.id = ANY (sqlc.arg(ids)::integer[])
+  AND transportation.deleted_at IS NULL;
 
 -- name: UpsertGeoJson :exec
 INSERT INTO transportation_geojson (transportation_id, geojson)
 VALUES ($1, $2)
 ON CONFLICT(transportation_id) DO UPDATE SET
     geojson = $2;
+
+-- name: UpsertTrack :exec
+INSERT INTO transportation_track (transportation_id, display, distance_in_meters, elevation_gain_in_meters, start_time, end_time, geojson)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT(transportation_id) DO UPDATE SET
+    display = $2,
+    distance_in_meters = $3,
+    elevation_gain_in_meters = $4,
+    start_time = $5,
+    end_time = $6,
+    geojson = $7;
+
+-- name: GetTrackByTransportationID :one
+SELECT transportation_id, display, distance_in_meters, elevation_gain_in_meters, start_time, end_time
+FROM transportation_track
+WHERE transportation_id = $1;
+
+-- name: UpdateTrackDisplay :one
+UPDATE transportation_track
+SET display = $3
+FROM transportation
+WHERE transportation_track.transportation_id = transportation.id
+  AND transportation.trip_id = $1
+  AND transportation.id = $2
+  AND transportation.deleted_at IS NULL
+  AND (sqlc.narg(revision)::bigint IS NULL OR transportation.revision = sqlc.narg(revision))
+RETURNING transportation_track.transportation_id;
+
+-- name: DeleteTrack :one
+DELETE
+FROM transportation_track
+USING transportation
+WHERE transportation_track.transportation_id = transportation.id
+  AND transportation.trip_id = $1
+  AND transportation.id = $2
+  AND transportation.deleted_at IS NULL
+  AND (sqlc.narg(revision)::bigint IS NULL OR transportation.revision = sqlc.narg(revision))
+RETURNING transportation_track.transportation_id;
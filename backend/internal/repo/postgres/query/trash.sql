@@ -0,0 +1,131 @@
+-- name: GetTrash :many
+WITH accessible AS (SELECT DISTINCT trip.id, trip.name, trip.deleted_at
+                    FROM trip
+                             LEFT JOIN permissions p ON trip.id = p.trip_id
+                    WHERE (trip.owner_id = sqlc.arg(user_id) OR p.user_id = sqlc.arg(user_id))
+                      AND (sqlc.narg(trip_id)::integer IS NULL OR trip.id = sqlc.narg(trip_id)))
+SELECT 'trip'::varchar AS kind, id, id AS trip_id, name, deleted_at::timestamptz AS deleted_at
+FROM accessible
+WHERE deleted_at IS NOT NULL
+UNION ALL
+SELECT 'transportation', transportation.id, transportation.trip_id,
+       coalesce(transportation_generic.name, transportation.type), transportation.deleted_at
+FROM transportation
+         JOIN accessible ON accessible.id = transportation.trip_id
+         LEFT JOIN transportation_generic ON transportation_generic.transportation_id = transportation.id
+WHERE accessible.deleted_at IS NULL
+  AND transportation.deleted_at IS NOT NULL
+UNION ALL
+SELECT 'activity', activity.id, activity.trip_id, activity.name, activity.deleted_at
+FROM activity
+         JOIN accessible ON accessible.id = activity.trip_id
+WHERE accessible.deleted_at IS NULL
+  AND activity.deleted_at IS NOT NULL
+UNION ALL
+SELECT 'accommodation', accommodation.id, accommodation.trip_id, accommodation.name, accommodation.deleted_at
+FROM accommodation
+         JOIN accessible ON accessible.id = accommodation.trip_id
+WHERE accessible.deleted_at IS NULL
+  AND accommodation.deleted_at IS NOT NULL
+UNION ALL
+SELECT 'expense', expense.id, expense.trip_id, expense.name, expense.deleted_at
+FROM expense
+         JOIN accessible ON accessible.id = expense.trip_id
+WHERE accessible.deleted_at IS NULL
+  AND expense.deleted_at IS NOT NULL
+UNION ALL
+SELECT 'attachment', attachment.id, attachment.trip_id, attachment.name, attachment.deleted_at
+FROM attachment
+         JOIN accessible ON accessible.id = attachment.trip_id
+WHERE accessible.deleted_at IS NULL
+  AND attachment.deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, kind, id;
+
+-- name: RestoreTripByID :one
+UPDATE trip
+SET deleted_at = NULL
+WHERE id = sqlc.arg(trip_id)
+  AND deleted_at IS NOT NULL
+  AND (owner_id = sqlc.arg(user_id) OR EXISTS (SELECT 1
+                                               FROM permissions p
+                                               WHERE p.trip_id = trip.id
+                                                 AND p.user_id = sqlc.arg(user_id)
+                                                 AND p.write is true))
+RETURNING id;
+
+-- name: RestoreTransportationByID :one
+UPDATE transportation
+SET deleted_at = NULL
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NOT NULL
+RETURNING id;
+
+-- name: RestoreActivityByID :one
+UPDATE activity
+SET deleted_at = NULL
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NOT NULL
+RETURNING id;
+
+-- name: RestoreAccommodationByID :one
+UPDATE accommodation
+SET deleted_at = NULL
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NOT NULL
+RETURNING id;
+
+-- name: RestoreExpenseByID :one
+UPDATE expense
+SET deleted_at = NULL
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NOT NULL
+RETURNING id;
+
+-- name: RestoreAttachmentByID :one
+UPDATE attachment
+SET deleted_at = NULL
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NOT NULL
+RETURNING id;
+
+-- name: GetBlobKeysOfTrashedTrips :many
+SELECT DISTINCT attachment.blob_key
+FROM attachment
+         JOIN trip ON trip.id = attachment.trip_id
+WHERE trip.deleted_at < sqlc.arg(before)::timestamptz;
+
+-- name: PurgeTrashedAttachments :many
+DELETE
+FROM attachment
+WHERE deleted_at < sqlc.arg(before)::timestamptz
+RETURNING blob_key;
+
+-- name: PurgeTrashedTransportation :execrows
+DELETE
+FROM transportation
+WHERE deleted_at < sqlc.arg(before)::timestamptz;
+
+-- name: PurgeTrashedActivities :execrows
+DELETE
+FROM activity
+WHERE deleted_at < sqlc.arg(before)::timestamptz;
+
+-- name: PurgeTrashedAccommodation :execrows
+DELETE
+FROM accommodation
+WHERE deleted_at < sqlc.arg(before)::timestamptz;
+
+-- name: PurgeTrashedExpenses :execrows
+DELETE
+FROM expense
+WHERE deleted_at < sqlc.arg(before)::timestamptz;
+
+-- name: PurgeTrashedTrips :execrows
+DELETE
+FROM trip
+WHERE deleted_at < sqlc.arg(before)::timestamptz;
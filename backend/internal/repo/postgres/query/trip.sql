@@ -2,29 +2,146 @@
 SELECT trip.*
 FROM trip
 LEFT JOIN permissions p ON trip.id = p.trip_id
-WHERE trip.owner_id = sqlc.arg(user_id) OR p.user_id = sqlc.arg(user_id);
+WHERE (trip.owner_id = sqlc.arg(user_id) OR p.user_id = sqlc.arg(user_id))
+  AND trip.deleted_at IS NULL;
 
 -- name: GetTripByID :one
 SELECT *
 FROM trip
-WHERE id = $1;
+WHERE id = $1
+  AND deleted_at IS NULL;
+
+-- name: GetTemplates :many
+SELECT *
+FROM trip
+WHERE template
+  AND deleted_at IS NULL
+ORDER BY name;
 
 -- name: InsertTrip :one
-INSERT INTO trip (owner_id, name, start_date, end_date, description, image_url)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO trip (owner_id, name, start_date, end_date, description, image_url, home_currency, template)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING id;
 
--- name: UpdateTrip :exec
+-- name: UpdateTrip :execrows
 UPDATE trip
 SET name        = $2,
     start_date  = $3,
     end_date    = $4,
     description = $5,
-    image_url   = $6
-WHERE id = $1;
+    image_url     = $6,
+    template      = $7,
+    home_currency = coalesce(sqlc.narg(home_currency)::varchar, home_currency)
+WHERE id = $1
+  AND deleted_at IS NULL
+  AND (sqlc.narg(revision)::bigint IS NULL OR revision = sqlc.narg(revision));
 
--- name: DeleteTripByID :one
-DELETE
-FROM trip
+-- name: TrashTripByID :one
+UPDATE trip
+SET deleted_at = now()
 WHERE id = $1
+  AND deleted_at IS NULL
+  AND (sqlc.narg(revision)::bigint IS NULL OR revision = sqlc.narg(revision))
 RETURNING id;
+
+-- name: GetEntitiesOutOfRange :many
+-- the dates are shifted by the given days before they are compared with the trip dates
+SELECT 'transportation'::varchar AS kind, transportation.id,
+       coalesce(transportation_generic.name, transportation.type)::varchar AS name,
+       (transportation.departure_time::date + sqlc.arg(days)::integer)::date AS start_date,
+       (transportation.arrival_time::date + sqlc.arg(days)::integer)::date AS end_date
+FROM transportation
+         LEFT JOIN transportation_generic ON transportation_generic.transportation_id = transportation.id
+WHERE transportation.trip_id = sqlc.arg(trip_id)
+  AND transportation.deleted_at IS NULL
+  AND (transportation.departure_time::date + sqlc.arg(days)::integer < sqlc.arg(start_date)::date
+    OR transportation.arrival_time::date + sqlc.arg(days)::integer > sqlc.arg(end_date)::date)
+UNION ALL
+SELECT 'activity', id, name, date + sqlc.arg(days)::integer, date + sqlc.arg(days)::integer
+FROM activity
+WHERE trip_id = sqlc.arg(trip_id)
+  AND deleted_at IS NULL
+  AND (date + sqlc.arg(days)::integer < sqlc.arg(start_date)::date
+    OR date + sqlc.arg(days)::integer > sqlc.arg(end_date)::date)
+UNION ALL
+SELECT 'accommodation', id, name, arrival_date + sqlc.arg(days)::integer, departure_date + sqlc.arg(days)::integer
+FROM accommodation
+WHERE trip_id = sqlc.arg(trip_id)
+  AND deleted_at IS NULL
+  AND (arrival_date + sqlc.arg(days)::integer < sqlc.arg(start_date)::date
+    OR departure_date + sqlc.arg(days)::integer > sqlc.arg(end_date)::date)
+ORDER BY start_date, kind, id;
+
+-- name: ShiftTransportation :many
+-- trashed entities are shifted as well, so they are still within the trip dates if they are restored
+UPDATE transportation
+SET departure_time = departure_time + make_interval(days => sqlc.arg(days)::integer),
+    arrival_time   = arrival_time + make_interval(days => sqlc.arg(days)::integer)
+WHERE trip_id = sqlc.arg(trip_id)
+RETURNING id, (deleted_at IS NOT NULL)::boolean AS trashed;
+
+-- name: ShiftFlightLegs :exec
+UPDATE flight_leg
+SET departure_time = departure_time + make_interval(days => sqlc.arg(days)::integer),
+    arrival_time   = arrival_time + make_interval(days => sqlc.arg(days)::integer),
+    amadeus_date   = amadeus_date + sqlc.arg(days)::integer
+FROM transportation
+WHERE transportation.id = flight_leg.transportation_id
+  AND transportation.trip_id = sqlc.arg(trip_id);
+
+-- name: ShiftTrainLegs :exec
+UPDATE train_leg
+SET departure_time = departure_time + make_interval(days => sqlc.arg(days)::integer),
+    arrival_time   = arrival_time + make_interval(days => sqlc.arg(days)::integer)
+FROM transportation
+WHERE transportation.id = train_leg.transportation_id
+  AND transportation.trip_id = sqlc.arg(trip_id);
+
+-- name: ShiftActivities :many
+UPDATE activity
+SET date = date + sqlc.arg(days)::integer
+WHERE trip_id = sqlc.arg(trip_id)
+RETURNING id, (deleted_at IS NOT NULL)::boolean AS trashed;
+
+-- name: ShiftAccommodation :many
+UPDATE accommodation
+SET arrival_date   = arrival_date + sqlc.arg(days)::integer,
+    departure_date = departure_date + sqlc.arg(days)::integer
+WHERE trip_id = sqlc.arg(trip_id)
+RETURNING id, (deleted_at IS NOT NULL)::boolean AS trashed;
+
+-- name: GetTripRouteGeoJson :many
+SELECT transportation_geojson.transportation_id, transportation_geojson.geojson
+FROM transportation_geojson
+         JOIN transportation on transportation_geojson.transportation_id = transportation.id
+WHERE transportation.trip_id = $1;
+
+-- name: GetTripTrackGeoJson :many
+SELECT transportation_track.transportation_id, transportation_track.geojson
+FROM transportation_track
+         JOIN transportation on transportation_track.transportation_id = transportation.id
+WHERE transportation.trip_id = $1;
+
+-- name: ShiftTrack :exec
+UPDATE transportation_track
+SET start_time = start_time + make_interval(days => sqlc.arg(days)::integer),
+    end_time   = end_time + make_interval(days => sqlc.arg(days)::integer),
+    geojson    = sqlc.arg(geojson)
+WHERE transportation_id = sqlc.arg(transportation_id);
+
+-- name: GetDatedEntityIDs :many
+SELECT 'transportation'::varchar AS kind, id
+FROM transportation
+WHERE trip_id = sqlc.arg(trip_id)::integer
+  AND deleted_at IS NULL
+UNION ALL
+SELECT 'activity', id
+FROM activity
+WHERE trip_id = sqlc.arg(trip_id)::integer
+  AND deleted_at IS NULL
+UNION ALL
+SELECT 'accommodation', id
+FROM accommodation
+WHERE trip_id = sqlc.arg(trip_id)::integer
+  AND deleted_at IS NULL
+ORDER BY kind, id;
@@ -0,0 +1,87 @@
+-- name: GetCurrentTripKey :one
+SELECT *
+FROM trip_key
+WHERE trip_id = $1
+ORDER BY version DESC
+LIMIT 1;
+
+-- name: GetTripKey :one
+SELECT *
+FROM trip_key
+WHERE trip_id = $1
+  AND version = $2;
+
+-- name: GetAllTripKeys :many
+SELECT *
+FROM trip_key
+ORDER BY trip_id, version;
+
+-- name: InsertTripKey :execrows
+INSERT INTO trip_key (trip_id, version, master_key_id, wrapped_key)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (trip_id, version) DO NOTHING;
+
+-- name: UpdateTripKeyWrapping :exec
+UPDATE trip_key
+SET master_key_id = $3,
+    wrapped_key   = $4
+WHERE trip_id = $1
+  AND version = $2;
+
+-- name: DeletePreviousTripKeys :exec
+DELETE
+FROM trip_key
+WHERE trip_id = $1
+  AND version < $2;
+
+-- name: GetTripIDs :many
+SELECT id
+FROM trip
+ORDER BY id;
+
+-- name: GetTripPnrs :many
+SELECT flight_pnr.*
+FROM flight_pnr
+         JOIN transportation ON transportation.id = flight_pnr.transportation_id
+WHERE transportation.trip_id = $1;
+
+-- name: UpdatePnr :exec
+UPDATE flight_pnr
+SET pnr = $2
+WHERE id = $1;
+
+-- name: GetSensitiveActivityDescriptions :many
+SELECT id, description
+FROM activity
+WHERE trip_id = $1
+  AND sensitive
+  AND description IS NOT NULL;
+
+-- name: UpdateActivityDescription :exec
+UPDATE activity
+SET description = $2
+WHERE id = $1;
+
+-- name: GetSensitiveAccommodationDescriptions :many
+SELECT id, description
+FROM accommodation
+WHERE trip_id = $1
+  AND sensitive
+  AND description IS NOT NULL;
+
+-- name: UpdateAccommodationDescription :exec
+UPDATE accommodation
+SET description = $2
+WHERE id = $1;
+
+-- name: GetHistorySnapshots :many
+SELECT id, before, after
+FROM history
+WHERE trip_id = $1
+  AND (before IS NOT NULL OR after IS NOT NULL);
+
+-- name: UpdateHistorySnapshots :exec
+UPDATE history
+SET before = $2,
+    after  = $3
+WHERE id = $1;
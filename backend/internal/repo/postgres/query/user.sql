@@ -24,6 +24,7 @@ SELECT EXISTS (
              LEFT JOIN permissions p ON trip.id = p.trip_id
     WHERE (trip.owner_id = sqlc.arg(user_id) OR p.user_id = sqlc.arg(user_id))
       AND id = sqlc.arg(trip_id)
+      AND deleted_at IS NULL
 );
 
 -- name: HasWritePermission :one
@@ -33,6 +34,17 @@ SELECT EXISTS (
              LEFT JOIN permissions p ON trip.id = p.trip_id
     WHERE (trip.owner_id = sqlc.arg(user_id) OR (p.user_id = sqlc.arg(user_id) AND p.write is true))
       AND id = sqlc.arg(trip_id)
+      AND deleted_at IS NULL
+);
+
+-- name: HasReadSensitivePermission :one
+SELECT EXISTS (
+    SELECT 1
+    FROM trip
+             LEFT JOIN permissions p ON trip.id = p.trip_id
+    WHERE (trip.owner_id = sqlc.arg(user_id) OR (p.user_id = sqlc.arg(user_id) AND p.read_sensitive is true))
+      AND id = sqlc.arg(trip_id)
+      AND deleted_at IS NULL
 );
 
 -- name: IsTripOwner :one
@@ -41,4 +53,12 @@ SELECT EXISTS (
     FROM trip
     WHERE trip.owner_id = sqlc.arg(user_id)
       AND id = sqlc.arg(trip_id)
+      AND deleted_at IS NULL
 );
+
+-- name: GetTripMembers :many
+SELECT *
+FROM "user"
+WHERE id = (SELECT owner_id FROM trip WHERE trip.id = sqlc.arg(trip_id))
+   OR id IN (SELECT user_id FROM permissions WHERE permissions.trip_id = sqlc.arg(trip_id))
+ORDER BY id;
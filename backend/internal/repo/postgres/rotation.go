@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kompass/pkg/sqlc"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// RewrapDataKeys wraps all data keys that are not wrapped with the current master key yet, so that previous master
+// keys can be removed from the configuration afterwards. It returns the number of re-wrapped keys.
+func (k *TripKeys) RewrapDataKeys(ctx context.Context) (int, error) {
+	tripKeys, err := k.Queries.GetAllTripKeys(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get all data keys: %w", err)
+	}
+
+	count := 0
+	for _, tripKey := range tripKeys {
+		if tripKey.MasterKeyID == k.keyring.CurrentID() {
+			continue
+		}
+
+		dataKey, err := k.unwrap(tripKey)
+		if err != nil {
+			return count, err
+		}
+		masterKeyID, wrapped, err := k.keyring.Wrap(dataKey)
+		if err != nil {
+			return count, fmt.Errorf("wrap data key [tripID=%d, version=%d]: %w", tripKey.TripID, tripKey.Version, err)
+		}
+		if err := k.Queries.UpdateTripKeyWrapping(ctx, sqlc.UpdateTripKeyWrappingParams{
+			TripID:      tripKey.TripID,
+			Version:     tripKey.Version,
+			MasterKeyID: masterKeyID,
+			WrappedKey:  wrapped,
+		}); err != nil {
+			return count, fmt.Errorf("update data key [tripID=%d, version=%d]: %w", tripKey.TripID, tripKey.Version, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// RotateDataKeys creates a new data key for every trip and re-encrypts PNRs, sensitive descriptions, history snapshots
+// and attachments with it. Values that were stored before encryption was enabled are encrypted as well. Previous data keys are deleted
+// once nothing depends on them anymore, so an interrupted rotation can simply be started again.
+func (k *TripKeys) RotateDataKeys(ctx context.Context, attachments *AttachmentsRepo) (int, error) {
+	tripIDs, err := k.Queries.GetTripIDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get trips: %w", err)
+	}
+
+	for i, tripID := range tripIDs {
+		if err := k.rotateDataKey(ctx, attachments, tripID); err != nil {
+			return i, fmt.Errorf("rotate data key [tripID=%d]: %w", tripID, err)
+		}
+	}
+	return len(tripIDs), nil
+}
+
+func (k *TripKeys) rotateDataKey(ctx context.Context, attachments *AttachmentsRepo, tripID int32) error {
+	version := int32(1)
+	current, err := k.Queries.GetCurrentTripKey(ctx, tripID)
+	if err == nil {
+		version = current.Version + 1
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("get current data key: %w", err)
+	}
+	if _, _, err := k.create(ctx, k.Queries, tripID, version); err != nil {
+		return err
+	}
+
+	tx, err := k.Db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := k.Queries.WithTx(tx)
+
+	if err := k.reencryptPnrs(ctx, qtx, tripID); err != nil {
+		return err
+	}
+	if err := k.reencryptDescriptions(ctx, qtx, tripID); err != nil {
+		return err
+	}
+	if err := k.reencryptHistory(ctx, qtx, tripID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	if _, err := attachments.reencryptAttachments(ctx, tripID); err != nil {
+		return err
+	}
+
+	if err := k.Queries.DeletePreviousTripKeys(ctx, sqlc.DeletePreviousTripKeysParams{TripID: tripID, Version: version}); err != nil {
+		return fmt.Errorf("delete previous data keys: %w", err)
+	}
+	return nil
+}
+
+func (k *TripKeys) reencryptPnrs(ctx context.Context, qtx *sqlc.Queries, tripID int32) error {
+	pnrs, err := qtx.GetTripPnrs(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("get PNRs: %w", err)
+	}
+
+	for _, pnr := range pnrs {
+		reencrypted, err := k.reencrypt(ctx, qtx, tripID, pnr.Pnr)
+		if err != nil {
+			return fmt.Errorf("re-encrypt PNR [id=%d]: %w", pnr.ID, err)
+		}
+		if err := qtx.UpdatePnr(ctx, sqlc.UpdatePnrParams{ID: pnr.ID, Pnr: reencrypted}); err != nil {
+			return fmt.Errorf("update PNR [id=%d]: %w", pnr.ID, err)
+		}
+	}
+	return nil
+}
+
+func (k *TripKeys) reencryptDescriptions(ctx context.Context, qtx *sqlc.Queries, tripID int32) error {
+	activities, err := qtx.GetSensitiveActivityDescriptions(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("get activity descriptions: %w", err)
+	}
+	for _, activity := range activities {
+		reencrypted, err := k.reencrypt(ctx, qtx, tripID, *activity.Description)
+		if err != nil {
+			return fmt.Errorf("re-encrypt description of activity [id=%d]: %w", activity.ID, err)
+		}
+		if err := qtx.UpdateActivityDescription(ctx, sqlc.UpdateActivityDescriptionParams{ID: activity.ID, Description: &reencrypted}); err != nil {
+			return fmt.Errorf("update activity [id=%d]: %w", activity.ID, err)
+		}
+	}
+
+	accommodation, err := qtx.GetSensitiveAccommodationDescriptions(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("get accommodation descriptions: %w", err)
+	}
+	for _, a := range accommodation {
+		reencrypted, err := k.reencrypt(ctx, qtx, tripID, *a.Description)
+		if err != nil {
+			return fmt.Errorf("re-encrypt description of accommodation [id=%d]: %w", a.ID, err)
+		}
+		if err := qtx.UpdateAccommodationDescription(ctx, sqlc.UpdateAccommodationDescriptionParams{ID: a.ID, Description: &reencrypted}); err != nil {
+			return fmt.Errorf("update accommodation [id=%d]: %w", a.ID, err)
+		}
+	}
+	return nil
+}
+
+func (k *TripKeys) reencryptHistory(ctx context.Context, qtx *sqlc.Queries, tripID int32) error {
+	snapshots, err := qtx.GetHistorySnapshots(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("get history snapshots: %w", err)
+	}
+
+	for _, snapshot := range snapshots {
+		before, err := k.reencryptNullable(ctx, qtx, tripID, snapshot.Before)
+		if err != nil {
+			return fmt.Errorf("re-encrypt history entry [id=%d]: %w", snapshot.ID, err)
+		}
+		after, err := k.reencryptNullable(ctx, qtx, tripID, snapshot.After)
+		if err != nil {
+			return fmt.Errorf("re-encrypt history entry [id=%d]: %w", snapshot.ID, err)
+		}
+		if err := qtx.UpdateHistorySnapshots(ctx, sqlc.UpdateHistorySnapshotsParams{ID: snapshot.ID, Before: before, After: after}); err != nil {
+			return fmt.Errorf("update history entry [id=%d]: %w", snapshot.ID, err)
+		}
+	}
+	return nil
+}
+
+func (k *TripKeys) reencryptNullable(ctx context.Context, qtx *sqlc.Queries, tripID int32, value *string) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	reencrypted, err := k.reencrypt(ctx, qtx, tripID, *value)
+	if err != nil {
+		return nil, err
+	}
+	return &reencrypted, nil
+}
+
+func (k *TripKeys) reencrypt(ctx context.Context, qtx *sqlc.Queries, tripID int32, value string) (string, error) {
+	plaintext, err := k.decrypt(ctx, qtx, tripID, value)
+	if err != nil {
+		return "", err
+	}
+	return k.encrypt(ctx, qtx, tripID, plaintext)
+}
@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"kompass/internal/entity"
+	"kompass/pkg/postgres"
+	"kompass/pkg/sqlc"
+)
+
+// SyncRepo reads the revisions maintained by the triggers of the sync migration. Every write to a trip or one of its
+// entities increments the revision of the trip and stamps it on the written row, deletions leave a tombstone.
+type SyncRepo struct {
+	Db      *pgxpool.Pool
+	Queries *sqlc.Queries
+}
+
+func NewSyncRepo(pg *postgres.Postgres) *SyncRepo {
+	return &SyncRepo{
+		pg.Pool,
+		sqlc.New(pg.Pool),
+	}
+}
+
+func (r *SyncRepo) GetCursor(ctx context.Context, tripID int32) (int64, error) {
+	revision, err := r.Queries.GetTripRevision(ctx, tripID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("get trip revision [tripID=%d]: %w", tripID, err)
+	}
+	return revision, nil
+}
+
+func (r *SyncRepo) GetTombstones(ctx context.Context, tripID int32, since int64) ([]entity.Tombstone, error) {
+	rows, err := r.Queries.GetTombstones(ctx, sqlc.GetTombstonesParams{
+		TripID:   tripID,
+		Revision: since,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get tombstones [tripID=%d]: %w", tripID, err)
+	}
+
+	tombstones := []entity.Tombstone{}
+	for _, row := range rows {
+		tombstones = append(tombstones, entity.Tombstone{
+			Kind:     entity.EntityKind(row.Kind),
+			ID:       row.EntityID,
+			Revision: row.Revision,
+		})
+	}
+	return tombstones, nil
+}
+
+// ClaimClientID reserves the client id of a creation for the entity about to be created. It returns false if the id
+// was claimed before, by an earlier push of the same creation.
+func (r *SyncRepo) ClaimClientID(ctx context.Context, tripID int32, clientID string, kind entity.EntityKind) (bool, error) {
+	claimed, err := r.Queries.ClaimClientID(ctx, sqlc.ClaimClientIDParams{
+		TripID:   tripID,
+		ClientID: clientID,
+		Kind:     string(kind),
+	})
+	if err != nil {
+		return false, fmt.Errorf("claim client id [tripID=%d]: %w", tripID, err)
+	}
+	return claimed > 0, nil
+}
+
+// GetCreation returns the kind and the id of the entity created for a client id. The id is nil while the entity is
+// still being created.
+func (r *SyncRepo) GetCreation(ctx context.Context, tripID int32, clientID string) (entity.EntityKind, *int32, error) {
+	creation, err := r.Queries.GetSyncCreation(ctx, sqlc.GetSyncCreationParams{
+		TripID:   tripID,
+		ClientID: clientID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil, fiber.NewError(fiber.StatusNotFound, "client id not found")
+		}
+		return "", nil, fmt.Errorf("get creation [tripID=%d]: %w", tripID, err)
+	}
+	return entity.EntityKind(creation.Kind), creation.EntityID, nil
+}
+
+func (r *SyncRepo) SetCreatedID(ctx context.Context, tripID int32, clientID string, id int32) error {
+	err := r.Queries.SetSyncCreationEntity(ctx, sqlc.SetSyncCreationEntityParams{
+		TripID:   tripID,
+		ClientID: clientID,
+		EntityID: &id,
+	})
+	if err != nil {
+		return fmt.Errorf("set created id [tripID=%d, id=%d]: %w", tripID, id, err)
+	}
+	return nil
+}
+
+// ReleaseClientID frees a claimed client id whose creation failed, so that it can be pushed again.
+func (r *SyncRepo) ReleaseClientID(ctx context.Context, tripID int32, clientID string) error {
+	err := r.Queries.ReleaseClientID(ctx, sqlc.ReleaseClientIDParams{
+		TripID:   tripID,
+		ClientID: clientID,
+	})
+	if err != nil {
+		return fmt.Errorf("release client id [tripID=%d]: %w", tripID, err)
+	}
+	return nil
+}
+
+// notWritten explains why a write affected no row. With an expected revision, the row may exist but have been modified
+// in the meantime, which is reported as a failed precondition.
+func notWritten(name string, revision *int64) error {
+	if revision != nil {
+		return fiber.NewError(fiber.StatusPreconditionFailed, name+" was modified in the meantime")
+	}
+	return fiber.NewError(fiber.StatusNotFound, name+" not found")
+}
@@ -65,6 +65,13 @@ func (r *TrainsRepo) CreateTrainDetail(ctx context.Context, qtx *sqlc.Queries, t
 			return fmt.Errorf("check trainStation exists: %w", err)
 		}
 		if exists {
+			// stations stored before timezones were tracked are completed on their next use
+			if err := qtx.UpdateTrainStationTimezone(ctx, sqlc.UpdateTrainStationTimezoneParams{
+				ID:       trainStation.ID,
+				Timezone: trainStation.Location.Timezone,
+			}); err != nil {
+				return fmt.Errorf("update train station timezone: %w", err)
+			}
 			continue
 		}
 
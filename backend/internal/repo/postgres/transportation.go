@@ -35,7 +35,12 @@ func NewTransportationRepo(pg *postgres.Postgres, flights *FlightsRepo, trains *
 }
 
 func (r *TransportationRepo) GetAllTransportation(ctx context.Context, tripID int32) ([]entity.Transportation, error) {
-	rows, err := r.Queries.GetAllTransportation(ctx, tripID)
+	return r.GetAllTransportationSince(ctx, tripID, 0)
+}
+
+// GetAllTransportationSince returns the transportation of the trip written after the given revision.
+func (r *TransportationRepo) GetAllTransportationSince(ctx context.Context, tripID int32, since int64) ([]entity.Transportation, error) {
+	rows, err := r.Queries.GetAllTransportation(ctx, sqlc.GetAllTransportationParams{TripID: tripID, Since: since})
 	if err != nil {
 		return []entity.Transportation{}, fmt.Errorf("get all transportation from db: %w", err)
 	}
@@ -69,7 +74,9 @@ func (r *TransportationRepo) GetTransportationByID(ctx context.Context, tripID i
 	return transportation, nil
 }
 
-func (r *TransportationRepo) SaveTransportation(ctx context.Context, transportation entity.Transportation) (entity.Transportation, error) {
+// SaveTransportation creates the transportation if it has no ID yet. Updates are only written if the transportation
+// still has the expected revision, unless none is given.
+func (r *TransportationRepo) SaveTransportation(ctx context.Context, transportation entity.Transportation, revision *int64) (entity.Transportation, error) {
 	tx, err := r.Db.Begin(ctx)
 	if err != nil {
 		return entity.Transportation{}, fmt.Errorf("begin tx: %w", err)
@@ -86,10 +93,11 @@ func (r *TransportationRepo) SaveTransportation(ctx context.Context, transportat
 		return entity.Transportation{}, fmt.Errorf("save destination location: %w", err)
 	}
 
-	transportationID, err := r.saveTransportation(ctx, qtx, transportation, originId, destinationId)
+	// the transportation is written before its details, as writing those increments its revision
+	transportationID, err := r.saveTransportation(ctx, qtx, transportation, originId, destinationId, revision)
 	if err != nil {
 		if errors.Is(pgx.ErrNoRows, err) {
-			return entity.Transportation{}, fiber.NewError(http.StatusNotFound, "transportation not found")
+			return entity.Transportation{}, notWritten("transportation", revision)
 		}
 		return entity.Transportation{}, fmt.Errorf("save transportation: %w", err)
 	}
@@ -103,7 +111,7 @@ func (r *TransportationRepo) SaveTransportation(ctx context.Context, transportat
 	// it is not possible to update flight or train detail via the transportation api yet
 	if transportation.ID == 0 {
 		if transportation.FlightDetail != nil {
-			if err := r.flights.CreateFlightDetail(ctx, qtx, transportationID, *transportation.FlightDetail); err != nil {
+			if err := r.flights.CreateFlightDetail(ctx, qtx, transportation.TripID, transportationID, *transportation.FlightDetail); err != nil {
 				return entity.Transportation{}, fmt.Errorf("save flight detail: %w", err)
 			}
 		}
@@ -121,11 +129,11 @@ func (r *TransportationRepo) SaveTransportation(ctx context.Context, transportat
 	return r.GetTransportationByID(ctx, transportation.TripID, transportationID)
 }
 
-func (r *TransportationRepo) DeleteTransportation(ctx context.Context, tripID int32, transportationID int32) error {
-	_, err := r.Queries.DeleteTransportationByID(ctx, sqlc.DeleteTransportationByIDParams{TripID: tripID, ID: transportationID})
+func (r *TransportationRepo) DeleteTransportation(ctx context.Context, tripID int32, transportationID int32, revision *int64) error {
+	_, err := r.Queries.TrashTransportationByID(ctx, sqlc.TrashTransportationByIDParams{TripID: tripID, ID: transportationID, Revision: revision})
 	if err != nil {
 		if errors.Is(pgx.ErrNoRows, err) {
-			return fiber.NewError(http.StatusNotFound, "transportation not found")
+			return notWritten("transportation", revision)
 		}
 		return fmt.Errorf("delete transportation [id=%d]: %w", transportationID, err)
 	}
@@ -150,6 +158,24 @@ func (r *TransportationRepo) GetAllGeoJson(ctx context.Context, tripID int32) ([
 	return allGeoJson, nil
 }
 
+// GetRouteGeoJson returns the planned routes of the given transportation by ID, recorded tracks are not included.
+func (r *TransportationRepo) GetRouteGeoJson(ctx context.Context, tripID int32, transportationIDs []int32) (map[int32]geojson.FeatureCollection, error) {
+	rows, err := r.Queries.GetRouteGeoJson(ctx, sqlc.GetRouteGeoJsonParams{TripID: tripID, Ids: transportationIDs})
+	if err != nil {
+		return nil, fmt.Errorf("get route geojson from db [t.id=%d]: %w", tripID, err)
+	}
+
+	routes := map[int32]geojson.FeatureCollection{}
+	for _, row := range rows {
+		geoJson, err := geojson.UnmarshalFeatureCollection(row.Geojson)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshall GeoJson: %w", err)
+		}
+		routes[row.TransportationID] = *geoJson
+	}
+	return routes, nil
+}
+
 func (r *TransportationRepo) SaveGeoJson(ctx context.Context, transportationID int32, geoJson *geojson.FeatureCollection) error {
 	marshalledGeoJson, err := geoJson.MarshalJSON()
 	if err != nil {
@@ -162,17 +188,67 @@ func (r *TransportationRepo) SaveGeoJson(ctx context.Context, transportationID i
 	})
 }
 
-func (r *TransportationRepo) saveTransportation(ctx context.Context, queries *sqlc.Queries, transportation entity.Transportation, originID int32, destinationID int32) (int32, error) {
+func (r *TransportationRepo) SaveTrack(ctx context.Context, transportationID int32, track entity.Track, geoJson *geojson.FeatureCollection) error {
+	marshalledGeoJson, err := geoJson.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("marshal geojson: %w", err)
+	}
+
+	return r.Queries.UpsertTrack(ctx, sqlc.UpsertTrackParams{
+		TransportationID:      transportationID,
+		Display:               string(track.Display),
+		DistanceInMeters:      track.DistanceInMeters,
+		ElevationGainInMeters: track.ElevationGainInMeters,
+		StartTime:             track.StartDateTime,
+		EndTime:               track.EndDateTime,
+		Geojson:               marshalledGeoJson,
+	})
+}
+
+// UpdateTrackDisplay is only written if the transportation still has the expected revision, unless none is given.
+func (r *TransportationRepo) UpdateTrackDisplay(ctx context.Context, tripID int32, transportationID int32, display entity.TrackDisplay, revision *int64) error {
+	_, err := r.Queries.UpdateTrackDisplay(ctx, sqlc.UpdateTrackDisplayParams{TripID: tripID, ID: transportationID, Display: string(display), Revision: revision})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return notWritten("track", revision)
+		}
+		return fmt.Errorf("update track display [t.id=%d]: %w", transportationID, err)
+	}
+
+	return nil
+}
+
+// DeleteTrack is only written if the transportation still has the expected revision, unless none is given.
+func (r *TransportationRepo) DeleteTrack(ctx context.Context, tripID int32, transportationID int32, revision *int64) error {
+	_, err := r.Queries.DeleteTrack(ctx, sqlc.DeleteTrackParams{TripID: tripID, ID: transportationID, Revision: revision})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return notWritten("track", revision)
+		}
+		return fmt.Errorf("delete track [t.id=%d]: %w", transportationID, err)
+	}
+
+	return nil
+}
+
+func (r *TransportationRepo) saveTransportation(ctx context.Context, queries *sqlc.Queries, transportation entity.Transportation, originID int32, destinationID int32, revision *int64) (int32, error) {
 	if transportation.ID != 0 {
-		return transportation.ID, queries.UpdateTransportation(ctx, sqlc.UpdateTransportationParams{
+		rows, err := queries.UpdateTransportation(ctx, sqlc.UpdateTransportationParams{
 			ID:            transportation.ID,
+			TripID:        transportation.TripID,
 			Type:          transportation.Type.String(),
 			OriginID:      originID,
 			DestinationID: destinationID,
 			DepartureTime: transportation.DepartureDateTime,
 			ArrivalTime:   transportation.ArrivalDateTime,
 			Price:         transportation.Price,
+			Currency:      currencyOrNil(transportation.Currency),
+			Revision:      revision,
 		})
+		if err == nil && rows == 0 {
+			err = pgx.ErrNoRows
+		}
+		return transportation.ID, err
 	} else {
 		return queries.InsertTransportation(ctx, sqlc.InsertTransportationParams{
 			TripID:        transportation.TripID,
@@ -182,6 +258,7 @@ func (r *TransportationRepo) saveTransportation(ctx context.Context, queries *sq
 			DepartureTime: transportation.DepartureDateTime,
 			ArrivalTime:   transportation.ArrivalDateTime,
 			Price:         transportation.Price,
+			Currency:      currencyOrNil(transportation.Currency),
 		})
 	}
 }
@@ -192,9 +269,11 @@ func (r *TransportationRepo) convertTransportationRow(ctx context.Context, trans
 		Origin:         origin,
 		Destination:    destination,
 	})
+	converted.DepartureUtcOffset = converted.Origin.UtcOffset(converted.DepartureDateTime)
+	converted.ArrivalUtcOffset = converted.Destination.UtcOffset(converted.ArrivalDateTime)
 
 	if converted.Type == entity.FLIGHT {
-		flightDetail, err := r.flights.GetFlightDetail(ctx, converted.ID)
+		flightDetail, err := r.flights.GetFlightDetail(ctx, converted.TripID, converted.ID)
 		if err != nil {
 			return entity.Transportation{}, fmt.Errorf("get flightDetail [t.id=%d]: %w", converted.ID, err)
 		}
@@ -211,7 +290,19 @@ func (r *TransportationRepo) convertTransportationRow(ctx context.Context, trans
 			return entity.Transportation{}, fmt.Errorf("get genericDetail [t.id=%d]: %w", converted.ID, err)
 		}
 		converted.GenericDetail = &genericDetail
+
+		track, err := r.getTrack(ctx, converted.ID)
+		if err != nil {
+			return entity.Transportation{}, fmt.Errorf("get track [t.id=%d]: %w", converted.ID, err)
+		}
+		converted.Track = track
+	}
+
+	attachments, err := getTransportationAttachments(ctx, r.Queries, converted.TripID, &converted.ID)
+	if err != nil {
+		return entity.Transportation{}, fmt.Errorf("get attachments [t.id=%d]: %w", converted.ID, err)
 	}
+	converted.Attachments = attachmentsOrEmpty(attachments[converted.ID])
 
 	return converted, nil
 }
@@ -237,3 +328,21 @@ func (r *TransportationRepo) getGenericDetail(ctx context.Context, transportatio
 		DestinationAddress: row.DestinationAddress,
 	}, err
 }
+
+func (r *TransportationRepo) getTrack(ctx context.Context, transportationID int32) (*entity.Track, error) {
+	row, err := r.Queries.GetTrackByTransportationID(ctx, transportationID)
+	if err != nil {
+		if errors.Is(pgx.ErrNoRows, err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get track: %w", err)
+	}
+
+	return &entity.Track{
+		Display:               entity.TrackDisplay(row.Display),
+		DistanceInMeters:      row.DistanceInMeters,
+		ElevationGainInMeters: row.ElevationGainInMeters,
+		StartDateTime:         row.StartTime,
+		EndDateTime:           row.EndTime,
+	}, nil
+}
@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"kompass/internal/entity"
+	"kompass/pkg/postgres"
+	"kompass/pkg/sqlc"
+	"time"
+)
+
+// TrashRepo restores and purges trips and entities that were moved to the trash by their repos. Purging deletes them
+// for good, including their locations, details and the blobs of attachments that are not referenced anymore.
+type TrashRepo struct {
+	Db          *pgxpool.Pool
+	Queries     *sqlc.Queries
+	attachments *AttachmentsRepo
+}
+
+func NewTrashRepo(pg *postgres.Postgres, attachments *AttachmentsRepo) *TrashRepo {
+	return &TrashRepo{
+		pg.Pool,
+		sqlc.New(pg.Pool),
+		attachments,
+	}
+}
+
+func (r *TrashRepo) GetTrash(ctx context.Context, userID int32, tripID *int32) ([]entity.TrashItem, error) {
+	rows, err := r.Queries.GetTrash(ctx, sqlc.GetTrashParams{UserID: userID, TripID: tripID})
+	if err != nil {
+		return nil, fmt.Errorf("get trash [userID=%d]: %w", userID, err)
+	}
+
+	items := []entity.TrashItem{}
+	for _, row := range rows {
+		items = append(items, entity.TrashItem{
+			Kind:      entity.EntityKind(row.Kind),
+			ID:        row.ID,
+			TripID:    row.TripID,
+			Name:      row.Name,
+			DeletedAt: row.DeletedAt,
+		})
+	}
+	return items, nil
+}
+
+// RestoreTrip requires the user to be allowed to edit the trip, as deleted trips are not accessible otherwise.
+func (r *TrashRepo) RestoreTrip(ctx context.Context, userID int32, tripID int32) error {
+	_, err := r.Queries.RestoreTripByID(ctx, sqlc.RestoreTripByIDParams{TripID: tripID, UserID: userID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fiber.NewError(fiber.StatusNotFound, "trip not found in trash")
+		}
+		return fmt.Errorf("restore trip [id=%d]: %w", tripID, err)
+	}
+	return nil
+}
+
+// Restore is rolled back if the entity is outside of the trip dates, which may have changed since it was moved to the
+// trash.
+func (r *TrashRepo) Restore(ctx context.Context, tripID int32, kind entity.EntityKind, id int32) error {
+	tx, err := r.Db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := r.Queries.WithTx(tx)
+
+	switch kind {
+	case entity.TRANSPORTATION_KIND:
+		_, err = qtx.RestoreTransportationByID(ctx, sqlc.RestoreTransportationByIDParams{TripID: tripID, ID: id})
+	case entity.ACTIVITY_KIND:
+		_, err = qtx.RestoreActivityByID(ctx, sqlc.RestoreActivityByIDParams{TripID: tripID, ID: id})
+	case entity.ACCOMMODATION_KIND:
+		_, err = qtx.RestoreAccommodationByID(ctx, sqlc.RestoreAccommodationByIDParams{TripID: tripID, ID: id})
+	case entity.EXPENSE_KIND:
+		_, err = qtx.RestoreExpenseByID(ctx, sqlc.RestoreExpenseByIDParams{TripID: tripID, ID: id})
+	case entity.ATTACHMENT_KIND:
+		_, err = qtx.RestoreAttachmentByID(ctx, sqlc.RestoreAttachmentByIDParams{TripID: tripID, ID: id})
+	default:
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("%s can not be restored", kind))
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("%s not found in trash", kind))
+		}
+		return fmt.Errorf("restore %s [id=%d]: %w", kind, id, err)
+	}
+
+	if err := verifyRestoredInRange(ctx, qtx, tripID, kind, id); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+func verifyRestoredInRange(ctx context.Context, q *sqlc.Queries, tripID int32, kind entity.EntityKind, id int32) error {
+	trip, err := q.GetTripByID(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("get trip [id=%d]: %w", tripID, err)
+	}
+	outOfRange, err := q.GetEntitiesOutOfRange(ctx, sqlc.GetEntitiesOutOfRangeParams{
+		TripID:    tripID,
+		StartDate: trip.StartDate,
+		EndDate:   trip.EndDate,
+	})
+	if err != nil {
+		return fmt.Errorf("get entities out of range: %w", err)
+	}
+
+	for _, row := range outOfRange {
+		if entity.EntityKind(row.Kind) == kind && row.ID == id {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("%s is outside of the trip dates", kind))
+		}
+	}
+	return nil
+}
+
+// Purge deletes everything that was moved to the trash before the given time and returns how many trips and entities
+// were purged. Blobs are deleted after the rows referencing them are gone, so a failure leaves unreferenced blobs at
+// worst.
+func (r *TrashRepo) Purge(ctx context.Context, before time.Time) (int64, error) {
+	tx, err := r.Db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := r.Queries.WithTx(tx)
+
+	blobKeys, err := qtx.GetBlobKeysOfTrashedTrips(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("get blob keys of trashed trips: %w", err)
+	}
+	attachmentBlobKeys, err := qtx.PurgeTrashedAttachments(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge attachments: %w", err)
+	}
+	blobKeys = append(blobKeys, attachmentBlobKeys...)
+	purged := int64(len(attachmentBlobKeys))
+
+	transportation, err := qtx.PurgeTrashedTransportation(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge transportation: %w", err)
+	}
+	activities, err := qtx.PurgeTrashedActivities(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge activities: %w", err)
+	}
+	accommodation, err := qtx.PurgeTrashedAccommodation(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge accommodation: %w", err)
+	}
+	expenses, err := qtx.PurgeTrashedExpenses(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge expenses: %w", err)
+	}
+	trips, err := qtx.PurgeTrashedTrips(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge trips: %w", err)
+	}
+	purged += transportation + activities + accommodation + expenses + trips
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("commit tx: %w", err)
+	}
+
+	deleted := map[string]bool{}
+	for _, blobKey := range blobKeys {
+		if deleted[blobKey] {
+			continue
+		}
+		if err := r.attachments.deleteUnreferencedBlob(ctx, blobKey); err != nil {
+			return purged, err
+		}
+		deleted[blobKey] = true
+	}
+	return purged, nil
+}
@@ -1,22 +1,26 @@
 package postgres
 
 import (
+	"cloud.google.com/go/civil"
 	"context"
 	"errors"
 	"fmt"
 	"github.com/gofiber/fiber/v2"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/paulmach/orb/geojson"
 	"kompass/internal/entity"
 	"kompass/pkg/postgres"
 	"kompass/pkg/sqlc"
 )
 
 type TripsRepo struct {
+	Db *pgxpool.Pool
 	*sqlc.Queries
 }
 
 func NewTripsRepo(pg *postgres.Postgres) *TripsRepo {
-	return &TripsRepo{sqlc.New(pg.Pool)}
+	return &TripsRepo{pg.Pool, sqlc.New(pg.Pool)}
 }
 
 func (r *TripsRepo) GetTrips(ctx context.Context, userID int32) ([]entity.Trip, error) {
@@ -28,6 +32,15 @@ func (r *TripsRepo) GetTrips(ctx context.Context, userID int32) ([]entity.Trip,
 	return mapTrips(trips), nil
 }
 
+func (r *TripsRepo) GetTemplates(ctx context.Context) ([]entity.Trip, error) {
+	trips, err := r.Queries.GetTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get templates: %w", err)
+	}
+
+	return mapTrips(trips), nil
+}
+
 func (r *TripsRepo) GetTripByID(ctx context.Context, tripID int32) (entity.Trip, error) {
 	trip, err := r.Queries.GetTripByID(ctx, tripID)
 	if err != nil {
@@ -42,12 +55,14 @@ func (r *TripsRepo) GetTripByID(ctx context.Context, tripID int32) (entity.Trip,
 
 func (r *TripsRepo) CreateTrip(ctx context.Context, trip entity.Trip) (entity.Trip, error) {
 	tripID, err := r.Queries.InsertTrip(ctx, sqlc.InsertTripParams{
-		OwnerID:     trip.OwnerID,
-		Name:        trip.Name,
-		StartDate:   trip.StartDate,
-		EndDate:     trip.EndDate,
-		Description: trip.Description,
-		ImageUrl:    trip.ImageUrl,
+		OwnerID:      trip.OwnerID,
+		Name:         trip.Name,
+		StartDate:    trip.StartDate,
+		EndDate:      trip.EndDate,
+		Description:  trip.Description,
+		ImageUrl:     trip.ImageUrl,
+		HomeCurrency: trip.HomeCurrency,
+		Template:     trip.Template,
 	})
 	if err != nil {
 		return entity.Trip{}, fmt.Errorf("insert trip: %w", err)
@@ -56,30 +71,209 @@ func (r *TripsRepo) CreateTrip(ctx context.Context, trip entity.Trip) (entity.Tr
 	return r.GetTripByID(ctx, tripID)
 }
 
-func (r *TripsRepo) UpdateTrip(ctx context.Context, trip entity.Trip) error {
-	err := r.Queries.UpdateTrip(ctx, sqlc.UpdateTripParams{
-		ID:          trip.ID,
-		Name:        trip.Name,
-		StartDate:   trip.StartDate,
-		EndDate:     trip.EndDate,
-		Description: trip.Description,
-		ImageUrl:    trip.ImageUrl,
-	})
+func (r *TripsRepo) UpdateTrip(ctx context.Context, trip entity.Trip, revision *int64) error {
+	return updateTrip(ctx, r.Queries, trip, revision)
+}
+
+// ShiftTrip updates the trip and moves the dates of its entities by the given days. It returns the IDs of the shifted
+// entities by kind.
+func (r *TripsRepo) ShiftTrip(ctx context.Context, trip entity.Trip, days int32, revision *int64) (map[entity.EntityKind][]int32, error) {
+	tx, err := r.Db.Begin(ctx)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return fiber.NewError(fiber.StatusNotFound, "trip not found")
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := r.Queries.WithTx(tx)
+
+	if err := updateTrip(ctx, qtx, trip, revision); err != nil {
+		return nil, err
+	}
+	params := sqlc.ShiftTransportationParams{TripID: trip.ID, Days: days}
+	transportation, err := qtx.ShiftTransportation(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("shift transportation: %w", err)
+	}
+	if err := qtx.ShiftFlightLegs(ctx, sqlc.ShiftFlightLegsParams(params)); err != nil {
+		return nil, fmt.Errorf("shift flight legs: %w", err)
+	}
+	if err := qtx.ShiftTrainLegs(ctx, sqlc.ShiftTrainLegsParams(params)); err != nil {
+		return nil, fmt.Errorf("shift train legs: %w", err)
+	}
+	if err := shiftGeoJson(ctx, qtx, trip.ID, days); err != nil {
+		return nil, err
+	}
+	activities, err := qtx.ShiftActivities(ctx, sqlc.ShiftActivitiesParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("shift activities: %w", err)
+	}
+	accommodation, err := qtx.ShiftAccommodation(ctx, sqlc.ShiftAccommodationParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("shift accommodation: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit tx: %w", err)
+	}
+
+	// only entities that are not in the trash are returned
+	shifted := map[entity.EntityKind][]int32{}
+	for _, row := range transportation {
+		if !row.Trashed {
+			shifted[entity.TRANSPORTATION_KIND] = append(shifted[entity.TRANSPORTATION_KIND], row.ID)
+		}
+	}
+	for _, row := range activities {
+		if !row.Trashed {
+			shifted[entity.ACTIVITY_KIND] = append(shifted[entity.ACTIVITY_KIND], row.ID)
+		}
+	}
+	for _, row := range accommodation {
+		if !row.Trashed {
+			shifted[entity.ACCOMMODATION_KIND] = append(shifted[entity.ACCOMMODATION_KIND], row.ID)
+		}
+	}
+	return shifted, nil
+}
+
+// shiftGeoJson shifts the times shown with the planned routes and recorded tracks of the trip, which are stored as
+// part of their GeoJSON.
+func shiftGeoJson(ctx context.Context, q *sqlc.Queries, tripID int32, days int32) error {
+	routes, err := q.GetTripRouteGeoJson(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("get route geojson: %w", err)
+	}
+	for _, route := range routes {
+		shifted, err := shiftFeatureTimes(route.Geojson, days)
+		if err != nil {
+			return fmt.Errorf("shift route geojson [t.id=%d]: %w", route.TransportationID, err)
+		}
+		if err := q.UpsertGeoJson(ctx, sqlc.UpsertGeoJsonParams{TransportationID: route.TransportationID, Geojson: shifted}); err != nil {
+			return fmt.Errorf("save route geojson [t.id=%d]: %w", route.TransportationID, err)
+		}
+	}
+
+	tracks, err := q.GetTripTrackGeoJson(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("get track geojson: %w", err)
+	}
+	for _, track := range tracks {
+		shifted, err := shiftFeatureTimes(track.Geojson, days)
+		if err != nil {
+			return fmt.Errorf("shift track geojson [t.id=%d]: %w", track.TransportationID, err)
 		}
+		if err := q.ShiftTrack(ctx, sqlc.ShiftTrackParams{TransportationID: track.TransportationID, Days: days, Geojson: shifted}); err != nil {
+			return fmt.Errorf("shift track [t.id=%d]: %w", track.TransportationID, err)
+		}
+	}
+	return nil
+}
+
+// shiftFeatureTimes shifts the departure and arrival times in the properties of the features, including those of the
+// legs of flights and train journeys.
+func shiftFeatureTimes(raw []byte, days int32) ([]byte, error) {
+	featureCollection, err := geojson.UnmarshalFeatureCollection(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal geojson: %w", err)
+	}
+
+	for _, feature := range featureCollection.Features {
+		if err := shiftPropertyTimes(feature.Properties, days); err != nil {
+			return nil, err
+		}
+		legs, _ := feature.Properties["legs"].([]interface{})
+		for _, leg := range legs {
+			if properties, ok := leg.(map[string]interface{}); ok {
+				if err := shiftPropertyTimes(properties, days); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return featureCollection.MarshalJSON()
+}
+
+func shiftPropertyTimes(properties map[string]interface{}, days int32) error {
+	for _, key := range []string{"departureDateTime", "arrivalDateTime"} {
+		value, ok := properties[key].(string)
+		if !ok {
+			continue
+		}
+		dateTime, err := civil.ParseDateTime(value)
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", key, err)
+		}
+		dateTime.Date = dateTime.Date.AddDays(int(days))
+		properties[key] = dateTime
+	}
+	return nil
+}
+
+// GetDatedEntityIDs returns the IDs of the entities that are shifted with the trip dates, by kind.
+func (r *TripsRepo) GetDatedEntityIDs(ctx context.Context, tripID int32) (map[entity.EntityKind][]int32, error) {
+	rows, err := r.Queries.GetDatedEntityIDs(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get dated entity ids: %w", err)
+	}
+
+	ids := map[entity.EntityKind][]int32{}
+	for _, row := range rows {
+		kind := entity.EntityKind(row.Kind)
+		ids[kind] = append(ids[kind], row.ID)
+	}
+	return ids, nil
+}
+
+func (r *TripsRepo) GetEntitiesOutOfRange(ctx context.Context, tripID int32, days int32, startDate civil.Date, endDate civil.Date) ([]entity.OutOfRangeEntity, error) {
+	rows, err := r.Queries.GetEntitiesOutOfRange(ctx, sqlc.GetEntitiesOutOfRangeParams{
+		TripID:    tripID,
+		Days:      days,
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get entities out of range: %w", err)
+	}
+
+	result := []entity.OutOfRangeEntity{}
+	for _, row := range rows {
+		result = append(result, entity.OutOfRangeEntity{
+			Kind:      entity.EntityKind(row.Kind),
+			ID:        row.ID,
+			Name:      row.Name,
+			StartDate: row.StartDate,
+			EndDate:   row.EndDate,
+		})
+	}
+	return result, nil
+}
+
+func updateTrip(ctx context.Context, q *sqlc.Queries, trip entity.Trip, revision *int64) error {
+	rows, err := q.UpdateTrip(ctx, sqlc.UpdateTripParams{
+		ID:           trip.ID,
+		Name:         trip.Name,
+		StartDate:    trip.StartDate,
+		EndDate:      trip.EndDate,
+		Description:  trip.Description,
+		ImageUrl:     trip.ImageUrl,
+		Template:     trip.Template,
+		HomeCurrency: currencyOrNil(trip.HomeCurrency),
+		Revision:     revision,
+	})
+	if err != nil {
 		return fmt.Errorf("update trip: %w", err)
 	}
+	if rows == 0 {
+		return notWritten("trip", revision)
+	}
 
 	return nil
 }
 
-func (r *TripsRepo) DeleteTrip(ctx context.Context, tripID int32) error {
-	_, err := r.Queries.DeleteTripByID(ctx, tripID)
+func (r *TripsRepo) DeleteTrip(ctx context.Context, tripID int32, revision *int64) error {
+	_, err := r.Queries.TrashTripByID(ctx, sqlc.TrashTripByIDParams{ID: tripID, Revision: revision})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return fiber.NewError(fiber.StatusNotFound, "trip not found")
+			return notWritten("trip", revision)
 		}
 		return fmt.Errorf("delete trip: %w", err)
 	}
@@ -96,12 +290,16 @@ func mapTrips(trips []sqlc.Trip) []entity.Trip {
 
 func mapTrip(trip sqlc.Trip) entity.Trip {
 	return entity.Trip{
-		ID:          trip.ID,
-		OwnerID:     trip.OwnerID,
-		Name:        trip.Name,
-		StartDate:   trip.StartDate,
-		EndDate:     trip.EndDate,
-		Description: trip.Description,
-		ImageUrl:    trip.ImageUrl,
+		ID:           trip.ID,
+		Revision:     trip.Revision,
+		OwnerID:      trip.OwnerID,
+		Name:         trip.Name,
+		StartDate:    trip.StartDate,
+		EndDate:      trip.EndDate,
+		Description:  trip.Description,
+		ImageUrl:     trip.ImageUrl,
+		HomeCurrency: trip.HomeCurrency,
+		Budget:       trip.Budget,
+		Template:     trip.Template,
 	}
 }
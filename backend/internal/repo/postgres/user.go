@@ -53,6 +53,16 @@ func (r *UserRepo) GetUserByJwtSub(ctx context.Context, sub uuid.UUID) (entity.U
 	return mapUser(user), nil
 }
 
+// GetTripMembers returns the owner of the trip and all users with permissions on it.
+func (r *UserRepo) GetTripMembers(ctx context.Context, tripID int32) ([]entity.User, error) {
+	users, err := r.Queries.GetTripMembers(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get members of trip [id=%d]: %w", tripID, err)
+	}
+
+	return mapUsers(users), nil
+}
+
 func (r *UserRepo) CreateUser(ctx context.Context, user entity.User) (entity.User, error) {
 	userID, err := r.Queries.InsertUser(ctx, sqlc.InsertUserParams{
 		Name:   user.Name,
@@ -79,6 +89,13 @@ func (r *UserRepo) HasWritePermission(ctx context.Context, userID, tripID int32)
 	})
 }
 
+func (r *UserRepo) HasReadSensitivePermission(ctx context.Context, userID, tripID int32) (bool, error) {
+	return r.Queries.HasReadSensitivePermission(ctx, sqlc.HasReadSensitivePermissionParams{
+		UserID: userID,
+		TripID: tripID,
+	})
+}
+
 func (r *UserRepo) IsTripOwner(ctx context.Context, userID int32, tripID int32) (bool, error) {
 	return r.Queries.IsTripOwner(ctx, sqlc.IsTripOwnerParams{
 		UserID: userID,
@@ -18,12 +18,12 @@ type TrainConverter interface {
 	// goverter:map Line.Operator.Name OperatorName
 	// goverter:useZeroValueOnPointerInconsistency
 	// TODO!
-	// goverter:ignore DurationInMinutes
+	// goverter:ignore DurationInMinutes DepartureUtcOffset ArrivalUtcOffset
 	ConvertLeg(source response.Leg) (entity.TrainLeg, error)
 
 	ConvertStation(source response.StationOrStop) entity.TrainStation
 
-	// goverter:ignore ID
+	// goverter:ignore ID Timezone
 	ConvertLocation(source response.Location) entity.Location
 }
 
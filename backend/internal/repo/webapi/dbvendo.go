@@ -2,6 +2,7 @@ package webapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/paulmach/orb/geojson"
 	"kompass/config"
@@ -121,8 +122,12 @@ func (a *DbVendoWebAPI) convertJourney(source response.Journey) (entity.TrainDet
 		if err != nil {
 			return entity.TrainDetail{}, err
 		}
-		from := convertedLeg.DepartureDateTime.In(time.UTC)
-		to := convertedLeg.ArrivalDateTime.In(time.UTC)
+		// the timestamps include the utc offset, which is lost in the local times of the converted leg
+		from, err1 := time.Parse(time.RFC3339, leg.PlannedDeparture)
+		to, err2 := time.Parse(time.RFC3339, leg.PlannedArrival)
+		if err := errors.Join(err1, err2); err != nil {
+			return entity.TrainDetail{}, fmt.Errorf("parse timestamps: %w", err)
+		}
 		convertedLeg.DurationInMinutes = int32(to.Sub(from).Minutes())
 		legs = append(legs, convertedLeg)
 	}
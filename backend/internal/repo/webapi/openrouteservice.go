@@ -44,6 +44,29 @@ func (a *OpenRouteServiceWebAPI) LookupLocation(ctx context.Context, query strin
 	}, nil
 }
 
+// ReverseGeocode returns the name of the place closest to the coordinates.
+func (a *OpenRouteServiceWebAPI) ReverseGeocode(ctx context.Context, latitude float32, longitude float32) (string, error) {
+	urlFormat := "%s/geocode/reverse?api_key=%s&size=1&point.lat=%f&point.lon=%f"
+	reverseUrl := fmt.Sprintf(urlFormat, a.baseURL, a.apiKey, latitude, longitude)
+
+	result, err := RequestAndParseJsonBody[geojson.FeatureCollection](ctx, "GET", reverseUrl, nil)
+	if err != nil {
+		return "", fmt.Errorf("requestAndParseJsonBody: %w", err)
+	}
+
+	if len(result.Features) == 0 {
+		return "", fmt.Errorf("no place found")
+	}
+	properties := result.Features[0].Properties
+	if name, ok := properties["name"].(string); ok && name != "" {
+		return name, nil
+	}
+	if label, ok := properties["label"].(string); ok && label != "" {
+		return label, nil
+	}
+	return "", fmt.Errorf("place has no name")
+}
+
 func (a *OpenRouteServiceWebAPI) LookupDirections(ctx context.Context, start entity.Location, end entity.Location, transportatinoType entity.TransportationType) (*geojson.FeatureCollection, error) {
 	profile := getProfileByTransportationType(transportatinoType)
 	urlFormat := "%s/v2/directions/%s?api_key=%s&start=%f,%f&end=%f,%f"
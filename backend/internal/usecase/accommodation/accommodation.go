@@ -10,14 +10,22 @@ import (
 )
 
 type UseCase struct {
-	repo  repo.AccommodationRepo
-	trips usecase.Trips
+	repo       repo.AccommodationRepo
+	trips      usecase.Trips
+	budgets    usecase.Budgets
+	boundaries repo.BoundaryLookup
+	events     usecase.Events
+	history    usecase.History
 }
 
-func New(r repo.AccommodationRepo, trips usecase.Trips) *UseCase {
+func New(r repo.AccommodationRepo, trips usecase.Trips, budgets usecase.Budgets, boundaries repo.BoundaryLookup, events usecase.Events, history usecase.History) *UseCase {
 	return &UseCase{
-		repo:  r,
-		trips: trips,
+		repo:       r,
+		trips:      trips,
+		budgets:    budgets,
+		boundaries: boundaries,
+		events:     events,
+		history:    history,
 	}
 }
 
@@ -29,12 +37,23 @@ func (uc *UseCase) GetAllAccommodation(ctx context.Context, tripID int32) ([]ent
 	return uc.repo.GetAllAccommodation(ctx, tripID)
 }
 
+func (uc *UseCase) GetAllAccommodationSince(ctx context.Context, tripID int32, since int64) ([]entity.Accommodation, error) {
+	return uc.repo.GetAllAccommodationSince(ctx, tripID, since)
+}
+
 func (uc *UseCase) CreateAccommodation(ctx context.Context, tripID int32, accommodation request.Accommodation) (entity.Accommodation, error) {
 	if err := uc.trips.VerifyDatesInBounds(ctx, tripID, accommodation.DepartureDate, accommodation.ArrivalDate); err != nil {
 		return entity.Accommodation{}, fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
+	if err := usecase.ResolveTimezone(uc.boundaries, accommodation.Location); err != nil {
+		return entity.Accommodation{}, err
+	}
+	currency, err := usecase.ParseCurrency(accommodation.Currency)
+	if err != nil {
+		return entity.Accommodation{}, err
+	}
 
-	return uc.repo.CreateAccommodation(ctx, entity.Accommodation{
+	created, err := uc.repo.CreateAccommodation(ctx, entity.Accommodation{
 		TripID:        tripID,
 		Name:          accommodation.Name,
 		ArrivalDate:   accommodation.ArrivalDate,
@@ -42,18 +61,36 @@ func (uc *UseCase) CreateAccommodation(ctx context.Context, tripID int32, accomm
 		CheckInTime:   accommodation.CheckInTime,
 		CheckOutTime:  accommodation.CheckOutTime,
 		Description:   accommodation.Description,
+		Sensitive:     accommodation.Sensitive,
 		Address:       accommodation.Address,
 		Location:      accommodation.Location,
 		Price:         accommodation.Price,
+		Currency:      currency,
 	})
+	if err != nil {
+		return entity.Accommodation{}, err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.CREATED, entity.ACCOMMODATION_KIND, created.ID)
+	uc.history.Record(ctx, tripID, entity.CREATED, entity.ACCOMMODATION_KIND, created.ID, nil)
+	created.BudgetAlert = usecase.BudgetAlert(ctx, uc.budgets, tripID, created.Price, entity.ACCOMMODATION_COSTS)
+	return created, nil
 }
 
-func (uc *UseCase) UpdateAccommodation(ctx context.Context, tripID int32, accommodationID int32, accommodation request.Accommodation) error {
+func (uc *UseCase) UpdateAccommodation(ctx context.Context, tripID int32, accommodationID int32, accommodation request.Accommodation, revision *int64) error {
 	if err := uc.trips.VerifyDatesInBounds(ctx, tripID, accommodation.DepartureDate, accommodation.ArrivalDate); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
+	if err := usecase.ResolveTimezone(uc.boundaries, accommodation.Location); err != nil {
+		return err
+	}
+	currency, err := usecase.ParseCurrency(accommodation.Currency)
+	if err != nil {
+		return err
+	}
 
-	return uc.repo.UpdateAccommodation(ctx, entity.Accommodation{
+	before := uc.history.Snapshot(ctx, tripID, entity.ACCOMMODATION_KIND, accommodationID)
+	err = uc.repo.UpdateAccommodation(ctx, entity.Accommodation{
 		ID:            accommodationID,
 		TripID:        tripID,
 		Name:          accommodation.Name,
@@ -62,12 +99,54 @@ func (uc *UseCase) UpdateAccommodation(ctx context.Context, tripID int32, accomm
 		CheckInTime:   accommodation.CheckInTime,
 		CheckOutTime:  accommodation.CheckOutTime,
 		Description:   accommodation.Description,
+		Sensitive:     accommodation.Sensitive,
 		Address:       accommodation.Address,
 		Location:      accommodation.Location,
 		Price:         accommodation.Price,
-	})
+		Currency:      currency,
+	}, revision)
+	if err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.UPDATED, entity.ACCOMMODATION_KIND, accommodationID)
+	uc.history.Record(ctx, tripID, entity.UPDATED, entity.ACCOMMODATION_KIND, accommodationID, before)
+	return nil
 }
 
-func (uc *UseCase) DeleteAccommodation(ctx context.Context, tripID int32, accommodationID int32) error {
-	return uc.repo.DeleteAccommodation(ctx, tripID, accommodationID)
+func (uc *UseCase) DeleteAccommodation(ctx context.Context, tripID int32, accommodationID int32, revision *int64) error {
+	before := uc.history.Snapshot(ctx, tripID, entity.ACCOMMODATION_KIND, accommodationID)
+	if err := uc.repo.DeleteAccommodation(ctx, tripID, accommodationID, revision); err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.DELETED, entity.ACCOMMODATION_KIND, accommodationID)
+	uc.history.Record(ctx, tripID, entity.DELETED, entity.ACCOMMODATION_KIND, accommodationID, before)
+	return nil
+}
+
+// RestoreAccommodation restores the version of an accommodation recorded by a history entry. Deleted accommodation is
+// created again with a new ID.
+func (uc *UseCase) RestoreAccommodation(ctx context.Context, tripID int32, accommodationID int32, entryID int32) (entity.Accommodation, error) {
+	var version request.Accommodation
+	if err := uc.history.GetVersion(ctx, tripID, entity.ACCOMMODATION_KIND, accommodationID, entryID, &version); err != nil {
+		return entity.Accommodation{}, err
+	}
+	// the location of the version may have been deleted since
+	if version.Location != nil {
+		version.Location.ID = 0
+	}
+
+	_, err := uc.repo.GetAccommodationByID(ctx, tripID, accommodationID)
+	if usecase.IsNotFound(err) {
+		return uc.CreateAccommodation(ctx, tripID, version)
+	}
+	if err != nil {
+		return entity.Accommodation{}, err
+	}
+
+	if err := uc.UpdateAccommodation(ctx, tripID, accommodationID, version, nil); err != nil {
+		return entity.Accommodation{}, err
+	}
+	return uc.repo.GetAccommodationByID(ctx, tripID, accommodationID)
 }
@@ -10,14 +10,22 @@ import (
 )
 
 type UseCase struct {
-	repo  repo.ActivitiesRepo
-	trips usecase.Trips
+	repo       repo.ActivitiesRepo
+	trips      usecase.Trips
+	budgets    usecase.Budgets
+	boundaries repo.BoundaryLookup
+	events     usecase.Events
+	history    usecase.History
 }
 
-func New(r repo.ActivitiesRepo, trips usecase.Trips) *UseCase {
+func New(r repo.ActivitiesRepo, trips usecase.Trips, budgets usecase.Budgets, boundaries repo.BoundaryLookup, events usecase.Events, history usecase.History) *UseCase {
 	return &UseCase{
-		repo:  r,
-		trips: trips,
+		repo:       r,
+		trips:      trips,
+		budgets:    budgets,
+		boundaries: boundaries,
+		events:     events,
+		history:    history,
 	}
 }
 
@@ -25,6 +33,10 @@ func (uc *UseCase) GetActivities(ctx context.Context, tripID int32) ([]entity.Ac
 	return uc.repo.GetActivities(ctx, tripID)
 }
 
+func (uc *UseCase) GetActivitiesSince(ctx context.Context, tripID int32, since int64) ([]entity.Activity, error) {
+	return uc.repo.GetActivitiesSince(ctx, tripID, since)
+}
+
 func (uc *UseCase) GetActivityByID(ctx context.Context, tripID int32, id int32) (entity.Activity, error) {
 	return uc.repo.GetActivityByID(ctx, tripID, id)
 }
@@ -33,37 +45,104 @@ func (uc *UseCase) CreateActivity(ctx context.Context, tripID int32, activity re
 	if err := uc.trips.VerifyDatesInBounds(ctx, tripID, activity.Date); err != nil {
 		return entity.Activity{}, fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
+	if err := usecase.ResolveTimezone(uc.boundaries, activity.Location); err != nil {
+		return entity.Activity{}, err
+	}
+	currency, err := usecase.ParseCurrency(activity.Currency)
+	if err != nil {
+		return entity.Activity{}, err
+	}
 
-	return uc.repo.CreateActivity(ctx, entity.Activity{
+	created, err := uc.repo.CreateActivity(ctx, entity.Activity{
 		TripID:      tripID,
 		Name:        activity.Name,
 		Date:        activity.Date,
 		Time:        activity.Time,
 		Description: activity.Description,
+		Sensitive:   activity.Sensitive,
 		Address:     activity.Address,
 		Location:    activity.Location,
 		Price:       activity.Price,
+		Currency:    currency,
 	})
+	if err != nil {
+		return entity.Activity{}, err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.CREATED, entity.ACTIVITY_KIND, created.ID)
+	uc.history.Record(ctx, tripID, entity.CREATED, entity.ACTIVITY_KIND, created.ID, nil)
+	created.BudgetAlert = usecase.BudgetAlert(ctx, uc.budgets, tripID, created.Price, entity.ACTIVITY_COSTS)
+	return created, nil
 }
 
-func (uc *UseCase) UpdateActivity(ctx context.Context, tripID int32, activityID int32, activity request.Activity) error {
+func (uc *UseCase) UpdateActivity(ctx context.Context, tripID int32, activityID int32, activity request.Activity, revision *int64) error {
 	if err := uc.trips.VerifyDatesInBounds(ctx, tripID, activity.Date); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
+	if err := usecase.ResolveTimezone(uc.boundaries, activity.Location); err != nil {
+		return err
+	}
+	currency, err := usecase.ParseCurrency(activity.Currency)
+	if err != nil {
+		return err
+	}
 
-	return uc.repo.UpdateActivity(ctx, entity.Activity{
+	before := uc.history.Snapshot(ctx, tripID, entity.ACTIVITY_KIND, activityID)
+	err = uc.repo.UpdateActivity(ctx, entity.Activity{
 		ID:          activityID,
 		TripID:      tripID,
 		Name:        activity.Name,
 		Date:        activity.Date,
 		Time:        activity.Time,
 		Description: activity.Description,
+		Sensitive:   activity.Sensitive,
 		Address:     activity.Address,
 		Location:    activity.Location,
 		Price:       activity.Price,
-	})
+		Currency:    currency,
+	}, revision)
+	if err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.UPDATED, entity.ACTIVITY_KIND, activityID)
+	uc.history.Record(ctx, tripID, entity.UPDATED, entity.ACTIVITY_KIND, activityID, before)
+	return nil
 }
 
-func (uc *UseCase) DeleteActivity(ctx context.Context, tripID int32, activityId int32) error {
-	return uc.repo.DeleteActivity(ctx, tripID, activityId)
+func (uc *UseCase) DeleteActivity(ctx context.Context, tripID int32, activityId int32, revision *int64) error {
+	before := uc.history.Snapshot(ctx, tripID, entity.ACTIVITY_KIND, activityId)
+	if err := uc.repo.DeleteActivity(ctx, tripID, activityId, revision); err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.DELETED, entity.ACTIVITY_KIND, activityId)
+	uc.history.Record(ctx, tripID, entity.DELETED, entity.ACTIVITY_KIND, activityId, before)
+	return nil
+}
+
+// RestoreActivity restores the version of an activity recorded by a history entry. Deleted activities are created again
+// with a new ID.
+func (uc *UseCase) RestoreActivity(ctx context.Context, tripID int32, activityID int32, entryID int32) (entity.Activity, error) {
+	var version request.Activity
+	if err := uc.history.GetVersion(ctx, tripID, entity.ACTIVITY_KIND, activityID, entryID, &version); err != nil {
+		return entity.Activity{}, err
+	}
+	// the location of the version may have been deleted since
+	if version.Location != nil {
+		version.Location.ID = 0
+	}
+
+	_, err := uc.repo.GetActivityByID(ctx, tripID, activityID)
+	if usecase.IsNotFound(err) {
+		return uc.CreateActivity(ctx, tripID, version)
+	}
+	if err != nil {
+		return entity.Activity{}, err
+	}
+
+	if err := uc.UpdateActivity(ctx, tripID, activityID, version, nil); err != nil {
+		return entity.Activity{}, err
+	}
+	return uc.repo.GetActivityByID(ctx, tripID, activityID)
 }
@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+	"kompass/internal/entity"
+)
+
+type actorKey struct{}
+
+// WithActor returns a context for requests made by the user, who is recorded as the author of changes.
+func WithActor(ctx context.Context, user entity.User) context.Context {
+	return context.WithValue(ctx, actorKey{}, user)
+}
+
+// ActorID returns the ID of the user making the request, or nil for changes made by the backend itself.
+func ActorID(ctx context.Context) *int32 {
+	user, ok := ctx.Value(actorKey{}).(entity.User)
+	if !ok {
+		return nil
+	}
+	return &user.ID
+}
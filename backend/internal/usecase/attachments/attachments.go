@@ -2,20 +2,53 @@ package attachments
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"kompass/config"
 	"kompass/internal/entity"
 	"kompass/internal/repo"
+	"kompass/internal/usecase"
+	"kompass/pkg/exif"
+	"kompass/pkg/logger"
+	"kompass/pkg/thumbnail"
 	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
 )
 
+// sniffLength is the number of bytes http.DetectContentType considers.
+const sniffLength = 512
+
+// thumbnailWorkers limits how many images are decoded at the same time, because decoding needs a lot of memory.
+const thumbnailWorkers = 2
+
+const thumbnailTimeout = time.Minute
+
 type UseCase struct {
-	repo repo.AttachmentsRepo
+	repo           repo.AttachmentsRepo
+	maxSize        int64
+	presignExpiry  time.Duration
+	log            logger.Interface
+	events         usecase.Events
+	history        usecase.History
+	thumbnailSlots chan struct{}
 }
 
-func New(r repo.AttachmentsRepo) *UseCase {
+func New(r repo.AttachmentsRepo, cfg config.Attachments, log logger.Interface, events usecase.Events, history usecase.History) *UseCase {
 	return &UseCase{
-		repo: r,
+		repo:           r,
+		maxSize:        cfg.MaxSize,
+		presignExpiry:  cfg.PresignExpiry,
+		log:            log,
+		events:         events,
+		history:        history,
+		thumbnailSlots: make(chan struct{}, thumbnailWorkers),
 	}
 }
 
@@ -23,26 +56,238 @@ func (uc *UseCase) GetAttachments(ctx context.Context, tripID int32) ([]entity.A
 	return uc.repo.GetAttachments(ctx, tripID)
 }
 
+func (uc *UseCase) GetAttachmentsSince(ctx context.Context, tripID int32, since int64) ([]entity.Attachment, error) {
+	return uc.repo.GetAttachmentsSince(ctx, tripID, since)
+}
+
 func (uc *UseCase) GetAttachmentByID(ctx context.Context, tripID int32, id int32) (entity.Attachment, error) {
 	return uc.repo.GetAttachmentByID(ctx, tripID, id)
 }
 
-func (uc *UseCase) CreateAttachment(ctx context.Context, tripID int32, fileHeader *multipart.FileHeader) (entity.Attachment, error) {
+func (uc *UseCase) OpenAttachment(ctx context.Context, tripID int32, id int32) (entity.Attachment, io.ReadSeekCloser, error) {
+	return uc.repo.OpenAttachment(ctx, tripID, id)
+}
+
+func (uc *UseCase) GetAttachmentURL(ctx context.Context, tripID int32, id int32) (entity.AttachmentURL, error) {
+	if uc.presignExpiry <= 0 {
+		return entity.AttachmentURL{}, fiber.NewError(http.StatusNotImplemented, "pre-signed urls are disabled")
+	}
+	return uc.repo.PresignAttachment(ctx, tripID, id, uc.presignExpiry)
+}
+
+// CreateAttachment reads the uploaded file twice: once to determine size, hash and content type and once to store
+// it. Neither pass holds the whole file in memory.
+func (uc *UseCase) CreateAttachment(ctx context.Context, tripID int32, userID int32, fileHeader *multipart.FileHeader) (entity.Attachment, error) {
+	if fileHeader.Size > uc.maxSize {
+		return entity.Attachment{}, tooLarge(fileHeader.Filename, uc.maxSize)
+	}
+
 	file, err := fileHeader.Open()
 	if err != nil {
 		return entity.Attachment{}, fmt.Errorf("open file: %w", err)
 	}
-	fileBytes, err := io.ReadAll(file)
+	defer file.Close()
+
+	attachment, err := inspect(file, fileHeader.Filename, uc.maxSize)
+	if err != nil {
+		return entity.Attachment{}, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return entity.Attachment{}, fmt.Errorf("rewind file: %w", err)
+	}
+
+	attachment.TripID = tripID
+	attachment.Name = fileHeader.Filename
+	attachment.UploadedBy = &userID
+	created, err := uc.repo.CreateAttachment(ctx, attachment, io.LimitReader(file, attachment.Size))
+	if err != nil {
+		return entity.Attachment{}, err
+	}
+
+	if thumbnail.Supported(created.ContentType) {
+		go uc.generateThumbnailsInBackground(created)
+	}
+	uc.events.Publish(ctx, tripID, entity.CREATED, entity.ATTACHMENT_KIND, created.ID)
+	uc.history.Record(ctx, tripID, entity.CREATED, entity.ATTACHMENT_KIND, created.ID, nil)
+	return created, nil
+}
+
+// CopyAttachment stores the content of an attachment again for another trip, as blobs are encrypted per trip.
+func (uc *UseCase) CopyAttachment(ctx context.Context, tripID int32, attachmentID int32, toTripID int32, userID int32) (entity.Attachment, error) {
+	source, content, err := uc.repo.OpenAttachment(ctx, tripID, attachmentID)
+	if err != nil {
+		return entity.Attachment{}, err
+	}
+	defer content.Close()
+
+	created, err := uc.repo.CreateAttachment(ctx, entity.Attachment{
+		TripID:      toTripID,
+		Name:        source.Name,
+		Size:        source.Size,
+		ContentType: source.ContentType,
+		Sha256:      source.Sha256,
+		UploadedBy:  &userID,
+	}, content)
+	if err != nil {
+		return entity.Attachment{}, err
+	}
+
+	if thumbnail.Supported(created.ContentType) {
+		go uc.generateThumbnailsInBackground(created)
+	}
+	uc.events.Publish(ctx, toTripID, entity.CREATED, entity.ATTACHMENT_KIND, created.ID)
+	uc.history.Record(ctx, toTripID, entity.CREATED, entity.ATTACHMENT_KIND, created.ID, nil)
+	return created, nil
+}
+
+// GetThumbnail generates the thumbnails if they do not exist yet, e.g. because the upload happened before a restart.
+func (uc *UseCase) GetThumbnail(ctx context.Context, tripID int32, id int32, size entity.ThumbnailSize) (entity.Attachment, []byte, error) {
+	if size.Pixels() == 0 {
+		return entity.Attachment{}, nil, fiber.NewError(http.StatusBadRequest, "invalid thumbnail size: "+string(size))
+	}
+
+	attachment, err := uc.repo.GetAttachmentByID(ctx, tripID, id)
+	if err != nil {
+		return entity.Attachment{}, nil, err
+	}
+	if !thumbnail.Supported(attachment.ContentType) {
+		return entity.Attachment{}, nil, fiber.NewError(http.StatusNotFound, "no thumbnail available for "+attachment.ContentType)
+	}
+
+	data, err := uc.repo.GetThumbnail(ctx, tripID, id, size)
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) && fiberErr.Code == http.StatusNotFound {
+		thumbnails, err := uc.generateThumbnails(ctx, attachment)
+		return attachment, thumbnails[size], err
+	}
+	return attachment, data, err
+}
+
+func (uc *UseCase) generateThumbnailsInBackground(attachment entity.Attachment) {
+	ctx, cancel := context.WithTimeout(context.Background(), thumbnailTimeout)
+	defer cancel()
+
+	if _, err := uc.generateThumbnails(ctx, attachment); err != nil {
+		uc.log.Warn("attachments - generateThumbnails [id=%d]: %s", attachment.ID, err)
+	}
+}
+
+// generateThumbnails renders all sizes at once, because decoding the image is the expensive part.
+func (uc *UseCase) generateThumbnails(ctx context.Context, attachment entity.Attachment) (map[entity.ThumbnailSize][]byte, error) {
+	select {
+	case uc.thumbnailSlots <- struct{}{}:
+		defer func() { <-uc.thumbnailSlots }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	_, content, err := uc.repo.OpenAttachment(ctx, attachment.TripID, attachment.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	source, err := thumbnail.Decode(content)
+	if err != nil {
+		return nil, fiber.NewError(http.StatusUnprocessableEntity, fmt.Sprintf("unable to render %s: %s", attachment.Name, err))
+	}
+
+	thumbnails := map[entity.ThumbnailSize][]byte{}
+	for _, size := range entity.ThumbnailSizes {
+		data, err := source.Render(size.Pixels())
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.repo.SaveThumbnail(ctx, attachment.TripID, attachment.ID, size, data); err != nil {
+			return nil, err
+		}
+		thumbnails[size] = data
+	}
+	return thumbnails, nil
+}
+
+func (uc *UseCase) DeleteAttachment(ctx context.Context, tripID int32, attachmentId int32, revision *int64) error {
+	before := uc.history.Snapshot(ctx, tripID, entity.ATTACHMENT_KIND, attachmentId)
+	if err := uc.repo.DeleteAttachment(ctx, tripID, attachmentId, revision); err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.DELETED, entity.ATTACHMENT_KIND, attachmentId)
+	uc.history.Record(ctx, tripID, entity.DELETED, entity.ATTACHMENT_KIND, attachmentId, before)
+	return nil
+}
+
+func (uc *UseCase) LinkAttachment(ctx context.Context, tripID int32, attachmentID int32, target entity.AttachmentLinkTarget, targetID int32) error {
+	if !target.IsValid() {
+		return fiber.NewError(http.StatusBadRequest, "invalid link target: "+string(target))
+	}
+	before := uc.history.Snapshot(ctx, tripID, entity.ATTACHMENT_KIND, attachmentID)
+	if err := uc.repo.LinkAttachment(ctx, tripID, attachmentID, target, targetID); err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.UPDATED, entity.ATTACHMENT_KIND, attachmentID)
+	uc.history.Record(ctx, tripID, entity.UPDATED, entity.ATTACHMENT_KIND, attachmentID, before)
+	return nil
+}
+
+func (uc *UseCase) UnlinkAttachment(ctx context.Context, tripID int32, attachmentID int32, target entity.AttachmentLinkTarget, targetID int32) error {
+	if !target.IsValid() {
+		return fiber.NewError(http.StatusBadRequest, "invalid link target: "+string(target))
+	}
+	before := uc.history.Snapshot(ctx, tripID, entity.ATTACHMENT_KIND, attachmentID)
+	if err := uc.repo.UnlinkAttachment(ctx, tripID, attachmentID, target, targetID); err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.UPDATED, entity.ATTACHMENT_KIND, attachmentID)
+	uc.history.Record(ctx, tripID, entity.UPDATED, entity.ATTACHMENT_KIND, attachmentID, before)
+	return nil
+}
+
+func inspect(content io.Reader, filename string, maxSize int64) (entity.Attachment, error) {
+	hash := sha256.New()
+
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return entity.Attachment{}, fmt.Errorf("read file: %w", err)
+	}
+	head = head[:n]
+	hash.Write(head)
+
+	rest, err := io.Copy(hash, io.LimitReader(content, maxSize-int64(n)+1))
 	if err != nil {
 		return entity.Attachment{}, fmt.Errorf("read file: %w", err)
 	}
-	return uc.repo.CreateAttachment(ctx, entity.Attachment{
-		TripID: tripID,
-		Name:   fileHeader.Filename,
-		Blob:   fileBytes,
-	})
+
+	size := int64(n) + rest
+	if size > maxSize {
+		return entity.Attachment{}, tooLarge(filename, maxSize)
+	}
+
+	return entity.Attachment{
+		Size:        size,
+		ContentType: detectContentType(head, filename),
+		Sha256:      hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+// detectContentType sniffs the content type and falls back to the file extension for content that is not
+// recognized or only detected as plain text, like CSV or iCalendar files. HEIC photos are not known to net/http.
+func detectContentType(head []byte, filename string) string {
+	if exif.IsHEIF(head) {
+		return "image/heic"
+	}
+	contentType := http.DetectContentType(head)
+	if contentType == "application/octet-stream" || strings.HasPrefix(contentType, "text/plain") {
+		if byName := entity.ContentTypeByName(filename); byName != "application/octet-stream" {
+			return byName
+		}
+	}
+	return contentType
 }
 
-func (uc *UseCase) DeleteAttachment(ctx context.Context, tripID int32, attachmentId int32) error {
-	return uc.repo.DeleteAttachment(ctx, tripID, attachmentId)
+func tooLarge(filename string, maxSize int64) error {
+	return fiber.NewError(http.StatusRequestEntityTooLarge, fmt.Sprintf("attachment %s exceeds the maximum size of %d bytes", filename, maxSize))
 }
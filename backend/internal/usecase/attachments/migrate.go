@@ -0,0 +1,53 @@
+package attachments
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/repo"
+)
+
+// MigrateStorage copies the blobs of all attachments from source to target and returns how many were copied. Blobs
+// that already exist in target are skipped, so that an interrupted migration can be resumed. With deleteSource,
+// blobs are removed from source once they have been copied.
+func MigrateStorage(ctx context.Context, attachments repo.AttachmentsRepo, source repo.BlobStorage, target repo.BlobStorage, deleteSource bool) (int, error) {
+	blobs, err := attachments.GetBlobs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	copied := 0
+	for _, blob := range blobs {
+		exists, err := target.Exists(ctx, blob.Key)
+		if err != nil {
+			return copied, fmt.Errorf("check blob [key=%s]: %w", blob.Key, err)
+		}
+
+		if !exists {
+			if err := copyBlob(ctx, source, target, blob.Key, blob.Size); err != nil {
+				return copied, err
+			}
+			copied++
+		}
+
+		if deleteSource {
+			if err := source.Delete(ctx, blob.Key); err != nil {
+				return copied, fmt.Errorf("delete blob [key=%s]: %w", blob.Key, err)
+			}
+		}
+	}
+
+	return copied, nil
+}
+
+func copyBlob(ctx context.Context, source repo.BlobStorage, target repo.BlobStorage, key string, size int64) error {
+	content, err := source.Open(ctx, key, size)
+	if err != nil {
+		return fmt.Errorf("open blob [key=%s]: %w", key, err)
+	}
+	defer content.Close()
+
+	if err := target.Put(ctx, key, size, content); err != nil {
+		return fmt.Errorf("put blob [key=%s]: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+	"kompass/internal/entity"
+)
+
+// BudgetAlert checks whether a new booking with a price exceeds the budget of its category. The booking is saved at
+// this point, so the request does not fail if the costs can not be computed, e.g. for lack of exchange rates.
+func BudgetAlert(ctx context.Context, budgets Budgets, tripID int32, price *int32, category entity.CostCategory) *entity.BudgetAlert {
+	if price == nil {
+		return nil
+	}
+
+	alert, err := budgets.CheckBudget(ctx, tripID, category)
+	if err != nil {
+		return nil
+	}
+	return alert
+}
@@ -0,0 +1,168 @@
+package budgets
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/usecase"
+	"math"
+	"net/http"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+)
+
+type UseCase struct {
+	repo    repo.BudgetsRepo
+	trips   usecase.Trips
+	costs   usecase.Costs
+	events  usecase.Events
+	history usecase.History
+}
+
+func New(r repo.BudgetsRepo, trips usecase.Trips, costs usecase.Costs, events usecase.Events, history usecase.History) *UseCase {
+	return &UseCase{
+		repo:    r,
+		trips:   trips,
+		costs:   costs,
+		events:  events,
+		history: history,
+	}
+}
+
+func (uc *UseCase) GetBudget(ctx context.Context, tripID int32) (entity.Budget, error) {
+	return uc.repo.GetBudget(ctx, tripID)
+}
+
+func (uc *UseCase) SaveBudget(ctx context.Context, tripID int32, budget request.Budget) error {
+	if budget.Total != nil && *budget.Total < 0 {
+		return fiber.NewError(http.StatusBadRequest, "budget must not be negative")
+	}
+
+	seen := map[entity.CostCategory]bool{}
+	for _, categoryBudget := range budget.Categories {
+		if !categoryBudget.Category.IsValid() {
+			return fiber.NewError(http.StatusBadRequest, "invalid category")
+		}
+		if seen[categoryBudget.Category] {
+			return fiber.NewError(http.StatusBadRequest, fmt.Sprintf("category %s is listed more than once", categoryBudget.Category))
+		}
+		if categoryBudget.Amount < 0 {
+			return fiber.NewError(http.StatusBadRequest, "budget must not be negative")
+		}
+		seen[categoryBudget.Category] = true
+	}
+
+	before := uc.history.Snapshot(ctx, tripID, entity.BUDGET_KIND, tripID)
+	err := uc.repo.SaveBudget(ctx, tripID, entity.Budget{
+		Total:      budget.Total,
+		Categories: budget.Categories,
+	})
+	if err != nil {
+		return err
+	}
+
+	// a trip has a single budget, which is identified by the trip
+	uc.events.Publish(ctx, tripID, entity.UPDATED, entity.BUDGET_KIND, tripID)
+	uc.history.Record(ctx, tripID, entity.UPDATED, entity.BUDGET_KIND, tripID, before)
+	return nil
+}
+
+func (uc *UseCase) GetBudgetReport(ctx context.Context, tripID int32) (entity.BudgetReport, error) {
+	trip, err := uc.trips.GetTripByID(ctx, tripID)
+	if err != nil {
+		return entity.BudgetReport{}, fmt.Errorf("get trip [id=%d]: %w", tripID, err)
+	}
+
+	budget, err := uc.repo.GetBudget(ctx, tripID)
+	if err != nil {
+		return entity.BudgetReport{}, err
+	}
+
+	costs, err := uc.costs.GetCosts(ctx, tripID)
+	if err != nil {
+		return entity.BudgetReport{}, fmt.Errorf("get costs: %w", err)
+	}
+
+	planned := map[entity.CostCategory]float64{}
+	for _, categoryBudget := range budget.Categories {
+		planned[categoryBudget.Category] = categoryBudget.Amount
+	}
+
+	report := entity.BudgetReport{
+		HomeCurrency:  costs.HomeCurrency,
+		Planned:       budget.Total,
+		Spent:         costs.Total,
+		Remaining:     remaining(budget.Total, costs.Total),
+		DailyBurnRate: round(costs.Total / float64(daysPassed(trip, civil.DateOf(time.Now())))),
+		Categories:    []entity.CategoryBudgetReport{},
+	}
+	for _, categoryCosts := range costs.Categories {
+		var categoryPlanned *float64
+		if amount, ok := planned[categoryCosts.Category]; ok {
+			categoryPlanned = &amount
+		}
+		report.Categories = append(report.Categories, entity.CategoryBudgetReport{
+			Category:  categoryCosts.Category,
+			Planned:   categoryPlanned,
+			Spent:     categoryCosts.Total,
+			Remaining: remaining(categoryPlanned, categoryCosts.Total),
+			Exceeded:  categoryPlanned != nil && categoryCosts.Total > *categoryPlanned,
+		})
+	}
+
+	return report, nil
+}
+
+// CheckBudget returns an alert if the costs of category exceed its budget, or nil if they do not or no budget is
+// set for category.
+func (uc *UseCase) CheckBudget(ctx context.Context, tripID int32, category entity.CostCategory) (*entity.BudgetAlert, error) {
+	budget, err := uc.repo.GetBudget(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, categoryBudget := range budget.Categories {
+		if categoryBudget.Category != category {
+			continue
+		}
+
+		costs, err := uc.costs.GetCosts(ctx, tripID)
+		if err != nil {
+			return nil, fmt.Errorf("get costs: %w", err)
+		}
+		for _, categoryCosts := range costs.Categories {
+			if categoryCosts.Category == category && categoryCosts.Total > categoryBudget.Amount {
+				return &entity.BudgetAlert{
+					Category: category,
+					Planned:  categoryBudget.Amount,
+					Spent:    categoryCosts.Total,
+				}, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func remaining(planned *float64, spent float64) *float64 {
+	if planned == nil {
+		return nil
+	}
+	result := round(*planned - spent)
+	return &result
+}
+
+// daysPassed counts the days of the trip up to and including today, but at least one and at most all of them.
+func daysPassed(trip entity.Trip, today civil.Date) int {
+	total := trip.EndDate.DaysSince(trip.StartDate) + 1
+	passed := today.DaysSince(trip.StartDate) + 1
+	return max(1, min(passed, total))
+}
+
+func round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
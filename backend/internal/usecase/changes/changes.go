@@ -0,0 +1,363 @@
+package changes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/usecase"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// maxClientIDLength is the length of the client_id column.
+const maxClientIDLength = 100
+
+type UseCase struct {
+	repo           repo.SyncRepo
+	trips          usecase.Trips
+	transportation usecase.Transportation
+	activities     usecase.Activities
+	accommodation  usecase.Accommodation
+	expenses       usecase.Expenses
+	attachments    usecase.Attachments
+}
+
+func New(r repo.SyncRepo, trips usecase.Trips, transportation usecase.Transportation, activities usecase.Activities, accommodation usecase.Accommodation, expenses usecase.Expenses, attachments usecase.Attachments) *UseCase {
+	return &UseCase{
+		repo:           r,
+		trips:          trips,
+		transportation: transportation,
+		activities:     activities,
+		accommodation:  accommodation,
+		expenses:       expenses,
+		attachments:    attachments,
+	}
+}
+
+func (uc *UseCase) GetChanges(ctx context.Context, tripID int32, since int64) (entity.Changes, error) {
+	// the cursor is read before the entities, so that writes racing with this request are returned again by the next
+	// request instead of being missed
+	cursor, err := uc.repo.GetCursor(ctx, tripID)
+	if err != nil {
+		return entity.Changes{}, err
+	}
+
+	trip, err := uc.trips.GetTripByID(ctx, tripID)
+	if err != nil {
+		return entity.Changes{}, fmt.Errorf("get trip [id=%d]: %w", tripID, err)
+	}
+	transportation, err := uc.transportation.GetAllTransportationSince(ctx, tripID, since)
+	if err != nil {
+		return entity.Changes{}, fmt.Errorf("get transportation: %w", err)
+	}
+	activities, err := uc.activities.GetActivitiesSince(ctx, tripID, since)
+	if err != nil {
+		return entity.Changes{}, fmt.Errorf("get activities: %w", err)
+	}
+	accommodation, err := uc.accommodation.GetAllAccommodationSince(ctx, tripID, since)
+	if err != nil {
+		return entity.Changes{}, fmt.Errorf("get accommodation: %w", err)
+	}
+	expenses, err := uc.expenses.GetExpensesSince(ctx, tripID, since)
+	if err != nil {
+		return entity.Changes{}, fmt.Errorf("get expenses: %w", err)
+	}
+	attachments, err := uc.attachments.GetAttachmentsSince(ctx, tripID, since)
+	if err != nil {
+		return entity.Changes{}, fmt.Errorf("get attachments: %w", err)
+	}
+	tombstones, err := uc.repo.GetTombstones(ctx, tripID, since)
+	if err != nil {
+		return entity.Changes{}, err
+	}
+
+	changes := entity.Changes{
+		Cursor:         cursor,
+		Transportation: transportation,
+		Activities:     activities,
+		Accommodation:  accommodation,
+		Expenses:       expenses,
+		Attachments:    attachments,
+		Deleted:        tombstones,
+	}
+	if trip.Revision > since {
+		changes.Trip = &trip
+	}
+	return changes, nil
+}
+
+// ApplyChanges applies the changes one after another. A conflicting or invalid change does not prevent the following
+// changes from being applied, only unexpected errors abort the batch.
+func (uc *UseCase) ApplyChanges(ctx context.Context, tripID int32, changes []request.Change) ([]entity.ChangeResult, error) {
+	results := []entity.ChangeResult{}
+	for _, change := range changes {
+		result, err := uc.applyChange(ctx, tripID, change)
+		if err != nil {
+			message, rejected := rejection(err)
+			if !rejected {
+				return nil, fmt.Errorf("apply %s %s: %w", change.Kind, change.Type, err)
+			}
+			result = entity.ChangeResult{Status: entity.CHANGE_REJECTED, Kind: change.Kind, ID: change.ID, Error: &message}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// rejection returns the message of errors caused by the change itself rather than by the server.
+func rejection(err error) (string, bool) {
+	var outOfRange entity.ErrDatesOutOfRange
+	if errors.As(err, &outOfRange) {
+		return outOfRange.Error(), true
+	}
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) && fiberErr.Code < http.StatusInternalServerError {
+		return fiberErr.Message, true
+	}
+	return "", false
+}
+
+func (uc *UseCase) applyChange(ctx context.Context, tripID int32, change request.Change) (entity.ChangeResult, error) {
+	if change.Type == entity.CREATED {
+		return uc.applyCreation(ctx, tripID, change)
+	}
+	if change.Type != entity.UPDATED && change.Type != entity.DELETED {
+		return entity.ChangeResult{}, fiber.NewError(http.StatusBadRequest, "invalid change type")
+	}
+
+	id := tripID
+	if change.Kind != entity.TRIP_KIND {
+		if change.ID == nil {
+			return entity.ChangeResult{}, fiber.NewError(http.StatusBadRequest, "id is required")
+		}
+		id = *change.ID
+	}
+	if change.BaseRevision == nil {
+		return entity.ChangeResult{}, fiber.NewError(http.StatusBadRequest, "baseRevision is required")
+	}
+
+	// the revision is read to tell missing entities from stale ones, the write itself is only applied if the entity
+	// still has the base revision
+	revision, err := uc.getRevision(ctx, tripID, change.Kind, id)
+	if err != nil && !usecase.IsNotFound(err) {
+		return entity.ChangeResult{}, err
+	}
+	if err != nil || revision != *change.BaseRevision {
+		return uc.conflict(ctx, tripID, change, id)
+	}
+
+	if change.Type == entity.DELETED {
+		err = uc.delete(ctx, tripID, change.Kind, id, change.BaseRevision)
+	} else {
+		err = uc.update(ctx, tripID, id, change)
+	}
+	if usecase.IsPreconditionFailed(err) {
+		return uc.conflict(ctx, tripID, change, id)
+	}
+	if err != nil {
+		return entity.ChangeResult{}, err
+	}
+	if change.Type == entity.DELETED {
+		return entity.ChangeResult{Status: entity.CHANGE_APPLIED, Kind: change.Kind, ID: &id}, nil
+	}
+	return uc.applied(ctx, tripID, change.Kind, id)
+}
+
+// applyCreation creates the entity of a change once per client id. Without a client id, the entity is created every
+// time the change is pushed.
+func (uc *UseCase) applyCreation(ctx context.Context, tripID int32, change request.Change) (entity.ChangeResult, error) {
+	if change.ClientID == nil {
+		id, err := uc.create(ctx, tripID, change)
+		if err != nil {
+			return entity.ChangeResult{}, err
+		}
+		return uc.applied(ctx, tripID, change.Kind, id)
+	}
+	clientID := *change.ClientID
+	if clientID == "" || len(clientID) > maxClientIDLength {
+		return entity.ChangeResult{}, fiber.NewError(http.StatusBadRequest, fmt.Sprintf("clientId must have 1 to %d characters", maxClientIDLength))
+	}
+
+	claimed, err := uc.repo.ClaimClientID(ctx, tripID, clientID, change.Kind)
+	if err != nil {
+		return entity.ChangeResult{}, err
+	}
+	if !claimed {
+		return uc.replayed(ctx, tripID, change)
+	}
+
+	id, err := uc.create(ctx, tripID, change)
+	if err != nil {
+		if releaseErr := uc.repo.ReleaseClientID(ctx, tripID, clientID); releaseErr != nil {
+			return entity.ChangeResult{}, errors.Join(err, releaseErr)
+		}
+		return entity.ChangeResult{}, err
+	}
+	if err := uc.repo.SetCreatedID(ctx, tripID, clientID, id); err != nil {
+		return entity.ChangeResult{}, err
+	}
+	return uc.applied(ctx, tripID, change.Kind, id)
+}
+
+// replayed reports a creation pushed again with the entity created the first time. Creations that are still in
+// progress in another request, or whose entity was deleted in the meantime, are reported as conflicts.
+func (uc *UseCase) replayed(ctx context.Context, tripID int32, change request.Change) (entity.ChangeResult, error) {
+	kind, id, err := uc.repo.GetCreation(ctx, tripID, *change.ClientID)
+	if usecase.IsNotFound(err) {
+		// the creation failed and released the client id in the meantime
+		return entity.ChangeResult{Status: entity.CHANGE_CONFLICT, Kind: change.Kind}, nil
+	}
+	if err != nil {
+		return entity.ChangeResult{}, err
+	}
+	if kind != change.Kind {
+		return entity.ChangeResult{}, fiber.NewError(http.StatusBadRequest, fmt.Sprintf("clientId was already used for a %s", kind))
+	}
+	if id == nil {
+		return entity.ChangeResult{Status: entity.CHANGE_CONFLICT, Kind: kind}, nil
+	}
+
+	revision, err := uc.getRevision(ctx, tripID, kind, *id)
+	if usecase.IsNotFound(err) {
+		return entity.ChangeResult{Status: entity.CHANGE_CONFLICT, Kind: kind, ID: id}, nil
+	}
+	if err != nil {
+		return entity.ChangeResult{}, fmt.Errorf("get revision [id=%d]: %w", *id, err)
+	}
+	return entity.ChangeResult{Status: entity.CHANGE_APPLIED, Kind: kind, ID: id, Revision: &revision}, nil
+}
+
+// conflict reports a change whose entity was modified or deleted since the base revision, with the current revision if
+// there is one. Deletions of entities deleted in the meantime are applied nevertheless.
+func (uc *UseCase) conflict(ctx context.Context, tripID int32, change request.Change, id int32) (entity.ChangeResult, error) {
+	kind := change.Kind
+	revision, err := uc.getRevision(ctx, tripID, kind, id)
+	if usecase.IsNotFound(err) {
+		if change.Type == entity.DELETED {
+			return entity.ChangeResult{Status: entity.CHANGE_APPLIED, Kind: kind, ID: &id}, nil
+		}
+		return entity.ChangeResult{Status: entity.CHANGE_CONFLICT, Kind: kind, ID: &id}, nil
+	}
+	if err != nil {
+		return entity.ChangeResult{}, fmt.Errorf("get revision [id=%d]: %w", id, err)
+	}
+	return entity.ChangeResult{Status: entity.CHANGE_CONFLICT, Kind: kind, ID: &id, Revision: &revision}, nil
+}
+
+// applied reads the revision again, as writing child rows like locations increments it more than once.
+func (uc *UseCase) applied(ctx context.Context, tripID int32, kind entity.EntityKind, id int32) (entity.ChangeResult, error) {
+	revision, err := uc.getRevision(ctx, tripID, kind, id)
+	if err != nil {
+		return entity.ChangeResult{}, fmt.Errorf("get revision [id=%d]: %w", id, err)
+	}
+	return entity.ChangeResult{Status: entity.CHANGE_APPLIED, Kind: kind, ID: &id, Revision: &revision}, nil
+}
+
+func (uc *UseCase) getRevision(ctx context.Context, tripID int32, kind entity.EntityKind, id int32) (int64, error) {
+	switch kind {
+	case entity.TRIP_KIND:
+		trip, err := uc.trips.GetTripByID(ctx, tripID)
+		return trip.Revision, err
+	case entity.TRANSPORTATION_KIND:
+		transportation, err := uc.transportation.GetTransportationByID(ctx, tripID, id)
+		return transportation.Revision, err
+	case entity.ACTIVITY_KIND:
+		activity, err := uc.activities.GetActivityByID(ctx, tripID, id)
+		return activity.Revision, err
+	case entity.ACCOMMODATION_KIND:
+		accommodation, err := uc.accommodation.GetAccommodationByID(ctx, tripID, id)
+		return accommodation.Revision, err
+	case entity.EXPENSE_KIND:
+		expense, err := uc.expenses.GetExpenseByID(ctx, tripID, id)
+		return expense.Revision, err
+	}
+	return 0, unsupportedKind(kind)
+}
+
+func (uc *UseCase) create(ctx context.Context, tripID int32, change request.Change) (int32, error) {
+	switch change.Kind {
+	case entity.TRANSPORTATION_KIND:
+		if change.Transportation == nil {
+			return 0, missingPayload(change.Kind)
+		}
+		transportation, err := uc.transportation.CreateTransportation(ctx, tripID, *change.Transportation)
+		return transportation.ID, err
+	case entity.ACTIVITY_KIND:
+		if change.Activity == nil {
+			return 0, missingPayload(change.Kind)
+		}
+		activity, err := uc.activities.CreateActivity(ctx, tripID, *change.Activity)
+		return activity.ID, err
+	case entity.ACCOMMODATION_KIND:
+		if change.Accommodation == nil {
+			return 0, missingPayload(change.Kind)
+		}
+		accommodation, err := uc.accommodation.CreateAccommodation(ctx, tripID, *change.Accommodation)
+		return accommodation.ID, err
+	case entity.EXPENSE_KIND:
+		if change.Expense == nil {
+			return 0, missingPayload(change.Kind)
+		}
+		expense, err := uc.expenses.CreateExpense(ctx, tripID, *change.Expense)
+		return expense.ID, err
+	}
+	return 0, unsupportedKind(change.Kind)
+}
+
+func (uc *UseCase) update(ctx context.Context, tripID int32, id int32, change request.Change) error {
+	switch change.Kind {
+	case entity.TRIP_KIND:
+		if change.Trip == nil {
+			return missingPayload(change.Kind)
+		}
+		return uc.trips.UpdateTrip(ctx, tripID, *change.Trip, change.BaseRevision)
+	case entity.TRANSPORTATION_KIND:
+		if change.Transportation == nil {
+			return missingPayload(change.Kind)
+		}
+		_, err := uc.transportation.UpdateTransportation(ctx, tripID, id, *change.Transportation, change.BaseRevision)
+		return err
+	case entity.ACTIVITY_KIND:
+		if change.Activity == nil {
+			return missingPayload(change.Kind)
+		}
+		return uc.activities.UpdateActivity(ctx, tripID, id, *change.Activity, change.BaseRevision)
+	case entity.ACCOMMODATION_KIND:
+		if change.Accommodation == nil {
+			return missingPayload(change.Kind)
+		}
+		return uc.accommodation.UpdateAccommodation(ctx, tripID, id, *change.Accommodation, change.BaseRevision)
+	case entity.EXPENSE_KIND:
+		if change.Expense == nil {
+			return missingPayload(change.Kind)
+		}
+		return uc.expenses.UpdateExpense(ctx, tripID, id, *change.Expense, change.BaseRevision)
+	}
+	return unsupportedKind(change.Kind)
+}
+
+func (uc *UseCase) delete(ctx context.Context, tripID int32, kind entity.EntityKind, id int32, revision *int64) error {
+	switch kind {
+	case entity.TRANSPORTATION_KIND:
+		return uc.transportation.DeleteTransportation(ctx, tripID, id, revision)
+	case entity.ACTIVITY_KIND:
+		return uc.activities.DeleteActivity(ctx, tripID, id, revision)
+	case entity.ACCOMMODATION_KIND:
+		return uc.accommodation.DeleteAccommodation(ctx, tripID, id, revision)
+	case entity.EXPENSE_KIND:
+		return uc.expenses.DeleteExpense(ctx, tripID, id, revision)
+	}
+	return unsupportedKind(kind)
+}
+
+func missingPayload(kind entity.EntityKind) error {
+	return fiber.NewError(http.StatusBadRequest, fmt.Sprintf("%s is required", kind))
+}
+
+func unsupportedKind(kind entity.EntityKind) error {
+	return fiber.NewError(http.StatusBadRequest, fmt.Sprintf("changes of kind %q are not supported", kind))
+}
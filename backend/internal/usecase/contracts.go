@@ -4,10 +4,12 @@ package usecase
 import (
 	"cloud.google.com/go/civil"
 	"context"
+	"encoding/json"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/paulmach/orb/geojson"
 	"github.com/valyala/fasthttp"
+	"io"
 	"kompass/internal/controller/http/v1/request"
 	"kompass/internal/entity"
 	"kompass/internal/repo/opentraveldata"
@@ -27,6 +29,19 @@ type (
 		Activities     Activities
 		Accommodation  Accommodation
 		Attachments    Attachments
+		Photos         Photos
+		Export         Export
+		Itinerary      Itinerary
+		Costs          Costs
+		Expenses       Expenses
+		Budgets        Budgets
+		Events         Events
+		Changes        Changes
+		History        History
+		Trash          Trash
+		Templates      Templates
+		Stats          Stats
+		Emissions      Emissions
 		OPTD           opentraveldata.OpenTravelData
 	}
 
@@ -36,6 +51,7 @@ type (
 		GetUserByJwtSub(ctx context.Context, sub uuid.UUID) (entity.User, error)
 		CreateUserFromJwt(ctx context.Context, sub uuid.UUID, claims jwt.Claims) (entity.User, error)
 		HasReadPermission(ctx context.Context, userID, tripID int32) (bool, error)
+		HasReadSensitivePermission(ctx context.Context, userID, tripID int32) (bool, error)
 		HasWritePermission(ctx context.Context, userID, tripID int32) (bool, error)
 		IsTripOwner(ctx context.Context, userID int32, tripID int32) (bool, error)
 	}
@@ -48,24 +64,31 @@ type (
 	Trips interface {
 		GetTrips(ctx context.Context, userID int32) ([]entity.Trip, error)
 		GetTripByID(ctx context.Context, id int32) (entity.Trip, error)
+		GetTemplates(ctx context.Context) ([]entity.Trip, error)
 		CreateTrip(ctx context.Context, userID int32, trip request.Trip) (entity.Trip, error)
-		UpdateTrip(ctx context.Context, tripID int32, trip request.Trip) error
-		DeleteTrip(ctx context.Context, tripID int32) error
+		UpdateTrip(ctx context.Context, tripID int32, trip request.Trip, revision *int64) error
+		DeleteTrip(ctx context.Context, tripID int32, revision *int64) error
 		VerifyDatesInBounds(ctx context.Context, tripID int32, dates ...civil.Date) error
 	}
 
 	Transportation interface {
 		CreateTransportation(ctx context.Context, tripID int32, transportation request.Transportation) (entity.Transportation, error)
-		UpdateTransportation(ctx context.Context, tripID int32, transportationID int32, transportation request.Transportation) (entity.Transportation, error)
+		UpdateTransportation(ctx context.Context, tripID int32, transportationID int32, transportation request.Transportation, revision *int64) (entity.Transportation, error)
 		GetAllTransportation(ctx context.Context, tripID int32) ([]entity.Transportation, error)
+		GetAllTransportationSince(ctx context.Context, tripID int32, since int64) ([]entity.Transportation, error)
 		GetTransportationByID(ctx context.Context, tripID int32, transportationID int32) (entity.Transportation, error)
-		DeleteTransportation(ctx context.Context, tripID int32, transportationID int32) error
+		DeleteTransportation(ctx context.Context, tripID int32, transportationID int32, revision *int64) error
+		RestoreTransportation(ctx context.Context, tripID int32, transportationID int32, entryID int32) (entity.Transportation, error)
 		GetAllGeoJson(ctx context.Context, tripID int32) ([]geojson.FeatureCollection, error)
+		ImportTrack(ctx context.Context, tripID int32, transportationID int32, file *multipart.FileHeader, display entity.TrackDisplay) (entity.Transportation, error)
+		UpdateTrackDisplay(ctx context.Context, tripID int32, transportationID int32, display entity.TrackDisplay, revision *int64) error
+		DeleteTrack(ctx context.Context, tripID int32, transportationID int32, revision *int64) error
 	}
 
 	Flights interface {
 		CreateFlight(ctx context.Context, tripID int32, flight request.Flight) (entity.Transportation, error)
-		UpdateFlight(ctx context.Context, tripID int32, flightID int32) error
+		GetFlightByID(ctx context.Context, tripID int32, flightID int32) (entity.Transportation, error)
+		UpdateFlight(ctx context.Context, tripID int32, flightID int32, revision *int64) error
 	}
 
 	Trains interface {
@@ -75,24 +98,113 @@ type (
 
 	Activities interface {
 		GetActivities(ctx context.Context, tripID int32) ([]entity.Activity, error)
+		GetActivitiesSince(ctx context.Context, tripID int32, since int64) ([]entity.Activity, error)
 		GetActivityByID(ctx context.Context, tripID int32, activityID int32) (entity.Activity, error)
 		CreateActivity(ctx context.Context, tripID int32, activity request.Activity) (entity.Activity, error)
-		UpdateActivity(ctx context.Context, tripID int32, activityID int32, activity request.Activity) error
-		DeleteActivity(ctx context.Context, tripID int32, activityID int32) error
+		UpdateActivity(ctx context.Context, tripID int32, activityID int32, activity request.Activity, revision *int64) error
+		DeleteActivity(ctx context.Context, tripID int32, activityID int32, revision *int64) error
+		RestoreActivity(ctx context.Context, tripID int32, activityID int32, entryID int32) (entity.Activity, error)
 	}
 
 	Accommodation interface {
 		GetAllAccommodation(ctx context.Context, tripID int32) ([]entity.Accommodation, error)
+		GetAllAccommodationSince(ctx context.Context, tripID int32, since int64) ([]entity.Accommodation, error)
 		GetAccommodationByID(ctx context.Context, tripID int32, id int32) (entity.Accommodation, error)
 		CreateAccommodation(ctx context.Context, tripID int32, accommodation request.Accommodation) (entity.Accommodation, error)
-		UpdateAccommodation(ctx context.Context, tripID int32, accommodationID int32, accommodation request.Accommodation) error
-		DeleteAccommodation(ctx context.Context, tripID int32, accommodationID int32) error
+		UpdateAccommodation(ctx context.Context, tripID int32, accommodationID int32, accommodation request.Accommodation, revision *int64) error
+		DeleteAccommodation(ctx context.Context, tripID int32, accommodationID int32, revision *int64) error
+		RestoreAccommodation(ctx context.Context, tripID int32, accommodationID int32, entryID int32) (entity.Accommodation, error)
 	}
 
 	Attachments interface {
 		GetAttachments(ctx context.Context, tripID int32) ([]entity.Attachment, error)
+		GetAttachmentsSince(ctx context.Context, tripID int32, since int64) ([]entity.Attachment, error)
 		GetAttachmentByID(ctx context.Context, tripID int32, attachmentID int32) (entity.Attachment, error)
-		CreateAttachment(ctx context.Context, tripID int32, attachment *multipart.FileHeader) (entity.Attachment, error)
-		DeleteAttachment(ctx context.Context, tripID int32, attachmentID int32) error
+		OpenAttachment(ctx context.Context, tripID int32, attachmentID int32) (entity.Attachment, io.ReadSeekCloser, error)
+		GetAttachmentURL(ctx context.Context, tripID int32, attachmentID int32) (entity.AttachmentURL, error)
+		GetThumbnail(ctx context.Context, tripID int32, attachmentID int32, size entity.ThumbnailSize) (entity.Attachment, []byte, error)
+		CreateAttachment(ctx context.Context, tripID int32, userID int32, attachment *multipart.FileHeader) (entity.Attachment, error)
+		CopyAttachment(ctx context.Context, tripID int32, attachmentID int32, toTripID int32, userID int32) (entity.Attachment, error)
+		DeleteAttachment(ctx context.Context, tripID int32, attachmentID int32, revision *int64) error
+		LinkAttachment(ctx context.Context, tripID int32, attachmentID int32, target entity.AttachmentLinkTarget, targetID int32) error
+		UnlinkAttachment(ctx context.Context, tripID int32, attachmentID int32, target entity.AttachmentLinkTarget, targetID int32) error
+	}
+
+	Photos interface {
+		ImportPhotos(ctx context.Context, tripID int32, userID int32, photos []*multipart.FileHeader) (entity.PhotoImport, error)
+	}
+
+	Itinerary interface {
+		GetItinerary(ctx context.Context, tripID int32) ([]entity.ItineraryDay, error)
+		GetChecks(ctx context.Context, tripID int32) ([]entity.ItineraryCheck, error)
+	}
+
+	Expenses interface {
+		GetExpenses(ctx context.Context, tripID int32) ([]entity.Expense, error)
+		GetExpensesSince(ctx context.Context, tripID int32, since int64) ([]entity.Expense, error)
+		GetExpenseByID(ctx context.Context, tripID int32, expenseID int32) (entity.Expense, error)
+		CreateExpense(ctx context.Context, tripID int32, expense request.Expense) (entity.Expense, error)
+		UpdateExpense(ctx context.Context, tripID int32, expenseID int32, expense request.Expense, revision *int64) error
+		DeleteExpense(ctx context.Context, tripID int32, expenseID int32, revision *int64) error
+		GetSettlement(ctx context.Context, tripID int32) (entity.Settlement, error)
+	}
+
+	Budgets interface {
+		GetBudget(ctx context.Context, tripID int32) (entity.Budget, error)
+		SaveBudget(ctx context.Context, tripID int32, budget request.Budget) error
+		GetBudgetReport(ctx context.Context, tripID int32) (entity.BudgetReport, error)
+		CheckBudget(ctx context.Context, tripID int32, category entity.CostCategory) (*entity.BudgetAlert, error)
+	}
+
+	Costs interface {
+		GetCosts(ctx context.Context, tripID int32) (entity.Costs, error)
+		RefreshExchangeRates(ctx context.Context) error
+		Convert(ctx context.Context, amount float64, from string, to string, date civil.Date) (*float64, error)
+	}
+
+	Events interface {
+		Publish(ctx context.Context, tripID int32, change entity.ChangeType, kind entity.EntityKind, id int32)
+		Subscribe(tripID int32) (<-chan entity.TripEvent, func())
+		Listen(ctx context.Context)
+	}
+
+	Changes interface {
+		GetChanges(ctx context.Context, tripID int32, since int64) (entity.Changes, error)
+		ApplyChanges(ctx context.Context, tripID int32, changes []request.Change) ([]entity.ChangeResult, error)
+	}
+
+	History interface {
+		Snapshot(ctx context.Context, tripID int32, kind entity.EntityKind, id int32) json.RawMessage
+		Record(ctx context.Context, tripID int32, change entity.ChangeType, kind entity.EntityKind, id int32, before json.RawMessage)
+		GetHistory(ctx context.Context, tripID int32, filter request.HistoryFilter) ([]entity.HistoryEntry, error)
+		GetVersion(ctx context.Context, tripID int32, kind entity.EntityKind, id int32, entryID int32, version any) error
+	}
+
+	Trash interface {
+		GetTrash(ctx context.Context, userID int32) ([]entity.TrashItem, error)
+		GetTripTrash(ctx context.Context, userID int32, tripID int32) ([]entity.TrashItem, error)
+		RestoreTrip(ctx context.Context, userID int32, tripID int32) error
+		Restore(ctx context.Context, tripID int32, kind entity.EntityKind, id int32) error
+		PurgeRegularly(ctx context.Context)
+	}
+
+	Templates interface {
+		GetTemplates(ctx context.Context) ([]entity.Trip, error)
+		CopyTrip(ctx context.Context, userID int32, tripID int32, tripCopy request.TripCopy) (entity.Trip, error)
+	}
+
+	Emissions interface {
+		Estimate(ctx context.Context, tripID int32, allTransportation []entity.Transportation) error
+		GetTripEmissions(ctx context.Context, tripID int32) (entity.TripEmissions, error)
+	}
+
+	Stats interface {
+		GetStats(ctx context.Context, userID int32, year *int32) (entity.TravelStats, error)
+	}
+
+	Export interface {
+		ExportGpx(ctx context.Context, tripID int32) ([]byte, error)
+		ExportKml(ctx context.Context, tripID int32) ([]byte, error)
+		ExportPdf(ctx context.Context, userID int32, tripID int32) ([]byte, error)
 	}
 )
@@ -0,0 +1,268 @@
+package costs
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+const (
+	// rates are published once per working day, so they are retrieved at most twice a day
+	refreshInterval = 12 * time.Hour
+	// a failed retrieval is not repeated for every request while the provider is unavailable
+	retryInterval = 15 * time.Minute
+)
+
+type UseCase struct {
+	trips          repo.TripsRepo
+	transportation repo.TransportationRepo
+	activities     repo.ActivitiesRepo
+	accommodation  repo.AccommodationRepo
+	expenses       repo.ExpensesRepo
+	exchangeRates  repo.ExchangeRatesRepo
+	provider       repo.ExchangeRateProvider
+
+	mutex       sync.Mutex
+	nextRefresh time.Time
+}
+
+func New(trips repo.TripsRepo, transportation repo.TransportationRepo, activities repo.ActivitiesRepo, accommodation repo.AccommodationRepo, expenses repo.ExpensesRepo, exchangeRates repo.ExchangeRatesRepo, provider repo.ExchangeRateProvider) *UseCase {
+	return &UseCase{
+		trips:          trips,
+		transportation: transportation,
+		activities:     activities,
+		accommodation:  accommodation,
+		expenses:       expenses,
+		exchangeRates:  exchangeRates,
+		provider:       provider,
+	}
+}
+
+// price is a single price of the trip, which is converted at the rate of date and spread evenly across days.
+type price struct {
+	category entity.CostCategory
+	amount   float64
+	currency string
+	date     civil.Date
+	days     []civil.Date
+}
+
+func (uc *UseCase) GetCosts(ctx context.Context, tripID int32) (entity.Costs, error) {
+	trip, err := uc.trips.GetTripByID(ctx, tripID)
+	if err != nil {
+		return entity.Costs{}, fmt.Errorf("get trip [id=%d]: %w", tripID, err)
+	}
+
+	if err := uc.RefreshExchangeRates(ctx); err != nil {
+		return entity.Costs{}, err
+	}
+
+	prices, err := uc.collectPrices(ctx, tripID)
+	if err != nil {
+		return entity.Costs{}, err
+	}
+
+	categories := map[entity.CostCategory]float64{}
+	days := map[civil.Date]float64{}
+	currencies := map[string]*entity.CurrencyCosts{}
+	total := 0.0
+
+	for _, p := range prices {
+		currency, ok := currencies[p.currency]
+		if !ok {
+			currency = &entity.CurrencyCosts{Currency: p.currency, Total: new(float64)}
+			currencies[p.currency] = currency
+		}
+		currency.Amount += p.amount
+
+		converted, err := uc.Convert(ctx, p.amount, p.currency, trip.HomeCurrency, p.date)
+		if err != nil {
+			return entity.Costs{}, err
+		}
+		if converted == nil {
+			currency.Total = nil
+			continue
+		}
+
+		if currency.Total != nil {
+			*currency.Total += *converted
+		}
+		categories[p.category] += *converted
+		total += *converted
+		for _, day := range p.days {
+			days[day] += *converted / float64(len(p.days))
+		}
+	}
+
+	return buildCosts(trip.HomeCurrency, total, categories, days, currencies), nil
+}
+
+func (uc *UseCase) collectPrices(ctx context.Context, tripID int32) ([]price, error) {
+	allTransportation, err := uc.transportation.GetAllTransportation(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get transportation: %w", err)
+	}
+
+	activities, err := uc.activities.GetActivities(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get activities: %w", err)
+	}
+
+	accommodation, err := uc.accommodation.GetAllAccommodation(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get accommodation: %w", err)
+	}
+
+	expenses, err := uc.expenses.GetExpenses(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get expenses: %w", err)
+	}
+
+	var prices []price
+	for _, transportation := range allTransportation {
+		if transportation.Price == nil {
+			continue
+		}
+		date := transportation.DepartureDateTime.Date
+		prices = append(prices, price{entity.TRANSPORTATION_COSTS, float64(*transportation.Price), transportation.Currency, date, []civil.Date{date}})
+	}
+	for _, activity := range activities {
+		if activity.Price == nil {
+			continue
+		}
+		prices = append(prices, price{entity.ACTIVITY_COSTS, float64(*activity.Price), activity.Currency, activity.Date, []civil.Date{activity.Date}})
+	}
+	for _, a := range accommodation {
+		if a.Price == nil {
+			continue
+		}
+		prices = append(prices, price{entity.ACCOMMODATION_COSTS, float64(*a.Price), a.Currency, a.ArrivalDate, nights(a)})
+	}
+	for _, expense := range expenses {
+		// the price of the linked booking is already counted
+		if expense.TransportationID != nil || expense.ActivityID != nil || expense.AccommodationID != nil {
+			continue
+		}
+		prices = append(prices, price{expense.Category, expense.Amount, expense.Currency, expense.Date, []civil.Date{expense.Date}})
+	}
+
+	return prices, nil
+}
+
+// nights returns the dates of all nights of a stay. A stay without nights is attributed to its arrival date.
+func nights(accommodation entity.Accommodation) []civil.Date {
+	dates := []civil.Date{accommodation.ArrivalDate}
+	for date := accommodation.ArrivalDate.AddDays(1); date.Before(accommodation.DepartureDate); date = date.AddDays(1) {
+		dates = append(dates, date)
+	}
+	return dates
+}
+
+// Convert returns the amount in the target currency, or nil if no exchange rate is known for either currency.
+// All rates are relative to the euro, so other currencies are converted via euro. Only stored rates are used, callers
+// refresh them once with RefreshExchangeRates before converting.
+func (uc *UseCase) Convert(ctx context.Context, amount float64, from string, to string, date civil.Date) (*float64, error) {
+	if from == to {
+		return &amount, nil
+	}
+
+	fromRate, err := uc.rate(ctx, from, date)
+	if err != nil || fromRate == nil {
+		return nil, err
+	}
+	toRate, err := uc.rate(ctx, to, date)
+	if err != nil || toRate == nil {
+		return nil, err
+	}
+
+	converted := amount / *fromRate * *toRate
+	return &converted, nil
+}
+
+func (uc *UseCase) rate(ctx context.Context, currency string, date civil.Date) (*float64, error) {
+	if currency == "EUR" {
+		rate := 1.0
+		return &rate, nil
+	}
+
+	exchangeRate, err := uc.exchangeRates.GetExchangeRate(ctx, currency, date)
+	if err != nil || exchangeRate == nil {
+		return nil, err
+	}
+	return &exchangeRate.Rate, nil
+}
+
+// RefreshExchangeRates stores the latest rates of the provider. If the provider is unavailable, the stored rates
+// are used as long as there are any, and the retrieval is retried after the retry interval.
+func (uc *UseCase) RefreshExchangeRates(ctx context.Context) error {
+	uc.mutex.Lock()
+	defer uc.mutex.Unlock()
+
+	if time.Now().Before(uc.nextRefresh) {
+		return nil
+	}
+
+	rates, err := uc.provider.RetrieveExchangeRates(ctx)
+	if err != nil {
+		uc.nextRefresh = time.Now().Add(retryInterval)
+		latest, latestErr := uc.exchangeRates.GetLatestExchangeRateDate(ctx)
+		if latestErr != nil {
+			return latestErr
+		}
+		if latest == nil {
+			return fmt.Errorf("retrieve exchange rates: %w", err)
+		}
+		return nil
+	}
+
+	if err := uc.exchangeRates.SaveExchangeRates(ctx, rates); err != nil {
+		return fmt.Errorf("save exchange rates: %w", err)
+	}
+	uc.nextRefresh = time.Now().Add(refreshInterval)
+	return nil
+}
+
+func buildCosts(homeCurrency string, total float64, categories map[entity.CostCategory]float64, days map[civil.Date]float64, currencies map[string]*entity.CurrencyCosts) entity.Costs {
+	costs := entity.Costs{
+		HomeCurrency: homeCurrency,
+		Total:        round(total),
+		Categories:   []entity.CategoryCosts{},
+		Days:         []entity.DayCosts{},
+		Currencies:   []entity.CurrencyCosts{},
+	}
+
+	for _, category := range entity.CostCategories {
+		costs.Categories = append(costs.Categories, entity.CategoryCosts{Category: category, Total: round(categories[category])})
+	}
+
+	for date, dayTotal := range days {
+		costs.Days = append(costs.Days, entity.DayCosts{Date: date, Total: round(dayTotal)})
+	}
+	sort.Slice(costs.Days, func(i, j int) bool {
+		return costs.Days[i].Date.Before(costs.Days[j].Date)
+	})
+
+	for _, currency := range currencies {
+		currency.Amount = round(currency.Amount)
+		if currency.Total != nil {
+			*currency.Total = round(*currency.Total)
+		}
+		costs.Currencies = append(costs.Currencies, *currency)
+	}
+	sort.Slice(costs.Currencies, func(i, j int) bool {
+		return costs.Currencies[i].Currency < costs.Currencies[j].Currency
+	})
+
+	return costs
+}
+
+func round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ParseCurrency normalizes an ISO 4217 currency code. An empty string is returned if no currency is given.
+func ParseCurrency(currency *string) (string, error) {
+	if currency == nil {
+		return "", nil
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(*currency))
+	if !currencyPattern.MatchString(code) {
+		return "", fiber.NewError(http.StatusBadRequest, "invalid currency, expected ISO 4217 code")
+	}
+	return code, nil
+}
+
+// minorUnits lists the currencies of ISO 4217 whose minor unit is not a hundredth.
+var minorUnits = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0,
+	"UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// MinorUnits returns the number of decimals of the minor unit of currency according to ISO 4217, e.g. 0 for JPY.
+func MinorUnits(currency string) int {
+	if units, ok := minorUnits[currency]; ok {
+		return units
+	}
+	return 2
+}
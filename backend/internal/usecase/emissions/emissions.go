@@ -0,0 +1,184 @@
+package emissions
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"sort"
+	"strings"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+	"github.com/paulmach/orb/geojson"
+)
+
+type UseCase struct {
+	transportation repo.TransportationRepo
+	factors        repo.EmissionFactorTable
+	boundaries     repo.BoundaryLookup
+}
+
+func New(transportation repo.TransportationRepo, factors repo.EmissionFactorTable, boundaries repo.BoundaryLookup) *UseCase {
+	return &UseCase{
+		transportation: transportation,
+		factors:        factors,
+		boundaries:     boundaries,
+	}
+}
+
+// Estimate sets the estimated emissions of the given transportation of the trip in place. Only the routes of generic
+// transportation are loaded, flights and train journeys are estimated by their legs.
+func (uc *UseCase) Estimate(ctx context.Context, tripID int32, allTransportation []entity.Transportation) error {
+	factors, err := uc.factors.Factors()
+	if err != nil {
+		return fmt.Errorf("get emission factors: %w", err)
+	}
+
+	routeIDs := []int32{}
+	for _, transportation := range allTransportation {
+		if transportation.FlightDetail == nil && transportation.TrainDetail == nil {
+			routeIDs = append(routeIDs, transportation.ID)
+		}
+	}
+	routes := map[int32]geojson.FeatureCollection{}
+	if len(routeIDs) > 0 {
+		routes, err = uc.transportation.GetRouteGeoJson(ctx, tripID, routeIDs)
+		if err != nil {
+			return fmt.Errorf("get routes: %w", err)
+		}
+	}
+
+	for i, transportation := range allTransportation {
+		switch {
+		case transportation.FlightDetail != nil:
+			allTransportation[i].Co2e = flightEmissions(factors, *transportation.FlightDetail)
+		case transportation.TrainDetail != nil:
+			allTransportation[i].Co2e = uc.trainEmissions(factors, *transportation.TrainDetail)
+		default:
+			allTransportation[i].Co2e = genericEmissions(factors, transportation, routes[transportation.ID])
+		}
+	}
+	return nil
+}
+
+// GetTripEmissions sums up the estimated emissions of all transportation of the trip.
+func (uc *UseCase) GetTripEmissions(ctx context.Context, tripID int32) (entity.TripEmissions, error) {
+	allTransportation, err := uc.transportation.GetAllTransportation(ctx, tripID)
+	if err != nil {
+		return entity.TripEmissions{}, fmt.Errorf("get transportation: %w", err)
+	}
+	if err := uc.Estimate(ctx, tripID, allTransportation); err != nil {
+		return entity.TripEmissions{}, err
+	}
+
+	result := entity.TripEmissions{Types: []entity.TransportationEmissions{}}
+	byType := map[entity.TransportationType]float64{}
+	for _, transportation := range allTransportation {
+		if transportation.Co2e == nil {
+			result.Unestimated++
+			continue
+		}
+		result.Total += *transportation.Co2e
+		byType[transportation.Type] += *transportation.Co2e
+	}
+
+	for transportationType, co2e := range byType {
+		result.Types = append(result.Types, entity.TransportationEmissions{Type: transportationType, Co2e: co2e})
+	}
+	sort.Slice(result.Types, func(i, j int) bool {
+		return result.Types[i].Co2e > result.Types[j].Co2e
+	})
+	return result, nil
+}
+
+// flightEmissions chooses the distance band by the great-circle distance of each leg, before the uplift is applied.
+func flightEmissions(factors entity.EmissionFactors, detail entity.FlightDetail) *float64 {
+	flight := factors.Flight
+	co2e := 0.0
+	for _, leg := range detail.Legs {
+		kilometers := leg.Origin.Location.Distance(leg.Destination.Location) / 1000
+		factor := flight.Bands[len(flight.Bands)-1].Factor
+		for _, band := range flight.Bands {
+			if band.MaxDistance == nil || kilometers <= *band.MaxDistance {
+				factor = band.Factor
+				break
+			}
+		}
+
+		cabinClass := entity.ECONOMY
+		if leg.CabinClass != nil {
+			cabinClass = *leg.CabinClass
+		}
+		if multiplier, ok := flight.CabinClasses[cabinClass]; ok {
+			factor *= multiplier
+		}
+		if leg.Aircraft != nil {
+			factor *= aircraftMultiplier(flight.Aircraft, *leg.Aircraft)
+		}
+
+		co2e += kilometers * flight.DistanceUplift * factor
+	}
+	return &co2e
+}
+
+// aircraftMultiplier uses the longest key contained in the aircraft name, so "A320neo" wins over "A320".
+func aircraftMultiplier(multipliers map[string]float64, aircraft string) float64 {
+	result, matched := 1.0, ""
+	for key, multiplier := range multipliers {
+		if len(key) > len(matched) && strings.Contains(strings.ToLower(aircraft), strings.ToLower(key)) {
+			result, matched = multiplier, key
+		}
+	}
+	return result
+}
+
+func (uc *UseCase) trainEmissions(factors entity.EmissionFactors, detail entity.TrainDetail) *float64 {
+	train := factors.Train
+	co2e := 0.0
+	for _, leg := range detail.Legs {
+		kilometers := leg.Origin.Location.Distance(leg.Destination.Location) / 1000 * train.DetourFactor
+		co2e += kilometers * uc.trainFactor(train, leg)
+	}
+	return &co2e
+}
+
+func (uc *UseCase) trainFactor(train entity.TrainEmissionFactors, leg entity.TrainLeg) float64 {
+	if factor, ok := train.Operators[leg.OperatorName]; ok {
+		return factor
+	}
+	// unknown countries fall back to the default factor, so a failed lookup is not an error
+	country, err := uc.boundaries.CountryAt(leg.Origin.Location.Latitude, leg.Origin.Location.Longitude)
+	if err == nil {
+		if factor, ok := train.Countries[country]; ok {
+			return factor
+		}
+	}
+	return train.Default
+}
+
+// genericEmissions measures the lines of the stored route. Without route, the great-circle distance is used.
+func genericEmissions(factors entity.EmissionFactors, transportation entity.Transportation, route geojson.FeatureCollection) *float64 {
+	factor, ok := factors.Types[transportation.Type]
+	if !ok {
+		return nil
+	}
+
+	kilometers := routeLength(route)
+	if kilometers == 0 {
+		kilometers = transportation.Origin.Distance(transportation.Destination) / 1000 * factors.DetourFactor
+	}
+	co2e := kilometers * factor
+	return &co2e
+}
+
+func routeLength(route geojson.FeatureCollection) float64 {
+	meters := 0.0
+	for _, feature := range route.Features {
+		switch feature.Geometry.(type) {
+		case orb.LineString, orb.MultiLineString:
+			meters += geo.Length(feature.Geometry)
+		}
+	}
+	return meters / 1000
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// IsNotFound reports whether err is caused by a missing entity.
+func IsNotFound(err error) bool {
+	var fiberErr *fiber.Error
+	return errors.As(err, &fiberErr) && fiberErr.Code == http.StatusNotFound
+}
+
+// IsPreconditionFailed reports whether err is caused by an entity modified since the revision a write was based on.
+func IsPreconditionFailed(err error) bool {
+	var fiberErr *fiber.Error
+	return errors.As(err, &fiberErr) && fiberErr.Code == http.StatusPreconditionFailed
+}
@@ -0,0 +1,112 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/pkg/logger"
+	"sync"
+	"time"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind before events are dropped for it.
+const subscriberBuffer = 16
+
+const reconnectDelay = 5 * time.Second
+
+type UseCase struct {
+	repo repo.EventsRepo
+	log  logger.Interface
+
+	mu          sync.Mutex
+	subscribers map[int32]map[chan entity.TripEvent]struct{}
+}
+
+func New(r repo.EventsRepo, log logger.Interface) *UseCase {
+	return &UseCase{
+		repo:        r,
+		log:         log,
+		subscribers: map[int32]map[chan entity.TripEvent]struct{}{},
+	}
+}
+
+// Publish notifies all instances of a change after it was written. The change already succeeded, so failures are only
+// logged and collaborators see it on their next reload.
+func (uc *UseCase) Publish(ctx context.Context, tripID int32, change entity.ChangeType, kind entity.EntityKind, id int32) {
+	event := entity.TripEvent{TripID: tripID, Type: change, Kind: kind, ID: id}
+	if err := uc.repo.Publish(ctx, event); err != nil {
+		uc.log.Error(fmt.Errorf("events - Publish: %w", err))
+	}
+}
+
+// Subscribe returns the events of a trip until unsubscribe is called. The channel is closed when the events of this
+// instance stop, e.g. on shutdown.
+func (uc *UseCase) Subscribe(tripID int32) (<-chan entity.TripEvent, func()) {
+	events := make(chan entity.TripEvent, subscriberBuffer)
+
+	uc.mu.Lock()
+	if uc.subscribers[tripID] == nil {
+		uc.subscribers[tripID] = map[chan entity.TripEvent]struct{}{}
+	}
+	uc.subscribers[tripID][events] = struct{}{}
+	uc.mu.Unlock()
+
+	unsubscribe := func() {
+		uc.mu.Lock()
+		defer uc.mu.Unlock()
+		if _, ok := uc.subscribers[tripID][events]; ok {
+			delete(uc.subscribers[tripID], events)
+			if len(uc.subscribers[tripID]) == 0 {
+				delete(uc.subscribers, tripID)
+			}
+			close(events)
+		}
+	}
+	return events, unsubscribe
+}
+
+// Listen receives the events published by all instances and fans them out to the subscribers of this instance. It
+// reconnects after failures and returns once the context is done.
+func (uc *UseCase) Listen(ctx context.Context) {
+	defer uc.closeAll()
+
+	for {
+		err := uc.repo.Listen(ctx, uc.dispatch)
+		if ctx.Err() != nil {
+			return
+		}
+		uc.log.Error(fmt.Errorf("events - Listen: %w", err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+func (uc *UseCase) dispatch(event entity.TripEvent) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	for events := range uc.subscribers[event.TripID] {
+		select {
+		case events <- event:
+		default:
+			uc.log.Warn("events - dispatch: dropped event for slow subscriber of trip %d", event.TripID)
+		}
+	}
+}
+
+func (uc *UseCase) closeAll() {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	for tripID, subscribers := range uc.subscribers {
+		for events := range subscribers {
+			close(events)
+		}
+		delete(uc.subscribers, tripID)
+	}
+}
@@ -0,0 +1,207 @@
+package expenses
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/usecase"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type UseCase struct {
+	repo    repo.ExpensesRepo
+	users   repo.UserRepo
+	trips   usecase.Trips
+	costs   usecase.Costs
+	events  usecase.Events
+	history usecase.History
+}
+
+func New(r repo.ExpensesRepo, users repo.UserRepo, trips usecase.Trips, costs usecase.Costs, events usecase.Events, history usecase.History) *UseCase {
+	return &UseCase{
+		repo:    r,
+		users:   users,
+		trips:   trips,
+		costs:   costs,
+		events:  events,
+		history: history,
+	}
+}
+
+func (uc *UseCase) GetExpenses(ctx context.Context, tripID int32) ([]entity.Expense, error) {
+	return uc.GetExpensesSince(ctx, tripID, 0)
+}
+
+func (uc *UseCase) GetExpensesSince(ctx context.Context, tripID int32, since int64) ([]entity.Expense, error) {
+	expenses, err := uc.repo.GetExpensesSince(ctx, tripID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range expenses {
+		splitExpense(&expenses[i])
+	}
+	return expenses, nil
+}
+
+func (uc *UseCase) GetExpenseByID(ctx context.Context, tripID int32, expenseID int32) (entity.Expense, error) {
+	expense, err := uc.repo.GetExpenseByID(ctx, tripID, expenseID)
+	if err != nil {
+		return entity.Expense{}, err
+	}
+
+	splitExpense(&expense)
+	return expense, nil
+}
+
+func (uc *UseCase) CreateExpense(ctx context.Context, tripID int32, expense request.Expense) (entity.Expense, error) {
+	validated, err := uc.validateExpense(ctx, tripID, expense)
+	if err != nil {
+		return entity.Expense{}, err
+	}
+
+	created, err := uc.repo.CreateExpense(ctx, validated)
+	if err != nil {
+		return entity.Expense{}, err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.CREATED, entity.EXPENSE_KIND, created.ID)
+	uc.history.Record(ctx, tripID, entity.CREATED, entity.EXPENSE_KIND, created.ID, nil)
+	splitExpense(&created)
+	return created, nil
+}
+
+func (uc *UseCase) UpdateExpense(ctx context.Context, tripID int32, expenseID int32, expense request.Expense, revision *int64) error {
+	validated, err := uc.validateExpense(ctx, tripID, expense)
+	if err != nil {
+		return err
+	}
+
+	validated.ID = expenseID
+	before := uc.history.Snapshot(ctx, tripID, entity.EXPENSE_KIND, expenseID)
+	if err := uc.repo.UpdateExpense(ctx, validated, revision); err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.UPDATED, entity.EXPENSE_KIND, expenseID)
+	uc.history.Record(ctx, tripID, entity.UPDATED, entity.EXPENSE_KIND, expenseID, before)
+	return nil
+}
+
+func (uc *UseCase) DeleteExpense(ctx context.Context, tripID int32, expenseID int32, revision *int64) error {
+	before := uc.history.Snapshot(ctx, tripID, entity.EXPENSE_KIND, expenseID)
+	if err := uc.repo.DeleteExpense(ctx, tripID, expenseID, revision); err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.DELETED, entity.EXPENSE_KIND, expenseID)
+	uc.history.Record(ctx, tripID, entity.DELETED, entity.EXPENSE_KIND, expenseID, before)
+	return nil
+}
+
+func (uc *UseCase) validateExpense(ctx context.Context, tripID int32, expense request.Expense) (entity.Expense, error) {
+	if expense.Amount <= 0 {
+		return entity.Expense{}, fiber.NewError(http.StatusBadRequest, "amount must be positive")
+	}
+	if !expense.SplitMode.IsValid() {
+		return entity.Expense{}, fiber.NewError(http.StatusBadRequest, "invalid split mode")
+	}
+	category := entity.OTHER_COSTS
+	if expense.Category != nil {
+		category = *expense.Category
+	}
+	if !category.IsValid() {
+		return entity.Expense{}, fiber.NewError(http.StatusBadRequest, "invalid category")
+	}
+	if len(expense.Participants) == 0 {
+		return entity.Expense{}, fiber.NewError(http.StatusBadRequest, "expense needs at least one participant")
+	}
+
+	currency, err := usecase.ParseCurrency(expense.Currency)
+	if err != nil {
+		return entity.Expense{}, err
+	}
+	if currency == "" {
+		trip, err := uc.trips.GetTripByID(ctx, tripID)
+		if err != nil {
+			return entity.Expense{}, fmt.Errorf("get trip [id=%d]: %w", tripID, err)
+		}
+		currency = trip.HomeCurrency
+	}
+
+	members, err := uc.users.GetTripMembers(ctx, tripID)
+	if err != nil {
+		return entity.Expense{}, err
+	}
+	isMember := map[int32]bool{}
+	for _, member := range members {
+		isMember[member.ID] = true
+	}
+	if !isMember[expense.PayerID] {
+		return entity.Expense{}, fiber.NewError(http.StatusBadRequest, fmt.Sprintf("payer [id=%d] is not a member of the trip", expense.PayerID))
+	}
+
+	participants, err := validateParticipants(expense, currency, isMember)
+	if err != nil {
+		return entity.Expense{}, err
+	}
+
+	return entity.Expense{
+		TripID:           tripID,
+		Name:             expense.Name,
+		Date:             expense.Date,
+		Amount:           expense.Amount,
+		Currency:         currency,
+		PayerID:          expense.PayerID,
+		SplitMode:        expense.SplitMode,
+		Category:         category,
+		Participants:     participants,
+		TransportationID: expense.TransportationID,
+		ActivityID:       expense.ActivityID,
+		AccommodationID:  expense.AccommodationID,
+		AttachmentID:     expense.AttachmentID,
+	}, nil
+}
+
+func validateParticipants(expense request.Expense, currency string, isMember map[int32]bool) ([]entity.ExpenseParticipant, error) {
+	participants := []entity.ExpenseParticipant{}
+	seen := map[int32]bool{}
+	exactTotal := int64(0)
+
+	for _, participant := range expense.Participants {
+		if !isMember[participant.UserID] {
+			return nil, fiber.NewError(http.StatusBadRequest, fmt.Sprintf("participant [id=%d] is not a member of the trip", participant.UserID))
+		}
+		if seen[participant.UserID] {
+			return nil, fiber.NewError(http.StatusBadRequest, fmt.Sprintf("participant [id=%d] is listed more than once", participant.UserID))
+		}
+		seen[participant.UserID] = true
+
+		share := participant.Share
+		switch expense.SplitMode {
+		case entity.EQUAL_SPLIT:
+			share = nil
+		case entity.SHARES_SPLIT:
+			if share == nil || *share <= 0 {
+				return nil, fiber.NewError(http.StatusBadRequest, "shares must be positive")
+			}
+		case entity.EXACT_SPLIT:
+			if share == nil || *share < 0 {
+				return nil, fiber.NewError(http.StatusBadRequest, "exact amounts must not be negative")
+			}
+			exactTotal += toMinorUnits(*share, currency)
+		}
+
+		participants = append(participants, entity.ExpenseParticipant{UserID: participant.UserID, Share: share})
+	}
+
+	if expense.SplitMode == entity.EXACT_SPLIT && exactTotal != toMinorUnits(expense.Amount, currency) {
+		return nil, fiber.NewError(http.StatusBadRequest, "exact amounts do not add up to the amount of the expense")
+	}
+
+	return participants, nil
+}
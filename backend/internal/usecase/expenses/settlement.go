@@ -0,0 +1,231 @@
+package expenses
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/entity"
+	"net/http"
+	"sort"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetSettlement converts all expenses to the home currency of the trip at the rate of their date and computes
+// the transfers that settle all balances.
+func (uc *UseCase) GetSettlement(ctx context.Context, tripID int32) (entity.Settlement, error) {
+	trip, err := uc.trips.GetTripByID(ctx, tripID)
+	if err != nil {
+		return entity.Settlement{}, fmt.Errorf("get trip [id=%d]: %w", tripID, err)
+	}
+
+	members, err := uc.users.GetTripMembers(ctx, tripID)
+	if err != nil {
+		return entity.Settlement{}, err
+	}
+
+	expenses, err := uc.repo.GetExpenses(ctx, tripID)
+	if err != nil {
+		return entity.Settlement{}, err
+	}
+
+	if err := uc.costs.RefreshExchangeRates(ctx); err != nil {
+		return entity.Settlement{}, err
+	}
+
+	paid := map[int32]int64{}
+	owed := map[int32]int64{}
+	for _, member := range members {
+		paid[member.ID] = 0
+		owed[member.ID] = 0
+	}
+
+	for _, expense := range expenses {
+		converted, err := uc.costs.Convert(ctx, expense.Amount, expense.Currency, trip.HomeCurrency, expense.Date)
+		if err != nil {
+			return entity.Settlement{}, fmt.Errorf("convert expense [id=%d]: %w", expense.ID, err)
+		}
+		if converted == nil {
+			return entity.Settlement{}, fiber.NewError(http.StatusConflict, fmt.Sprintf("no exchange rate known for %s", expense.Currency))
+		}
+
+		// the converted amount is split again, so that rounding never leaves a minor unit unaccounted for
+		units := toMinorUnits(*converted, trip.HomeCurrency)
+		paid[expense.PayerID] += units
+		for i, amount := range splitMinorUnits(units, shareWeights(expense)) {
+			owed[expense.Participants[i].UserID] += amount
+		}
+	}
+
+	return buildSettlement(trip.HomeCurrency, paid, owed), nil
+}
+
+func buildSettlement(homeCurrency string, paid map[int32]int64, owed map[int32]int64) entity.Settlement {
+	settlement := entity.Settlement{
+		HomeCurrency: homeCurrency,
+		Balances:     []entity.MemberBalance{},
+		Transfers:    []entity.SettlementTransfer{},
+	}
+
+	balances := map[int32]int64{}
+	for userID := range paid {
+		balances[userID] = paid[userID] - owed[userID]
+	}
+	for userID := range owed {
+		balances[userID] = paid[userID] - owed[userID]
+	}
+
+	for userID, balance := range balances {
+		settlement.Balances = append(settlement.Balances, entity.MemberBalance{
+			UserID:  userID,
+			Paid:    fromMinorUnits(paid[userID], homeCurrency),
+			Owed:    fromMinorUnits(owed[userID], homeCurrency),
+			Balance: fromMinorUnits(balance, homeCurrency),
+		})
+	}
+	sort.Slice(settlement.Balances, func(i, j int) bool {
+		return settlement.Balances[i].UserID < settlement.Balances[j].UserID
+	})
+
+	for _, transfer := range settle(balances) {
+		settlement.Transfers = append(settlement.Transfers, entity.SettlementTransfer{
+			FromUserID: transfer.from,
+			ToUserID:   transfer.to,
+			Amount:     fromMinorUnits(transfer.units, homeCurrency),
+		})
+	}
+	return settlement
+}
+
+// maxExactMembers limits the exhaustive search for the fewest transfers, which is exponential in the number of
+// members with an open balance.
+const maxExactMembers = 16
+
+type balance struct {
+	userID int32
+	units  int64
+}
+
+type transfer struct {
+	from  int32
+	to    int32
+	units int64
+}
+
+// settle returns the fewest transfers that settle all balances. Members whose balances add up to zero can settle
+// among themselves with one transfer less than there are members, so the fewest transfers are needed when the
+// members are split into as many groups with zero sum as possible. Each group is then settled by largest debtor
+// and largest creditor. With too many open balances, all members form a single group.
+func settle(balances map[int32]int64) []transfer {
+	var open []balance
+	for userID, units := range balances {
+		if units != 0 {
+			open = append(open, balance{userID, units})
+		}
+	}
+	sort.Slice(open, func(i, j int) bool {
+		return open[i].userID < open[j].userID
+	})
+
+	groups := [][]balance{open}
+	if len(open) <= maxExactMembers {
+		groups = zeroSumGroups(open)
+	}
+
+	transfers := []transfer{}
+	for _, group := range groups {
+		transfers = append(transfers, settleGroup(group)...)
+	}
+	return transfers
+}
+
+// zeroSumGroups partitions balances, which add up to zero, into the largest number of groups adding up to zero.
+// groups[mask] is the largest number of zero sum groups the members of the bit mask can be partitioned into, if
+// only the last group may have a sum other than zero.
+func zeroSumGroups(balances []balance) [][]balance {
+	n := len(balances)
+	sums := make([]int64, 1<<n)
+	groups := make([]int, 1<<n)
+	for mask := 1; mask < 1<<n; mask++ {
+		for i := range n {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			previous := mask &^ (1 << i)
+			sums[mask] = sums[previous] + balances[i].units
+			groups[mask] = max(groups[mask], groups[previous])
+		}
+		if sums[mask] == 0 {
+			groups[mask]++
+		}
+	}
+
+	// members are removed one by one while the number of groups can still be reached, a new group starts whenever
+	// the remaining members add up to zero
+	var result [][]balance
+	var group []balance
+	for mask := 1<<n - 1; mask != 0; {
+		if sums[mask] == 0 && len(group) > 0 {
+			result = append(result, group)
+			group = nil
+		}
+		target := groups[mask]
+		if sums[mask] == 0 {
+			target--
+		}
+		for i := range n {
+			previous := mask &^ (1 << i)
+			if mask&(1<<i) != 0 && groups[previous] == target {
+				group = append(group, balances[i])
+				mask = previous
+				break
+			}
+		}
+	}
+	if len(group) > 0 {
+		result = append(result, group)
+	}
+	return result
+}
+
+// settleGroup repeatedly lets the largest debtor pay the largest creditor. Each transfer settles at least one
+// member and the last one settles two, so n members with zero sum need at most n-1 transfers.
+func settleGroup(group []balance) []transfer {
+	var creditors, debtors []balance
+	for _, b := range group {
+		if b.units > 0 {
+			creditors = append(creditors, b)
+		} else if b.units < 0 {
+			debtors = append(debtors, balance{b.userID, -b.units})
+		}
+	}
+
+	transfers := []transfer{}
+	for len(creditors) > 0 && len(debtors) > 0 {
+		sortByAmount(creditors)
+		sortByAmount(debtors)
+		creditor, debtor := &creditors[0], &debtors[0]
+
+		amount := min(creditor.units, debtor.units)
+		transfers = append(transfers, transfer{from: debtor.userID, to: creditor.userID, units: amount})
+
+		creditor.units -= amount
+		debtor.units -= amount
+		if creditor.units == 0 {
+			creditors = creditors[1:]
+		}
+		if debtor.units == 0 {
+			debtors = debtors[1:]
+		}
+	}
+
+	return transfers
+}
+
+func sortByAmount(balances []balance) {
+	sort.Slice(balances, func(i, j int) bool {
+		if balances[i].units != balances[j].units {
+			return balances[i].units > balances[j].units
+		}
+		return balances[i].userID < balances[j].userID
+	})
+}
@@ -0,0 +1,85 @@
+package expenses
+
+import (
+	"kompass/internal/entity"
+	"kompass/internal/usecase"
+	"math"
+	"sort"
+)
+
+// splitExpense sets the amount every participant owes, in the currency of the expense.
+func splitExpense(expense *entity.Expense) {
+	amounts := splitAmount(expense.Amount, *expense)
+	for i := range expense.Participants {
+		expense.Participants[i].Amount = amounts[i]
+	}
+}
+
+// splitAmount divides amount between the participants of the expense according to its split mode. The amount is
+// split in the minor unit of its currency, so the parts always add up to amount.
+func splitAmount(amount float64, expense entity.Expense) []float64 {
+	parts := splitMinorUnits(toMinorUnits(amount, expense.Currency), shareWeights(expense))
+	result := make([]float64, len(parts))
+	for i, part := range parts {
+		result[i] = fromMinorUnits(part, expense.Currency)
+	}
+	return result
+}
+
+// shareWeights returns the weight of every participant. For EXACT splits, the requested amounts are used as
+// weights, which reproduces them exactly in the currency of the expense.
+func shareWeights(expense entity.Expense) []float64 {
+	weights := make([]float64, len(expense.Participants))
+	for i, participant := range expense.Participants {
+		weights[i] = 1
+		if expense.SplitMode != entity.EQUAL_SPLIT && participant.Share != nil {
+			weights[i] = *participant.Share
+		}
+	}
+	return weights
+}
+
+// splitMinorUnits divides total proportionally to weights. Units lost to rounding down are handed out by largest
+// remainder, ties going to the earlier participant.
+func splitMinorUnits(total int64, weights []float64) []int64 {
+	parts := make([]int64, len(weights))
+	weightSum := 0.0
+	for _, weight := range weights {
+		weightSum += weight
+	}
+	if weightSum == 0 {
+		return parts
+	}
+
+	remainders := make([]float64, len(weights))
+	distributed := int64(0)
+	for i, weight := range weights {
+		exact := float64(total) * weight / weightSum
+		parts[i] = int64(math.Floor(exact))
+		remainders[i] = exact - float64(parts[i])
+		distributed += parts[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+	for i := 0; distributed < total; i++ {
+		parts[order[i%len(order)]]++
+		distributed++
+	}
+
+	return parts
+}
+
+// toMinorUnits converts amount to an integer number of the minor unit of currency, e.g. cents for EUR.
+func toMinorUnits(amount float64, currency string) int64 {
+	return int64(math.Round(amount * math.Pow10(usecase.MinorUnits(currency))))
+}
+
+func fromMinorUnits(units int64, currency string) float64 {
+	return float64(units) / math.Pow10(usecase.MinorUnits(currency))
+}
@@ -0,0 +1,271 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"kompass/config"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/usecase"
+	"sort"
+
+	"cloud.google.com/go/civil"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+)
+
+type UseCase struct {
+	trips          repo.TripsRepo
+	transportation repo.TransportationRepo
+	activities     repo.ActivitiesRepo
+	accommodation  repo.AccommodationRepo
+	users          repo.UserRepo
+	itinerary      usecase.Itinerary
+	app            config.App
+}
+
+func New(trips repo.TripsRepo, transportation repo.TransportationRepo, activities repo.ActivitiesRepo, accommodation repo.AccommodationRepo, users repo.UserRepo, itinerary usecase.Itinerary, app config.App) *UseCase {
+	return &UseCase{
+		trips:          trips,
+		transportation: transportation,
+		activities:     activities,
+		accommodation:  accommodation,
+		users:          users,
+		itinerary:      itinerary,
+		app:            app,
+	}
+}
+
+type tripExport struct {
+	trip entity.Trip
+	days []exportDay
+}
+
+// exportDay bundles everything happening on one date. Routes whose date cannot be determined end up in a day without date.
+type exportDay struct {
+	date   *civil.Date
+	routes []exportRoute
+	places []exportPlace
+}
+
+type exportRoute struct {
+	name      string
+	kind      entity.TransportationType
+	departure *civil.DateTime
+	lines     []orb.LineString
+}
+
+type placeKind string
+
+const (
+	activityPlace      placeKind = "ACTIVITY"
+	accommodationPlace placeKind = "ACCOMMODATION"
+)
+
+type exportPlace struct {
+	name        string
+	description *string
+	address     *string
+	kind        placeKind
+	location    entity.Location
+}
+
+func (uc *UseCase) collectTrip(ctx context.Context, tripID int32) (tripExport, error) {
+	trip, err := uc.trips.GetTripByID(ctx, tripID)
+	if err != nil {
+		return tripExport{}, fmt.Errorf("get trip [id=%d]: %w", tripID, err)
+	}
+
+	allGeoJson, err := uc.transportation.GetAllGeoJson(ctx, tripID)
+	if err != nil {
+		return tripExport{}, fmt.Errorf("get geojson: %w", err)
+	}
+
+	activities, err := uc.activities.GetActivities(ctx, tripID)
+	if err != nil {
+		return tripExport{}, fmt.Errorf("get activities: %w", err)
+	}
+
+	accommodation, err := uc.accommodation.GetAllAccommodation(ctx, tripID)
+	if err != nil {
+		return tripExport{}, fmt.Errorf("get accommodation: %w", err)
+	}
+
+	daysByDate := map[civil.Date]*exportDay{}
+	undated := &exportDay{}
+	dayOf := func(date *civil.Date) *exportDay {
+		if date == nil {
+			return undated
+		}
+		if _, ok := daysByDate[*date]; !ok {
+			daysByDate[*date] = &exportDay{date: date}
+		}
+		return daysByDate[*date]
+	}
+
+	for _, featureCollection := range allGeoJson {
+		route := convertRoute(featureCollection)
+		if len(route.lines) == 0 {
+			continue
+		}
+
+		var date *civil.Date
+		if route.departure != nil {
+			date = &route.departure.Date
+		}
+		day := dayOf(date)
+		day.routes = append(day.routes, route)
+	}
+
+	for _, activity := range activities {
+		if activity.Location == nil {
+			continue
+		}
+		day := dayOf(&activity.Date)
+		day.places = append(day.places, exportPlace{
+			name:        activity.Name,
+			description: activity.Description,
+			address:     activity.Address,
+			kind:        activityPlace,
+			location:    *activity.Location,
+		})
+	}
+
+	for _, a := range accommodation {
+		if a.Location == nil {
+			continue
+		}
+		day := dayOf(&a.ArrivalDate)
+		day.places = append(day.places, exportPlace{
+			name:        a.Name,
+			description: a.Description,
+			address:     a.Address,
+			kind:        accommodationPlace,
+			location:    *a.Location,
+		})
+	}
+
+	days := []exportDay{}
+	for _, day := range daysByDate {
+		sortRoutes(day.routes)
+		days = append(days, *day)
+	}
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].date.Before(*days[j].date)
+	})
+	if len(undated.routes) > 0 || len(undated.places) > 0 {
+		days = append(days, *undated)
+	}
+
+	return tripExport{trip: trip, days: days}, nil
+}
+
+func sortRoutes(routes []exportRoute) {
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].departure == nil || routes[j].departure == nil {
+			return routes[j].departure == nil && routes[i].departure != nil
+		}
+		return routes[i].departure.Before(*routes[j].departure)
+	})
+}
+
+// convertRoute extracts the lines of a stored transportation FeatureCollection together with the name and
+// departure time from the point features that are added alongside them (see the saveGeoJson implementations).
+func convertRoute(featureCollection geojson.FeatureCollection) exportRoute {
+	route := exportRoute{kind: entity.OTHER}
+	if transportationType, ok := featureCollection.ExtraMembers["transportationType"].(string); ok {
+		route.kind = entity.TransportationType(transportationType)
+	}
+
+	for _, feature := range featureCollection.Features {
+		switch geometry := feature.Geometry.(type) {
+		case orb.LineString:
+			route.lines = append(route.lines, geometry)
+		case orb.MultiLineString:
+			route.lines = append(route.lines, geometry...)
+		case orb.Point:
+			if route.name == "" {
+				route.name = routeName(feature.Properties)
+			}
+			for _, departure := range departureDateTimes(feature.Properties) {
+				if route.departure == nil || departure.Before(*route.departure) {
+					route.departure = &departure
+				}
+			}
+		}
+	}
+
+	if route.name == "" {
+		route.name = route.kind.String()
+	}
+
+	return route
+}
+
+func routeName(properties geojson.Properties) string {
+	if name := stringProperty(properties, "name"); name != "" {
+		return name
+	}
+
+	from := stringProperty(properties, "fromMunicipality")
+	to := stringProperty(properties, "toMunicipality")
+	if from != "" && to != "" {
+		return fmt.Sprintf("%s - %s", from, to)
+	}
+
+	return ""
+}
+
+func departureDateTimes(properties geojson.Properties) []civil.DateTime {
+	result := []civil.DateTime{}
+	if departure, err := civil.ParseDateTime(stringProperty(properties, "departureDateTime")); err == nil {
+		result = append(result, departure)
+	}
+
+	legs, _ := properties["legs"].([]interface{})
+	for _, leg := range legs {
+		legProperties, ok := leg.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if departure, err := civil.ParseDateTime(stringProperty(legProperties, "departureDateTime")); err == nil {
+			result = append(result, departure)
+		}
+	}
+
+	return result
+}
+
+func stringProperty(properties map[string]interface{}, key string) string {
+	value, _ := properties[key].(string)
+	return value
+}
+
+// typeColors are used to style routes and places in the exported files, given as RGB hex.
+var typeColors = map[string]string{
+	entity.FLIGHT.String():     "1e88e5",
+	entity.TRAIN.String():      "e53935",
+	entity.BUS.String():        "8e24aa",
+	entity.CAR.String():        "fb8c00",
+	entity.FERRY.String():      "00897b",
+	entity.BOAT.String():       "3949ab",
+	entity.BIKE.String():       "7cb342",
+	entity.HIKE.String():       "6d4c41",
+	entity.OTHER.String():      "757575",
+	string(activityPlace):      "fdd835",
+	string(accommodationPlace): "d81b60",
+}
+
+func colorOf(kind string) string {
+	if color, ok := typeColors[kind]; ok {
+		return color
+	}
+	return typeColors[entity.OTHER.String()]
+}
+
+func dayLabel(day exportDay) string {
+	if day.date == nil {
+		return "Undated"
+	}
+	return day.date.String()
+}
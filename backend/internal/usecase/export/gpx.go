@@ -0,0 +1,135 @@
+package export
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/paulmach/orb"
+)
+
+type gpx struct {
+	XMLName   xml.Name      `xml:"gpx"`
+	Version   string        `xml:"version,attr"`
+	Creator   string        `xml:"creator,attr"`
+	Namespace string        `xml:"xmlns,attr"`
+	OsmAnd    string        `xml:"xmlns:osmand,attr"`
+	Metadata  gpxMetadata   `xml:"metadata"`
+	Waypoints []gpxWaypoint `xml:"wpt"`
+	Tracks    []gpxTrack    `xml:"trk"`
+}
+
+type gpxMetadata struct {
+	Name        string  `xml:"name"`
+	Description *string `xml:"desc,omitempty"`
+}
+
+type gpxWaypoint struct {
+	Latitude    float32       `xml:"lat,attr"`
+	Longitude   float32       `xml:"lon,attr"`
+	Name        string        `xml:"name"`
+	Description *string       `xml:"desc,omitempty"`
+	Type        string        `xml:"type"`
+	Extensions  gpxExtensions `xml:"extensions"`
+}
+
+type gpxTrack struct {
+	Name       string            `xml:"name"`
+	Type       string            `xml:"type"`
+	Extensions gpxExtensions     `xml:"extensions"`
+	Segments   []gpxTrackSegment `xml:"trkseg"`
+}
+
+type gpxTrackSegment struct {
+	Points []gpxTrackPoint `xml:"trkpt"`
+}
+
+type gpxTrackPoint struct {
+	Latitude  float64 `xml:"lat,attr"`
+	Longitude float64 `xml:"lon,attr"`
+}
+
+type gpxExtensions struct {
+	Color string `xml:"osmand:color"`
+}
+
+func (uc *UseCase) ExportGpx(ctx context.Context, tripID int32) ([]byte, error) {
+	trip, err := uc.collectTrip(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("collect trip: %w", err)
+	}
+
+	document := gpx{
+		Version:   "1.1",
+		Creator:   "kompa.ss",
+		Namespace: "http://www.topografix.com/GPX/1/1",
+		OsmAnd:    "https://osmand.net",
+		Metadata: gpxMetadata{
+			Name:        trip.trip.Name,
+			Description: trip.trip.Description,
+		},
+		Waypoints: []gpxWaypoint{},
+		Tracks:    []gpxTrack{},
+	}
+
+	// GPX has no concept of folders, so the day is encoded in the names and the output is ordered by day
+	for _, day := range trip.days {
+		for _, place := range day.places {
+			document.Waypoints = append(document.Waypoints, gpxWaypoint{
+				Latitude:    place.location.Latitude,
+				Longitude:   place.location.Longitude,
+				Name:        fmt.Sprintf("%s: %s", dayLabel(day), place.name),
+				Description: placeDescription(place),
+				Type:        string(place.kind),
+				Extensions:  gpxExtensions{Color: "#" + colorOf(string(place.kind))},
+			})
+		}
+
+		for _, route := range day.routes {
+			document.Tracks = append(document.Tracks, gpxTrack{
+				Name:       fmt.Sprintf("%s: %s", dayLabel(day), route.name),
+				Type:       route.kind.String(),
+				Extensions: gpxExtensions{Color: "#" + colorOf(route.kind.String())},
+				Segments:   convertTrackSegments(route.lines),
+			})
+		}
+	}
+
+	return marshalXml(document)
+}
+
+func convertTrackSegments(lines []orb.LineString) []gpxTrackSegment {
+	segments := []gpxTrackSegment{}
+	for _, line := range lines {
+		segment := gpxTrackSegment{}
+		for _, point := range line {
+			segment.Points = append(segment.Points, gpxTrackPoint{
+				Latitude:  point.Lat(),
+				Longitude: point.Lon(),
+			})
+		}
+		segments = append(segments, segment)
+	}
+	return segments
+}
+
+func placeDescription(place exportPlace) *string {
+	if place.address == nil {
+		return place.description
+	}
+	if place.description == nil {
+		return place.address
+	}
+
+	description := fmt.Sprintf("%s\n%s", *place.address, *place.description)
+	return &description
+}
+
+func marshalXml(document any) ([]byte, error) {
+	marshalled, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal xml: %w", err)
+	}
+
+	return append([]byte(xml.Header), marshalled...), nil
+}
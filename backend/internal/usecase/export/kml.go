@@ -0,0 +1,162 @@
+package export
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/paulmach/orb"
+)
+
+type kml struct {
+	XMLName   xml.Name    `xml:"kml"`
+	Namespace string      `xml:"xmlns,attr"`
+	Document  kmlDocument `xml:"Document"`
+}
+
+type kmlDocument struct {
+	Name        string      `xml:"name"`
+	Description *string     `xml:"description,omitempty"`
+	Styles      []kmlStyle  `xml:"Style"`
+	Folders     []kmlFolder `xml:"Folder"`
+}
+
+type kmlStyle struct {
+	ID        string        `xml:"id,attr"`
+	LineStyle *kmlLineStyle `xml:"LineStyle,omitempty"`
+	IconStyle *kmlIconStyle `xml:"IconStyle,omitempty"`
+}
+
+type kmlLineStyle struct {
+	Color string `xml:"color"`
+	Width int    `xml:"width"`
+}
+
+type kmlIconStyle struct {
+	Color string `xml:"color"`
+}
+
+type kmlFolder struct {
+	Name       string         `xml:"name"`
+	Placemarks []kmlPlacemark `xml:"Placemark"`
+}
+
+type kmlPlacemark struct {
+	Name          string            `xml:"name"`
+	Description   *string           `xml:"description,omitempty"`
+	StyleURL      string            `xml:"styleUrl"`
+	Point         *kmlPoint         `xml:"Point,omitempty"`
+	LineString    *kmlLineString    `xml:"LineString,omitempty"`
+	MultiGeometry *kmlMultiGeometry `xml:"MultiGeometry,omitempty"`
+}
+
+type kmlPoint struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+type kmlLineString struct {
+	Tessellate  int    `xml:"tessellate"`
+	Coordinates string `xml:"coordinates"`
+}
+
+type kmlMultiGeometry struct {
+	LineStrings []kmlLineString `xml:"LineString"`
+}
+
+func (uc *UseCase) ExportKml(ctx context.Context, tripID int32) ([]byte, error) {
+	trip, err := uc.collectTrip(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("collect trip: %w", err)
+	}
+
+	document := kml{
+		Namespace: "http://www.opengis.net/kml/2.2",
+		Document: kmlDocument{
+			Name:        trip.trip.Name,
+			Description: trip.trip.Description,
+			Styles:      kmlStyles(),
+			Folders:     []kmlFolder{},
+		},
+	}
+
+	for _, day := range trip.days {
+		folder := kmlFolder{Name: dayLabel(day)}
+
+		for _, route := range day.routes {
+			folder.Placemarks = append(folder.Placemarks, convertRoutePlacemark(route))
+		}
+
+		for _, place := range day.places {
+			folder.Placemarks = append(folder.Placemarks, kmlPlacemark{
+				Name:        place.name,
+				Description: placeDescription(place),
+				StyleURL:    "#" + string(place.kind),
+				Point: &kmlPoint{
+					Coordinates: fmt.Sprintf("%f,%f", place.location.Longitude, place.location.Latitude),
+				},
+			})
+		}
+
+		document.Document.Folders = append(document.Document.Folders, folder)
+	}
+
+	return marshalXml(document)
+}
+
+func kmlStyles() []kmlStyle {
+	kinds := []string{}
+	for kind := range typeColors {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	styles := []kmlStyle{}
+	for _, kind := range kinds {
+		color := kmlColor(typeColors[kind])
+		if kind == string(activityPlace) || kind == string(accommodationPlace) {
+			styles = append(styles, kmlStyle{ID: kind, IconStyle: &kmlIconStyle{Color: color}})
+		} else {
+			styles = append(styles, kmlStyle{ID: kind, LineStyle: &kmlLineStyle{Color: color, Width: 3}})
+		}
+	}
+	return styles
+}
+
+// kmlColor converts an RGB hex color to the aabbggrr notation used by KML.
+func kmlColor(rgb string) string {
+	return "ff" + rgb[4:6] + rgb[2:4] + rgb[0:2]
+}
+
+func convertRoutePlacemark(route exportRoute) kmlPlacemark {
+	placemark := kmlPlacemark{
+		Name:     route.name,
+		StyleURL: "#" + route.kind.String(),
+	}
+	if _, ok := typeColors[route.kind.String()]; !ok {
+		placemark.StyleURL = "#OTHER"
+	}
+
+	if len(route.lines) == 1 {
+		placemark.LineString = &kmlLineString{Tessellate: 1, Coordinates: kmlCoordinates(route.lines[0])}
+	} else {
+		placemark.MultiGeometry = &kmlMultiGeometry{}
+		for _, line := range route.lines {
+			placemark.MultiGeometry.LineStrings = append(placemark.MultiGeometry.LineStrings, kmlLineString{
+				Tessellate:  1,
+				Coordinates: kmlCoordinates(line),
+			})
+		}
+	}
+
+	return placemark
+}
+
+func kmlCoordinates(line orb.LineString) string {
+	coordinates := []string{}
+	for _, point := range line {
+		coordinates = append(coordinates, fmt.Sprintf("%f,%f", point.Lon(), point.Lat()))
+	}
+	return strings.Join(coordinates, " ")
+}
@@ -0,0 +1,402 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"kompass/internal/entity"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/go-pdf/fpdf"
+	"github.com/paulmach/orb"
+	"github.com/skip2/go-qrcode"
+)
+
+const (
+	pdfMargin    = 15.0
+	pdfMapHeight = 110.0
+	pdfQrSize    = 30.0
+)
+
+type pdfEntry struct {
+	timeLabel string
+	title     string
+	details   []string
+}
+
+func (uc *UseCase) ExportPdf(ctx context.Context, userID int32, tripID int32) ([]byte, error) {
+	trip, err := uc.collectTrip(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("collect trip: %w", err)
+	}
+
+	days, err := uc.itinerary.GetItinerary(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get itinerary: %w", err)
+	}
+
+	showSensitive, err := uc.users.HasReadSensitivePermission(ctx, userID, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("has read sensitive permission: %w", err)
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.SetTitle(trip.trip.Name, true)
+	pdf.SetCreator("kompa.ss", true)
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-10)
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.SetTextColor(128, 128, 128)
+		pdf.CellFormat(0, 5, fmt.Sprintf("%d/{nb}", pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+	pdf.AddPage()
+
+	if err := writePdfHeader(pdf, tr, trip.trip, uc.tripURL(tripID)); err != nil {
+		return nil, err
+	}
+	writePdfMap(pdf, tr, trip.days)
+	writePdfDays(pdf, tr, days, showSensitive)
+
+	var buffer bytes.Buffer
+	if err := pdf.Output(&buffer); err != nil {
+		return nil, fmt.Errorf("render pdf: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+func (uc *UseCase) tripURL(tripID int32) string {
+	return fmt.Sprintf("%s/%d/itinerary", strings.TrimSuffix(uc.app.URL, "/"), tripID)
+}
+
+func writePdfHeader(pdf *fpdf.Fpdf, tr func(string) string, trip entity.Trip, url string) error {
+	qr, err := qrcode.Encode(url, qrcode.Medium, 256)
+	if err != nil {
+		return fmt.Errorf("encode qr code: %w", err)
+	}
+
+	pageWidth, _ := pdf.GetPageSize()
+	qrX := pageWidth - pdfMargin - pdfQrSize
+	pdf.RegisterImageOptionsReader("qr", fpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(qr))
+	pdf.ImageOptions("qr", qrX, pdfMargin, pdfQrSize, pdfQrSize, false, fpdf.ImageOptions{ImageType: "PNG"}, 0, url)
+
+	textWidth := qrX - pdfMargin - 5
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.MultiCell(textWidth, 9, tr(trip.Name), "", "L", false)
+	pdf.SetFont("Helvetica", "", 11)
+	pdf.SetTextColor(90, 90, 90)
+	pdf.MultiCell(textWidth, 6, fmt.Sprintf("%s - %s", formatDate(trip.StartDate), formatDate(trip.EndDate)), "", "L", false)
+	if trip.Description != nil {
+		pdf.MultiCell(textWidth, 5, tr(*trip.Description), "", "L", false)
+	}
+	pdf.SetTextColor(0, 0, 0)
+
+	pdf.SetY(math.Max(pdf.GetY(), pdfMargin+pdfQrSize) + 5)
+	return nil
+}
+
+// writePdfMap draws the stored routes and places as a simple overview in web mercator projection.
+// There is no base map, the shapes of the routes are usually recognizable enough on paper.
+func writePdfMap(pdf *fpdf.Fpdf, tr func(string) string, days []exportDay) {
+	bound, ok := mapBound(days)
+	if !ok {
+		return
+	}
+
+	pageWidth, _ := pdf.GetPageSize()
+	width := pageWidth - 2*pdfMargin
+	top := pdf.GetY()
+
+	minX, minY := mercator(bound.Min)
+	maxX, maxY := mercator(bound.Max)
+	scale := math.Min((width-10)/math.Max(maxX-minX, 1e-9), (pdfMapHeight-10)/math.Max(maxY-minY, 1e-9))
+	offsetX := pdfMargin + (width-(maxX-minX)*scale)/2
+	offsetY := top + (pdfMapHeight-(maxY-minY)*scale)/2
+	project := func(point orb.Point) (float64, float64) {
+		x, y := mercator(point)
+		return offsetX + (x-minX)*scale, offsetY + (maxY-y)*scale
+	}
+
+	pdf.SetDrawColor(200, 200, 200)
+	pdf.SetLineWidth(0.2)
+	pdf.Rect(pdfMargin, top, width, pdfMapHeight, "D")
+
+	kinds := map[string]bool{}
+	pdf.SetLineWidth(0.6)
+	for _, day := range days {
+		for _, route := range day.routes {
+			kinds[route.kind.String()] = true
+			pdf.SetDrawColor(rgbOf(route.kind.String()))
+			for _, line := range route.lines {
+				for i := 1; i < len(line); i++ {
+					x1, y1 := project(line[i-1])
+					x2, y2 := project(line[i])
+					pdf.Line(x1, y1, x2, y2)
+				}
+			}
+		}
+	}
+
+	for _, day := range days {
+		for _, place := range day.places {
+			kinds[string(place.kind)] = true
+			pdf.SetFillColor(rgbOf(string(place.kind)))
+			x, y := project(place.location.Point())
+			pdf.Circle(x, y, 0.8, "F")
+		}
+	}
+
+	writePdfLegend(pdf, tr, kinds, top+pdfMapHeight+2)
+	pdf.SetDrawColor(0, 0, 0)
+	pdf.SetLineWidth(0.2)
+}
+
+func writePdfLegend(pdf *fpdf.Fpdf, tr func(string) string, kinds map[string]bool, y float64) {
+	sorted := []string{}
+	for kind := range kinds {
+		sorted = append(sorted, kind)
+	}
+	sort.Strings(sorted)
+
+	pdf.SetFont("Helvetica", "", 8)
+	x := pdfMargin
+	for _, kind := range sorted {
+		pdf.SetFillColor(rgbOf(kind))
+		pdf.Rect(x, y+1, 4, 2, "F")
+		label := tr(strings.ToLower(kind))
+		pdf.Text(x+5, y+3, label)
+		x += 9 + pdf.GetStringWidth(label)
+	}
+
+	pdf.SetY(y + 10)
+}
+
+func mapBound(days []exportDay) (orb.Bound, bool) {
+	points := orb.MultiPoint{}
+	for _, day := range days {
+		for _, route := range day.routes {
+			for _, line := range route.lines {
+				points = append(points, line...)
+			}
+		}
+		for _, place := range day.places {
+			points = append(points, place.location.Point())
+		}
+	}
+
+	if len(points) == 0 {
+		return orb.Bound{}, false
+	}
+	return points.Bound(), true
+}
+
+func mercator(point orb.Point) (float64, float64) {
+	lat := math.Max(math.Min(point.Lat(), 85), -85) * math.Pi / 180
+	return point.Lon() * math.Pi / 180, math.Log(math.Tan(math.Pi/4 + lat/2))
+}
+
+func rgbOf(kind string) (int, int, int) {
+	rgb, _ := strconv.ParseUint(colorOf(kind), 16, 32)
+	return int(rgb >> 16 & 0xff), int(rgb >> 8 & 0xff), int(rgb & 0xff)
+}
+
+func writePdfDays(pdf *fpdf.Fpdf, tr func(string) string, days []entity.ItineraryDay, showSensitive bool) {
+	for _, day := range days {
+		// keep the day heading together with its first entry
+		if pdf.GetY() > 250 {
+			pdf.AddPage()
+		}
+
+		pdf.Ln(3)
+		pdf.SetFont("Helvetica", "B", 13)
+		pdf.CellFormat(0, 8, formatDate(day.Date), "B", 1, "L", false, 0, "")
+		pdf.Ln(1)
+
+		if len(day.Events) == 0 {
+			writePdfNote(pdf, "Nothing planned")
+		}
+		for _, event := range day.Events {
+			writePdfEntry(pdf, tr, eventEntry(event, showSensitive))
+		}
+
+		switch {
+		case day.Accommodation != nil:
+			writePdfNote(pdf, tr(fmt.Sprintf("Night at %s", day.Accommodation.Name)))
+		case len(day.OvernightTransportation) > 0:
+			writePdfNote(pdf, "Night in transit")
+		case day.MissingAccommodation:
+			writePdfNote(pdf, "No accommodation booked")
+		}
+	}
+}
+
+func writePdfNote(pdf *fpdf.Fpdf, note string) {
+	pdf.SetFont("Helvetica", "I", 10)
+	pdf.SetTextColor(128, 128, 128)
+	pdf.CellFormat(0, 6, note, "", 1, "L", false, 0, "")
+	pdf.SetTextColor(0, 0, 0)
+}
+
+func writePdfEntry(pdf *fpdf.Fpdf, tr func(string) string, entry pdfEntry) {
+	pageWidth, _ := pdf.GetPageSize()
+	const timeWidth = 28.0
+	textWidth := pageWidth - 2*pdfMargin - timeWidth
+
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(timeWidth, 6, entry.timeLabel, "", 0, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.MultiCell(textWidth, 6, tr(entry.title), "", "L", false)
+
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.SetTextColor(70, 70, 70)
+	for _, detail := range entry.details {
+		pdf.SetX(pdfMargin + timeWidth)
+		pdf.MultiCell(textWidth, 4.5, tr(detail), "", "L", false)
+	}
+	pdf.SetTextColor(0, 0, 0)
+	pdf.Ln(1.5)
+}
+
+func eventEntry(event entity.ItineraryEvent, showSensitive bool) pdfEntry {
+	switch event.Type {
+	case entity.DEPARTURE:
+		return transportationEntry(*event.Transportation, showSensitive)
+	case entity.ARRIVAL:
+		return pdfEntry{
+			timeLabel: formatTime(event.Time),
+			title:     fmt.Sprintf("Arrival: %s", transportationTitle(*event.Transportation)),
+		}
+	case entity.CHECK_IN:
+		return checkInEntry(*event.Accommodation, showSensitive)
+	case entity.CHECK_OUT:
+		return pdfEntry{
+			timeLabel: formatTime(event.Time),
+			title:     fmt.Sprintf("Check-out: %s", event.Accommodation.Name),
+		}
+	default:
+		return activityEntry(*event.Activity, showSensitive)
+	}
+}
+
+func transportationEntry(transportation entity.Transportation, showSensitive bool) pdfEntry {
+	details := []string{}
+	switch {
+	case transportation.FlightDetail != nil:
+		for _, leg := range transportation.FlightDetail.Legs {
+			detail := fmt.Sprintf("%s %s %s (%s) - %s (%s), %s", formatTimeRange(leg.DepartureDateTime, leg.ArrivalDateTime),
+				leg.FlightNumber, leg.Origin.Name, leg.Origin.Iata, leg.Destination.Name, leg.Destination.Iata, formatDuration(leg.DurationInMinutes))
+			if leg.Aircraft != nil {
+				detail += fmt.Sprintf(", %s", *leg.Aircraft)
+			}
+			details = append(details, detail)
+		}
+		if showSensitive {
+			for _, pnr := range transportation.FlightDetail.PNRs {
+				details = append(details, fmt.Sprintf("PNR (%s): %s", pnr.Airline, pnr.PNR))
+			}
+		}
+	case transportation.TrainDetail != nil:
+		for _, leg := range transportation.TrainDetail.Legs {
+			details = append(details, fmt.Sprintf("%s %s (%s) %s - %s, %s", formatTimeRange(leg.DepartureDateTime, leg.ArrivalDateTime),
+				leg.LineName, leg.OperatorName, leg.Origin.Name, leg.Destination.Name, formatDuration(leg.DurationInMinutes)))
+		}
+	case transportation.GenericDetail != nil:
+		if transportation.GenericDetail.OriginAddress != nil {
+			details = append(details, fmt.Sprintf("From: %s", *transportation.GenericDetail.OriginAddress))
+		}
+		if transportation.GenericDetail.DestinationAddress != nil {
+			details = append(details, fmt.Sprintf("To: %s", *transportation.GenericDetail.DestinationAddress))
+		}
+	}
+
+	return pdfEntry{
+		timeLabel: formatTimeRange(transportation.DepartureDateTime, transportation.ArrivalDateTime),
+		title:     transportationTitle(transportation),
+		details:   details,
+	}
+}
+
+func transportationTitle(transportation entity.Transportation) string {
+	switch {
+	case transportation.FlightDetail != nil && len(transportation.FlightDetail.Legs) > 0:
+		legs := transportation.FlightDetail.Legs
+		return fmt.Sprintf("Flight %s - %s", legs[0].Origin.Municipality, legs[len(legs)-1].Destination.Municipality)
+	case transportation.TrainDetail != nil && len(transportation.TrainDetail.Legs) > 0:
+		legs := transportation.TrainDetail.Legs
+		return fmt.Sprintf("Train %s - %s", legs[0].Origin.Name, legs[len(legs)-1].Destination.Name)
+	case transportation.GenericDetail != nil:
+		kind := strings.ToLower(transportation.Type.String())
+		return fmt.Sprintf("%s%s: %s", strings.ToUpper(kind[:1]), kind[1:], transportation.GenericDetail.Name)
+	}
+	return transportation.Type.String()
+}
+
+func activityEntry(activity entity.Activity, showSensitive bool) pdfEntry {
+	if !showSensitive {
+		activity = activity.Redacted()
+	}
+	details := []string{}
+	if activity.Address != nil {
+		details = append(details, *activity.Address)
+	}
+	if activity.Description != nil {
+		details = append(details, *activity.Description)
+	}
+
+	return pdfEntry{
+		timeLabel: formatTime(activity.Time),
+		title:     activity.Name,
+		details:   details,
+	}
+}
+
+func checkInEntry(accommodation entity.Accommodation, showSensitive bool) pdfEntry {
+	if !showSensitive {
+		accommodation = accommodation.Redacted()
+	}
+	details := []string{fmt.Sprintf("%d night(s) until %s", accommodation.DepartureDate.DaysSince(accommodation.ArrivalDate), formatDate(accommodation.DepartureDate))}
+	if accommodation.Address != nil {
+		details = append(details, *accommodation.Address)
+	}
+	if accommodation.Description != nil {
+		details = append(details, *accommodation.Description)
+	}
+
+	return pdfEntry{
+		timeLabel: formatTime(accommodation.CheckInTime),
+		title:     fmt.Sprintf("Check-in: %s", accommodation.Name),
+		details:   details,
+	}
+}
+
+func formatDate(date civil.Date) string {
+	return date.In(time.UTC).Format("Monday, 2 January 2006")
+}
+
+func formatTime(t *civil.Time) string {
+	if t == nil {
+		return ""
+	}
+	return fmt.Sprintf("%02d:%02d", t.Hour, t.Minute)
+}
+
+// formatTimeRange marks arrivals on a later day with "+n" like airline timetables do.
+func formatTimeRange(departure civil.DateTime, arrival civil.DateTime) string {
+	label := fmt.Sprintf("%s - %s", formatTime(&departure.Time), formatTime(&arrival.Time))
+	if days := arrival.Date.DaysSince(departure.Date); days > 0 {
+		label += fmt.Sprintf(" +%d", days)
+	}
+	return label
+}
+
+func formatDuration(minutes int32) string {
+	return fmt.Sprintf("%dh %02dm", minutes/60, minutes%60)
+}
@@ -6,27 +6,42 @@ import (
 	"kompass/internal/controller/http/v1/request"
 	"kompass/internal/entity"
 	"kompass/internal/repo"
+	"kompass/internal/usecase"
 	"sort"
 	"strings"
 
 	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
 )
 
 type UseCase struct {
 	transportationRepo repo.TransportationRepo
 	flightsRepo        repo.FlightsRepo
 	flightsApi         repo.FlightInformationWebAPI
+	trips              usecase.Trips
+	budgets            usecase.Budgets
+	events             usecase.Events
+	history            usecase.History
 }
 
-func New(transportationRepo repo.TransportationRepo, flightsRepo repo.FlightsRepo, a repo.FlightInformationWebAPI) *UseCase {
+func New(transportationRepo repo.TransportationRepo, flightsRepo repo.FlightsRepo, a repo.FlightInformationWebAPI, trips usecase.Trips, budgets usecase.Budgets, events usecase.Events, history usecase.History) *UseCase {
 	return &UseCase{
 		transportationRepo: transportationRepo,
 		flightsRepo:        flightsRepo,
 		flightsApi:         a,
+		trips:              trips,
+		budgets:            budgets,
+		events:             events,
+		history:            history,
 	}
 }
 
 func (uc *UseCase) CreateFlight(ctx context.Context, tripID int32, flight request.Flight) (entity.Transportation, error) {
+	currency, err := usecase.ParseCurrency(flight.Currency)
+	if err != nil {
+		return entity.Transportation{}, err
+	}
+
 	flightLegs, err := uc.retrieveFlightLegs(ctx, flight)
 	if err != nil {
 		return entity.Transportation{}, err
@@ -35,6 +50,9 @@ func (uc *UseCase) CreateFlight(ctx context.Context, tripID int32, flight reques
 	sortByDepartureDate(flightLegs)
 	firstLeg := flightLegs[0]
 	lastLeg := flightLegs[len(flightLegs)-1]
+	if err := uc.trips.VerifyDatesInBounds(ctx, tripID, firstLeg.DepartureDateTime.Date, lastLeg.ArrivalDateTime.Date); err != nil {
+		return entity.Transportation{}, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
 
 	transportation, err := uc.transportationRepo.SaveTransportation(ctx, entity.Transportation{
 		TripID:            tripID,
@@ -44,35 +62,51 @@ func (uc *UseCase) CreateFlight(ctx context.Context, tripID int32, flight reques
 		DepartureDateTime: firstLeg.DepartureDateTime,
 		ArrivalDateTime:   lastLeg.ArrivalDateTime,
 		Price:             flight.Price,
+		Currency:          currency,
 		FlightDetail: &entity.FlightDetail{
 			Legs: flightLegs,
 			PNRs: flight.PNRs,
 		},
-	})
+	}, nil)
 	if err != nil {
 		return entity.Transportation{}, err
 	}
 
-	return transportation, uc.saveGeoJson(ctx, transportation)
+	transportation.BudgetAlert = usecase.BudgetAlert(ctx, uc.budgets, tripID, transportation.Price, entity.TRANSPORTATION_COSTS)
+	if err := uc.saveGeoJson(ctx, transportation); err != nil {
+		return transportation, err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.CREATED, entity.TRANSPORTATION_KIND, transportation.ID)
+	uc.history.Record(ctx, tripID, entity.CREATED, entity.TRANSPORTATION_KIND, transportation.ID, nil)
+	return transportation, nil
 }
 
-func (uc *UseCase) UpdateFlight(ctx context.Context, tripID int32, flightID int32) error {
-	// FIXME: use tripID in query!
-	flightDetail, err := uc.flightsRepo.GetFlightDetail(ctx, flightID)
+func (uc *UseCase) GetFlightByID(ctx context.Context, tripID int32, flightID int32) (entity.Transportation, error) {
+	transportation, err := uc.transportationRepo.GetTransportationByID(ctx, tripID, flightID)
 	if err != nil {
-		return fmt.Errorf("get flight detail [id=%d]: %w", flightID, err)
+		return entity.Transportation{}, err
+	}
+	if transportation.FlightDetail == nil {
+		return entity.Transportation{}, fiber.NewError(fiber.StatusNotFound, "flight not found")
 	}
+	return transportation, nil
+}
 
-	flightLegs, err := uc.retrieveFlightLegsUpdate(ctx, flightDetail)
+// UpdateFlight retrieves the current information of the flight legs. The transportation is written before its legs,
+// as writing those increments its revision, so it is only updated if it still has the expected revision.
+func (uc *UseCase) UpdateFlight(ctx context.Context, tripID int32, flightID int32, revision *int64) error {
+	flightDetail, err := uc.flightsRepo.GetFlightDetail(ctx, tripID, flightID)
 	if err != nil {
-		return fmt.Errorf("retrieve flight leg information: %w", err)
+		return fmt.Errorf("get flight detail [id=%d]: %w", flightID, err)
 	}
 
-	err = uc.flightsRepo.UpdateFlightLegs(ctx, flightLegs)
+	flightLegs, err := uc.retrieveFlightLegsUpdate(ctx, flightDetail)
 	if err != nil {
-		return fmt.Errorf("update flight leg [id=%d]: %w", flightID, err)
+		return fmt.Errorf("retrieve flight leg information: %w", err)
 	}
 
+	before := uc.history.Snapshot(ctx, tripID, entity.TRANSPORTATION_KIND, flightID)
 	transportation, err := uc.transportationRepo.GetTransportationByID(ctx, tripID, flightID)
 	if err != nil {
 		return err
@@ -87,21 +121,35 @@ func (uc *UseCase) UpdateFlight(ctx context.Context, tripID int32, flightID int3
 	transportation.Destination = lastLeg.Destination.Location
 	// TODO: update PNRs and price
 
-	updated, err := uc.transportationRepo.SaveTransportation(ctx, transportation)
+	updated, err := uc.transportationRepo.SaveTransportation(ctx, transportation, revision)
 	if err != nil {
 		return fmt.Errorf("update transportation: %w", err)
 	}
+	if err := uc.flightsRepo.UpdateFlightLegs(ctx, flightLegs); err != nil {
+		return fmt.Errorf("update flight leg [id=%d]: %w", flightID, err)
+	}
+	updated.FlightDetail.Legs = flightLegs
+
+	if err := uc.saveGeoJson(ctx, updated); err != nil {
+		return err
+	}
 
-	return uc.saveGeoJson(ctx, updated)
+	uc.events.Publish(ctx, tripID, entity.UPDATED, entity.TRANSPORTATION_KIND, flightID)
+	uc.history.Record(ctx, tripID, entity.UPDATED, entity.TRANSPORTATION_KIND, flightID, before)
+	return nil
 }
 
 func (uc *UseCase) retrieveFlightLegs(ctx context.Context, flight request.Flight) ([]entity.FlightLeg, error) {
 	legs := []entity.FlightLeg{}
 	for _, leg := range flight.Legs {
+		if leg.CabinClass != nil && !leg.CabinClass.IsValid() {
+			return []entity.FlightLeg{}, fiber.NewError(fiber.StatusBadRequest, "invalid cabin class")
+		}
 		flightLeg, err := uc.flightsApi.RetrieveFlightLeg(ctx, leg.Date, leg.FlightNumber, leg.OriginAirport)
 		if err != nil {
 			return []entity.FlightLeg{}, err
 		}
+		flightLeg.CabinClass = leg.CabinClass
 		legs = append(legs, flightLeg)
 	}
 
@@ -117,6 +165,7 @@ func (uc *UseCase) retrieveFlightLegsUpdate(ctx context.Context, flight entity.F
 			return []entity.FlightLeg{}, err
 		}
 		flightLeg.ID = leg.ID
+		flightLeg.CabinClass = leg.CabinClass
 		legs = append(legs, flightLeg)
 	}
 
@@ -125,7 +174,7 @@ func (uc *UseCase) retrieveFlightLegsUpdate(ctx context.Context, flight entity.F
 
 func sortByDepartureDate(legs []entity.FlightLeg) {
 	sort.Slice(legs, func(i, j int) bool {
-		return legs[i].DepartureDateTime.Compare(legs[j].DepartureDateTime) < 0
+		return legs[i].Origin.Location.Instant(legs[i].DepartureDateTime).Before(legs[j].Origin.Location.Instant(legs[j].DepartureDateTime))
 	})
 }
 
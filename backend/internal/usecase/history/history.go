@@ -0,0 +1,139 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 500
+)
+
+type UseCase struct {
+	repo           repo.HistoryRepo
+	trips          repo.TripsRepo
+	transportation repo.TransportationRepo
+	activities     repo.ActivitiesRepo
+	accommodation  repo.AccommodationRepo
+	expenses       repo.ExpensesRepo
+	attachments    repo.AttachmentsRepo
+	budgets        repo.BudgetsRepo
+	log            logger.Interface
+}
+
+func New(r repo.HistoryRepo, trips repo.TripsRepo, transportation repo.TransportationRepo, activities repo.ActivitiesRepo, accommodation repo.AccommodationRepo, expenses repo.ExpensesRepo, attachments repo.AttachmentsRepo, budgets repo.BudgetsRepo, log logger.Interface) *UseCase {
+	return &UseCase{
+		repo:           r,
+		trips:          trips,
+		transportation: transportation,
+		activities:     activities,
+		accommodation:  accommodation,
+		expenses:       expenses,
+		attachments:    attachments,
+		budgets:        budgets,
+		log:            log,
+	}
+}
+
+// Snapshot captures an entity before it is changed, to be passed to Record once the change succeeded. Failures are
+// logged and result in a missing snapshot, they must not prevent the change.
+func (uc *UseCase) Snapshot(ctx context.Context, tripID int32, kind entity.EntityKind, id int32) json.RawMessage {
+	current, err := uc.get(ctx, tripID, kind, id)
+	if err != nil {
+		uc.log.Error(fmt.Errorf("history - Snapshot [kind=%s, id=%d]: %w", kind, id, err))
+		return nil
+	}
+
+	snapshot, err := json.Marshal(current)
+	if err != nil {
+		uc.log.Error(fmt.Errorf("history - Snapshot [kind=%s, id=%d]: %w", kind, id, err))
+		return nil
+	}
+	return snapshot
+}
+
+// Record adds a change to the history after it was written, the entity after the change is captured here. The change
+// already succeeded, so failures are only logged.
+func (uc *UseCase) Record(ctx context.Context, tripID int32, change entity.ChangeType, kind entity.EntityKind, id int32, before json.RawMessage) {
+	var after json.RawMessage
+	if change != entity.DELETED {
+		after = uc.Snapshot(ctx, tripID, kind, id)
+	}
+
+	err := uc.repo.AddHistoryEntry(ctx, entity.HistoryEntry{
+		TripID:   tripID,
+		UserID:   usecase.ActorID(ctx),
+		Type:     change,
+		Kind:     kind,
+		EntityID: id,
+		Before:   before,
+		After:    after,
+	})
+	if err != nil {
+		uc.log.Error(fmt.Errorf("history - Record: %w", err))
+	}
+}
+
+func (uc *UseCase) GetHistory(ctx context.Context, tripID int32, filter request.HistoryFilter) ([]entity.HistoryEntry, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultLimit
+	}
+	filter.Limit = min(filter.Limit, maxLimit)
+
+	return uc.repo.GetHistory(ctx, tripID, filter)
+}
+
+// GetVersion unmarshals the version of an entity recorded by a history entry into version. That is the entity after
+// the change, or before the change for deletions.
+func (uc *UseCase) GetVersion(ctx context.Context, tripID int32, kind entity.EntityKind, id int32, entryID int32, version any) error {
+	entry, err := uc.repo.GetHistoryEntry(ctx, tripID, entryID)
+	if err != nil {
+		return err
+	}
+	if entry.Kind != kind || entry.EntityID != id {
+		return fiber.NewError(http.StatusNotFound, "history entry not found")
+	}
+
+	snapshot := entry.After
+	if entry.Type == entity.DELETED {
+		snapshot = entry.Before
+	}
+	if snapshot == nil {
+		return fiber.NewError(http.StatusUnprocessableEntity, "history entry has no snapshot to restore")
+	}
+
+	if err := json.Unmarshal(snapshot, version); err != nil {
+		return fmt.Errorf("unmarshal snapshot of history entry [id=%d]: %w", entryID, err)
+	}
+	return nil
+}
+
+func (uc *UseCase) get(ctx context.Context, tripID int32, kind entity.EntityKind, id int32) (any, error) {
+	switch kind {
+	case entity.TRIP_KIND:
+		return uc.trips.GetTripByID(ctx, tripID)
+	case entity.TRANSPORTATION_KIND:
+		return uc.transportation.GetTransportationByID(ctx, tripID, id)
+	case entity.ACTIVITY_KIND:
+		return uc.activities.GetActivityByID(ctx, tripID, id)
+	case entity.ACCOMMODATION_KIND:
+		return uc.accommodation.GetAccommodationByID(ctx, tripID, id)
+	case entity.EXPENSE_KIND:
+		return uc.expenses.GetExpenseByID(ctx, tripID, id)
+	case entity.ATTACHMENT_KIND:
+		return uc.attachments.GetAttachmentByID(ctx, tripID, id)
+	case entity.BUDGET_KIND:
+		return uc.budgets.GetBudget(ctx, tripID)
+	}
+	return nil, fmt.Errorf("unknown kind %q", kind)
+}
@@ -0,0 +1,288 @@
+package itinerary
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/entity"
+	"sort"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+// GetChecks analyses the itinerary for overlaps, gaps and connections that are too short. The checks are ordered by
+// date.
+func (uc *UseCase) GetChecks(ctx context.Context, tripID int32) ([]entity.ItineraryCheck, error) {
+	trip, err := uc.trips.GetTripByID(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get trip [id=%d]: %w", tripID, err)
+	}
+
+	allTransportation, err := uc.transportation.GetAllTransportation(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get transportation: %w", err)
+	}
+
+	activities, err := uc.activities.GetActivities(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get activities: %w", err)
+	}
+
+	accommodation, err := uc.accommodation.GetAllAccommodation(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get accommodation: %w", err)
+	}
+
+	sortByDeparture(allTransportation)
+	days := buildItinerary(trip, allTransportation, activities, accommodation)
+
+	checks := []entity.ItineraryCheck{}
+	checks = append(checks, overlappingTransportation(allTransportation)...)
+	checks = append(checks, activitiesInTransit(allTransportation, activities)...)
+	checks = append(checks, missingAccommodation(days)...)
+	checks = append(checks, uc.distantAccommodation(days, allTransportation)...)
+	checks = append(checks, uc.shortConnections(allTransportation)...)
+
+	sort.SliceStable(checks, func(i, j int) bool {
+		return checks[i].Date.Before(checks[j].Date)
+	})
+	return checks, nil
+}
+
+func overlappingTransportation(allTransportation []entity.Transportation) []entity.ItineraryCheck {
+	checks := []entity.ItineraryCheck{}
+	for i, first := range allTransportation {
+		for _, second := range allTransportation[i+1:] {
+			if !departure(second).Before(arrival(first)) {
+				continue
+			}
+			checks = append(checks, entity.ItineraryCheck{
+				Type:     entity.OVERLAPPING_TRANSPORTATION,
+				Date:     second.DepartureDateTime.Date,
+				Message:  fmt.Sprintf("%s departs before %s arrives", transportationName(second), transportationName(first)),
+				Entities: []entity.CheckedEntity{checkedTransportation(first), checkedTransportation(second)},
+			})
+		}
+	}
+	return checks
+}
+
+// activitiesInTransit interprets the time of activities without location in the timezone of the departure.
+func activitiesInTransit(allTransportation []entity.Transportation, activities []entity.Activity) []entity.ItineraryCheck {
+	checks := []entity.ItineraryCheck{}
+	for _, activity := range activities {
+		if activity.Time == nil {
+			continue
+		}
+		dateTime := civil.DateTime{Date: activity.Date, Time: *activity.Time}
+
+		for _, transportation := range allTransportation {
+			location := transportation.Origin
+			if activity.Location != nil {
+				location = *activity.Location
+			}
+			instant := location.Instant(dateTime)
+			if !instant.After(departure(transportation)) || !instant.Before(arrival(transportation)) {
+				continue
+			}
+			checks = append(checks, entity.ItineraryCheck{
+				Type:    entity.ACTIVITY_IN_TRANSIT,
+				Date:    activity.Date,
+				Message: fmt.Sprintf("%s takes place during %s", activity.Name, transportationName(transportation)),
+				Entities: []entity.CheckedEntity{
+					{Kind: entity.ACTIVITY_KIND, ID: activity.ID, Name: activity.Name},
+					checkedTransportation(transportation),
+				},
+			})
+		}
+	}
+	return checks
+}
+
+func missingAccommodation(days []entity.ItineraryDay) []entity.ItineraryCheck {
+	checks := []entity.ItineraryCheck{}
+	for _, day := range days {
+		if !day.MissingAccommodation {
+			continue
+		}
+		checks = append(checks, entity.ItineraryCheck{
+			Type:     entity.MISSING_ACCOMMODATION,
+			Date:     day.Date,
+			Message:  fmt.Sprintf("No accommodation for the night after %s", day.Date),
+			Entities: []entity.CheckedEntity{},
+		})
+	}
+	return checks
+}
+
+// distantAccommodation compares the accommodation of each night with the destination of the last transportation
+// arriving that day.
+func (uc *UseCase) distantAccommodation(days []entity.ItineraryDay, allTransportation []entity.Transportation) []entity.ItineraryCheck {
+	checks := []entity.ItineraryCheck{}
+	for _, day := range days {
+		if day.Accommodation == nil || day.Accommodation.Location == nil {
+			continue
+		}
+		last := lastArrival(allTransportation, day.Date)
+		if last == nil {
+			continue
+		}
+
+		distance := last.Destination.Distance(*day.Accommodation.Location)
+		if distance <= uc.checks.MaxAccommodationDistance {
+			continue
+		}
+		checks = append(checks, entity.ItineraryCheck{
+			Type:    entity.DISTANT_ACCOMMODATION,
+			Date:    day.Date,
+			Message: fmt.Sprintf("%s is %.0f km from where %s arrives", day.Accommodation.Name, distance/1000, transportationName(*last)),
+			Entities: []entity.CheckedEntity{
+				checkedTransportation(*last),
+				{Kind: entity.ACCOMMODATION_KIND, ID: day.Accommodation.ID, Name: day.Accommodation.Name},
+			},
+		})
+	}
+	return checks
+}
+
+// connectionLeg is a flight or train leg with the transportation it belongs to.
+type connectionLeg struct {
+	transportation entity.Transportation
+	name           string
+	origin         string
+	destination    string
+	departure      time.Time
+	arrival        time.Time
+	arrivalDate    civil.Date
+}
+
+// shortConnections checks consecutive legs of the same booking and of different bookings changing at the same
+// airport or station. Legs that overlap are reported as overlapping transportation instead.
+func (uc *UseCase) shortConnections(allTransportation []entity.Transportation) []entity.ItineraryCheck {
+	flightLegs := []connectionLeg{}
+	trainLegs := []connectionLeg{}
+	for _, transportation := range allTransportation {
+		if transportation.FlightDetail != nil {
+			for _, leg := range transportation.FlightDetail.Legs {
+				flightLegs = append(flightLegs, connectionLeg{
+					transportation: transportation,
+					name:           leg.FlightNumber,
+					origin:         leg.Origin.Iata,
+					destination:    leg.Destination.Iata,
+					departure:      legInstant(leg.DepartureDateTime, leg.DepartureUtcOffset, leg.Origin.Location),
+					arrival:        legInstant(leg.ArrivalDateTime, leg.ArrivalUtcOffset, leg.Destination.Location),
+					arrivalDate:    leg.ArrivalDateTime.Date,
+				})
+			}
+		}
+		if transportation.TrainDetail != nil {
+			for _, leg := range transportation.TrainDetail.Legs {
+				trainLegs = append(trainLegs, connectionLeg{
+					transportation: transportation,
+					name:           leg.LineName,
+					origin:         leg.Origin.ID,
+					destination:    leg.Destination.ID,
+					departure:      legInstant(leg.DepartureDateTime, leg.DepartureUtcOffset, leg.Origin.Location),
+					arrival:        legInstant(leg.ArrivalDateTime, leg.ArrivalUtcOffset, leg.Destination.Location),
+					arrivalDate:    leg.ArrivalDateTime.Date,
+				})
+			}
+		}
+	}
+
+	checks := shortChanges(flightLegs, uc.checks.MinFlightConnection, entity.SHORT_FLIGHT_CONNECTION, "Connection")
+	return append(checks, shortChanges(trainLegs, uc.checks.MinTrainTransfer, entity.SHORT_TRAIN_TRANSFER, "Transfer")...)
+}
+
+func shortChanges(legs []connectionLeg, minimum time.Duration, checkType entity.CheckType, change string) []entity.ItineraryCheck {
+	sort.SliceStable(legs, func(i, j int) bool {
+		return legs[i].departure.Before(legs[j].departure)
+	})
+
+	checks := []entity.ItineraryCheck{}
+	for i := 1; i < len(legs); i++ {
+		previous, next := legs[i-1], legs[i]
+		if previous.transportation.ID != next.transportation.ID && previous.destination != next.origin {
+			continue
+		}
+		gap := next.departure.Sub(previous.arrival)
+		if gap < 0 || gap >= minimum {
+			continue
+		}
+
+		entities := []entity.CheckedEntity{checkedTransportation(previous.transportation)}
+		if previous.transportation.ID != next.transportation.ID {
+			entities = append(entities, checkedTransportation(next.transportation))
+		}
+		checks = append(checks, entity.ItineraryCheck{
+			Type:     checkType,
+			Date:     previous.arrivalDate,
+			Message:  fmt.Sprintf("%s from %s to %s takes %d minutes", change, previous.name, next.name, int(gap.Minutes())),
+			Entities: entities,
+		})
+	}
+	return checks
+}
+
+// lastArrival returns the transportation arriving last on date, if any.
+func lastArrival(allTransportation []entity.Transportation, date civil.Date) *entity.Transportation {
+	var result *entity.Transportation
+	for i, transportation := range allTransportation {
+		if transportation.ArrivalDateTime.Date != date {
+			continue
+		}
+		if result == nil || arrival(transportation).After(arrival(*result)) {
+			result = &allTransportation[i]
+		}
+	}
+	return result
+}
+
+func sortByDeparture(allTransportation []entity.Transportation) {
+	sort.SliceStable(allTransportation, func(i, j int) bool {
+		return departure(allTransportation[i]).Before(departure(allTransportation[j]))
+	})
+}
+
+func departure(transportation entity.Transportation) time.Time {
+	return transportation.Origin.Instant(transportation.DepartureDateTime)
+}
+
+func arrival(transportation entity.Transportation) time.Time {
+	return transportation.Destination.Instant(transportation.ArrivalDateTime)
+}
+
+// legInstant prefers the utc offset stored with the leg over the timezone of the location.
+func legInstant(dateTime civil.DateTime, utcOffset *string, location entity.Location) time.Time {
+	if utcOffset != nil {
+		if parsed, err := time.Parse(time.RFC3339, dateTime.String()+*utcOffset); err == nil {
+			return parsed
+		}
+	}
+	return location.Instant(dateTime)
+}
+
+func checkedTransportation(transportation entity.Transportation) entity.CheckedEntity {
+	return entity.CheckedEntity{Kind: entity.TRANSPORTATION_KIND, ID: transportation.ID, Name: transportationName(transportation)}
+}
+
+func transportationName(transportation entity.Transportation) string {
+	names := []string{}
+	switch {
+	case transportation.GenericDetail != nil:
+		return transportation.GenericDetail.Name
+	case transportation.FlightDetail != nil:
+		for _, leg := range transportation.FlightDetail.Legs {
+			names = append(names, leg.FlightNumber)
+		}
+	case transportation.TrainDetail != nil:
+		for _, leg := range transportation.TrainDetail.Legs {
+			names = append(names, leg.LineName)
+		}
+	}
+	if len(names) == 0 {
+		return transportation.Type.String()
+	}
+	return strings.Join(names, ", ")
+}
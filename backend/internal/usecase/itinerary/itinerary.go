@@ -0,0 +1,256 @@
+package itinerary
+
+import (
+	"context"
+	"fmt"
+	"kompass/config"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"sort"
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+type UseCase struct {
+	trips          repo.TripsRepo
+	transportation repo.TransportationRepo
+	activities     repo.ActivitiesRepo
+	accommodation  repo.AccommodationRepo
+	checks         config.Checks
+}
+
+func New(trips repo.TripsRepo, transportation repo.TransportationRepo, activities repo.ActivitiesRepo, accommodation repo.AccommodationRepo, checks config.Checks) *UseCase {
+	return &UseCase{
+		trips:          trips,
+		transportation: transportation,
+		activities:     activities,
+		accommodation:  accommodation,
+		checks:         checks,
+	}
+}
+
+// GetItinerary returns one day for every date between the start and end date of the trip. The range is extended
+// if anything is planned outside of it, so nothing is lost when the trip dates were changed afterwards.
+func (uc *UseCase) GetItinerary(ctx context.Context, tripID int32) ([]entity.ItineraryDay, error) {
+	trip, err := uc.trips.GetTripByID(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get trip [id=%d]: %w", tripID, err)
+	}
+
+	allTransportation, err := uc.transportation.GetAllTransportation(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get transportation: %w", err)
+	}
+
+	activities, err := uc.activities.GetActivities(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get activities: %w", err)
+	}
+
+	accommodation, err := uc.accommodation.GetAllAccommodation(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("get accommodation: %w", err)
+	}
+
+	return buildItinerary(trip, allTransportation, activities, accommodation), nil
+}
+
+func buildItinerary(trip entity.Trip, allTransportation []entity.Transportation, activities []entity.Activity, accommodation []entity.Accommodation) []entity.ItineraryDay {
+	start, end := trip.StartDate, trip.EndDate
+	extend := func(date civil.Date) {
+		if date.Before(start) {
+			start = date
+		}
+		if date.After(end) {
+			end = date
+		}
+	}
+
+	events := map[civil.Date][]entity.ItineraryEvent{}
+	for _, transportation := range allTransportation {
+		departure := transportation.DepartureDateTime
+		arrival := transportation.ArrivalDateTime
+		extend(departure.Date)
+		extend(arrival.Date)
+
+		events[departure.Date] = append(events[departure.Date], entity.ItineraryEvent{
+			Type:           entity.DEPARTURE,
+			Time:           &departure.Time,
+			UtcOffset:      transportation.DepartureUtcOffset,
+			Transportation: &transportation,
+		})
+		if arrival.Date.After(departure.Date) {
+			events[arrival.Date] = append(events[arrival.Date], entity.ItineraryEvent{
+				Type:           entity.ARRIVAL,
+				Time:           &arrival.Time,
+				UtcOffset:      transportation.ArrivalUtcOffset,
+				Transportation: &transportation,
+			})
+		}
+	}
+
+	for _, activity := range activities {
+		extend(activity.Date)
+		events[activity.Date] = append(events[activity.Date], entity.ItineraryEvent{
+			Type:      entity.ACTIVITY,
+			Time:      activity.Time,
+			UtcOffset: activity.UtcOffset,
+			Activity:  &activity,
+		})
+	}
+
+	for _, a := range accommodation {
+		extend(a.ArrivalDate)
+		extend(a.DepartureDate)
+		events[a.ArrivalDate] = append(events[a.ArrivalDate], entity.ItineraryEvent{
+			Type:          entity.CHECK_IN,
+			Time:          a.CheckInTime,
+			UtcOffset:     a.CheckInUtcOffset,
+			Accommodation: &a,
+		})
+		events[a.DepartureDate] = append(events[a.DepartureDate], entity.ItineraryEvent{
+			Type:          entity.CHECK_OUT,
+			Time:          a.CheckOutTime,
+			UtcOffset:     a.CheckOutUtcOffset,
+			Accommodation: &a,
+		})
+	}
+
+	days := []entity.ItineraryDay{}
+	for date := start; !date.After(end); date = date.AddDays(1) {
+		dayEvents := events[date]
+		if dayEvents == nil {
+			dayEvents = []entity.ItineraryEvent{}
+		}
+		sortEvents(date, dayEvents)
+
+		day := entity.ItineraryDay{
+			Date:                    date,
+			Events:                  dayEvents,
+			Accommodation:           accommodationForNight(accommodation, date),
+			OvernightTransportation: transportationOverNight(allTransportation, date),
+		}
+		day.MissingAccommodation = date.Before(end) && day.Accommodation == nil && len(day.OvernightTransportation) == 0
+
+		days = append(days, day)
+	}
+
+	return days
+}
+
+// accommodationForNight returns the accommodation for the night following date. If several overlap, the one
+// checked in last is returned, as that is usually where the luggage is.
+func accommodationForNight(accommodation []entity.Accommodation, date civil.Date) *entity.Accommodation {
+	var result *entity.Accommodation
+	for i, a := range accommodation {
+		if a.ArrivalDate.After(date) || !a.DepartureDate.After(date) {
+			continue
+		}
+		if result == nil || a.ArrivalDate.After(result.ArrivalDate) {
+			result = &accommodation[i]
+		}
+	}
+	return result
+}
+
+func transportationOverNight(allTransportation []entity.Transportation, date civil.Date) []entity.Transportation {
+	result := []entity.Transportation{}
+	for _, transportation := range allTransportation {
+		if !transportation.DepartureDateTime.Date.After(date) && transportation.ArrivalDateTime.Date.After(date) {
+			result = append(result, transportation)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Origin.Instant(result[i].DepartureDateTime).Before(result[j].Origin.Instant(result[j].DepartureDateTime))
+	})
+	return result
+}
+
+// sortEvents orders the events of a single day:
+//  1. check-outs without time come first, as they happen in the morning,
+//  2. followed by all timed events in chronological order, which takes the utc offsets into account when
+//     the day crosses timezones. Times without utc offset are treated as utc,
+//  3. then activities without time, in the order they were created,
+//  4. and check-ins without time come last.
+//
+// Events at the same time are ordered check-out, arrival, departure, activity, check-in, so that a day reads
+// like it is experienced, e.g. leaving the hotel before catching the train.
+func sortEvents(date civil.Date, events []entity.ItineraryEvent) {
+	// every event gets a single sort key up front, as mixing instants and wall-clock times is not a consistent order
+	type sortKey struct {
+		event   entity.ItineraryEvent
+		rank    int
+		instant time.Time
+	}
+	keys := make([]sortKey, len(events))
+	for i, event := range events {
+		keys[i] = sortKey{event: event, rank: untimedRank(event), instant: instant(date, event)}
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.rank != b.rank {
+			return a.rank < b.rank
+		}
+		if !a.instant.Equal(b.instant) {
+			return a.instant.Before(b.instant)
+		}
+		if typeRank[a.event.Type] != typeRank[b.event.Type] {
+			return typeRank[a.event.Type] < typeRank[b.event.Type]
+		}
+		return eventID(a.event) < eventID(b.event)
+	})
+
+	for i, key := range keys {
+		events[i] = key.event
+	}
+}
+
+var typeRank = map[entity.ItineraryEventType]int{
+	entity.CHECK_OUT: 0,
+	entity.ARRIVAL:   1,
+	entity.DEPARTURE: 2,
+	entity.ACTIVITY:  3,
+	entity.CHECK_IN:  4,
+}
+
+// instant returns the point in time of an event on date. Without utc offset, the time is interpreted as utc, and
+// untimed events all share the zero time.
+func instant(date civil.Date, event entity.ItineraryEvent) time.Time {
+	if event.Time == nil {
+		return time.Time{}
+	}
+	dateTime := civil.DateTime{Date: date, Time: *event.Time}
+	if event.UtcOffset != nil {
+		if parsed, err := time.Parse(time.RFC3339, dateTime.String()+*event.UtcOffset); err == nil {
+			return parsed
+		}
+	}
+	return dateTime.In(time.UTC)
+}
+
+func untimedRank(event entity.ItineraryEvent) int {
+	switch {
+	case event.Time != nil:
+		return 1
+	case event.Type == entity.CHECK_OUT:
+		return 0
+	case event.Type == entity.CHECK_IN:
+		return 3
+	default:
+		return 2
+	}
+}
+
+func eventID(event entity.ItineraryEvent) int32 {
+	switch {
+	case event.Transportation != nil:
+		return event.Transportation.ID
+	case event.Activity != nil:
+		return event.Activity.ID
+	case event.Accommodation != nil:
+		return event.Accommodation.ID
+	}
+	return 0
+}
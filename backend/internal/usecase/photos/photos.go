@@ -0,0 +1,276 @@
+package photos
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/usecase"
+	"kompass/pkg/exif"
+	"math"
+	"mime/multipart"
+	"slices"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+// Photos taken further apart than maxGap or maxDistance belong to different clusters.
+const (
+	maxGap      = 2 * time.Hour
+	maxDistance = 1000.0
+)
+
+// matchDistance is how far an existing activity may be from a cluster to get its photos.
+const matchDistance = 2000.0
+
+const fallbackName = "Photos"
+
+type UseCase struct {
+	attachments usecase.Attachments
+	activities  usecase.Activities
+	trips       usecase.Trips
+	geocoder    repo.OpenRouteServiceWebAPI
+	boundaries  repo.BoundaryLookup
+}
+
+func New(attachments usecase.Attachments, activities usecase.Activities, trips usecase.Trips, geocoder repo.OpenRouteServiceWebAPI, boundaries repo.BoundaryLookup) *UseCase {
+	return &UseCase{
+		attachments: attachments,
+		activities:  activities,
+		trips:       trips,
+		geocoder:    geocoder,
+		boundaries:  boundaries,
+	}
+}
+
+type photo struct {
+	metadata entity.AttachmentMetadata
+	time     civil.DateTime
+	point    *orb.Point
+}
+
+type cluster struct {
+	photos []photo
+	point  *orb.Point
+}
+
+// ImportPhotos stores the photos as attachments and groups them by the time and place they were taken. Clusters are
+// linked to an existing activity on the same day nearby, otherwise a new activity is proposed but not saved.
+func (uc *UseCase) ImportPhotos(ctx context.Context, tripID int32, userID int32, fileHeaders []*multipart.FileHeader) (entity.PhotoImport, error) {
+	if len(fileHeaders) == 0 {
+		return entity.PhotoImport{}, fiber.NewError(fiber.StatusBadRequest, "no photos uploaded")
+	}
+
+	result := entity.PhotoImport{
+		Clusters: []entity.PhotoCluster{},
+		Unplaced: []entity.AttachmentMetadata{},
+	}
+	var photos []photo
+	for _, fileHeader := range fileHeaders {
+		metadata, err := readExif(fileHeader)
+		if err != nil {
+			return entity.PhotoImport{}, err
+		}
+
+		attachment, err := uc.attachments.CreateAttachment(ctx, tripID, userID, fileHeader)
+		if err != nil {
+			return entity.PhotoImport{}, fmt.Errorf("create attachment %s: %w", fileHeader.Filename, err)
+		}
+		attachmentMetadata := entity.AttachmentMetadata{
+			ID:          attachment.ID,
+			Name:        attachment.Name,
+			Size:        attachment.Size,
+			ContentType: attachment.ContentType,
+		}
+
+		if metadata.Time == nil {
+			result.Unplaced = append(result.Unplaced, attachmentMetadata)
+			continue
+		}
+		p := photo{metadata: attachmentMetadata, time: *metadata.Time}
+		if metadata.Latitude != nil && metadata.Longitude != nil {
+			p.point = &orb.Point{*metadata.Longitude, *metadata.Latitude}
+		}
+		photos = append(photos, p)
+	}
+
+	trip, err := uc.trips.GetTripByID(ctx, tripID)
+	if err != nil {
+		return entity.PhotoImport{}, fmt.Errorf("get trip: %w", err)
+	}
+	activities, err := uc.activities.GetActivities(ctx, tripID)
+	if err != nil {
+		return entity.PhotoImport{}, fmt.Errorf("get activities: %w", err)
+	}
+
+	for _, c := range clusterPhotos(photos) {
+		photoCluster, err := uc.placeCluster(ctx, trip, activities, c)
+		if err != nil {
+			return entity.PhotoImport{}, err
+		}
+		result.Clusters = append(result.Clusters, photoCluster)
+	}
+
+	return result, nil
+}
+
+func readExif(fileHeader *multipart.FileHeader) (exif.Exif, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return exif.Exif{}, fmt.Errorf("open file: %w", err)
+	}
+	defer file.Close()
+
+	// photos without or with broken metadata are still imported, just without time and place
+	metadata, _ := exif.Decode(file)
+	return metadata, nil
+}
+
+// clusterPhotos starts a new cluster whenever the day changes or a photo was taken too long after or too far away
+// from the previous one. Photos without coordinates stay with the cluster of the previous photo.
+func clusterPhotos(photos []photo) []cluster {
+	slices.SortStableFunc(photos, func(a, b photo) int {
+		return a.time.Compare(b.time)
+	})
+
+	var clusters []cluster
+	var previous photo
+	for i, p := range photos {
+		if i == 0 || splits(previous, p) {
+			clusters = append(clusters, cluster{})
+		}
+		current := &clusters[len(clusters)-1]
+		current.photos = append(current.photos, p)
+		if p.point == nil {
+			p.point = previous.point
+		}
+		previous = p
+	}
+
+	for i := range clusters {
+		clusters[i].point = centroid(clusters[i].photos)
+	}
+	return clusters
+}
+
+func splits(previous photo, next photo) bool {
+	if previous.time.Date != next.time.Date {
+		return true
+	}
+	if next.time.In(time.UTC).Sub(previous.time.In(time.UTC)) > maxGap {
+		return true
+	}
+	return previous.point != nil && next.point != nil && geo.Distance(*previous.point, *next.point) > maxDistance
+}
+
+func centroid(photos []photo) *orb.Point {
+	var sum orb.Point
+	count := 0
+	for _, p := range photos {
+		if p.point != nil {
+			sum[0] += p.point[0]
+			sum[1] += p.point[1]
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	return &orb.Point{sum[0] / float64(count), sum[1] / float64(count)}
+}
+
+func (uc *UseCase) placeCluster(ctx context.Context, trip entity.Trip, activities []entity.Activity, c cluster) (entity.PhotoCluster, error) {
+	first := c.photos[0].time
+	photoCluster := entity.PhotoCluster{
+		Date:   first.Date,
+		Start:  first.Time,
+		End:    c.photos[len(c.photos)-1].time.Time,
+		Photos: make([]entity.AttachmentMetadata, 0, len(c.photos)),
+	}
+	for _, p := range c.photos {
+		photoCluster.Photos = append(photoCluster.Photos, p.metadata)
+	}
+	if c.point != nil {
+		photoCluster.Location = &entity.Location{
+			Latitude:  float32(c.point.Lat()),
+			Longitude: float32(c.point.Lon()),
+		}
+		if err := usecase.ResolveTimezone(uc.boundaries, photoCluster.Location); err != nil {
+			return entity.PhotoCluster{}, err
+		}
+	}
+
+	if activity := matchActivity(activities, photoCluster.Date, c.point); activity != nil {
+		for _, p := range photoCluster.Photos {
+			if err := uc.attachments.LinkAttachment(ctx, trip.ID, p.ID, entity.ACTIVITY_LINK, activity.ID); err != nil {
+				return entity.PhotoCluster{}, fmt.Errorf("link attachment: %w", err)
+			}
+		}
+		photoCluster.ActivityID = &activity.ID
+		return photoCluster, nil
+	}
+
+	if photoCluster.Date.Before(trip.StartDate) || photoCluster.Date.After(trip.EndDate) {
+		return photoCluster, nil
+	}
+
+	start := photoCluster.Start
+	photoCluster.Proposal = &entity.Activity{
+		TripID:      trip.ID,
+		Name:        uc.placeName(ctx, photoCluster.Location),
+		Date:        photoCluster.Date,
+		Time:        &start,
+		Location:    photoCluster.Location,
+		Currency:    trip.HomeCurrency,
+		Attachments: photoCluster.Photos,
+	}
+	return photoCluster, nil
+}
+
+// matchActivity returns the closest activity on the date within matchDistance. Without coordinates on either side,
+// the photos can only be matched if there is exactly one activity on that day.
+func matchActivity(activities []entity.Activity, date civil.Date, point *orb.Point) *entity.Activity {
+	var sameDay []*entity.Activity
+	for i := range activities {
+		if activities[i].Date == date {
+			sameDay = append(sameDay, &activities[i])
+		}
+	}
+
+	var closest *entity.Activity
+	closestDistance := math.Inf(1)
+	for _, activity := range sameDay {
+		if point == nil || activity.Location == nil {
+			continue
+		}
+		distance := geo.Distance(*point, activity.Location.Point())
+		if distance <= matchDistance && distance < closestDistance {
+			closest = activity
+			closestDistance = distance
+		}
+	}
+	if closest != nil {
+		return closest
+	}
+
+	if len(sameDay) == 1 && (point == nil || sameDay[0].Location == nil) {
+		return sameDay[0]
+	}
+	return nil
+}
+
+// placeName reverse geocodes the location. The name is only a suggestion, so lookup errors are not fatal.
+func (uc *UseCase) placeName(ctx context.Context, location *entity.Location) string {
+	if location == nil {
+		return fallbackName
+	}
+	name, err := uc.geocoder.ReverseGeocode(ctx, location.Latitude, location.Longitude)
+	if err != nil {
+		return fallbackName
+	}
+	return name
+}
@@ -0,0 +1,160 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"sort"
+
+	"cloud.google.com/go/civil"
+)
+
+// topCount limits the number of entries of the top airports, airlines, aircraft and train operators.
+const topCount = 10
+
+type UseCase struct {
+	trips          repo.TripsRepo
+	transportation repo.TransportationRepo
+}
+
+func New(trips repo.TripsRepo, transportation repo.TransportationRepo) *UseCase {
+	return &UseCase{
+		trips:          trips,
+		transportation: transportation,
+	}
+}
+
+// GetStats aggregates the transportation of all trips the user owns or is a member of, templates are skipped. If
+// year is given, only trips overlapping that year and legs departing in it are counted.
+func (uc *UseCase) GetStats(ctx context.Context, userID int32, year *int32) (entity.TravelStats, error) {
+	trips, err := uc.trips.GetTrips(ctx, userID)
+	if err != nil {
+		return entity.TravelStats{}, fmt.Errorf("get trips: %w", err)
+	}
+
+	a := newAggregator(year)
+	seen := map[int32]bool{}
+	for _, trip := range trips {
+		if seen[trip.ID] || trip.Template {
+			continue
+		}
+		seen[trip.ID] = true
+		if year != nil && (trip.StartDate.Year > int(*year) || trip.EndDate.Year < int(*year)) {
+			continue
+		}
+
+		allTransportation, err := uc.transportation.GetAllTransportation(ctx, trip.ID)
+		if err != nil {
+			return entity.TravelStats{}, fmt.Errorf("get transportation [tripId=%d]: %w", trip.ID, err)
+		}
+
+		a.stats.Trips++
+		for _, transportation := range allTransportation {
+			a.add(transportation)
+		}
+	}
+
+	return a.result(), nil
+}
+
+type aggregator struct {
+	year      *int32
+	stats     entity.TravelStats
+	distances map[entity.TransportationType]float64
+	airports  map[string]int32
+	airlines  map[string]int32
+	aircraft  map[string]int32
+	operators map[string]int32
+}
+
+func newAggregator(year *int32) *aggregator {
+	return &aggregator{
+		year:      year,
+		stats:     entity.TravelStats{Year: year},
+		distances: map[entity.TransportationType]float64{},
+		airports:  map[string]int32{},
+		airlines:  map[string]int32{},
+		aircraft:  map[string]int32{},
+		operators: map[string]int32{},
+	}
+}
+
+// add counts the legs of transportation departing in the selected year. Flights and trains are measured leg by
+// leg, everything else directly from origin to destination.
+func (a *aggregator) add(transportation entity.Transportation) {
+	switch {
+	case transportation.FlightDetail != nil:
+		for _, leg := range transportation.FlightDetail.Legs {
+			if !a.includes(leg.DepartureDateTime.Date) {
+				continue
+			}
+			a.distances[transportation.Type] += leg.Origin.Location.Distance(leg.Destination.Location)
+			a.stats.Flights++
+			a.stats.FlightHours += float64(leg.DurationInMinutes) / 60
+			a.airports[leg.Origin.Iata]++
+			a.airports[leg.Destination.Iata]++
+			a.airlines[leg.Airline]++
+			if leg.Aircraft != nil && *leg.Aircraft != "" {
+				a.aircraft[*leg.Aircraft]++
+			}
+		}
+	case transportation.TrainDetail != nil:
+		for _, leg := range transportation.TrainDetail.Legs {
+			if !a.includes(leg.DepartureDateTime.Date) {
+				continue
+			}
+			a.distances[transportation.Type] += leg.Origin.Location.Distance(leg.Destination.Location)
+			a.stats.TrainHours += float64(leg.DurationInMinutes) / 60
+			if leg.OperatorName != "" {
+				a.operators[leg.OperatorName]++
+			}
+		}
+	default:
+		if a.includes(transportation.DepartureDateTime.Date) {
+			a.distances[transportation.Type] += transportation.Origin.Distance(transportation.Destination)
+		}
+	}
+}
+
+func (a *aggregator) includes(date civil.Date) bool {
+	return a.year == nil || date.Year == int(*a.year)
+}
+
+func (a *aggregator) result() entity.TravelStats {
+	stats := a.stats
+	stats.Distances = []entity.TransportationDistance{}
+	for transportationType, meters := range a.distances {
+		stats.Distances = append(stats.Distances, entity.TransportationDistance{Type: transportationType, Kilometers: meters / 1000})
+	}
+	sort.Slice(stats.Distances, func(i, j int) bool {
+		if stats.Distances[i].Kilometers != stats.Distances[j].Kilometers {
+			return stats.Distances[i].Kilometers > stats.Distances[j].Kilometers
+		}
+		return stats.Distances[i].Type < stats.Distances[j].Type
+	})
+
+	stats.TopAirports = top(a.airports)
+	stats.TopAirlines = top(a.airlines)
+	stats.TopAircraft = top(a.aircraft)
+	stats.TopTrainOperators = top(a.operators)
+	return stats
+}
+
+// top returns the most frequent entries of counts, ties are ordered by name.
+func top(counts map[string]int32) []entity.StatsCount {
+	result := []entity.StatsCount{}
+	for name, count := range counts {
+		result = append(result, entity.StatsCount{Name: name, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Name < result[j].Name
+	})
+	if len(result) > topCount {
+		result = result[:topCount]
+	}
+	return result
+}
@@ -0,0 +1,368 @@
+package templates
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/controller/http/v1/request"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"net/http"
+
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+)
+
+type UseCase struct {
+	users          repo.UserRepo
+	trips          usecase.Trips
+	transportation usecase.Transportation
+	flights        usecase.Flights
+	trains         usecase.Trains
+	activities     usecase.Activities
+	accommodation  usecase.Accommodation
+	attachments    usecase.Attachments
+	log            logger.Interface
+}
+
+func New(users repo.UserRepo, trips usecase.Trips, transportation usecase.Transportation, flights usecase.Flights, trains usecase.Trains, activities usecase.Activities, accommodation usecase.Accommodation, attachments usecase.Attachments, log logger.Interface) *UseCase {
+	return &UseCase{
+		users:          users,
+		trips:          trips,
+		transportation: transportation,
+		flights:        flights,
+		trains:         trains,
+		activities:     activities,
+		accommodation:  accommodation,
+		attachments:    attachments,
+		log:            log,
+	}
+}
+
+func (uc *UseCase) GetTemplates(ctx context.Context) ([]entity.Trip, error) {
+	return uc.trips.GetTemplates(ctx)
+}
+
+// copier holds the state of a single copy. links collects the attachments of the copied bookings, so that the copied
+// attachments can be linked again.
+type copier struct {
+	uc            *UseCase
+	userID        int32
+	from          entity.Trip
+	to            entity.Trip
+	days          int
+	showSensitive bool
+	links         []attachmentLinks
+}
+
+type attachmentLinks struct {
+	target      entity.AttachmentLinkTarget
+	targetID    int32
+	attachments []entity.AttachmentMetadata
+}
+
+// CopyTrip copies a trip the user can read or any template into a new trip owned by the user. Expenses and members are
+// not copied. If copying a booking fails, the new trip is moved to the trash.
+func (uc *UseCase) CopyTrip(ctx context.Context, userID int32, tripID int32, tripCopy request.TripCopy) (entity.Trip, error) {
+	// the trip is only read once it may be copied, so other trips can not be told apart from missing ones
+	canCopy, err := uc.canCopy(ctx, userID, tripID)
+	if err != nil {
+		return entity.Trip{}, err
+	}
+	if !canCopy {
+		return entity.Trip{}, fiber.NewError(http.StatusForbidden)
+	}
+	from, err := uc.trips.GetTripByID(ctx, tripID)
+	if err != nil {
+		return entity.Trip{}, fmt.Errorf("get trip [id=%d]: %w", tripID, err)
+	}
+	showSensitive, err := uc.users.HasReadSensitivePermission(ctx, userID, tripID)
+	if err != nil {
+		return entity.Trip{}, fmt.Errorf("has read sensitive permission: %w", err)
+	}
+
+	days := tripCopy.StartDate.DaysSince(from.StartDate)
+	name := from.Name
+	if tripCopy.Name != nil {
+		name = *tripCopy.Name
+	}
+	to, err := uc.trips.CreateTrip(ctx, userID, request.Trip{
+		Name:         name,
+		StartDate:    tripCopy.StartDate,
+		EndDate:      from.EndDate.AddDays(days),
+		Description:  from.Description,
+		ImageUrl:     from.ImageUrl,
+		HomeCurrency: &from.HomeCurrency,
+	})
+	if err != nil {
+		return entity.Trip{}, fmt.Errorf("create trip: %w", err)
+	}
+
+	c := &copier{
+		uc:            uc,
+		userID:        userID,
+		from:          from,
+		to:            to,
+		days:          days,
+		showSensitive: showSensitive,
+	}
+	if err := c.copyBookings(ctx, tripCopy); err != nil {
+		if err := uc.trips.DeleteTrip(ctx, to.ID, nil); err != nil {
+			uc.log.Error(fmt.Errorf("templates - CopyTrip - DeleteTrip: %w", err))
+		}
+		return entity.Trip{}, err
+	}
+
+	return to, nil
+}
+
+func (uc *UseCase) canCopy(ctx context.Context, userID int32, tripID int32) (bool, error) {
+	canRead, err := uc.users.HasReadPermission(ctx, userID, tripID)
+	if err != nil {
+		return false, fmt.Errorf("has read permission: %w", err)
+	}
+	if canRead {
+		return true, nil
+	}
+
+	templates, err := uc.trips.GetTemplates(ctx)
+	if err != nil {
+		return false, fmt.Errorf("get templates: %w", err)
+	}
+	for _, template := range templates {
+		if template.ID == tripID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *copier) copyBookings(ctx context.Context, tripCopy request.TripCopy) error {
+	activities, err := c.uc.activities.GetActivities(ctx, c.from.ID)
+	if err != nil {
+		return fmt.Errorf("get activities: %w", err)
+	}
+	for _, activity := range activities {
+		if err := c.copyActivity(ctx, activity); err != nil {
+			return fmt.Errorf("copy activity [id=%d]: %w", activity.ID, err)
+		}
+	}
+
+	accommodation, err := c.uc.accommodation.GetAllAccommodation(ctx, c.from.ID)
+	if err != nil {
+		return fmt.Errorf("get accommodation: %w", err)
+	}
+	for _, a := range accommodation {
+		if err := c.copyAccommodation(ctx, a); err != nil {
+			return fmt.Errorf("copy accommodation [id=%d]: %w", a.ID, err)
+		}
+	}
+
+	transportation, err := c.uc.transportation.GetAllTransportation(ctx, c.from.ID)
+	if err != nil {
+		return fmt.Errorf("get transportation: %w", err)
+	}
+	for _, t := range transportation {
+		if err := c.copyTransportation(ctx, t, tripCopy.ResolveBookings); err != nil {
+			return fmt.Errorf("copy transportation [id=%d]: %w", t.ID, err)
+		}
+	}
+
+	if !tripCopy.Attachments {
+		return nil
+	}
+	return c.copyAttachments(ctx)
+}
+
+func (c *copier) copyActivity(ctx context.Context, activity entity.Activity) error {
+	if !c.showSensitive {
+		activity = activity.Redacted()
+	}
+	copied, err := c.uc.activities.CreateActivity(ctx, c.to.ID, request.Activity{
+		Name:        activity.Name,
+		Date:        activity.Date.AddDays(c.days),
+		Time:        activity.Time,
+		Description: activity.Description,
+		Sensitive:   activity.Sensitive,
+		Address:     activity.Address,
+		Location:    newLocation(activity.Location),
+		Price:       activity.Price,
+		Currency:    &activity.Currency,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.link(entity.ACTIVITY_LINK, copied.ID, activity.Attachments)
+	return nil
+}
+
+func (c *copier) copyAccommodation(ctx context.Context, accommodation entity.Accommodation) error {
+	if !c.showSensitive {
+		accommodation = accommodation.Redacted()
+	}
+	copied, err := c.uc.accommodation.CreateAccommodation(ctx, c.to.ID, request.Accommodation{
+		Name:          accommodation.Name,
+		ArrivalDate:   accommodation.ArrivalDate.AddDays(c.days),
+		DepartureDate: accommodation.DepartureDate.AddDays(c.days),
+		CheckInTime:   accommodation.CheckInTime,
+		CheckOutTime:  accommodation.CheckOutTime,
+		Description:   accommodation.Description,
+		Sensitive:     accommodation.Sensitive,
+		Address:       accommodation.Address,
+		Location:      newLocation(accommodation.Location),
+		Price:         accommodation.Price,
+		Currency:      &accommodation.Currency,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.link(entity.ACCOMMODATION_LINK, copied.ID, accommodation.Attachments)
+	return nil
+}
+
+// copyTransportation looks up flights and trains again if requested. If they are not found on the new dates, e.g.
+// because the schedule changed, they are copied as manual transportation like when no lookup is requested.
+func (c *copier) copyTransportation(ctx context.Context, transportation entity.Transportation, resolve bool) error {
+	switch {
+	case transportation.FlightDetail != nil:
+		if resolve {
+			copied, err := c.uc.flights.CreateFlight(ctx, c.to.ID, c.flightRequest(transportation))
+			if err == nil {
+				c.link(entity.TRANSPORTATION_LINK, copied.ID, transportation.Attachments)
+				return nil
+			}
+			c.uc.log.Warn("templates - copyTransportation - CreateFlight [id=%d]: %s", transportation.ID, err)
+		}
+		return c.copyGenericTransportation(ctx, transportation, entity.FLIGHT, transportation.FlightDetail.Name(), nil, nil)
+	case transportation.TrainDetail != nil:
+		if resolve {
+			copied, err := c.uc.trains.CreateTrainJourney(ctx, c.to.ID, c.trainRequest(transportation))
+			if err == nil {
+				c.link(entity.TRANSPORTATION_LINK, copied.ID, transportation.Attachments)
+				return nil
+			}
+			c.uc.log.Warn("templates - copyTransportation - CreateTrainJourney [id=%d]: %s", transportation.ID, err)
+		}
+		return c.copyGenericTransportation(ctx, transportation, entity.TRAIN, transportation.TrainDetail.Name(), nil, nil)
+	default:
+		detail := entity.GenericDetail{}
+		if transportation.GenericDetail != nil {
+			detail = *transportation.GenericDetail
+		}
+		return c.copyGenericTransportation(ctx, transportation, transportation.Type, detail.Name, detail.OriginAddress, detail.DestinationAddress)
+	}
+}
+
+// copyGenericTransportation keeps the copy if only the lookup of the route fails, as the route is looked up after the
+// transportation is saved.
+func (c *copier) copyGenericTransportation(ctx context.Context, transportation entity.Transportation, transportationType entity.TransportationType, name string, originAddress *string, destinationAddress *string) error {
+	copied, err := c.uc.transportation.CreateTransportation(ctx, c.to.ID, request.Transportation{
+		Name:               name,
+		Type:               transportationType,
+		Origin:             *newLocation(&transportation.Origin),
+		Destination:        *newLocation(&transportation.Destination),
+		DepartureDateTime:  c.shift(transportation.DepartureDateTime),
+		ArrivalDateTime:    c.shift(transportation.ArrivalDateTime),
+		OriginAddress:      originAddress,
+		DestinationAddress: destinationAddress,
+		Price:              transportation.Price,
+		Currency:           &transportation.Currency,
+	})
+	if err != nil && copied.ID == 0 {
+		return err
+	}
+	if err != nil {
+		c.uc.log.Warn("templates - copyGenericTransportation [id=%d]: %s", transportation.ID, err)
+	}
+
+	c.link(entity.TRANSPORTATION_LINK, copied.ID, transportation.Attachments)
+	return nil
+}
+
+// flightRequest omits the PNRs, as bookings are not copied.
+func (c *copier) flightRequest(transportation entity.Transportation) request.Flight {
+	legs := []request.FlightLeg{}
+	for _, leg := range transportation.FlightDetail.Legs {
+		legs = append(legs, request.FlightLeg{
+			Date:          c.shift(leg.DepartureDateTime).Date,
+			FlightNumber:  leg.FlightNumber,
+			OriginAirport: &leg.Origin.Iata,
+			CabinClass:    leg.CabinClass,
+		})
+	}
+
+	return request.Flight{
+		Legs:     legs,
+		PNRs:     []entity.PNR{},
+		Price:    transportation.Price,
+		Currency: &transportation.Currency,
+	}
+}
+
+func (c *copier) trainRequest(transportation entity.Transportation) request.TrainJourney {
+	legs := transportation.TrainDetail.Legs
+	trainNumbers := []string{}
+	for _, leg := range legs {
+		trainNumbers = append(trainNumbers, leg.LineName)
+	}
+
+	return request.TrainJourney{
+		FromStationID: legs[0].Origin.ID,
+		ToStationID:   legs[len(legs)-1].Destination.ID,
+		TrainNumbers:  trainNumbers,
+		DepartureDate: c.shift(legs[0].DepartureDateTime).Date,
+		Price:         transportation.Price,
+		Currency:      &transportation.Currency,
+	}
+}
+
+// copyAttachments copies all attachments of the trip, including those not linked to any booking.
+func (c *copier) copyAttachments(ctx context.Context) error {
+	attachments, err := c.uc.attachments.GetAttachments(ctx, c.from.ID)
+	if err != nil {
+		return fmt.Errorf("get attachments: %w", err)
+	}
+
+	copiedIDs := map[int32]int32{}
+	for _, attachment := range attachments {
+		copied, err := c.uc.attachments.CopyAttachment(ctx, c.from.ID, attachment.ID, c.to.ID, c.userID)
+		if err != nil {
+			return fmt.Errorf("copy attachment [id=%d]: %w", attachment.ID, err)
+		}
+		copiedIDs[attachment.ID] = copied.ID
+	}
+
+	for _, links := range c.links {
+		for _, attachment := range links.attachments {
+			err := c.uc.attachments.LinkAttachment(ctx, c.to.ID, copiedIDs[attachment.ID], links.target, links.targetID)
+			if err != nil {
+				return fmt.Errorf("link attachment [id=%d]: %w", copiedIDs[attachment.ID], err)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *copier) link(target entity.AttachmentLinkTarget, targetID int32, attachments []entity.AttachmentMetadata) {
+	if len(attachments) == 0 {
+		return
+	}
+	c.links = append(c.links, attachmentLinks{target: target, targetID: targetID, attachments: attachments})
+}
+
+func (c *copier) shift(dateTime civil.DateTime) civil.DateTime {
+	return civil.DateTime{Date: dateTime.Date.AddDays(c.days), Time: dateTime.Time}
+}
+
+// newLocation copies a location without its ID, as locations are not shared between bookings.
+func newLocation(location *entity.Location) *entity.Location {
+	if location == nil {
+		return nil
+	}
+	copied := *location
+	copied.ID = 0
+	return &copied
+}
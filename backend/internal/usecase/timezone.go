@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"fmt"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+)
+
+// ResolveTimezone derives the timezone of a location from its coordinates. Timezones sent by clients are
+// overwritten, as they are easily outdated when the location is moved.
+func ResolveTimezone(lookup repo.BoundaryLookup, location *entity.Location) error {
+	if location == nil {
+		return nil
+	}
+
+	timezone, err := lookup.TimezoneAt(location.Latitude, location.Longitude)
+	if err != nil {
+		return fmt.Errorf("lookup timezone: %w", err)
+	}
+	location.Timezone = &timezone
+	return nil
+}
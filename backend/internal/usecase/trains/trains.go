@@ -6,17 +6,30 @@ import (
 	"kompass/internal/controller/http/v1/request"
 	"kompass/internal/entity"
 	"kompass/internal/repo"
+	"kompass/internal/usecase"
+
+	"github.com/gofiber/fiber/v2"
 )
 
 type UseCase struct {
-	repo    repo.TransportationRepo
-	dbVendo repo.DbVendoWebAPI
+	repo       repo.TransportationRepo
+	dbVendo    repo.DbVendoWebAPI
+	trips      usecase.Trips
+	boundaries repo.BoundaryLookup
+	budgets    usecase.Budgets
+	events     usecase.Events
+	history    usecase.History
 }
 
-func New(r repo.TransportationRepo, a repo.DbVendoWebAPI) *UseCase {
+func New(r repo.TransportationRepo, a repo.DbVendoWebAPI, trips usecase.Trips, boundaries repo.BoundaryLookup, budgets usecase.Budgets, events usecase.Events, history usecase.History) *UseCase {
 	return &UseCase{
-		repo:    r,
-		dbVendo: a,
+		repo:       r,
+		dbVendo:    a,
+		trips:      trips,
+		boundaries: boundaries,
+		budgets:    budgets,
+		events:     events,
+		history:    history,
 	}
 }
 
@@ -25,13 +38,31 @@ func (uc *UseCase) LookupTrainStation(ctx context.Context, query string) (entity
 }
 
 func (uc *UseCase) CreateTrainJourney(ctx context.Context, tripID int32, journeyRequest request.TrainJourney) (entity.Transportation, error) {
+	currency, err := usecase.ParseCurrency(journeyRequest.Currency)
+	if err != nil {
+		return entity.Transportation{}, err
+	}
+
 	trainDetail, err := uc.dbVendo.RetrieveJourney(ctx, journeyRequest)
 	if err != nil {
 		return entity.Transportation{}, fmt.Errorf("failed to retrieve journey: %w", err)
 	}
 
+	for i := range trainDetail.Legs {
+		leg := &trainDetail.Legs[i]
+		if err := usecase.ResolveTimezone(uc.boundaries, &leg.Origin.Location); err != nil {
+			return entity.Transportation{}, err
+		}
+		if err := usecase.ResolveTimezone(uc.boundaries, &leg.Destination.Location); err != nil {
+			return entity.Transportation{}, err
+		}
+	}
+
 	firstLeg := trainDetail.Legs[0]
 	lastLeg := trainDetail.Legs[len(trainDetail.Legs)-1]
+	if err := uc.trips.VerifyDatesInBounds(ctx, tripID, firstLeg.DepartureDateTime.Date, lastLeg.ArrivalDateTime.Date); err != nil {
+		return entity.Transportation{}, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
 
 	transportation, err := uc.repo.SaveTransportation(ctx, entity.Transportation{
 		TripID:            tripID,
@@ -41,8 +72,9 @@ func (uc *UseCase) CreateTrainJourney(ctx context.Context, tripID int32, journey
 		DepartureDateTime: firstLeg.DepartureDateTime,
 		ArrivalDateTime:   lastLeg.ArrivalDateTime,
 		Price:             journeyRequest.Price,
+		Currency:          currency,
 		TrainDetail:       &trainDetail,
-	})
+	}, nil)
 	if err != nil {
 		return entity.Transportation{}, err
 	}
@@ -52,5 +84,9 @@ func (uc *UseCase) CreateTrainJourney(ctx context.Context, tripID int32, journey
 		return entity.Transportation{}, fmt.Errorf("retrieve and process polyline: %w", err)
 	}
 
+	uc.events.Publish(ctx, tripID, entity.CREATED, entity.TRANSPORTATION_KIND, transportation.ID)
+	uc.history.Record(ctx, tripID, entity.CREATED, entity.TRANSPORTATION_KIND, transportation.ID, nil)
+
+	transportation.BudgetAlert = usecase.BudgetAlert(ctx, uc.budgets, tripID, transportation.Price, entity.TRANSPORTATION_COSTS)
 	return transportation, nil
 }
@@ -13,7 +13,7 @@ func (uc *UseCase) GetAllGeoJson(ctx context.Context, tripID int32) ([]geojson.F
 }
 
 func (uc *UseCase) saveGeoJson(ctx context.Context, transportation entity.Transportation) error {
-	featureCollection, err := uc.ors.LookupDirections(ctx, transportation.Origin, transportation.Destination, transportation.Type)
+	featureCollection, err := uc.lookupRoute(ctx, transportation)
 	if err != nil {
 		return fmt.Errorf("lookup directions: %w", err)
 	}
@@ -29,6 +29,20 @@ func (uc *UseCase) saveGeoJson(ctx context.Context, transportation entity.Transp
 	return nil
 }
 
+// lookupRoute draws flights as a straight line like the legs of looked up flights, there are no directions for them.
+func (uc *UseCase) lookupRoute(ctx context.Context, transportation entity.Transportation) (*geojson.FeatureCollection, error) {
+	if transportation.Type != entity.FLIGHT {
+		return uc.ors.LookupDirections(ctx, transportation.Origin, transportation.Destination, transportation.Type)
+	}
+
+	featureCollection := geojson.NewFeatureCollection()
+	featureCollection.Append(geojson.NewFeature(orb.LineString{
+		locationToPoint(transportation.Origin),
+		locationToPoint(transportation.Destination),
+	}))
+	return featureCollection, nil
+}
+
 func featureWithProperties(location entity.Location, transportation entity.Transportation) *geojson.Feature {
 	feature := geojson.NewFeature(locationToPoint(location))
 
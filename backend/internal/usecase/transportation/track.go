@@ -0,0 +1,163 @@
+package transportation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"kompass/internal/entity"
+	"kompass/pkg/track"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/gofiber/fiber/v2"
+	"github.com/paulmach/orb/geojson"
+)
+
+func (uc *UseCase) ImportTrack(ctx context.Context, tripID int32, transportationID int32, fileHeader *multipart.FileHeader, display entity.TrackDisplay) (entity.Transportation, error) {
+	if !display.IsValid() {
+		return entity.Transportation{}, fiber.NewError(http.StatusBadRequest, "invalid track display")
+	}
+
+	transportation, err := uc.repo.GetTransportationByID(ctx, tripID, transportationID)
+	if err != nil {
+		return entity.Transportation{}, fmt.Errorf("get transportation [id=%d]: %w", transportationID, err)
+	}
+	if transportation.GenericDetail == nil {
+		return entity.Transportation{}, fiber.NewError(http.StatusBadRequest, "tracks can not be imported for flights or trains")
+	}
+
+	recorded, err := readTrack(fileHeader)
+	if err != nil {
+		return entity.Transportation{}, err
+	}
+
+	// transportation created before timezones were tracked has none yet
+	if err := uc.resolveTimezones(&transportation.Origin, &transportation.Destination); err != nil {
+		return entity.Transportation{}, err
+	}
+
+	before := uc.history.Snapshot(ctx, tripID, entity.TRANSPORTATION_KIND, transportationID)
+	start, end := recorded.TimeRange()
+	result := entity.Track{
+		Display:               display,
+		DistanceInMeters:      recorded.Distance(),
+		ElevationGainInMeters: recorded.ElevationGain(),
+	}
+
+	// the recorded times are converted to local time at origin and destination, if their timezone is known
+	if start != nil && end != nil {
+		startDateTime := civil.DateTimeOf(inTimezone(*start, transportation.Origin))
+		endDateTime := civil.DateTimeOf(inTimezone(*end, transportation.Destination))
+		if err := uc.trips.VerifyDatesInBounds(ctx, tripID, startDateTime.Date, endDateTime.Date); err != nil {
+			return entity.Transportation{}, fiber.NewError(http.StatusBadRequest, "track: "+err.Error())
+		}
+		result.StartDateTime = &startDateTime
+		result.EndDateTime = &endDateTime
+
+		transportation.DepartureDateTime = startDateTime
+		transportation.ArrivalDateTime = endDateTime
+		transportation, err = uc.repo.SaveTransportation(ctx, transportation, nil)
+		if err != nil {
+			return entity.Transportation{}, fmt.Errorf("update transportation times: %w", err)
+		}
+		if err := uc.saveGeoJson(ctx, transportation); err != nil {
+			return entity.Transportation{}, err
+		}
+	}
+
+	if err := uc.repo.SaveTrack(ctx, transportation.ID, result, trackGeoJson(recorded, transportation)); err != nil {
+		return entity.Transportation{}, fmt.Errorf("save track: %w", err)
+	}
+
+	transportation.Track = &result
+	uc.events.Publish(ctx, tripID, entity.UPDATED, entity.TRANSPORTATION_KIND, transportationID)
+	uc.history.Record(ctx, tripID, entity.UPDATED, entity.TRANSPORTATION_KIND, transportationID, before)
+	return transportation, nil
+}
+
+func (uc *UseCase) UpdateTrackDisplay(ctx context.Context, tripID int32, transportationID int32, display entity.TrackDisplay, revision *int64) error {
+	if !display.IsValid() {
+		return fiber.NewError(http.StatusBadRequest, "invalid track display")
+	}
+
+	before := uc.history.Snapshot(ctx, tripID, entity.TRANSPORTATION_KIND, transportationID)
+	if err := uc.repo.UpdateTrackDisplay(ctx, tripID, transportationID, display, revision); err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.UPDATED, entity.TRANSPORTATION_KIND, transportationID)
+	uc.history.Record(ctx, tripID, entity.UPDATED, entity.TRANSPORTATION_KIND, transportationID, before)
+	return nil
+}
+
+func (uc *UseCase) DeleteTrack(ctx context.Context, tripID int32, transportationID int32, revision *int64) error {
+	before := uc.history.Snapshot(ctx, tripID, entity.TRANSPORTATION_KIND, transportationID)
+	if err := uc.repo.DeleteTrack(ctx, tripID, transportationID, revision); err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.UPDATED, entity.TRANSPORTATION_KIND, transportationID)
+	uc.history.Record(ctx, tripID, entity.UPDATED, entity.TRANSPORTATION_KIND, transportationID, before)
+	return nil
+}
+
+func inTimezone(t time.Time, location entity.Location) time.Time {
+	if timeLocation := location.TimeLocation(); timeLocation != nil {
+		return t.In(timeLocation)
+	}
+	return t
+}
+
+func readTrack(fileHeader *multipart.FileHeader) (track.Track, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return track.Track{}, fmt.Errorf("open file: %w", err)
+	}
+	defer file.Close()
+
+	fileBytes, err := io.ReadAll(file)
+	if err != nil {
+		return track.Track{}, fmt.Errorf("read file: %w", err)
+	}
+
+	recorded, err := track.Parse(fileBytes)
+	if err != nil {
+		if errors.Is(err, track.ErrUnsupportedFormat) {
+			return track.Track{}, fiber.NewError(http.StatusBadRequest, "unsupported track format, expected GPX or FIT")
+		}
+		return track.Track{}, fiber.NewError(http.StatusBadRequest, "unable to parse track")
+	}
+	if len(recorded.Lines()) == 0 {
+		return track.Track{}, fiber.NewError(http.StatusBadRequest, "track contains no points")
+	}
+
+	return recorded, nil
+}
+
+// trackGeoJson builds a FeatureCollection in the same shape as the planned route, so the recorded track
+// can be shown instead of or in addition to it. The "track" member tells both apart.
+func trackGeoJson(recorded track.Track, transportation entity.Transportation) *geojson.FeatureCollection {
+	featureCollection := geojson.NewFeatureCollection()
+	featureCollection.ExtraMembers = map[string]interface{}{
+		"transportationType": transportation.Type,
+		"track":              true,
+	}
+
+	lines := recorded.Lines()
+	var line *geojson.Feature
+	if len(lines) == 1 {
+		line = geojson.NewFeature(lines[0])
+	} else {
+		line = geojson.NewFeature(lines)
+	}
+	line.Properties["track"] = true
+
+	featureCollection.Append(line)
+	featureCollection.Append(featureWithProperties(transportation.Origin, transportation))
+	featureCollection.Append(featureWithProperties(transportation.Destination, transportation))
+
+	return featureCollection
+}
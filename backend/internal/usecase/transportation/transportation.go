@@ -6,21 +6,47 @@ import (
 	"kompass/internal/controller/http/v1/request"
 	"kompass/internal/entity"
 	"kompass/internal/repo"
+	"kompass/internal/usecase"
+
+	"github.com/gofiber/fiber/v2"
 )
 
 type UseCase struct {
-	repo repo.TransportationRepo
-	ors  repo.OpenRouteServiceWebAPI
+	repo       repo.TransportationRepo
+	trips      usecase.Trips
+	ors        repo.OpenRouteServiceWebAPI
+	boundaries repo.BoundaryLookup
+	budgets    usecase.Budgets
+	emissions  usecase.Emissions
+	events     usecase.Events
+	history    usecase.History
 }
 
-func New(r repo.TransportationRepo, ors repo.OpenRouteServiceWebAPI) *UseCase {
+func New(r repo.TransportationRepo, trips usecase.Trips, ors repo.OpenRouteServiceWebAPI, boundaries repo.BoundaryLookup, budgets usecase.Budgets, emissions usecase.Emissions, events usecase.Events, history usecase.History) *UseCase {
 	return &UseCase{
-		repo: r,
-		ors:  ors,
+		repo:       r,
+		trips:      trips,
+		ors:        ors,
+		boundaries: boundaries,
+		budgets:    budgets,
+		emissions:  emissions,
+		events:     events,
+		history:    history,
 	}
 }
 
 func (uc *UseCase) CreateTransportation(ctx context.Context, tripID int32, request request.Transportation) (entity.Transportation, error) {
+	if err := uc.trips.VerifyDatesInBounds(ctx, tripID, request.DepartureDateTime.Date, request.ArrivalDateTime.Date); err != nil {
+		return entity.Transportation{}, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err := uc.resolveTimezones(&request.Origin, &request.Destination); err != nil {
+		return entity.Transportation{}, err
+	}
+	currency, err := usecase.ParseCurrency(request.Currency)
+	if err != nil {
+		return entity.Transportation{}, err
+	}
+
 	transportation, err := uc.repo.SaveTransportation(ctx, entity.Transportation{
 		TripID:            tripID,
 		Type:              request.Type,
@@ -29,25 +55,44 @@ func (uc *UseCase) CreateTransportation(ctx context.Context, tripID int32, reque
 		DepartureDateTime: request.DepartureDateTime,
 		ArrivalDateTime:   request.ArrivalDateTime,
 		Price:             request.Price,
+		Currency:          currency,
 		GenericDetail: &entity.GenericDetail{
 			Name:               request.Name,
 			OriginAddress:      request.OriginAddress,
 			DestinationAddress: request.DestinationAddress,
 		},
-	})
+	}, nil)
 	if err != nil {
 		return entity.Transportation{}, err
 	}
 
-	return transportation, uc.saveGeoJson(ctx, transportation)
+	transportation.BudgetAlert = usecase.BudgetAlert(ctx, uc.budgets, tripID, transportation.Price, entity.TRANSPORTATION_COSTS)
+	if err := uc.saveGeoJson(ctx, transportation); err != nil {
+		return transportation, err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.CREATED, entity.TRANSPORTATION_KIND, transportation.ID)
+	uc.history.Record(ctx, tripID, entity.CREATED, entity.TRANSPORTATION_KIND, transportation.ID, nil)
+	return transportation, nil
 }
 
-func (uc *UseCase) UpdateTransportation(ctx context.Context, tripID int32, transportationID int32, request request.Transportation) (entity.Transportation, error) {
+func (uc *UseCase) UpdateTransportation(ctx context.Context, tripID int32, transportationID int32, request request.Transportation, revision *int64) (entity.Transportation, error) {
 	existing, err := uc.repo.GetTransportationByID(ctx, tripID, transportationID)
 	if err != nil {
 		return entity.Transportation{}, fmt.Errorf("get existing transportation: %w", err)
 	}
+	if err := uc.trips.VerifyDatesInBounds(ctx, tripID, request.DepartureDateTime.Date, request.ArrivalDateTime.Date); err != nil {
+		return entity.Transportation{}, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err := uc.resolveTimezones(&request.Origin, &request.Destination); err != nil {
+		return entity.Transportation{}, err
+	}
+	currency, err := usecase.ParseCurrency(request.Currency)
+	if err != nil {
+		return entity.Transportation{}, err
+	}
 
+	before := uc.history.Snapshot(ctx, tripID, entity.TRANSPORTATION_KIND, transportationID)
 	transportation, err := uc.repo.SaveTransportation(ctx, entity.Transportation{
 		ID:     transportationID,
 		TripID: tripID,
@@ -56,33 +101,48 @@ func (uc *UseCase) UpdateTransportation(ctx context.Context, tripID int32, trans
 			ID:        existing.Origin.ID,
 			Latitude:  request.Origin.Latitude,
 			Longitude: request.Origin.Longitude,
+			Timezone:  request.Origin.Timezone,
 		},
 		Destination: entity.Location{
 			ID:        existing.Destination.ID,
 			Latitude:  request.Destination.Latitude,
 			Longitude: request.Destination.Longitude,
+			Timezone:  request.Destination.Timezone,
 		},
 		DepartureDateTime: request.DepartureDateTime,
 		ArrivalDateTime:   request.ArrivalDateTime,
 		Price:             request.Price,
+		Currency:          currency,
 		GenericDetail: &entity.GenericDetail{
 			Name:               request.Name,
 			OriginAddress:      request.OriginAddress,
 			DestinationAddress: request.DestinationAddress,
 		},
-	})
+	}, revision)
 	if err != nil {
 		return entity.Transportation{}, fmt.Errorf("update transportation: %w", err)
 	}
+	if err := uc.saveGeoJson(ctx, transportation); err != nil {
+		return transportation, err
+	}
 
-	return transportation, uc.saveGeoJson(ctx, transportation)
+	uc.events.Publish(ctx, tripID, entity.UPDATED, entity.TRANSPORTATION_KIND, transportationID)
+	uc.history.Record(ctx, tripID, entity.UPDATED, entity.TRANSPORTATION_KIND, transportationID, before)
+	return transportation, nil
 }
 
 func (uc *UseCase) GetAllTransportation(ctx context.Context, tripID int32) ([]entity.Transportation, error) {
-	allTransportation, err := uc.repo.GetAllTransportation(ctx, tripID)
+	return uc.GetAllTransportationSince(ctx, tripID, 0)
+}
+
+func (uc *UseCase) GetAllTransportationSince(ctx context.Context, tripID int32, since int64) ([]entity.Transportation, error) {
+	allTransportation, err := uc.repo.GetAllTransportationSince(ctx, tripID, since)
 	if err != nil {
 		return nil, fmt.Errorf("get all transportation: %w", err)
 	}
+	if err := uc.emissions.Estimate(ctx, tripID, allTransportation); err != nil {
+		return nil, fmt.Errorf("estimate emissions: %w", err)
+	}
 
 	return allTransportation, nil
 }
@@ -92,10 +152,82 @@ func (uc *UseCase) GetTransportationByID(ctx context.Context, tripID int32, tran
 	if err != nil {
 		return entity.Transportation{}, fmt.Errorf("get transportation [id=%d]: %w", transportationID, err)
 	}
+	estimated := []entity.Transportation{transportation}
+	if err := uc.emissions.Estimate(ctx, tripID, estimated); err != nil {
+		return entity.Transportation{}, fmt.Errorf("estimate emissions: %w", err)
+	}
 
-	return transportation, nil
+	return estimated[0], nil
+}
+
+func (uc *UseCase) DeleteTransportation(ctx context.Context, tripID int32, transportationID int32, revision *int64) error {
+	before := uc.history.Snapshot(ctx, tripID, entity.TRANSPORTATION_KIND, transportationID)
+	if err := uc.repo.DeleteTransportation(ctx, tripID, transportationID, revision); err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.DELETED, entity.TRANSPORTATION_KIND, transportationID)
+	uc.history.Record(ctx, tripID, entity.DELETED, entity.TRANSPORTATION_KIND, transportationID, before)
+	return nil
+}
+
+// RestoreTransportation restores the version of transportation recorded by a history entry. Deleted transportation is
+// created again with a new ID. Flights and train journeys are restored as generic transportation named after their
+// legs, as looking them up again may not return the recorded version. Their legs can not be written here, so a flight
+// or train journey that still exists is moved to the trash and replaced.
+func (uc *UseCase) RestoreTransportation(ctx context.Context, tripID int32, transportationID int32, entryID int32) (entity.Transportation, error) {
+	var version entity.Transportation
+	if err := uc.history.GetVersion(ctx, tripID, entity.TRANSPORTATION_KIND, transportationID, entryID, &version); err != nil {
+		return entity.Transportation{}, err
+	}
+
+	// the locations of the version may have been deleted since
+	version.Origin.ID = 0
+	version.Destination.ID = 0
+
+	currency := version.Currency
+	restored := request.Transportation{
+		Type:              version.Type,
+		Origin:            version.Origin,
+		Destination:       version.Destination,
+		DepartureDateTime: version.DepartureDateTime,
+		ArrivalDateTime:   version.ArrivalDateTime,
+		Price:             version.Price,
+		Currency:          &currency,
+	}
+	switch {
+	case version.FlightDetail != nil:
+		restored.Name = version.FlightDetail.Name()
+	case version.TrainDetail != nil:
+		restored.Name = version.TrainDetail.Name()
+	case version.GenericDetail != nil:
+		restored.Name = version.GenericDetail.Name
+		restored.OriginAddress = version.GenericDetail.OriginAddress
+		restored.DestinationAddress = version.GenericDetail.DestinationAddress
+	}
+
+	existing, err := uc.repo.GetTransportationByID(ctx, tripID, transportationID)
+	if usecase.IsNotFound(err) {
+		return uc.CreateTransportation(ctx, tripID, restored)
+	}
+	if err != nil {
+		return entity.Transportation{}, err
+	}
+	if existing.GenericDetail == nil {
+		if err := uc.DeleteTransportation(ctx, tripID, transportationID, nil); err != nil {
+			return entity.Transportation{}, fmt.Errorf("replace transportation [id=%d]: %w", transportationID, err)
+		}
+		return uc.CreateTransportation(ctx, tripID, restored)
+	}
+
+	return uc.UpdateTransportation(ctx, tripID, transportationID, restored, nil)
 }
 
-func (uc *UseCase) DeleteTransportation(ctx context.Context, tripID int32, transportationID int32) error {
-	return uc.repo.DeleteTransportation(ctx, tripID, transportationID)
+func (uc *UseCase) resolveTimezones(locations ...*entity.Location) error {
+	for _, location := range locations {
+		if err := usecase.ResolveTimezone(uc.boundaries, location); err != nil {
+			return err
+		}
+	}
+	return nil
 }
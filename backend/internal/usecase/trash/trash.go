@@ -0,0 +1,106 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+	"kompass/internal/entity"
+	"kompass/internal/repo"
+	"kompass/internal/usecase"
+	"kompass/pkg/logger"
+	"time"
+)
+
+const purgeInterval = time.Hour
+
+type UseCase struct {
+	repo      repo.TrashRepo
+	retention time.Duration
+	events    usecase.Events
+	history   usecase.History
+	log       logger.Interface
+}
+
+func New(r repo.TrashRepo, retention time.Duration, events usecase.Events, history usecase.History, log logger.Interface) *UseCase {
+	return &UseCase{
+		repo:      r,
+		retention: retention,
+		events:    events,
+		history:   history,
+		log:       log,
+	}
+}
+
+func (uc *UseCase) GetTrash(ctx context.Context, userID int32) ([]entity.TrashItem, error) {
+	items, err := uc.repo.GetTrash(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return uc.withPurgeDates(items), nil
+}
+
+func (uc *UseCase) GetTripTrash(ctx context.Context, userID int32, tripID int32) ([]entity.TrashItem, error) {
+	items, err := uc.repo.GetTrash(ctx, userID, &tripID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.withPurgeDates(items), nil
+}
+
+func (uc *UseCase) RestoreTrip(ctx context.Context, userID int32, tripID int32) error {
+	if err := uc.repo.RestoreTrip(ctx, userID, tripID); err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.CREATED, entity.TRIP_KIND, tripID)
+	uc.history.Record(ctx, tripID, entity.CREATED, entity.TRIP_KIND, tripID, nil)
+	return nil
+}
+
+// Restore brings back an entity of a trip with its ID, so links to it are intact again.
+func (uc *UseCase) Restore(ctx context.Context, tripID int32, kind entity.EntityKind, id int32) error {
+	if err := uc.repo.Restore(ctx, tripID, kind, id); err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.CREATED, kind, id)
+	uc.history.Record(ctx, tripID, entity.CREATED, kind, id, nil)
+	return nil
+}
+
+// PurgeRegularly purges everything that exceeded the retention period until the context is done. Nothing is purged
+// without a retention period.
+func (uc *UseCase) PurgeRegularly(ctx context.Context) {
+	if uc.retention <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := uc.repo.Purge(ctx, time.Now().Add(-uc.retention))
+		if err != nil {
+			uc.log.Error(fmt.Errorf("trash - PurgeRegularly: %w", err))
+		} else if purged > 0 {
+			uc.log.Info("trash - PurgeRegularly: purged %d trips and entities", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (uc *UseCase) withPurgeDates(items []entity.TrashItem) []entity.TrashItem {
+	if uc.retention <= 0 {
+		return items
+	}
+
+	for i := range items {
+		purgeAt := items[i].DeletedAt.Add(uc.retention)
+		items[i].PurgeAt = &purgeAt
+	}
+	return items
+}
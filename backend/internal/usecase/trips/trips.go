@@ -3,19 +3,30 @@ package trips
 import (
 	"cloud.google.com/go/civil"
 	"context"
+	"encoding/json"
 	"fmt"
 	"kompass/internal/controller/http/v1/request"
 	"kompass/internal/entity"
 	"kompass/internal/repo"
+	"kompass/internal/usecase"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
 )
 
+const defaultHomeCurrency = "EUR"
+
 type UseCase struct {
-	repo repo.TripsRepo
+	repo    repo.TripsRepo
+	events  usecase.Events
+	history usecase.History
 }
 
-func New(r repo.TripsRepo) *UseCase {
+func New(r repo.TripsRepo, events usecase.Events, history usecase.History) *UseCase {
 	return &UseCase{
-		repo: r,
+		repo:    r,
+		events:  events,
+		history: history,
 	}
 }
 
@@ -27,30 +38,150 @@ func (uc *UseCase) GetTrips(ctx context.Context, userID int32) ([]entity.Trip, e
 	return uc.repo.GetTrips(ctx, userID)
 }
 
+func (uc *UseCase) GetTemplates(ctx context.Context) ([]entity.Trip, error) {
+	return uc.repo.GetTemplates(ctx)
+}
+
 func (uc *UseCase) CreateTrip(ctx context.Context, userID int32, trip request.Trip) (entity.Trip, error) {
-	return uc.repo.CreateTrip(ctx, entity.Trip{
-		OwnerID:     userID,
-		Name:        trip.Name,
-		StartDate:   trip.StartDate,
-		EndDate:     trip.EndDate,
-		Description: trip.Description,
-		ImageUrl:    trip.ImageUrl,
+	homeCurrency, err := usecase.ParseCurrency(trip.HomeCurrency)
+	if err != nil {
+		return entity.Trip{}, err
+	}
+	if homeCurrency == "" {
+		homeCurrency = defaultHomeCurrency
+	}
+
+	created, err := uc.repo.CreateTrip(ctx, entity.Trip{
+		OwnerID:      userID,
+		Name:         trip.Name,
+		StartDate:    trip.StartDate,
+		EndDate:      trip.EndDate,
+		Description:  trip.Description,
+		ImageUrl:     trip.ImageUrl,
+		HomeCurrency: homeCurrency,
+		Template:     trip.Template != nil && *trip.Template,
 	})
+	if err != nil {
+		return entity.Trip{}, err
+	}
+
+	uc.history.Record(ctx, created.ID, entity.CREATED, entity.TRIP_KIND, created.ID, nil)
+	return created, nil
 }
 
-func (uc *UseCase) UpdateTrip(ctx context.Context, tripID int32, trip request.Trip) error {
-	return uc.repo.UpdateTrip(ctx, entity.Trip{
-		ID:          tripID,
-		Name:        trip.Name,
-		StartDate:   trip.StartDate,
-		EndDate:     trip.EndDate,
-		Description: trip.Description,
-		ImageUrl:    trip.ImageUrl,
-	})
+func (uc *UseCase) UpdateTrip(ctx context.Context, tripID int32, trip request.Trip, revision *int64) error {
+	// the home currency and the template flag are kept if none is given
+	homeCurrency, err := usecase.ParseCurrency(trip.HomeCurrency)
+	if err != nil {
+		return err
+	}
+
+	existing, err := uc.repo.GetTripByID(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("get existing trip: %w", err)
+	}
+	template := existing.Template
+	if trip.Template != nil {
+		template = *trip.Template
+	}
+	days, err := uc.dateShift(ctx, existing, trip)
+	if err != nil {
+		return err
+	}
+
+	updated := entity.Trip{
+		ID:           tripID,
+		Name:         trip.Name,
+		StartDate:    trip.StartDate,
+		EndDate:      trip.EndDate,
+		Description:  trip.Description,
+		ImageUrl:     trip.ImageUrl,
+		HomeCurrency: homeCurrency,
+		Template:     template,
+	}
+	before := uc.history.Snapshot(ctx, tripID, entity.TRIP_KIND, tripID)
+	shiftedBefore := map[entity.EntityKind]map[int32]json.RawMessage{}
+	shifted := map[entity.EntityKind][]int32{}
+	if days == 0 {
+		err = uc.repo.UpdateTrip(ctx, updated, revision)
+	} else {
+		shiftedBefore, err = uc.snapshotDatedEntities(ctx, tripID)
+		if err != nil {
+			return err
+		}
+		shifted, err = uc.repo.ShiftTrip(ctx, updated, days, revision)
+	}
+	if err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.UPDATED, entity.TRIP_KIND, tripID)
+	uc.history.Record(ctx, tripID, entity.UPDATED, entity.TRIP_KIND, tripID, before)
+	for kind, ids := range shifted {
+		for _, id := range ids {
+			uc.events.Publish(ctx, tripID, entity.UPDATED, kind, id)
+			uc.history.Record(ctx, tripID, entity.UPDATED, kind, id, shiftedBefore[kind][id])
+		}
+	}
+	return nil
+}
+
+// snapshotDatedEntities captures the entities that are shifted with the trip dates, to record their shift.
+func (uc *UseCase) snapshotDatedEntities(ctx context.Context, tripID int32) (map[entity.EntityKind]map[int32]json.RawMessage, error) {
+	ids, err := uc.repo.GetDatedEntityIDs(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := map[entity.EntityKind]map[int32]json.RawMessage{}
+
+	for kind, kindIDs := range ids {
+		snapshots[kind] = map[int32]json.RawMessage{}
+		for _, id := range kindIDs {
+			snapshots[kind][id] = uc.history.Snapshot(ctx, tripID, kind, id)
+		}
+	}
+	return snapshots, nil
+}
+
+// dateShift returns the days by which the entities of the trip are shifted if its dates change. The change is
+// rejected if entities would be outside of the new dates, listing those entities.
+func (uc *UseCase) dateShift(ctx context.Context, existing entity.Trip, trip request.Trip) (int32, error) {
+	if trip.StartDate == existing.StartDate && trip.EndDate == existing.EndDate {
+		return 0, nil
+	}
+
+	dateChange := entity.VALIDATE_DATES
+	if trip.DateChange != nil {
+		dateChange = *trip.DateChange
+	}
+	if !dateChange.IsValid() {
+		return 0, fiber.NewError(http.StatusBadRequest, "invalid date change: "+string(dateChange))
+	}
+
+	var days int32
+	if dateChange == entity.SHIFT_DATES {
+		days = int32(trip.StartDate.DaysSince(existing.StartDate))
+	}
+	outOfRange, err := uc.repo.GetEntitiesOutOfRange(ctx, existing.ID, days, trip.StartDate, trip.EndDate)
+	if err != nil {
+		return 0, err
+	}
+	if len(outOfRange) > 0 {
+		return 0, entity.ErrDatesOutOfRange(outOfRange)
+	}
+	return days, nil
 }
 
-func (uc *UseCase) DeleteTrip(ctx context.Context, tripID int32) error {
-	return uc.repo.DeleteTrip(ctx, tripID)
+func (uc *UseCase) DeleteTrip(ctx context.Context, tripID int32, revision *int64) error {
+	before := uc.history.Snapshot(ctx, tripID, entity.TRIP_KIND, tripID)
+	if err := uc.repo.DeleteTrip(ctx, tripID, revision); err != nil {
+		return err
+	}
+
+	uc.events.Publish(ctx, tripID, entity.DELETED, entity.TRIP_KIND, tripID)
+	uc.history.Record(ctx, tripID, entity.DELETED, entity.TRIP_KIND, tripID, before)
+	return nil
 }
 
 func (uc *UseCase) VerifyDatesInBounds(ctx context.Context, tripID int32, dates ...civil.Date) error {
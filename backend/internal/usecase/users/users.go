@@ -43,6 +43,10 @@ func (uc *UseCase) HasReadPermission(ctx context.Context, userID, tripID int32)
 	return uc.repo.HasReadPermission(ctx, userID, tripID)
 }
 
+func (uc *UseCase) HasReadSensitivePermission(ctx context.Context, userID, tripID int32) (bool, error) {
+	return uc.repo.HasReadSensitivePermission(ctx, userID, tripID)
+}
+
 func (uc *UseCase) HasWritePermission(ctx context.Context, userID, tripID int32) (bool, error) {
 	return uc.repo.HasWritePermission(ctx, userID, tripID)
 }
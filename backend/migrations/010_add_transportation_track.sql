@@ -0,0 +1,14 @@
+-- +goose Up
+create table transportation_track
+(
+    transportation_id        integer primary key references transportation on delete cascade,
+    display                  varchar(10)      not null,
+    distance_in_meters       double precision not null,
+    elevation_gain_in_meters double precision,
+    start_time               timestamp,
+    end_time                 timestamp,
+    geojson                  json             not null
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS transportation_track;
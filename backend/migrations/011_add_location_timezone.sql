@@ -0,0 +1,5 @@
+-- +goose Up
+alter table location add column timezone varchar(64);
+
+-- +goose Down
+alter table location drop column timezone;
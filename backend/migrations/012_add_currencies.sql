@@ -0,0 +1,21 @@
+-- +goose Up
+alter table trip add column home_currency char(3) not null default 'EUR';
+alter table transportation add column currency char(3) not null default 'EUR';
+alter table activity add column currency char(3) not null default 'EUR';
+alter table accommodation add column currency char(3) not null default 'EUR';
+
+-- rates are relative to EUR, as published by the ECB
+create table exchange_rate
+(
+    date     date             not null,
+    currency char(3)          not null,
+    rate     double precision not null,
+    primary key (date, currency)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS exchange_rate;
+alter table accommodation drop column currency;
+alter table activity drop column currency;
+alter table transportation drop column currency;
+alter table trip drop column home_currency;
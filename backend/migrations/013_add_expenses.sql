@@ -0,0 +1,29 @@
+-- +goose Up
+create table expense
+(
+    id                serial primary key,
+    trip_id           integer          not null references trip on delete cascade,
+    name              varchar(255)     not null,
+    date              date             not null,
+    amount            double precision not null,
+    currency          char(3)          not null,
+    payer_id          integer          not null references "user" on delete cascade,
+    split_mode        varchar(10)      not null,
+    transportation_id integer references transportation on delete set null,
+    activity_id       integer references activity on delete set null,
+    accommodation_id  integer references accommodation on delete set null,
+    attachment_id     integer references attachment on delete set null
+);
+
+-- share is the weight of the participant for SHARES and the amount owed for EXACT
+create table expense_participant
+(
+    expense_id integer not null references expense on delete cascade,
+    user_id    integer not null references "user" on delete cascade,
+    share      double precision,
+    primary key (expense_id, user_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS expense_participant;
+DROP TABLE IF EXISTS expense;
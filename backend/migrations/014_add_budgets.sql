@@ -0,0 +1,18 @@
+-- +goose Up
+alter table trip add column budget double precision;
+
+create table category_budget
+(
+    trip_id  integer          not null references trip on delete cascade,
+    category varchar(16)      not null,
+    amount   double precision not null,
+    primary key (trip_id, category)
+);
+
+-- expenses linked to a booking share its category, all others are categorized by the payer
+alter table expense add column category varchar(16) not null default 'OTHER';
+
+-- +goose Down
+alter table expense drop column category;
+DROP TABLE IF EXISTS category_budget;
+alter table trip drop column budget;
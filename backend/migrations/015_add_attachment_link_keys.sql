@@ -0,0 +1,9 @@
+-- +goose Up
+alter table attachment_activity add primary key (attachment_id, activity_id);
+alter table attachment_accommodation add primary key (attachment_id, accommodation_id);
+alter table attachment_transportation add primary key (attachment_id, transportation_id);
+
+-- +goose Down
+alter table attachment_transportation drop constraint attachment_transportation_pkey;
+alter table attachment_accommodation drop constraint attachment_accommodation_pkey;
+alter table attachment_activity drop constraint attachment_activity_pkey;
@@ -0,0 +1,35 @@
+-- +goose Up
+alter table attachment add column size bigint;
+alter table attachment add column content_type varchar(255);
+alter table attachment add column sha256 char(64);
+alter table attachment add column created_at timestamptz not null default now();
+alter table attachment add column uploaded_by integer references "user" on delete set null;
+
+update attachment
+set size         = octet_length(blob),
+    sha256       = encode(sha256(blob), 'hex'),
+    content_type = case lower(substring(name from '\.([^.]+)$'))
+                       when 'pdf' then 'application/pdf'
+                       when 'png' then 'image/png'
+                       when 'jpg' then 'image/jpeg'
+                       when 'jpeg' then 'image/jpeg'
+                       when 'gif' then 'image/gif'
+                       when 'webp' then 'image/webp'
+                       when 'txt' then 'text/plain; charset=utf-8'
+                       else 'application/octet-stream'
+                       end;
+
+alter table attachment alter column size set not null;
+alter table attachment alter column content_type set not null;
+alter table attachment alter column sha256 set not null;
+
+-- blobs are read in chunks, which requires them to be stored uncompressed
+alter table attachment alter column blob set storage external;
+
+-- +goose Down
+alter table attachment alter column blob set storage extended;
+alter table attachment drop column uploaded_by;
+alter table attachment drop column created_at;
+alter table attachment drop column sha256;
+alter table attachment drop column content_type;
+alter table attachment drop column size;
@@ -0,0 +1,32 @@
+-- +goose Up
+-- contents are stored once per hash, regardless of how many attachments share them
+create table attachment_blob
+(
+    sha256 char(64) primary key,
+    data   bytea not null
+);
+
+-- blobs are read in chunks, which requires them to be stored uncompressed
+alter table attachment_blob alter column data set storage external;
+
+insert into attachment_blob (sha256, data)
+select distinct on (sha256) sha256, blob
+from attachment
+order by sha256, id;
+
+alter table attachment drop column blob;
+
+-- +goose Down
+-- blobs that were moved to another storage backend have to be migrated back to postgres first
+alter table attachment add column blob bytea;
+
+update attachment
+set blob = attachment_blob.data
+from attachment_blob
+where attachment_blob.sha256 = attachment.sha256;
+
+update attachment set blob = '' where blob is null;
+alter table attachment alter column blob set not null;
+alter table attachment alter column blob set storage external;
+
+DROP TABLE IF EXISTS attachment_blob;
@@ -0,0 +1,37 @@
+-- +goose Up
+-- data keys are wrapped with the master key, previous versions are kept until everything is re-encrypted
+create table trip_key
+(
+    trip_id       integer     not null references trip (id) on delete cascade,
+    version       integer     not null,
+    master_key_id varchar(16) not null,
+    wrapped_key   bytea       not null,
+    created_at    timestamptz not null default now(),
+    primary key (trip_id, version)
+);
+
+alter table activity add column sensitive boolean not null default false;
+alter table accommodation add column sensitive boolean not null default false;
+
+-- encrypted values are longer than the plain text
+alter table flight_pnr alter column pnr type varchar;
+
+-- encrypted blobs cannot be shared between trips, so they are stored by a key derived from the trip and its data key
+alter table attachment_blob rename column sha256 to blob_key;
+alter table attachment add column blob_key char(64);
+alter table attachment add column key_version integer;
+update attachment set blob_key = sha256;
+alter table attachment alter column blob_key set not null;
+
+-- +goose Down
+-- values that are still encrypted cannot be decrypted anymore after migrating down
+alter table attachment drop column key_version;
+alter table attachment drop column blob_key;
+alter table attachment_blob rename column blob_key to sha256;
+
+alter table flight_pnr alter column pnr type varchar(255);
+
+alter table accommodation drop column sensitive;
+alter table activity drop column sensitive;
+
+DROP TABLE IF EXISTS trip_key;
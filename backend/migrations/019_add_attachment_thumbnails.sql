@@ -0,0 +1,13 @@
+-- +goose Up
+-- thumbnails are small, so they are kept in postgres regardless of the attachment storage backend
+create table attachment_thumbnail
+(
+    attachment_id integer     not null references attachment (id) on delete cascade,
+    size          varchar(16) not null,
+    data          bytea       not null,
+    key_version   integer,
+    primary key (attachment_id, size)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS attachment_thumbnail;
@@ -0,0 +1,220 @@
+-- +goose Up
+-- every change to a trip increments its revision, which is used as the cursor for delta syncs. The row of the trip is
+-- locked until the change is committed, so changes become visible in the order of their revisions.
+create table trip_revision
+(
+    trip_id  integer primary key,
+    revision bigint not null
+);
+
+-- deleted entities are kept as tombstones, so clients that sync later learn about the deletion
+create table tombstone
+(
+    trip_id    integer     not null,
+    kind       varchar(20) not null,
+    entity_id  integer     not null,
+    revision   bigint      not null,
+    deleted_at timestamptz not null default now()
+);
+create index tombstone_trip_revision_idx on tombstone (trip_id, revision);
+
+alter table trip add column revision bigint not null default 1;
+alter table trip add column updated_at timestamptz not null default now();
+alter table transportation add column revision bigint not null default 1;
+alter table transportation add column updated_at timestamptz not null default now();
+alter table activity add column revision bigint not null default 1;
+alter table activity add column updated_at timestamptz not null default now();
+alter table accommodation add column revision bigint not null default 1;
+alter table accommodation add column updated_at timestamptz not null default now();
+alter table expense add column revision bigint not null default 1;
+alter table expense add column updated_at timestamptz not null default now();
+alter table attachment add column revision bigint not null default 1;
+alter table attachment add column updated_at timestamptz not null default now();
+
+insert into trip_revision (trip_id, revision)
+select id, 1
+from trip;
+
+-- +goose statementbegin
+CREATE OR REPLACE FUNCTION next_trip_revision(trip integer)
+RETURNS bigint AS
+$$
+    INSERT INTO trip_revision (trip_id, revision)
+    VALUES ($1, 1)
+    ON CONFLICT (trip_id) DO UPDATE SET revision = trip_revision.revision + 1
+    RETURNING revision;
+$$
+LANGUAGE sql;
+-- +goose statementend
+
+-- +goose statementbegin
+CREATE OR REPLACE FUNCTION touch_trip()
+RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.revision := next_trip_revision(NEW.id);
+    NEW.updated_at := now();
+    RETURN NEW;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose statementend
+
+-- +goose statementbegin
+CREATE OR REPLACE FUNCTION touch_trip_entity()
+RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.revision := next_trip_revision(NEW.trip_id);
+    NEW.updated_at := now();
+    RETURN NEW;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose statementend
+
+-- the kind of the entity is passed as argument of the trigger
+-- +goose statementbegin
+CREATE OR REPLACE FUNCTION bury_trip_entity()
+RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO tombstone (trip_id, kind, entity_id, revision)
+    VALUES (OLD.trip_id, TG_ARGV[0], OLD.id, next_trip_revision(OLD.trip_id));
+    RETURN OLD;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose statementend
+
+-- changes to the details of an entity change the entity itself. The table and the column referencing it are passed
+-- as arguments of the trigger.
+-- +goose statementbegin
+CREATE OR REPLACE FUNCTION touch_parent()
+RETURNS TRIGGER AS
+$$
+DECLARE
+    changed jsonb;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := to_jsonb(OLD);
+    ELSE
+        changed := to_jsonb(NEW);
+    END IF;
+    EXECUTE format('UPDATE %I SET updated_at = now() WHERE id = $1', TG_ARGV[0])
+        USING (changed ->> TG_ARGV[1])::integer;
+    RETURN NULL;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose statementend
+
+-- tombstones and revisions of deleted trips are not needed anymore, including those of the deleted entities
+-- +goose statementbegin
+CREATE OR REPLACE FUNCTION forget_trip()
+RETURNS TRIGGER AS
+$$
+BEGIN
+    DELETE FROM tombstone WHERE trip_id = OLD.id;
+    DELETE FROM trip_revision WHERE trip_id = OLD.id;
+    RETURN OLD;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose statementend
+
+CREATE TRIGGER trip_touch_trigger BEFORE INSERT OR UPDATE ON trip
+    FOR EACH ROW EXECUTE FUNCTION touch_trip();
+CREATE TRIGGER trip_forget_trigger AFTER DELETE ON trip
+    FOR EACH ROW EXECUTE FUNCTION forget_trip();
+
+CREATE TRIGGER transportation_touch_trigger BEFORE INSERT OR UPDATE ON transportation
+    FOR EACH ROW EXECUTE FUNCTION touch_trip_entity();
+CREATE TRIGGER transportation_bury_trigger AFTER DELETE ON transportation
+    FOR EACH ROW EXECUTE FUNCTION bury_trip_entity('transportation');
+CREATE TRIGGER activity_touch_trigger BEFORE INSERT OR UPDATE ON activity
+    FOR EACH ROW EXECUTE FUNCTION touch_trip_entity();
+CREATE TRIGGER activity_bury_trigger AFTER DELETE ON activity
+    FOR EACH ROW EXECUTE FUNCTION bury_trip_entity('activity');
+CREATE TRIGGER accommodation_touch_trigger BEFORE INSERT OR UPDATE ON accommodation
+    FOR EACH ROW EXECUTE FUNCTION touch_trip_entity();
+CREATE TRIGGER accommodation_bury_trigger AFTER DELETE ON accommodation
+    FOR EACH ROW EXECUTE FUNCTION bury_trip_entity('accommodation');
+CREATE TRIGGER expense_touch_trigger BEFORE INSERT OR UPDATE ON expense
+    FOR EACH ROW EXECUTE FUNCTION touch_trip_entity();
+CREATE TRIGGER expense_bury_trigger AFTER DELETE ON expense
+    FOR EACH ROW EXECUTE FUNCTION bury_trip_entity('expense');
+CREATE TRIGGER attachment_touch_trigger BEFORE INSERT OR UPDATE ON attachment
+    FOR EACH ROW EXECUTE FUNCTION touch_trip_entity();
+CREATE TRIGGER attachment_bury_trigger AFTER DELETE ON attachment
+    FOR EACH ROW EXECUTE FUNCTION bury_trip_entity('attachment');
+
+CREATE TRIGGER flight_leg_touch_trigger AFTER INSERT OR UPDATE OR DELETE ON flight_leg
+    FOR EACH ROW EXECUTE FUNCTION touch_parent('transportation', 'transportation_id');
+CREATE TRIGGER flight_pnr_touch_trigger AFTER INSERT OR UPDATE OR DELETE ON flight_pnr
+    FOR EACH ROW EXECUTE FUNCTION touch_parent('transportation', 'transportation_id');
+CREATE TRIGGER train_detail_touch_trigger AFTER INSERT OR UPDATE OR DELETE ON train_detail
+    FOR EACH ROW EXECUTE FUNCTION touch_parent('transportation', 'transportation_id');
+CREATE TRIGGER train_leg_touch_trigger AFTER INSERT OR UPDATE OR DELETE ON train_leg
+    FOR EACH ROW EXECUTE FUNCTION touch_parent('transportation', 'transportation_id');
+CREATE TRIGGER transportation_generic_touch_trigger AFTER INSERT OR UPDATE OR DELETE ON transportation_generic
+    FOR EACH ROW EXECUTE FUNCTION touch_parent('transportation', 'transportation_id');
+CREATE TRIGGER transportation_track_touch_trigger AFTER INSERT OR UPDATE OR DELETE ON transportation_track
+    FOR EACH ROW EXECUTE FUNCTION touch_parent('transportation', 'transportation_id');
+CREATE TRIGGER attachment_activity_touch_trigger AFTER INSERT OR UPDATE OR DELETE ON attachment_activity
+    FOR EACH ROW EXECUTE FUNCTION touch_parent('activity', 'activity_id');
+CREATE TRIGGER attachment_accommodation_touch_trigger AFTER INSERT OR UPDATE OR DELETE ON attachment_accommodation
+    FOR EACH ROW EXECUTE FUNCTION touch_parent('accommodation', 'accommodation_id');
+CREATE TRIGGER attachment_transportation_touch_trigger AFTER INSERT OR UPDATE OR DELETE ON attachment_transportation
+    FOR EACH ROW EXECUTE FUNCTION touch_parent('transportation', 'transportation_id');
+CREATE TRIGGER expense_participant_touch_trigger AFTER INSERT OR UPDATE OR DELETE ON expense_participant
+    FOR EACH ROW EXECUTE FUNCTION touch_parent('expense', 'expense_id');
+CREATE TRIGGER category_budget_touch_trigger AFTER INSERT OR UPDATE OR DELETE ON category_budget
+    FOR EACH ROW EXECUTE FUNCTION touch_parent('trip', 'trip_id');
+
+-- +goose Down
+DROP TRIGGER IF EXISTS category_budget_touch_trigger ON category_budget;
+DROP TRIGGER IF EXISTS expense_participant_touch_trigger ON expense_participant;
+DROP TRIGGER IF EXISTS attachment_transportation_touch_trigger ON attachment_transportation;
+DROP TRIGGER IF EXISTS attachment_accommodation_touch_trigger ON attachment_accommodation;
+DROP TRIGGER IF EXISTS attachment_activity_touch_trigger ON attachment_activity;
+DROP TRIGGER IF EXISTS transportation_track_touch_trigger ON transportation_track;
+DROP TRIGGER IF EXISTS transportation_generic_touch_trigger ON transportation_generic;
+DROP TRIGGER IF EXISTS train_leg_touch_trigger ON train_leg;
+DROP TRIGGER IF EXISTS train_detail_touch_trigger ON train_detail;
+DROP TRIGGER IF EXISTS flight_pnr_touch_trigger ON flight_pnr;
+DROP TRIGGER IF EXISTS flight_leg_touch_trigger ON flight_leg;
+DROP TRIGGER IF EXISTS attachment_bury_trigger ON attachment;
+DROP TRIGGER IF EXISTS attachment_touch_trigger ON attachment;
+DROP TRIGGER IF EXISTS expense_bury_trigger ON expense;
+DROP TRIGGER IF EXISTS expense_touch_trigger ON expense;
+DROP TRIGGER IF EXISTS accommodation_bury_trigger ON accommodation;
+DROP TRIGGER IF EXISTS accommodation_touch_trigger ON accommodation;
+DROP TRIGGER IF EXISTS activity_bury_trigger ON activity;
+DROP TRIGGER IF EXISTS activity_touch_trigger ON activity;
+DROP TRIGGER IF EXISTS transportation_bury_trigger ON transportation;
+DROP TRIGGER IF EXISTS transportation_touch_trigger ON transportation;
+DROP TRIGGER IF EXISTS trip_forget_trigger ON trip;
+DROP TRIGGER IF EXISTS trip_touch_trigger ON trip;
+DROP FUNCTION IF EXISTS forget_trip;
+DROP FUNCTION IF EXISTS touch_parent;
+DROP FUNCTION IF EXISTS bury_trip_entity;
+DROP FUNCTION IF EXISTS touch_trip_entity;
+DROP FUNCTION IF EXISTS touch_trip;
+DROP FUNCTION IF EXISTS next_trip_revision;
+
+alter table attachment drop column updated_at;
+alter table attachment drop column revision;
+alter table expense drop column updated_at;
+alter table expense drop column revision;
+alter table accommodation drop column updated_at;
+alter table accommodation drop column revision;
+alter table activity drop column updated_at;
+alter table activity drop column revision;
+alter table transportation drop column updated_at;
+alter table transportation drop column revision;
+alter table trip drop column updated_at;
+alter table trip drop column revision;
+
+DROP TABLE IF EXISTS tombstone;
+DROP TABLE IF EXISTS trip_revision;
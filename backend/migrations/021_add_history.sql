@@ -0,0 +1,18 @@
+-- +goose Up
+-- snapshots are entities as returned by the API, encrypted with the data key of the trip if encryption is configured
+create table history
+(
+    id         serial primary key,
+    trip_id    integer     not null references trip (id) on delete cascade,
+    user_id    integer references "user" (id) on delete set null,
+    kind       varchar(20) not null,
+    entity_id  integer     not null,
+    change     varchar(10) not null,
+    before     text,
+    after      text,
+    created_at timestamptz not null default now()
+);
+create index history_trip_idx on history (trip_id, kind, entity_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS history;
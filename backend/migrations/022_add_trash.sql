@@ -0,0 +1,89 @@
+-- +goose Up
+-- deleted trips and entities are kept in the trash until they are restored or purged after the retention period
+alter table trip add column deleted_at timestamptz;
+alter table transportation add column deleted_at timestamptz;
+alter table activity add column deleted_at timestamptz;
+alter table accommodation add column deleted_at timestamptz;
+alter table expense add column deleted_at timestamptz;
+alter table attachment add column deleted_at timestamptz;
+
+create index trip_deleted_idx on trip (deleted_at) where deleted_at is not null;
+create index transportation_deleted_idx on transportation (deleted_at) where deleted_at is not null;
+create index activity_deleted_idx on activity (deleted_at) where deleted_at is not null;
+create index accommodation_deleted_idx on accommodation (deleted_at) where deleted_at is not null;
+create index expense_deleted_idx on expense (deleted_at) where deleted_at is not null;
+create index attachment_deleted_idx on attachment (deleted_at) where deleted_at is not null;
+
+-- for delta syncs, moving an entity to the trash deletes it and restoring it creates it again. The revision was already
+-- incremented by the touch trigger.
+-- +goose statementbegin
+CREATE OR REPLACE FUNCTION trash_trip_entity()
+RETURNS TRIGGER AS
+$$
+BEGIN
+    IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        INSERT INTO tombstone (trip_id, kind, entity_id, revision)
+        VALUES (NEW.trip_id, TG_ARGV[0], NEW.id, NEW.revision);
+    ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        DELETE FROM tombstone WHERE trip_id = NEW.trip_id AND kind = TG_ARGV[0] AND entity_id = NEW.id;
+    END IF;
+    RETURN NULL;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose statementend
+
+-- entities purged from the trash already have a tombstone
+-- +goose statementbegin
+CREATE OR REPLACE FUNCTION bury_trip_entity()
+RETURNS TRIGGER AS
+$$
+BEGIN
+    IF OLD.deleted_at IS NULL THEN
+        INSERT INTO tombstone (trip_id, kind, entity_id, revision)
+        VALUES (OLD.trip_id, TG_ARGV[0], OLD.id, next_trip_revision(OLD.trip_id));
+    END IF;
+    RETURN OLD;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose statementend
+
+CREATE TRIGGER transportation_trash_trigger AFTER UPDATE OF deleted_at ON transportation
+    FOR EACH ROW EXECUTE FUNCTION trash_trip_entity('transportation');
+CREATE TRIGGER activity_trash_trigger AFTER UPDATE OF deleted_at ON activity
+    FOR EACH ROW EXECUTE FUNCTION trash_trip_entity('activity');
+CREATE TRIGGER accommodation_trash_trigger AFTER UPDATE OF deleted_at ON accommodation
+    FOR EACH ROW EXECUTE FUNCTION trash_trip_entity('accommodation');
+CREATE TRIGGER expense_trash_trigger AFTER UPDATE OF deleted_at ON expense
+    FOR EACH ROW EXECUTE FUNCTION trash_trip_entity('expense');
+CREATE TRIGGER attachment_trash_trigger AFTER UPDATE OF deleted_at ON attachment
+    FOR EACH ROW EXECUTE FUNCTION trash_trip_entity('attachment');
+
+-- +goose Down
+DROP TRIGGER IF EXISTS attachment_trash_trigger ON attachment;
+DROP TRIGGER IF EXISTS expense_trash_trigger ON expense;
+DROP TRIGGER IF EXISTS accommodation_trash_trigger ON accommodation;
+DROP TRIGGER IF EXISTS activity_trash_trigger ON activity;
+DROP TRIGGER IF EXISTS transportation_trash_trigger ON transportation;
+DROP FUNCTION IF EXISTS trash_trip_entity;
+
+-- +goose statementbegin
+CREATE OR REPLACE FUNCTION bury_trip_entity()
+RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO tombstone (trip_id, kind, entity_id, revision)
+    VALUES (OLD.trip_id, TG_ARGV[0], OLD.id, next_trip_revision(OLD.trip_id));
+    RETURN OLD;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose statementend
+
+alter table attachment drop column deleted_at;
+alter table expense drop column deleted_at;
+alter table accommodation drop column deleted_at;
+alter table activity drop column deleted_at;
+alter table transportation drop column deleted_at;
+alter table trip drop column deleted_at;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- templates are trips that any user can copy
+alter table trip add column template boolean not null default false;
+create index trip_template_idx on trip (template) where template;
+
+-- +goose Down
+alter table trip drop column template;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- cabin class of the booking, used for emission estimates
+alter table flight_leg add column cabin_class varchar(255);
+
+-- +goose Down
+alter table flight_leg drop column cabin_class;
@@ -0,0 +1,31 @@
+-- +goose Up
+-- blobs are stored as one row per chunk, so that writing a chunk does not rewrite everything written before
+create table attachment_blob_chunk
+(
+    blob_key char(64) not null references attachment_blob (blob_key) on delete cascade,
+    start    bigint   not null,
+    data     bytea    not null,
+    primary key (blob_key, start)
+);
+
+insert into attachment_blob_chunk (blob_key, start, data)
+select blob_key, chunk * 4194304, substring(data from chunk * 4194304 + 1 for 4194304)
+from attachment_blob,
+     generate_series(0, (length(data) - 1) / 4194304) as chunk
+where length(data) > 0;
+
+alter table attachment_blob drop column data;
+
+-- +goose Down
+alter table attachment_blob add column data bytea not null default '';
+alter table attachment_blob alter column data drop default;
+alter table attachment_blob alter column data set storage external;
+
+update attachment_blob
+set data = chunks.data
+from (select blob_key, string_agg(data, '' order by start) as data
+      from attachment_blob_chunk
+      group by blob_key) as chunks
+where chunks.blob_key = attachment_blob.blob_key;
+
+DROP TABLE IF EXISTS attachment_blob_chunk;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- creations pushed by clients may carry an id chosen by the client, so that a batch pushed again after its response
+-- was lost does not create the entities twice. entity_id is null while the entity is being created.
+create table sync_creation
+(
+    trip_id    integer      not null references trip on delete cascade,
+    client_id  varchar(100) not null,
+    kind       varchar(20)  not null,
+    entity_id  integer,
+    created_at timestamptz  not null default now(),
+    primary key (trip_id, client_id)
+);
+
+-- +goose Down
+drop table sync_creation;
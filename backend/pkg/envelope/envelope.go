@@ -0,0 +1,126 @@
+// Package envelope implements envelope encryption. Data is encrypted with data keys, which are stored encrypted
+// ("wrapped") with a master key, so that rotating the master key only requires re-wrapping the data keys.
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// KeySize is the size of master and data keys, which are used for AES-256-GCM.
+const KeySize = 32
+
+var ErrUnknownMasterKey = errors.New("unknown master key")
+
+// Keyring holds the master keys. The current key wraps new data keys, previous keys are only used to unwrap data keys
+// that have not been re-wrapped yet.
+type Keyring struct {
+	current string
+	keys    map[string]cipher.AEAD
+}
+
+// NewKeyring parses base64-encoded master keys.
+func NewKeyring(current string, previous ...string) (*Keyring, error) {
+	k := &Keyring{keys: map[string]cipher.AEAD{}}
+	for i, encoded := range append([]string{current}, previous...) {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("decode master key %d: %w", i, err)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("master key %d: %w", i, err)
+		}
+
+		id := keyID(key)
+		k.keys[id] = aead
+		if i == 0 {
+			k.current = id
+		}
+	}
+	return k, nil
+}
+
+// CurrentID identifies the master key that wraps new data keys.
+func (k *Keyring) CurrentID() string {
+	return k.current
+}
+
+// Wrap encrypts a data key with the current master key.
+func (k *Keyring) Wrap(dataKey []byte) (string, []byte, error) {
+	wrapped, err := seal(k.keys[k.current], dataKey)
+	return k.current, wrapped, err
+}
+
+// Unwrap decrypts a data key with the master key it was wrapped with.
+func (k *Keyring) Unwrap(masterKeyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := k.keys[masterKeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMasterKey, masterKeyID)
+	}
+	return open(aead, wrapped)
+}
+
+func NewDataKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generate data key: %w", err)
+	}
+	return key, nil
+}
+
+// Seal encrypts a small value with a data key. The result starts with the random nonce.
+func Seal(dataKey []byte, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return seal(aead, plaintext)
+}
+
+// Open decrypts a value encrypted with Seal.
+func Open(dataKey []byte, sealed []byte) ([]byte, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return open(aead, sealed)
+}
+
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(aead cipher.AEAD, sealed []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("sealed value too short")
+	}
+	return aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("key must be %d bytes, got %d", KeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// keyID is derived from the key, so that configuring the same key again yields the same id.
+func keyID(key []byte) string {
+	hash := sha256.Sum256(key)
+	return hex.EncodeToString(hash[:8])
+}
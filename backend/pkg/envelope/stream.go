@@ -0,0 +1,195 @@
+package envelope
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Streams are encrypted in segments, so that they can be decrypted from any offset. A sealed stream consists of a
+// random nonce prefix followed by the sealed segments. The nonce of a segment is the prefix followed by its index, and
+// the last segment is authenticated as such, which detects truncation.
+const (
+	segmentSize  = 64 * 1024
+	prefixSize   = 8
+	overheadSize = 16
+)
+
+// SealedSize returns the size of a sealed stream of size bytes.
+func SealedSize(size int64) int64 {
+	return prefixSize + size + segments(size)*overheadSize
+}
+
+func segments(size int64) int64 {
+	return max(1, (size+segmentSize-1)/segmentSize)
+}
+
+func segmentNonce(prefix []byte, index int64) []byte {
+	nonce := make([]byte, prefixSize+4)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[prefixSize:], uint32(index))
+	return nonce
+}
+
+func segmentAD(last bool) []byte {
+	if last {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+type sealingReader struct {
+	aead    cipher.AEAD
+	prefix  []byte
+	src     io.Reader
+	index   int64
+	current []byte
+	eof     bool
+	out     []byte
+	done    bool
+}
+
+// NewSealingReader encrypts plaintext with a data key while it is read.
+func NewSealingReader(dataKey []byte, plaintext io.Reader) (io.Reader, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	prefix := make([]byte, prefixSize)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, fmt.Errorf("generate nonce prefix: %w", err)
+	}
+
+	s := &sealingReader{aead: aead, prefix: prefix, src: plaintext, out: prefix}
+	s.current, s.eof, err = readSegment(plaintext)
+	return s, err
+}
+
+func (s *sealingReader) Read(p []byte) (int, error) {
+	for len(s.out) == 0 {
+		if s.done {
+			return 0, io.EOF
+		}
+
+		// the next segment is read ahead to know whether the current one is the last
+		last := s.eof
+		var next []byte
+		var nextEOF bool
+		if !s.eof {
+			var err error
+			next, nextEOF, err = readSegment(s.src)
+			if err != nil {
+				return 0, err
+			}
+			last = len(next) == 0 && nextEOF
+		}
+
+		s.out = s.aead.Seal(nil, segmentNonce(s.prefix, s.index), s.current, segmentAD(last))
+		s.index++
+		if last {
+			s.done = true
+		} else {
+			s.current, s.eof = next, nextEOF
+		}
+	}
+
+	n := copy(p, s.out)
+	s.out = s.out[n:]
+	return n, nil
+}
+
+func readSegment(r io.Reader) ([]byte, bool, error) {
+	segment := make([]byte, segmentSize)
+	n, err := io.ReadFull(r, segment)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return segment[:n], true, nil
+	}
+	return segment[:n], false, err
+}
+
+type openingReader struct {
+	aead    cipher.AEAD
+	src     io.ReadSeekCloser
+	size    int64
+	prefix  []byte
+	offset  int64
+	segment []byte
+	index   int64
+}
+
+// NewOpeningReader decrypts a sealed stream of a plaintext of size bytes. Seeking only decrypts the segment that
+// contains the new offset.
+func NewOpeningReader(dataKey []byte, sealed io.ReadSeekCloser, size int64) (io.ReadSeekCloser, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	prefix := make([]byte, prefixSize)
+	if _, err := io.ReadFull(sealed, prefix); err != nil {
+		return nil, fmt.Errorf("read nonce prefix: %w", err)
+	}
+	return &openingReader{aead: aead, src: sealed, size: size, prefix: prefix, index: -1}, nil
+}
+
+func (o *openingReader) Read(p []byte) (int, error) {
+	if o.offset >= o.size {
+		return 0, io.EOF
+	}
+
+	index := o.offset / segmentSize
+	if index != o.index {
+		if err := o.openSegment(index); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, o.segment[o.offset-index*segmentSize:])
+	o.offset += int64(n)
+	return n, nil
+}
+
+func (o *openingReader) openSegment(index int64) error {
+	plaintextLength := min(segmentSize, o.size-index*segmentSize)
+	if _, err := o.src.Seek(prefixSize+index*(segmentSize+overheadSize), io.SeekStart); err != nil {
+		return fmt.Errorf("seek segment %d: %w", index, err)
+	}
+	sealed := make([]byte, plaintextLength+overheadSize)
+	if _, err := io.ReadFull(o.src, sealed); err != nil {
+		return fmt.Errorf("read segment %d: %w", index, err)
+	}
+
+	last := index == segments(o.size)-1
+	segment, err := o.aead.Open(nil, segmentNonce(o.prefix, index), sealed, segmentAD(last))
+	if err != nil {
+		return fmt.Errorf("open segment %d: %w", index, err)
+	}
+
+	o.segment = segment
+	o.index = index
+	return nil
+}
+
+func (o *openingReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += o.offset
+	case io.SeekEnd:
+		offset += o.size
+	default:
+		return 0, errors.New("seek: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("seek: negative position")
+	}
+
+	o.offset = offset
+	return offset, nil
+}
+
+func (o *openingReader) Close() error {
+	return o.src.Close()
+}
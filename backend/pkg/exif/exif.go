@@ -0,0 +1,162 @@
+// Package exif reads Exif metadata from JPEG, PNG, WebP and HEIC images without decoding them.
+package exif
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/civil"
+)
+
+// maxSize limits the Exif data that is read, JPEG files cannot have more than 64 KiB anyway.
+const maxSize = 1 << 20
+
+var ErrNotFound = errors.New("no exif data")
+
+// Exif is the subset of the metadata that is used by kompass.
+type Exif struct {
+	// Orientation is how the image has to be transformed to be displayed upright, from 1 (unchanged) to 8
+	Orientation int
+	// Time is when the photo was taken in the local time of the camera
+	Time *civil.DateTime
+	// Latitude and Longitude are only set for geotagged photos
+	Latitude  *float64
+	Longitude *float64
+}
+
+// Decode reads the Exif data of an image. Images without it return ErrNotFound.
+func Decode(r io.ReadSeeker) (Exif, error) {
+	data, err := find(r)
+	if err != nil {
+		return Exif{}, err
+	}
+	return parse(data)
+}
+
+func find(rs io.ReadSeeker) ([]byte, error) {
+	r := bufio.NewReader(rs)
+	magic, err := r.Peek(12)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	switch {
+	case IsHEIF(magic):
+		if _, err := rs.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		return findHEIF(rs)
+	case bytes.HasPrefix(magic, []byte{0xff, 0xd8}):
+		return findJPEG(r)
+	case bytes.HasPrefix(magic, []byte("\x89PNG\r\n\x1a\n")):
+		return findPNG(r)
+	case bytes.Equal(magic[:4], []byte("RIFF")) && bytes.Equal(magic[8:12], []byte("WEBP")):
+		return findWebP(r)
+	default:
+		return nil, ErrNotFound
+	}
+}
+
+// findJPEG looks for an APP1 segment starting with the Exif header before the image data.
+func findJPEG(r *bufio.Reader) ([]byte, error) {
+	if _, err := r.Discard(2); err != nil {
+		return nil, err
+	}
+
+	for {
+		var marker [2]byte
+		if _, err := io.ReadFull(r, marker[:]); err != nil {
+			return nil, ErrNotFound
+		}
+		if marker[0] != 0xff {
+			return nil, fmt.Errorf("invalid jpeg marker %x", marker)
+		}
+		// start of scan, the metadata segments are over
+		if marker[1] == 0xda {
+			return nil, ErrNotFound
+		}
+
+		var length uint16
+		if err := binary.Read(r, binary.BigEndian, &length); err != nil || length < 2 {
+			return nil, ErrNotFound
+		}
+		segment := make([]byte, length-2)
+		if _, err := io.ReadFull(r, segment); err != nil {
+			return nil, ErrNotFound
+		}
+
+		if marker[1] == 0xe1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return segment[6:], nil
+		}
+	}
+}
+
+// findPNG looks for an eXIf chunk, which has to come before the image data.
+func findPNG(r *bufio.Reader) ([]byte, error) {
+	if _, err := r.Discard(8); err != nil {
+		return nil, err
+	}
+
+	for {
+		var header struct {
+			Length uint32
+			Type   [4]byte
+		}
+		if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+			return nil, ErrNotFound
+		}
+
+		switch string(header.Type[:]) {
+		case "eXIf":
+			return readChunk(r, int64(header.Length))
+		case "IDAT", "IEND":
+			return nil, ErrNotFound
+		}
+		// skip the data and the crc
+		if _, err := io.CopyN(io.Discard, r, int64(header.Length)+4); err != nil {
+			return nil, ErrNotFound
+		}
+	}
+}
+
+// findWebP looks for the EXIF chunk of an extended WebP file, which comes after the image data.
+func findWebP(r *bufio.Reader) ([]byte, error) {
+	if _, err := r.Discard(12); err != nil {
+		return nil, err
+	}
+
+	for {
+		var header struct {
+			Type   [4]byte
+			Length uint32
+		}
+		if err := binary.Read(r, binary.LittleEndian, &header); err != nil {
+			return nil, ErrNotFound
+		}
+		if string(header.Type[:]) == "EXIF" {
+			data, err := readChunk(r, int64(header.Length))
+			// some writers include the header of the JPEG segment
+			return bytes.TrimPrefix(data, []byte("Exif\x00\x00")), err
+		}
+
+		// chunks are padded to an even length
+		if _, err := io.CopyN(io.Discard, r, int64(header.Length+header.Length%2)); err != nil {
+			return nil, ErrNotFound
+		}
+	}
+}
+
+func readChunk(r io.Reader, length int64) ([]byte, error) {
+	if length > maxSize {
+		return nil, fmt.Errorf("exif data too large: %d bytes", length)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, ErrNotFound
+	}
+	return data, nil
+}
@@ -0,0 +1,243 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// HEIF files (like HEIC photos) consist of boxes. The Exif data is an item, which is listed in the item info box and
+// located through the item location box of the meta box.
+const maxMetaSize = 4 << 20
+
+var heifBrands = [][]byte{[]byte("heic"), []byte("heix"), []byte("heim"), []byte("heis"), []byte("mif1")}
+
+// IsHEIF reports whether the first 12 bytes of a file are the file type box of a HEIF image.
+func IsHEIF(head []byte) bool {
+	if len(head) < 12 || !bytes.Equal(head[4:8], []byte("ftyp")) {
+		return false
+	}
+	for _, brand := range heifBrands {
+		if bytes.Equal(head[8:12], brand) {
+			return true
+		}
+	}
+	return false
+}
+
+type box struct {
+	typ  string
+	data []byte
+}
+
+func findHEIF(r io.ReadSeeker) ([]byte, error) {
+	meta, err := findMetaBox(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// meta is a full box, which starts with version and flags
+	if len(meta) < 4 {
+		return nil, errInvalid
+	}
+	var itemInfo, itemLocation []byte
+	for _, child := range parseBoxes(meta[4:]) {
+		switch child.typ {
+		case "iinf":
+			itemInfo = child.data
+		case "iloc":
+			itemLocation = child.data
+		}
+	}
+
+	itemID, ok := findExifItem(itemInfo)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	offset, length, ok := locateItem(itemLocation, itemID)
+	if !ok || length < 4 {
+		return nil, ErrNotFound
+	}
+
+	if _, err := r.Seek(int64(offset), io.SeekStart); err != nil {
+		return nil, fmt.Errorf("seek exif item: %w", err)
+	}
+	item, err := readChunk(r, int64(length))
+	if err != nil {
+		return nil, err
+	}
+
+	// the item starts with the offset of the tiff header
+	headerOffset := uint64(binary.BigEndian.Uint32(item)) + 4
+	if headerOffset >= uint64(len(item)) {
+		return nil, errInvalid
+	}
+	return item[headerOffset:], nil
+}
+
+func findMetaBox(r io.Reader) ([]byte, error) {
+	for {
+		var header struct {
+			Size uint32
+			Type [4]byte
+		}
+		if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+			return nil, ErrNotFound
+		}
+
+		size := uint64(header.Size)
+		headerSize := uint64(8)
+		if size == 1 {
+			if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+				return nil, ErrNotFound
+			}
+			headerSize = 16
+		}
+		if size < headerSize {
+			// a size of zero means that the box extends to the end of the file
+			return nil, ErrNotFound
+		}
+
+		if string(header.Type[:]) == "meta" {
+			if size-headerSize > maxMetaSize {
+				return nil, fmt.Errorf("meta box too large: %d bytes", size)
+			}
+			meta := make([]byte, size-headerSize)
+			if _, err := io.ReadFull(r, meta); err != nil {
+				return nil, ErrNotFound
+			}
+			return meta, nil
+		}
+		if _, err := io.CopyN(io.Discard, r, int64(size-headerSize)); err != nil {
+			return nil, ErrNotFound
+		}
+	}
+}
+
+func parseBoxes(data []byte) []box {
+	var boxes []box
+	for len(data) >= 8 {
+		size := uint64(binary.BigEndian.Uint32(data))
+		headerSize := uint64(8)
+		if size == 1 && len(data) >= 16 {
+			size = binary.BigEndian.Uint64(data[8:])
+			headerSize = 16
+		} else if size == 0 {
+			size = uint64(len(data))
+		}
+		if size < headerSize || size > uint64(len(data)) {
+			break
+		}
+
+		boxes = append(boxes, box{typ: string(data[4:8]), data: data[headerSize:size]})
+		data = data[size:]
+	}
+	return boxes
+}
+
+// findExifItem returns the id of the Exif item from the item info box.
+func findExifItem(itemInfo []byte) (uint32, bool) {
+	if len(itemInfo) < 6 {
+		return 0, false
+	}
+	entries := itemInfo[6:]
+	if itemInfo[0] != 0 {
+		if len(itemInfo) < 8 {
+			return 0, false
+		}
+		entries = itemInfo[8:]
+	}
+
+	for _, entry := range parseBoxes(entries) {
+		data := entry.data
+		if entry.typ != "infe" || len(data) < 4 {
+			continue
+		}
+
+		// item info entries before version 2 do not have an item type
+		switch version := data[0]; {
+		case version == 2 && len(data) >= 12:
+			if string(data[8:12]) == "Exif" {
+				return uint32(binary.BigEndian.Uint16(data[4:])), true
+			}
+		case version == 3 && len(data) >= 14:
+			if string(data[10:14]) == "Exif" {
+				return binary.BigEndian.Uint32(data[4:]), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// locateItem returns the file offset and length of the first extent of an item from the item location box.
+func locateItem(itemLocation []byte, itemID uint32) (uint64, uint64, bool) {
+	r := &fieldReader{data: itemLocation}
+	version := r.uint(1)
+	r.uint(3)
+	sizes := r.uint(1)
+	offsetSize, lengthSize := int(sizes>>4), int(sizes&0xf)
+	sizes = r.uint(1)
+	baseOffsetSize, indexSize := int(sizes>>4), int(sizes&0xf)
+	if version < 1 {
+		indexSize = 0
+	}
+
+	fieldSize := 2
+	if version == 2 {
+		fieldSize = 4
+	}
+
+	itemCount := r.uint(fieldSize)
+
+	for range itemCount {
+		id := r.uint(fieldSize)
+		constructionMethod := uint64(0)
+		if version >= 1 {
+			constructionMethod = r.uint(2) & 0xf
+		}
+		r.uint(2) // data reference index
+		baseOffset := r.uint(baseOffsetSize)
+
+		extentCount := r.uint(2)
+		var offset, length uint64
+		for i := range extentCount {
+			r.uint(indexSize)
+			extentOffset, extentLength := r.uint(offsetSize), r.uint(lengthSize)
+			if i == 0 {
+				offset, length = baseOffset+extentOffset, extentLength
+			}
+		}
+
+		if r.err != nil {
+			return 0, 0, false
+		}
+		// only items stored at a file offset are supported, not those in the item data box
+		if uint32(id) == itemID {
+			return offset, length, constructionMethod == 0 && extentCount > 0
+		}
+	}
+	return 0, 0, false
+}
+
+// fieldReader reads big-endian integers of 0 to 8 bytes and remembers if the data was too short.
+type fieldReader struct {
+	data []byte
+	err  error
+}
+
+func (r *fieldReader) uint(size int) uint64 {
+	if size > len(r.data) {
+		r.err = errors.New("unexpected end of box")
+		r.data = nil
+		return 0
+	}
+
+	var value uint64
+	for _, b := range r.data[:size] {
+		value = value<<8 | uint64(b)
+	}
+	r.data = r.data[size:]
+	return value
+}
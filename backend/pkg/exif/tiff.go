@@ -0,0 +1,213 @@
+package exif
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+)
+
+// Exif data is structured like a TIFF file: a header followed by image file directories (IFDs) of tagged values.
+const (
+	tagOrientation      = 0x0112
+	tagDateTime         = 0x0132
+	tagExifIFD          = 0x8769
+	tagGPSIFD           = 0x8825
+	tagDateTimeOriginal = 0x9003
+
+	tagGPSLatitudeRef  = 0x0001
+	tagGPSLatitude     = 0x0002
+	tagGPSLongitudeRef = 0x0003
+	tagGPSLongitude    = 0x0004
+
+	typeASCII    = 2
+	typeShort    = 3
+	typeLong     = 4
+	typeRational = 5
+)
+
+// dateTimeLayout is the format of Exif timestamps, which do not include a timezone.
+const dateTimeLayout = "2006:01:02 15:04:05"
+
+var errInvalid = errors.New("invalid exif data")
+
+type tiff struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+type entry struct {
+	typ   uint16
+	count uint32
+	// value holds the value itself if it fits into four bytes, otherwise its offset
+	value []byte
+}
+
+func parse(data []byte) (Exif, error) {
+	t, ifd0, err := newTiff(data)
+	if err != nil {
+		return Exif{}, err
+	}
+	entries, err := t.ifd(ifd0)
+	if err != nil {
+		return Exif{}, err
+	}
+
+	result := Exif{Orientation: 1}
+	if orientation, ok := t.uint(entries[tagOrientation]); ok && orientation >= 1 && orientation <= 8 {
+		result.Orientation = int(orientation)
+	}
+
+	// the time the photo was taken is preferred over the time it was last modified
+	result.Time = t.dateTime(entries[tagDateTime])
+	if offset, ok := t.uint(entries[tagExifIFD]); ok {
+		if exifEntries, err := t.ifd(offset); err == nil {
+			if original := t.dateTime(exifEntries[tagDateTimeOriginal]); original != nil {
+				result.Time = original
+			}
+		}
+	}
+
+	if offset, ok := t.uint(entries[tagGPSIFD]); ok {
+		if gpsEntries, err := t.ifd(offset); err == nil {
+			result.Latitude = t.coordinate(gpsEntries[tagGPSLatitude], gpsEntries[tagGPSLatitudeRef], "S")
+			result.Longitude = t.coordinate(gpsEntries[tagGPSLongitude], gpsEntries[tagGPSLongitudeRef], "W")
+			if result.Latitude == nil || result.Longitude == nil {
+				result.Latitude, result.Longitude = nil, nil
+			}
+		}
+	}
+	return result, nil
+}
+
+func newTiff(data []byte) (tiff, uint32, error) {
+	if len(data) < 8 {
+		return tiff{}, 0, errInvalid
+	}
+
+	var order binary.ByteOrder
+	switch string(data[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return tiff{}, 0, errInvalid
+	}
+	if order.Uint16(data[2:4]) != 42 {
+		return tiff{}, 0, errInvalid
+	}
+
+	return tiff{data, order}, order.Uint32(data[4:8]), nil
+}
+
+func (t tiff) ifd(offset uint32) (map[uint16]entry, error) {
+	if uint64(offset)+2 > uint64(len(t.data)) {
+		return nil, fmt.Errorf("%w: ifd offset %d out of range", errInvalid, offset)
+	}
+	count := int(t.order.Uint16(t.data[offset:]))
+	if uint64(offset)+2+uint64(count)*12 > uint64(len(t.data)) {
+		return nil, fmt.Errorf("%w: ifd at %d truncated", errInvalid, offset)
+	}
+
+	entries := map[uint16]entry{}
+	for i := range count {
+		raw := t.data[int(offset)+2+i*12:]
+		entries[t.order.Uint16(raw)] = entry{
+			typ:   t.order.Uint16(raw[2:]),
+			count: t.order.Uint32(raw[4:]),
+			value: raw[8:12],
+		}
+	}
+	return entries, nil
+}
+
+// uint reads a single SHORT or LONG value.
+func (t tiff) uint(e entry) (uint32, bool) {
+	if e.count != 1 {
+		return 0, false
+	}
+	switch e.typ {
+	case typeShort:
+		return uint32(t.order.Uint16(e.value)), true
+	case typeLong:
+		return t.order.Uint32(e.value), true
+	default:
+		return 0, false
+	}
+}
+
+// bytes returns the raw value, which is stored at an offset if it does not fit into the entry.
+func (t tiff) bytes(e entry) ([]byte, bool) {
+	var size uint64
+	switch e.typ {
+	case typeASCII:
+		size = 1
+	case typeShort:
+		size = 2
+	case typeLong:
+		size = 4
+	case typeRational:
+		size = 8
+	default:
+		return nil, false
+	}
+
+	length := size * uint64(e.count)
+	if length <= 4 {
+		return e.value[:length], true
+	}
+	offset := uint64(t.order.Uint32(e.value))
+	if offset+length > uint64(len(t.data)) {
+		return nil, false
+	}
+	return t.data[offset : offset+length], true
+}
+
+func (t tiff) string(e entry) string {
+	if e.typ != typeASCII {
+		return ""
+	}
+	value, _ := t.bytes(e)
+	return strings.TrimRight(string(value), "\x00 ")
+}
+
+func (t tiff) dateTime(e entry) *civil.DateTime {
+	parsed, err := time.Parse(dateTimeLayout, t.string(e))
+	if err != nil {
+		return nil
+	}
+	dateTime := civil.DateTimeOf(parsed)
+	return &dateTime
+}
+
+// coordinate converts degrees, minutes and seconds to decimal degrees, which are negative for the southern and
+// western hemispheres.
+func (t tiff) coordinate(e entry, ref entry, negativeRef string) *float64 {
+	if e.typ != typeRational || e.count != 3 {
+		return nil
+	}
+	value, ok := t.bytes(e)
+	if !ok {
+		return nil
+	}
+
+	var parts [3]float64
+	for i := range parts {
+		numerator := t.order.Uint32(value[i*8:])
+		denominator := t.order.Uint32(value[i*8+4:])
+		if denominator == 0 {
+			return nil
+		}
+		parts[i] = float64(numerator) / float64(denominator)
+	}
+
+	degrees := parts[0] + parts[1]/60 + parts[2]/3600
+	if t.string(ref) == negativeRef {
+		degrees = -degrees
+	}
+	return &degrees
+}
@@ -3,6 +3,7 @@ package httpserver
 import (
 	"github.com/gofiber/fiber/v2"
 	"net"
+	"strings"
 	"time"
 )
 
@@ -50,3 +51,21 @@ func ErrorHandler(errorHandler fiber.ErrorHandler) Option {
 		s.errorHandler = errorHandler
 	}
 }
+
+// BodyLimit -.
+func BodyLimit(limit int) Option {
+	return func(s *Server) {
+		s.bodyLimit = limit
+	}
+}
+
+// Route overrides the server configuration for requests to method and path, a "*" in path matches a single segment.
+func Route(method string, path string, config RouteConfig) Option {
+	return func(s *Server) {
+		s.routes = append(s.routes, route{
+			method:   method,
+			segments: strings.Split(strings.Trim(path, "/"), "/"),
+			config:   config,
+		})
+	}
+}
@@ -2,10 +2,15 @@
 package httpserver
 
 import (
+	"bytes"
+	"io"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/goccy/go-json"
 	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
 )
 
 const (
@@ -13,8 +18,12 @@ const (
 	_defaultReadTimeout     = 5 * time.Second
 	_defaultWriteTimeout    = 5 * time.Second
 	_defaultShutdownTimeout = 3 * time.Second
+	_defaultBodyLimit       = 4 * 1024 * 1024
 )
 
+// NoTimeout disables a timeout of a route.
+const NoTimeout time.Duration = math.MaxInt64
+
 // Server -.
 type Server struct {
 	App    *fiber.App
@@ -25,9 +34,27 @@ type Server struct {
 	readTimeout     time.Duration
 	writeTimeout    time.Duration
 	shutdownTimeout time.Duration
+	bodyLimit       int
+	routes          []route
 	errorHandler    fiber.ErrorHandler
 }
 
+// RouteConfig overrides the server configuration for requests to a route. Zero values keep the server defaults.
+type RouteConfig struct {
+	BodyLimit int
+	// StreamBody leaves reading the body to the handler, which gets it from fasthttp.Request.BodyStream. Streamed
+	// requests need a Content-Length, so that the handler cannot read more than BodyLimit.
+	StreamBody   bool
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+type route struct {
+	method   string
+	segments []string
+	config   RouteConfig
+}
+
 // New -.
 func New(opts ...Option) *Server {
 	s := &Server{
@@ -37,6 +64,7 @@ func New(opts ...Option) *Server {
 		readTimeout:     _defaultReadTimeout,
 		writeTimeout:    _defaultWriteTimeout,
 		shutdownTimeout: _defaultShutdownTimeout,
+		bodyLimit:       _defaultBodyLimit,
 		errorHandler:    fiber.DefaultErrorHandler,
 	}
 
@@ -53,13 +81,87 @@ func New(opts ...Option) *Server {
 		JSONEncoder:    json.Marshal,
 		ErrorHandler:   s.errorHandler,
 		ReadBufferSize: 8192,
+		BodyLimit:      s.bodyLimit,
+		// fasthttp can only stream the bodies of all requests, limitBody reads the others with their limit
+		StreamRequestBody:            true,
+		DisablePreParseMultipartForm: true,
 	})
+	// the configuration has to be known before the body is read, which is before fiber routes the request
+	app.Server().HeaderReceived = s.headerReceived
+	app.Use(s.limitBody)
 
 	s.App = app
 
 	return s
 }
 
+// headerReceived applies the timeouts and body limit of the route matching the request.
+func (s *Server) headerReceived(header *fasthttp.RequestHeader) fasthttp.RequestConfig {
+	path, _, _ := bytes.Cut(header.RequestURI(), []byte("?"))
+	config := s.routeConfig(string(header.Method()), string(path))
+	return fasthttp.RequestConfig{
+		ReadTimeout:        config.ReadTimeout,
+		WriteTimeout:       config.WriteTimeout,
+		MaxRequestBodySize: config.BodyLimit,
+	}
+}
+
+// limitBody rejects bodies larger than the limit of their route. As the server streams request bodies, fasthttp
+// hands oversized and chunked bodies to the handler instead of rejecting them. Chunked bodies are read here, unless
+// the route streams them.
+func (s *Server) limitBody(ctx *fiber.Ctx) error {
+	config := s.routeConfig(ctx.Method(), ctx.Path())
+	limit := config.BodyLimit
+	if limit <= 0 {
+		limit = s.bodyLimit
+	}
+
+	request := ctx.Request()
+	length := request.Header.ContentLength()
+	switch {
+	case length > limit:
+		ctx.Context().SetConnectionClose()
+		return fiber.ErrRequestEntityTooLarge
+	case length == -1 && config.StreamBody:
+		ctx.Context().SetConnectionClose()
+		return fiber.ErrLengthRequired
+	case length == -1:
+		body, err := io.ReadAll(io.LimitReader(request.BodyStream(), int64(limit)+1))
+		if err != nil {
+			ctx.Context().SetConnectionClose()
+			return fiber.NewError(fiber.StatusBadRequest, "unable to read body")
+		}
+		if len(body) > limit {
+			ctx.Context().SetConnectionClose()
+			return fiber.ErrRequestEntityTooLarge
+		}
+		request.SetBody(body)
+	}
+	return ctx.Next()
+}
+
+func (s *Server) routeConfig(method string, path string) RouteConfig {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, route := range s.routes {
+		if route.matches(method, segments) {
+			return route.config
+		}
+	}
+	return RouteConfig{}
+}
+
+func (r route) matches(method string, segments []string) bool {
+	if method != r.method || len(segments) != len(r.segments) {
+		return false
+	}
+	for i, segment := range r.segments {
+		if segment != "*" && !strings.EqualFold(segment, segments[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // Start -.
 func (s *Server) Start() {
 	go func() {
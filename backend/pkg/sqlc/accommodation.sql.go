@@ -11,32 +11,13 @@ import (
 	"cloud.google.com/go/civil"
 )
 
-const deleteAccommodationByID = `-- name: DeleteAccommodationByID :one
-DELETE
-FROM accommodation
-WHERE trip_id = $1
-  AND accommodation.id = $2
-RETURNING id
-`
-
-type DeleteAccommodationByIDParams struct {
-	TripID int32
-	ID     int32
-}
-
-func (q *Queries) DeleteAccommodationByID(ctx context.Context, arg DeleteAccommodationByIDParams) (int32, error) {
-	row := q.db.QueryRow(ctx, deleteAccommodationByID, arg.TripID, arg.ID)
-	var id int32
-	err := row.Scan(&id)
-	return id, err
-}
-
 const getAccommodationByID = `-- name: GetAccommodationByID :one
-SELECT accommodation.id, accommodation.trip_id, accommodation.location_id, accommodation.name, accommodation.arrival_date, accommodation.departure_date, accommodation.check_in_time, accommodation.check_out_time, accommodation.description, accommodation.address, accommodation.price, location.id, location.latitude, location.longitude
+SELECT accommodation.id, accommodation.trip_id, accommodation.location_id, accommodation.name, accommodation.arrival_date, accommodation.departure_date, accommodation.check_in_time, accommodation.check_out_time, accommodation.description, accommodation.address, accommodation.price, accommodation.currency, accommodation.sensitive, accommodation.revision, accommodation.updated_at, accommodation.deleted_at, location.id, location.latitude, location.longitude, location.timezone
 FROM accommodation
          LEFT JOIN location on location_id = location.id
 WHERE trip_id = $1
   AND accommodation.id = $2
+  AND accommodation.deleted_at IS NULL
 `
 
 type GetAccommodationByIDParams struct {
@@ -49,6 +30,7 @@ type GetAccommodationByIDRow struct {
 	ID            *int32
 	Latitude      *float32
 	Longitude     *float32
+	Timezone      *string
 }
 
 func (q *Queries) GetAccommodationByID(ctx context.Context, arg GetAccommodationByIDParams) (GetAccommodationByIDRow, error) {
@@ -66,29 +48,43 @@ func (q *Queries) GetAccommodationByID(ctx context.Context, arg GetAccommodation
 		&i.Accommodation.Description,
 		&i.Accommodation.Address,
 		&i.Accommodation.Price,
+		&i.Accommodation.Currency,
+		&i.Accommodation.Sensitive,
+		&i.Accommodation.Revision,
+		&i.Accommodation.UpdatedAt,
+		&i.Accommodation.DeletedAt,
 		&i.ID,
 		&i.Latitude,
 		&i.Longitude,
+		&i.Timezone,
 	)
 	return i, err
 }
 
 const getAllAccommodation = `-- name: GetAllAccommodation :many
-SELECT accommodation.id, accommodation.trip_id, accommodation.location_id, accommodation.name, accommodation.arrival_date, accommodation.departure_date, accommodation.check_in_time, accommodation.check_out_time, accommodation.description, accommodation.address, accommodation.price, location.id, location.latitude, location.longitude
+SELECT accommodation.id, accommodation.trip_id, accommodation.location_id, accommodation.name, accommodation.arrival_date, accommodation.departure_date, accommodation.check_in_time, accommodation.check_out_time, accommodation.description, accommodation.address, accommodation.price, accommodation.currency, accommodation.sensitive, accommodation.revision, accommodation.updated_at, accommodation.deleted_at, location.id, location.latitude, location.longitude, location.timezone
 FROM accommodation
          LEFT JOIN location on location_id = location.id
 WHERE trip_id = $1
+  AND accommodation.deleted_at IS NULL
+  AND accommodation.revision > $2
 `
 
+type GetAllAccommodationParams struct {
+	TripID int32
+	Since  int64
+}
+
 type GetAllAccommodationRow struct {
 	Accommodation Accommodation
 	ID            *int32
 	Latitude      *float32
 	Longitude     *float32
+	Timezone      *string
 }
 
-func (q *Queries) GetAllAccommodation(ctx context.Context, tripID int32) ([]GetAllAccommodationRow, error) {
-	rows, err := q.db.Query(ctx, getAllAccommodation, tripID)
+func (q *Queries) GetAllAccommodation(ctx context.Context, arg GetAllAccommodationParams) ([]GetAllAccommodationRow, error) {
+	rows, err := q.db.Query(ctx, getAllAccommodation, arg.TripID, arg.Since)
 	if err != nil {
 		return nil, err
 	}
@@ -108,9 +104,15 @@ func (q *Queries) GetAllAccommodation(ctx context.Context, tripID int32) ([]GetA
 			&i.Accommodation.Description,
 			&i.Accommodation.Address,
 			&i.Accommodation.Price,
+			&i.Accommodation.Currency,
+			&i.Accommodation.Sensitive,
+			&i.Accommodation.Revision,
+			&i.Accommodation.UpdatedAt,
+			&i.Accommodation.DeletedAt,
 			&i.ID,
 			&i.Latitude,
 			&i.Longitude,
+			&i.Timezone,
 		); err != nil {
 			return nil, err
 		}
@@ -123,8 +125,9 @@ func (q *Queries) GetAllAccommodation(ctx context.Context, tripID int32) ([]GetA
 }
 
 const insertAccommodation = `-- name: InsertAccommodation :one
-INSERT INTO accommodation (trip_id, location_id, name, arrival_date, departure_date, check_in_time, check_out_time, description, address, price)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+INSERT INTO accommodation (trip_id, location_id, name, arrival_date, departure_date, check_in_time, check_out_time, description, address, price, sensitive, currency)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11,
+        coalesce($12::varchar, (SELECT home_currency FROM trip WHERE trip.id = $1)))
 RETURNING id
 `
 
@@ -139,6 +142,8 @@ type InsertAccommodationParams struct {
 	Description   *string
 	Address       *string
 	Price         *int32
+	Sensitive     bool
+	Currency      *string
 }
 
 func (q *Queries) InsertAccommodation(ctx context.Context, arg InsertAccommodationParams) (int32, error) {
@@ -153,13 +158,38 @@ func (q *Queries) InsertAccommodation(ctx context.Context, arg InsertAccommodati
 		arg.Description,
 		arg.Address,
 		arg.Price,
+		arg.Sensitive,
+		arg.Currency,
 	)
 	var id int32
 	err := row.Scan(&id)
 	return id, err
 }
 
-const updateAccommodation = `-- name: UpdateAccommodation :exec
+const trashAccommodationByID = `-- name: TrashAccommodationByID :one
+UPDATE accommodation
+SET deleted_at = now()
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NULL
+  AND ($3::bigint IS NULL OR revision = $3)
+RETURNING id
+`
+
+type TrashAccommodationByIDParams struct {
+	TripID   int32
+	ID       int32
+	Revision *int64
+}
+
+func (q *Queries) TrashAccommodationByID(ctx context.Context, arg TrashAccommodationByIDParams) (int32, error) {
+	row := q.db.QueryRow(ctx, trashAccommodationByID, arg.TripID, arg.ID, arg.Revision)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateAccommodation = `-- name: UpdateAccommodation :execrows
 UPDATE accommodation
 SET location_id    = $2,
     name           = $3,
@@ -169,8 +199,13 @@ SET location_id    = $2,
     check_out_time = $7,
     description    = $8,
     address        = $9,
-    price          = $10
+    price          = $10,
+    sensitive      = $11,
+    currency       = coalesce($12::varchar, currency)
 WHERE id = $1
+  AND trip_id = $13
+  AND deleted_at IS NULL
+  AND ($14::bigint IS NULL OR revision = $14)
 `
 
 type UpdateAccommodationParams struct {
@@ -184,11 +219,14 @@ type UpdateAccommodationParams struct {
 	Description   *string
 	Address       *string
 	Price         *int32
+	Sensitive     bool
+	Currency      *string
+	TripID        int32
+	Revision      *int64
 }
 
-// TODO: check tripID!
-func (q *Queries) UpdateAccommodation(ctx context.Context, arg UpdateAccommodationParams) error {
-	_, err := q.db.Exec(ctx, updateAccommodation,
+func (q *Queries) UpdateAccommodation(ctx context.Context, arg UpdateAccommodationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateAccommodation,
 		arg.ID,
 		arg.LocationID,
 		arg.Name,
@@ -199,6 +237,13 @@ func (q *Queries) UpdateAccommodation(ctx context.Context, arg UpdateAccommodati
 		arg.Description,
 		arg.Address,
 		arg.Price,
+		arg.Sensitive,
+		arg.Currency,
+		arg.TripID,
+		arg.Revision,
 	)
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
@@ -11,42 +11,30 @@ import (
 	"cloud.google.com/go/civil"
 )
 
-const deleteActivityByID = `-- name: DeleteActivityByID :one
-DELETE
+const getActivities = `-- name: GetActivities :many
+SELECT activity.id, activity.trip_id, activity.location_id, activity.name, activity.date, activity.time, activity.description, activity.address, activity.price, activity.currency, activity.sensitive, activity.revision, activity.updated_at, activity.deleted_at, location.id, location.latitude, location.longitude, location.timezone
 FROM activity
+         LEFT JOIN location on activity.location_id = location.id
 WHERE trip_id = $1
-  AND activity.id = $2
-RETURNING id
+  AND activity.deleted_at IS NULL
+  AND activity.revision > $2
 `
 
-type DeleteActivityByIDParams struct {
+type GetActivitiesParams struct {
 	TripID int32
-	ID     int32
-}
-
-func (q *Queries) DeleteActivityByID(ctx context.Context, arg DeleteActivityByIDParams) (int32, error) {
-	row := q.db.QueryRow(ctx, deleteActivityByID, arg.TripID, arg.ID)
-	var id int32
-	err := row.Scan(&id)
-	return id, err
+	Since  int64
 }
 
-const getActivities = `-- name: GetActivities :many
-SELECT activity.id, activity.trip_id, activity.location_id, activity.name, activity.date, activity.time, activity.description, activity.address, activity.price, location.id, location.latitude, location.longitude
-FROM activity
-         LEFT JOIN location on activity.location_id = location.id
-WHERE trip_id = $1
-`
-
 type GetActivitiesRow struct {
 	Activity  Activity
 	ID        *int32
 	Latitude  *float32
 	Longitude *float32
+	Timezone  *string
 }
 
-func (q *Queries) GetActivities(ctx context.Context, tripID int32) ([]GetActivitiesRow, error) {
-	rows, err := q.db.Query(ctx, getActivities, tripID)
+func (q *Queries) GetActivities(ctx context.Context, arg GetActivitiesParams) ([]GetActivitiesRow, error) {
+	rows, err := q.db.Query(ctx, getActivities, arg.TripID, arg.Since)
 	if err != nil {
 		return nil, err
 	}
@@ -64,9 +52,15 @@ func (q *Queries) GetActivities(ctx context.Context, tripID int32) ([]GetActivit
 			&i.Activity.Description,
 			&i.Activity.Address,
 			&i.Activity.Price,
+			&i.Activity.Currency,
+			&i.Activity.Sensitive,
+			&i.Activity.Revision,
+			&i.Activity.UpdatedAt,
+			&i.Activity.DeletedAt,
 			&i.ID,
 			&i.Latitude,
 			&i.Longitude,
+			&i.Timezone,
 		); err != nil {
 			return nil, err
 		}
@@ -79,11 +73,12 @@ func (q *Queries) GetActivities(ctx context.Context, tripID int32) ([]GetActivit
 }
 
 const getActivityByID = `-- name: GetActivityByID :one
-SELECT activity.id, activity.trip_id, activity.location_id, activity.name, activity.date, activity.time, activity.description, activity.address, activity.price, location.id, location.latitude, location.longitude
+SELECT activity.id, activity.trip_id, activity.location_id, activity.name, activity.date, activity.time, activity.description, activity.address, activity.price, activity.currency, activity.sensitive, activity.revision, activity.updated_at, activity.deleted_at, location.id, location.latitude, location.longitude, location.timezone
 FROM activity
          LEFT JOIN location on activity.location_id = location.id
 WHERE trip_id = $1
   AND activity.id = $2
+  AND activity.deleted_at IS NULL
 `
 
 type GetActivityByIDParams struct {
@@ -96,6 +91,7 @@ type GetActivityByIDRow struct {
 	ID        *int32
 	Latitude  *float32
 	Longitude *float32
+	Timezone  *string
 }
 
 func (q *Queries) GetActivityByID(ctx context.Context, arg GetActivityByIDParams) (GetActivityByIDRow, error) {
@@ -111,16 +107,23 @@ func (q *Queries) GetActivityByID(ctx context.Context, arg GetActivityByIDParams
 		&i.Activity.Description,
 		&i.Activity.Address,
 		&i.Activity.Price,
+		&i.Activity.Currency,
+		&i.Activity.Sensitive,
+		&i.Activity.Revision,
+		&i.Activity.UpdatedAt,
+		&i.Activity.DeletedAt,
 		&i.ID,
 		&i.Latitude,
 		&i.Longitude,
+		&i.Timezone,
 	)
 	return i, err
 }
 
 const insertActivity = `-- name: InsertActivity :one
-INSERT INTO activity (trip_id, location_id, name, date, time, address, description, price)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id
+INSERT INTO activity (trip_id, location_id, name, date, time, address, description, price, sensitive, currency)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9,
+        coalesce($10::varchar, (SELECT home_currency FROM trip WHERE trip.id = $1))) RETURNING id
 `
 
 type InsertActivityParams struct {
@@ -132,6 +135,8 @@ type InsertActivityParams struct {
 	Address     *string
 	Description *string
 	Price       *int32
+	Sensitive   bool
+	Currency    *string
 }
 
 func (q *Queries) InsertActivity(ctx context.Context, arg InsertActivityParams) (int32, error) {
@@ -144,13 +149,38 @@ func (q *Queries) InsertActivity(ctx context.Context, arg InsertActivityParams)
 		arg.Address,
 		arg.Description,
 		arg.Price,
+		arg.Sensitive,
+		arg.Currency,
 	)
 	var id int32
 	err := row.Scan(&id)
 	return id, err
 }
 
-const updateActivity = `-- name: UpdateActivity :exec
+const trashActivityByID = `-- name: TrashActivityByID :one
+UPDATE activity
+SET deleted_at = now()
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NULL
+  AND ($3::bigint IS NULL OR revision = $3)
+RETURNING id
+`
+
+type TrashActivityByIDParams struct {
+	TripID   int32
+	ID       int32
+	Revision *int64
+}
+
+func (q *Queries) TrashActivityByID(ctx context.Context, arg TrashActivityByIDParams) (int32, error) {
+	row := q.db.QueryRow(ctx, trashActivityByID, arg.TripID, arg.ID, arg.Revision)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateActivity = `-- name: UpdateActivity :execrows
 UPDATE activity
 SET location_id = $2,
     name        = $3,
@@ -158,8 +188,13 @@ SET location_id = $2,
     time        = $5,
     address     = $6,
     description = $7,
-    price       = $8
+    price       = $8,
+    sensitive   = $9,
+    currency    = coalesce($10::varchar, currency)
 WHERE id = $1
+  AND trip_id = $11
+  AND deleted_at IS NULL
+  AND ($12::bigint IS NULL OR revision = $12)
 `
 
 type UpdateActivityParams struct {
@@ -171,11 +206,14 @@ type UpdateActivityParams struct {
 	Address     *string
 	Description *string
 	Price       *int32
+	Sensitive   bool
+	Currency    *string
+	TripID      int32
+	Revision    *int64
 }
 
-// TODO: check tripID!
-func (q *Queries) UpdateActivity(ctx context.Context, arg UpdateActivityParams) error {
-	_, err := q.db.Exec(ctx, updateActivity,
+func (q *Queries) UpdateActivity(ctx context.Context, arg UpdateActivityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateActivity,
 		arg.ID,
 		arg.LocationID,
 		arg.Name,
@@ -184,6 +222,13 @@ func (q *Queries) UpdateActivity(ctx context.Context, arg UpdateActivityParams)
 		arg.Address,
 		arg.Description,
 		arg.Price,
+		arg.Sensitive,
+		arg.Currency,
+		arg.TripID,
+		arg.Revision,
 	)
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
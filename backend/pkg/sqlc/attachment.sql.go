@@ -9,31 +9,155 @@ import (
 	"context"
 )
 
-const deleteAttachmentByID = `-- name: DeleteAttachmentByID :one
+const deleteTripThumbnails = `-- name: DeleteTripThumbnails :exec
 DELETE
+FROM attachment_thumbnail
+    USING attachment
+WHERE attachment.id = attachment_thumbnail.attachment_id
+  AND attachment.trip_id = $1
+`
+
+func (q *Queries) DeleteTripThumbnails(ctx context.Context, tripID int32) error {
+	_, err := q.db.Exec(ctx, deleteTripThumbnails, tripID)
+	return err
+}
+
+const getAccommodationAttachments = `-- name: GetAccommodationAttachments :many
+SELECT attachment_accommodation.accommodation_id, attachment.id, attachment.name, attachment.size, attachment.content_type
+FROM attachment_accommodation
+         JOIN attachment ON attachment.id = attachment_accommodation.attachment_id
+WHERE attachment.trip_id = $1
+  AND attachment.deleted_at IS NULL
+  AND ($2::integer IS NULL OR attachment_accommodation.accommodation_id = $2)
+ORDER BY attachment.id
+`
+
+type GetAccommodationAttachmentsParams struct {
+	TripID          int32
+	AccommodationID *int32
+}
+
+type GetAccommodationAttachmentsRow struct {
+	AccommodationID int32
+	ID              int32
+	Name            string
+	Size            int64
+	ContentType     string
+}
+
+func (q *Queries) GetAccommodationAttachments(ctx context.Context, arg GetAccommodationAttachmentsParams) ([]GetAccommodationAttachmentsRow, error) {
+	rows, err := q.db.Query(ctx, getAccommodationAttachments, arg.TripID, arg.AccommodationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetAccommodationAttachmentsRow{}
+	for rows.Next() {
+		var i GetAccommodationAttachmentsRow
+		if err := rows.Scan(
+			&i.AccommodationID,
+			&i.ID,
+			&i.Name,
+			&i.Size,
+			&i.ContentType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActivityAttachments = `-- name: GetActivityAttachments :many
+SELECT attachment_activity.activity_id, attachment.id, attachment.name, attachment.size, attachment.content_type
+FROM attachment_activity
+         JOIN attachment ON attachment.id = attachment_activity.attachment_id
+WHERE attachment.trip_id = $1
+  AND attachment.deleted_at IS NULL
+  AND ($2::integer IS NULL OR attachment_activity.activity_id = $2)
+ORDER BY attachment.id
+`
+
+type GetActivityAttachmentsParams struct {
+	TripID     int32
+	ActivityID *int32
+}
+
+type GetActivityAttachmentsRow struct {
+	ActivityID  int32
+	ID          int32
+	Name        string
+	Size        int64
+	ContentType string
+}
+
+func (q *Queries) GetActivityAttachments(ctx context.Context, arg GetActivityAttachmentsParams) ([]GetActivityAttachmentsRow, error) {
+	rows, err := q.db.Query(ctx, getActivityAttachments, arg.TripID, arg.ActivityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetActivityAttachmentsRow{}
+	for rows.Next() {
+		var i GetActivityAttachmentsRow
+		if err := rows.Scan(
+			&i.ActivityID,
+			&i.ID,
+			&i.Name,
+			&i.Size,
+			&i.ContentType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAttachmentBlobs = `-- name: GetAttachmentBlobs :many
+SELECT DISTINCT blob_key, size, (key_version IS NOT NULL)::boolean AS encrypted
 FROM attachment
-WHERE trip_id = $1
-  AND id = $2
-RETURNING id
+ORDER BY blob_key
 `
 
-type DeleteAttachmentByIDParams struct {
-	TripID int32
-	ID     int32
+type GetAttachmentBlobsRow struct {
+	BlobKey   string
+	Size      int64
+	Encrypted bool
 }
 
-func (q *Queries) DeleteAttachmentByID(ctx context.Context, arg DeleteAttachmentByIDParams) (int32, error) {
-	row := q.db.QueryRow(ctx, deleteAttachmentByID, arg.TripID, arg.ID)
-	var id int32
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) GetAttachmentBlobs(ctx context.Context) ([]GetAttachmentBlobsRow, error) {
+	rows, err := q.db.Query(ctx, getAttachmentBlobs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetAttachmentBlobsRow{}
+	for rows.Next() {
+		var i GetAttachmentBlobsRow
+		if err := rows.Scan(&i.BlobKey, &i.Size, &i.Encrypted); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const getAttachmentByID = `-- name: GetAttachmentByID :one
-SELECT id, trip_id, name, blob
+SELECT id, trip_id, name, size, content_type, sha256, created_at, uploaded_by, blob_key, key_version, revision, updated_at, deleted_at
 FROM attachment
 WHERE trip_id = $1
   AND id = $2
+  AND deleted_at IS NULL
 `
 
 type GetAttachmentByIDParams struct {
@@ -48,19 +172,105 @@ func (q *Queries) GetAttachmentByID(ctx context.Context, arg GetAttachmentByIDPa
 		&i.ID,
 		&i.TripID,
 		&i.Name,
-		&i.Blob,
+		&i.Size,
+		&i.ContentType,
+		&i.Sha256,
+		&i.CreatedAt,
+		&i.UploadedBy,
+		&i.BlobKey,
+		&i.KeyVersion,
+		&i.Revision,
+		&i.UpdatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
+const getAttachmentThumbnail = `-- name: GetAttachmentThumbnail :one
+SELECT attachment_thumbnail.data, attachment_thumbnail.key_version
+FROM attachment_thumbnail
+         JOIN attachment ON attachment.id = attachment_thumbnail.attachment_id
+WHERE attachment.trip_id = $1
+  AND attachment.deleted_at IS NULL
+  AND attachment_thumbnail.attachment_id = $2
+  AND attachment_thumbnail.size = $3
+`
+
+type GetAttachmentThumbnailParams struct {
+	TripID       int32
+	AttachmentID int32
+	Size         string
+}
+
+type GetAttachmentThumbnailRow struct {
+	Data       []byte
+	KeyVersion *int32
+}
+
+func (q *Queries) GetAttachmentThumbnail(ctx context.Context, arg GetAttachmentThumbnailParams) (GetAttachmentThumbnailRow, error) {
+	row := q.db.QueryRow(ctx, getAttachmentThumbnail, arg.TripID, arg.AttachmentID, arg.Size)
+	var i GetAttachmentThumbnailRow
+	err := row.Scan(&i.Data, &i.KeyVersion)
+	return i, err
+}
+
 const getAttachments = `-- name: GetAttachments :many
-SELECT id, trip_id, name, blob
+SELECT id, trip_id, name, size, content_type, sha256, created_at, uploaded_by, blob_key, key_version, revision, updated_at, deleted_at
+FROM attachment
+WHERE trip_id = $1
+  AND deleted_at IS NULL
+  AND revision > $2
+ORDER BY id
+`
+
+type GetAttachmentsParams struct {
+	TripID int32
+	Since  int64
+}
+
+func (q *Queries) GetAttachments(ctx context.Context, arg GetAttachmentsParams) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, getAttachments, arg.TripID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Attachment{}
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Name,
+			&i.Size,
+			&i.ContentType,
+			&i.Sha256,
+			&i.CreatedAt,
+			&i.UploadedBy,
+			&i.BlobKey,
+			&i.KeyVersion,
+			&i.Revision,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAttachmentsIncludingTrash = `-- name: GetAttachmentsIncludingTrash :many
+SELECT id, trip_id, name, size, content_type, sha256, created_at, uploaded_by, blob_key, key_version, revision, updated_at, deleted_at
 FROM attachment
 WHERE trip_id = $1
+ORDER BY id
 `
 
-func (q *Queries) GetAttachments(ctx context.Context, tripID int32) ([]Attachment, error) {
-	rows, err := q.db.Query(ctx, getAttachments, tripID)
+func (q *Queries) GetAttachmentsIncludingTrash(ctx context.Context, tripID int32) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, getAttachmentsIncludingTrash, tripID)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +282,65 @@ func (q *Queries) GetAttachments(ctx context.Context, tripID int32) ([]Attachmen
 			&i.ID,
 			&i.TripID,
 			&i.Name,
-			&i.Blob,
+			&i.Size,
+			&i.ContentType,
+			&i.Sha256,
+			&i.CreatedAt,
+			&i.UploadedBy,
+			&i.BlobKey,
+			&i.KeyVersion,
+			&i.Revision,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTransportationAttachments = `-- name: GetTransportationAttachments :many
+SELECT attachment_transportation.transportation_id, attachment.id, attachment.name, attachment.size, attachment.content_type
+FROM attachment_transportation
+         JOIN attachment ON attachment.id = attachment_transportation.attachment_id
+WHERE attachment.trip_id = $1
+  AND attachment.deleted_at IS NULL
+  AND ($2::integer IS NULL OR attachment_transportation.transportation_id = $2)
+ORDER BY attachment.id
+`
+
+type GetTransportationAttachmentsParams struct {
+	TripID           int32
+	TransportationID *int32
+}
+
+type GetTransportationAttachmentsRow struct {
+	TransportationID int32
+	ID               int32
+	Name             string
+	Size             int64
+	ContentType      string
+}
+
+func (q *Queries) GetTransportationAttachments(ctx context.Context, arg GetTransportationAttachmentsParams) ([]GetTransportationAttachmentsRow, error) {
+	rows, err := q.db.Query(ctx, getTransportationAttachments, arg.TripID, arg.TransportationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTransportationAttachmentsRow{}
+	for rows.Next() {
+		var i GetTransportationAttachmentsRow
+		if err := rows.Scan(
+			&i.TransportationID,
+			&i.ID,
+			&i.Name,
+			&i.Size,
+			&i.ContentType,
 		); err != nil {
 			return nil, err
 		}
@@ -85,20 +353,274 @@ func (q *Queries) GetAttachments(ctx context.Context, tripID int32) ([]Attachmen
 }
 
 const insertAttachment = `-- name: InsertAttachment :one
-INSERT INTO attachment (trip_id, name, blob)
-VALUES ($1, $2, $3)
+INSERT INTO attachment (trip_id, name, size, content_type, sha256, uploaded_by, blob_key, key_version)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING id
 `
 
 type InsertAttachmentParams struct {
-	TripID int32
-	Name   string
-	Blob   []byte
+	TripID      int32
+	Name        string
+	Size        int64
+	ContentType string
+	Sha256      string
+	UploadedBy  *int32
+	BlobKey     string
+	KeyVersion  *int32
 }
 
 func (q *Queries) InsertAttachment(ctx context.Context, arg InsertAttachmentParams) (int32, error) {
-	row := q.db.QueryRow(ctx, insertAttachment, arg.TripID, arg.Name, arg.Blob)
+	row := q.db.QueryRow(ctx, insertAttachment,
+		arg.TripID,
+		arg.Name,
+		arg.Size,
+		arg.ContentType,
+		arg.Sha256,
+		arg.UploadedBy,
+		arg.BlobKey,
+		arg.KeyVersion,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertAttachmentThumbnail = `-- name: InsertAttachmentThumbnail :exec
+INSERT INTO attachment_thumbnail (attachment_id, size, data, key_version)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (attachment_id, size) DO NOTHING
+`
+
+type InsertAttachmentThumbnailParams struct {
+	AttachmentID int32
+	Size         string
+	Data         []byte
+	KeyVersion   *int32
+}
+
+func (q *Queries) InsertAttachmentThumbnail(ctx context.Context, arg InsertAttachmentThumbnailParams) error {
+	_, err := q.db.Exec(ctx, insertAttachmentThumbnail,
+		arg.AttachmentID,
+		arg.Size,
+		arg.Data,
+		arg.KeyVersion,
+	)
+	return err
+}
+
+const isBlobReferenced = `-- name: IsBlobReferenced :one
+SELECT EXISTS(SELECT 1 FROM attachment WHERE blob_key = $1)
+`
+
+func (q *Queries) IsBlobReferenced(ctx context.Context, blobKey string) (bool, error) {
+	row := q.db.QueryRow(ctx, isBlobReferenced, blobKey)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const linkAttachmentToAccommodation = `-- name: LinkAttachmentToAccommodation :one
+INSERT INTO attachment_accommodation (attachment_id, accommodation_id)
+SELECT attachment.id, accommodation.id
+FROM attachment,
+     accommodation
+WHERE attachment.id = $1
+  AND accommodation.id = $2
+  AND attachment.trip_id = $3
+  AND accommodation.trip_id = $3
+  AND attachment.deleted_at IS NULL
+  AND accommodation.deleted_at IS NULL
+ON CONFLICT (attachment_id, accommodation_id) DO UPDATE SET attachment_id = excluded.attachment_id
+RETURNING attachment_id
+`
+
+type LinkAttachmentToAccommodationParams struct {
+	AttachmentID    int32
+	AccommodationID int32
+	TripID          int32
+}
+
+func (q *Queries) LinkAttachmentToAccommodation(ctx context.Context, arg LinkAttachmentToAccommodationParams) (int32, error) {
+	row := q.db.QueryRow(ctx, linkAttachmentToAccommodation, arg.AttachmentID, arg.AccommodationID, arg.TripID)
+	var attachment_id int32
+	err := row.Scan(&attachment_id)
+	return attachment_id, err
+}
+
+const linkAttachmentToActivity = `-- name: LinkAttachmentToActivity :one
+INSERT INTO attachment_activity (attachment_id, activity_id)
+SELECT attachment.id, activity.id
+FROM attachment,
+     activity
+WHERE attachment.id = $1
+  AND activity.id = $2
+  AND attachment.trip_id = $3
+  AND activity.trip_id = $3
+  AND attachment.deleted_at IS NULL
+  AND activity.deleted_at IS NULL
+ON CONFLICT (attachment_id, activity_id) DO UPDATE SET attachment_id = excluded.attachment_id
+RETURNING attachment_id
+`
+
+type LinkAttachmentToActivityParams struct {
+	AttachmentID int32
+	ActivityID   int32
+	TripID       int32
+}
+
+func (q *Queries) LinkAttachmentToActivity(ctx context.Context, arg LinkAttachmentToActivityParams) (int32, error) {
+	row := q.db.QueryRow(ctx, linkAttachmentToActivity, arg.AttachmentID, arg.ActivityID, arg.TripID)
+	var attachment_id int32
+	err := row.Scan(&attachment_id)
+	return attachment_id, err
+}
+
+const linkAttachmentToTransportation = `-- name: LinkAttachmentToTransportation :one
+INSERT INTO attachment_transportation (attachment_id, transportation_id)
+SELECT attachment.id, transportation.id
+FROM attachment,
+     transportation
+WHERE attachment.id = $1
+  AND transportation.id = $2
+  AND attachment.trip_id = $3
+  AND transportation.trip_id = $3
+  AND attachment.deleted_at IS NULL
+  AND transportation.deleted_at IS NULL
+ON CONFLICT (attachment_id, transportation_id) DO UPDATE SET attachment_id = excluded.attachment_id
+RETURNING attachment_id
+`
+
+type LinkAttachmentToTransportationParams struct {
+	AttachmentID     int32
+	TransportationID int32
+	TripID           int32
+}
+
+func (q *Queries) LinkAttachmentToTransportation(ctx context.Context, arg LinkAttachmentToTransportationParams) (int32, error) {
+	row := q.db.QueryRow(ctx, linkAttachmentToTransportation, arg.AttachmentID, arg.TransportationID, arg.TripID)
+	var attachment_id int32
+	err := row.Scan(&attachment_id)
+	return attachment_id, err
+}
+
+const lockBlobKey = `-- name: LockBlobKey :exec
+SELECT pg_advisory_xact_lock(hashtextextended($1::text, 0))
+`
+
+func (q *Queries) LockBlobKey(ctx context.Context, blobKey string) error {
+	_, err := q.db.Exec(ctx, lockBlobKey, blobKey)
+	return err
+}
+
+const trashAttachmentByID = `-- name: TrashAttachmentByID :one
+UPDATE attachment
+SET deleted_at = now()
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NULL
+  AND ($3::bigint IS NULL OR revision = $3)
+RETURNING id
+`
+
+type TrashAttachmentByIDParams struct {
+	TripID   int32
+	ID       int32
+	Revision *int64
+}
+
+func (q *Queries) TrashAttachmentByID(ctx context.Context, arg TrashAttachmentByIDParams) (int32, error) {
+	row := q.db.QueryRow(ctx, trashAttachmentByID, arg.TripID, arg.ID, arg.Revision)
 	var id int32
 	err := row.Scan(&id)
 	return id, err
 }
+
+const unlinkAttachmentFromAccommodation = `-- name: UnlinkAttachmentFromAccommodation :one
+DELETE
+FROM attachment_accommodation
+    USING attachment
+WHERE attachment.id = attachment_accommodation.attachment_id
+  AND attachment.trip_id = $1
+  AND attachment_accommodation.attachment_id = $2
+  AND attachment_accommodation.accommodation_id = $3
+RETURNING attachment_accommodation.attachment_id
+`
+
+type UnlinkAttachmentFromAccommodationParams struct {
+	TripID          int32
+	AttachmentID    int32
+	AccommodationID int32
+}
+
+func (q *Queries) UnlinkAttachmentFromAccommodation(ctx context.Context, arg UnlinkAttachmentFromAccommodationParams) (int32, error) {
+	row := q.db.QueryRow(ctx, unlinkAttachmentFromAccommodation, arg.TripID, arg.AttachmentID, arg.AccommodationID)
+	var attachment_id int32
+	err := row.Scan(&attachment_id)
+	return attachment_id, err
+}
+
+const unlinkAttachmentFromActivity = `-- name: UnlinkAttachmentFromActivity :one
+DELETE
+FROM attachment_activity
+    USING attachment
+WHERE attachment.id = attachment_activity.attachment_id
+  AND attachment.trip_id = $1
+  AND attachment_activity.attachment_id = $2
+  AND attachment_activity.activity_id = $3
+RETURNING attachment_activity.attachment_id
+`
+
+type UnlinkAttachmentFromActivityParams struct {
+	TripID       int32
+	AttachmentID int32
+	ActivityID   int32
+}
+
+func (q *Queries) UnlinkAttachmentFromActivity(ctx context.Context, arg UnlinkAttachmentFromActivityParams) (int32, error) {
+	row := q.db.QueryRow(ctx, unlinkAttachmentFromActivity, arg.TripID, arg.AttachmentID, arg.ActivityID)
+	var attachment_id int32
+	err := row.Scan(&attachment_id)
+	return attachment_id, err
+}
+
+const unlinkAttachmentFromTransportation = `-- name: UnlinkAttachmentFromTransportation :one
+DELETE
+FROM attachment_transportation
+    USING attachment
+WHERE attachment.id = attachment_transportation.attachment_id
+  AND attachment.trip_id = $1
+  AND attachment_transportation.attachment_id = $2
+  AND attachment_transportation.transportation_id = $3
+RETURNING attachment_transportation.attachment_id
+`
+
+type UnlinkAttachmentFromTransportationParams struct {
+	TripID           int32
+	AttachmentID     int32
+	TransportationID int32
+}
+
+func (q *Queries) UnlinkAttachmentFromTransportation(ctx context.Context, arg UnlinkAttachmentFromTransportationParams) (int32, error) {
+	row := q.db.QueryRow(ctx, unlinkAttachmentFromTransportation, arg.TripID, arg.AttachmentID, arg.TransportationID)
+	var attachment_id int32
+	err := row.Scan(&attachment_id)
+	return attachment_id, err
+}
+
+const updateAttachmentBlob = `-- name: UpdateAttachmentBlob :exec
+UPDATE attachment
+SET blob_key    = $2,
+    key_version = $3
+WHERE id = $1
+`
+
+type UpdateAttachmentBlobParams struct {
+	ID         int32
+	BlobKey    string
+	KeyVersion *int32
+}
+
+func (q *Queries) UpdateAttachmentBlob(ctx context.Context, arg UpdateAttachmentBlobParams) error {
+	_, err := q.db.Exec(ctx, updateAttachmentBlob, arg.ID, arg.BlobKey, arg.KeyVersion)
+	return err
+}
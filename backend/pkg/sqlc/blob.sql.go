@@ -0,0 +1,88 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: blob.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const blobExists = `-- name: BlobExists :one
+SELECT EXISTS(SELECT 1 FROM attachment_blob WHERE blob_key = $1)
+`
+
+func (q *Queries) BlobExists(ctx context.Context, blobKey string) (bool, error) {
+	row := q.db.QueryRow(ctx, blobExists, blobKey)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const deleteBlob = `-- name: DeleteBlob :exec
+DELETE
+FROM attachment_blob
+WHERE blob_key = $1
+`
+
+func (q *Queries) DeleteBlob(ctx context.Context, blobKey string) error {
+	_, err := q.db.Exec(ctx, deleteBlob, blobKey)
+	return err
+}
+
+const getBlobChunk = `-- name: GetBlobChunk :one
+SELECT start, data
+FROM attachment_blob_chunk
+WHERE blob_key = $1
+  AND start <= $2::bigint
+ORDER BY start DESC
+LIMIT 1
+`
+
+type GetBlobChunkParams struct {
+	BlobKey  string
+	Position int64
+}
+
+type GetBlobChunkRow struct {
+	Start int64
+	Data  []byte
+}
+
+func (q *Queries) GetBlobChunk(ctx context.Context, arg GetBlobChunkParams) (GetBlobChunkRow, error) {
+	row := q.db.QueryRow(ctx, getBlobChunk, arg.BlobKey, arg.Position)
+	var i GetBlobChunkRow
+	err := row.Scan(&i.Start, &i.Data)
+	return i, err
+}
+
+const insertBlob = `-- name: InsertBlob :execrows
+INSERT INTO attachment_blob (blob_key)
+VALUES ($1)
+ON CONFLICT (blob_key) DO NOTHING
+`
+
+func (q *Queries) InsertBlob(ctx context.Context, blobKey string) (int64, error) {
+	result, err := q.db.Exec(ctx, insertBlob, blobKey)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertBlobChunk = `-- name: InsertBlobChunk :exec
+INSERT INTO attachment_blob_chunk (blob_key, start, data)
+VALUES ($1, $2, $3)
+`
+
+type InsertBlobChunkParams struct {
+	BlobKey string
+	Start   int64
+	Data    []byte
+}
+
+func (q *Queries) InsertBlobChunk(ctx context.Context, arg InsertBlobChunkParams) error {
+	_, err := q.db.Exec(ctx, insertBlobChunk, arg.BlobKey, arg.Start, arg.Data)
+	return err
+}
@@ -0,0 +1,83 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: budget.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const deleteCategoryBudgets = `-- name: DeleteCategoryBudgets :exec
+DELETE
+FROM category_budget
+WHERE trip_id = $1
+`
+
+func (q *Queries) DeleteCategoryBudgets(ctx context.Context, tripID int32) error {
+	_, err := q.db.Exec(ctx, deleteCategoryBudgets, tripID)
+	return err
+}
+
+const getCategoryBudgets = `-- name: GetCategoryBudgets :many
+SELECT trip_id, category, amount
+FROM category_budget
+WHERE trip_id = $1
+ORDER BY category
+`
+
+func (q *Queries) GetCategoryBudgets(ctx context.Context, tripID int32) ([]CategoryBudget, error) {
+	rows, err := q.db.Query(ctx, getCategoryBudgets, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CategoryBudget{}
+	for rows.Next() {
+		var i CategoryBudget
+		if err := rows.Scan(&i.TripID, &i.Category, &i.Amount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertCategoryBudget = `-- name: InsertCategoryBudget :exec
+INSERT INTO category_budget (trip_id, category, amount)
+VALUES ($1, $2, $3)
+`
+
+type InsertCategoryBudgetParams struct {
+	TripID   int32
+	Category string
+	Amount   float64
+}
+
+func (q *Queries) InsertCategoryBudget(ctx context.Context, arg InsertCategoryBudgetParams) error {
+	_, err := q.db.Exec(ctx, insertCategoryBudget, arg.TripID, arg.Category, arg.Amount)
+	return err
+}
+
+const updateTripBudget = `-- name: UpdateTripBudget :one
+UPDATE trip
+SET budget = $2
+WHERE id = $1
+RETURNING id
+`
+
+type UpdateTripBudgetParams struct {
+	ID     int32
+	Budget *float64
+}
+
+func (q *Queries) UpdateTripBudget(ctx context.Context, arg UpdateTripBudgetParams) (int32, error) {
+	row := q.db.QueryRow(ctx, updateTripBudget, arg.ID, arg.Budget)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
@@ -0,0 +1,19 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: event.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const notifyTripEvent = `-- name: NotifyTripEvent :exec
+SELECT pg_notify('trip_events', $1::text)
+`
+
+func (q *Queries) NotifyTripEvent(ctx context.Context, payload string) error {
+	_, err := q.db.Exec(ctx, notifyTripEvent, payload)
+	return err
+}
@@ -0,0 +1,66 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: exchange_rate.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"cloud.google.com/go/civil"
+)
+
+const getExchangeRate = `-- name: GetExchangeRate :one
+SELECT date, currency, rate
+FROM exchange_rate
+WHERE currency = $1
+ORDER BY date > $2, abs(date - $2)
+LIMIT 1
+`
+
+type GetExchangeRateParams struct {
+	Currency string
+	Date     civil.Date
+}
+
+// Returns the rate published on or before the given date. For dates before the first stored rate, the earliest
+// rate is used instead.
+func (q *Queries) GetExchangeRate(ctx context.Context, arg GetExchangeRateParams) (ExchangeRate, error) {
+	row := q.db.QueryRow(ctx, getExchangeRate, arg.Currency, arg.Date)
+	var i ExchangeRate
+	err := row.Scan(&i.Date, &i.Currency, &i.Rate)
+	return i, err
+}
+
+const getLatestExchangeRateDate = `-- name: GetLatestExchangeRateDate :one
+SELECT date
+FROM exchange_rate
+ORDER BY date DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestExchangeRateDate(ctx context.Context) (civil.Date, error) {
+	row := q.db.QueryRow(ctx, getLatestExchangeRateDate)
+	var date civil.Date
+	err := row.Scan(&date)
+	return date, err
+}
+
+const upsertExchangeRate = `-- name: UpsertExchangeRate :exec
+INSERT INTO exchange_rate (date, currency, rate)
+VALUES ($1, $2, $3)
+ON CONFLICT (date, currency) DO UPDATE
+    SET rate = excluded.rate
+`
+
+type UpsertExchangeRateParams struct {
+	Date     civil.Date
+	Currency string
+	Rate     float64
+}
+
+func (q *Queries) UpsertExchangeRate(ctx context.Context, arg UpsertExchangeRateParams) error {
+	_, err := q.db.Exec(ctx, upsertExchangeRate, arg.Date, arg.Currency, arg.Rate)
+	return err
+}
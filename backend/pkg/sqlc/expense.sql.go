@@ -0,0 +1,351 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: expense.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"cloud.google.com/go/civil"
+)
+
+const deleteExpenseParticipants = `-- name: DeleteExpenseParticipants :exec
+DELETE
+FROM expense_participant
+WHERE expense_id = $1
+`
+
+func (q *Queries) DeleteExpenseParticipants(ctx context.Context, expenseID int32) error {
+	_, err := q.db.Exec(ctx, deleteExpenseParticipants, expenseID)
+	return err
+}
+
+const expenseLinksBelongToTrip = `-- name: ExpenseLinksBelongToTrip :one
+SELECT ($1::integer IS NULL OR EXISTS (SELECT 1
+                                                               FROM transportation
+                                                               WHERE id = $1::integer
+                                                                 AND trip_id = $2::integer
+                                                                 AND deleted_at IS NULL))
+           AND ($3::integer IS NULL OR EXISTS (SELECT 1
+                                                                  FROM activity
+                                                                  WHERE id = $3::integer
+                                                                    AND trip_id = $2::integer
+                                                                    AND deleted_at IS NULL))
+           AND ($4::integer IS NULL OR EXISTS (SELECT 1
+                                                                       FROM accommodation
+                                                                       WHERE id = $4::integer
+                                                                         AND trip_id = $2::integer
+                                                                         AND deleted_at IS NULL))
+           AND ($5::integer IS NULL OR EXISTS (SELECT 1
+                                                                    FROM attachment
+                                                                    WHERE id = $5::integer
+                                                                      AND trip_id = $2::integer
+                                                                      AND deleted_at IS NULL))
+`
+
+type ExpenseLinksBelongToTripParams struct {
+	TransportationID *int32
+	TripID           int32
+	ActivityID       *int32
+	AccommodationID  *int32
+	AttachmentID     *int32
+}
+
+func (q *Queries) ExpenseLinksBelongToTrip(ctx context.Context, arg ExpenseLinksBelongToTripParams) (*bool, error) {
+	row := q.db.QueryRow(ctx, expenseLinksBelongToTrip,
+		arg.TransportationID,
+		arg.TripID,
+		arg.ActivityID,
+		arg.AccommodationID,
+		arg.AttachmentID,
+	)
+	var column_1 *bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getExpenseByID = `-- name: GetExpenseByID :one
+SELECT id, trip_id, name, date, amount, currency, payer_id, split_mode, transportation_id, activity_id, accommodation_id, attachment_id, category, revision, updated_at, deleted_at
+FROM expense
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NULL
+`
+
+type GetExpenseByIDParams struct {
+	TripID int32
+	ID     int32
+}
+
+func (q *Queries) GetExpenseByID(ctx context.Context, arg GetExpenseByIDParams) (Expense, error) {
+	row := q.db.QueryRow(ctx, getExpenseByID, arg.TripID, arg.ID)
+	var i Expense
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Name,
+		&i.Date,
+		&i.Amount,
+		&i.Currency,
+		&i.PayerID,
+		&i.SplitMode,
+		&i.TransportationID,
+		&i.ActivityID,
+		&i.AccommodationID,
+		&i.AttachmentID,
+		&i.Category,
+		&i.Revision,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getExpenseParticipants = `-- name: GetExpenseParticipants :many
+SELECT expense_participant.expense_id, expense_participant.user_id, expense_participant.share
+FROM expense_participant
+         JOIN expense ON expense_participant.expense_id = expense.id
+WHERE expense.trip_id = $1
+  AND expense.deleted_at IS NULL
+ORDER BY expense_id, user_id
+`
+
+func (q *Queries) GetExpenseParticipants(ctx context.Context, tripID int32) ([]ExpenseParticipant, error) {
+	rows, err := q.db.Query(ctx, getExpenseParticipants, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExpenseParticipant{}
+	for rows.Next() {
+		var i ExpenseParticipant
+		if err := rows.Scan(&i.ExpenseID, &i.UserID, &i.Share); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExpenseParticipantsByExpenseID = `-- name: GetExpenseParticipantsByExpenseID :many
+SELECT expense_id, user_id, share
+FROM expense_participant
+WHERE expense_id = $1
+ORDER BY user_id
+`
+
+func (q *Queries) GetExpenseParticipantsByExpenseID(ctx context.Context, expenseID int32) ([]ExpenseParticipant, error) {
+	rows, err := q.db.Query(ctx, getExpenseParticipantsByExpenseID, expenseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExpenseParticipant{}
+	for rows.Next() {
+		var i ExpenseParticipant
+		if err := rows.Scan(&i.ExpenseID, &i.UserID, &i.Share); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExpenses = `-- name: GetExpenses :many
+SELECT id, trip_id, name, date, amount, currency, payer_id, split_mode, transportation_id, activity_id, accommodation_id, attachment_id, category, revision, updated_at, deleted_at
+FROM expense
+WHERE trip_id = $1
+  AND deleted_at IS NULL
+  AND revision > $2
+ORDER BY date, id
+`
+
+type GetExpensesParams struct {
+	TripID int32
+	Since  int64
+}
+
+func (q *Queries) GetExpenses(ctx context.Context, arg GetExpensesParams) ([]Expense, error) {
+	rows, err := q.db.Query(ctx, getExpenses, arg.TripID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Expense{}
+	for rows.Next() {
+		var i Expense
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Name,
+			&i.Date,
+			&i.Amount,
+			&i.Currency,
+			&i.PayerID,
+			&i.SplitMode,
+			&i.TransportationID,
+			&i.ActivityID,
+			&i.AccommodationID,
+			&i.AttachmentID,
+			&i.Category,
+			&i.Revision,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertExpense = `-- name: InsertExpense :one
+INSERT INTO expense (trip_id, name, date, amount, currency, payer_id, split_mode, transportation_id, activity_id,
+                     accommodation_id, attachment_id, category)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id
+`
+
+type InsertExpenseParams struct {
+	TripID           int32
+	Name             string
+	Date             civil.Date
+	Amount           float64
+	Currency         string
+	PayerID          int32
+	SplitMode        string
+	TransportationID *int32
+	ActivityID       *int32
+	AccommodationID  *int32
+	AttachmentID     *int32
+	Category         string
+}
+
+func (q *Queries) InsertExpense(ctx context.Context, arg InsertExpenseParams) (int32, error) {
+	row := q.db.QueryRow(ctx, insertExpense,
+		arg.TripID,
+		arg.Name,
+		arg.Date,
+		arg.Amount,
+		arg.Currency,
+		arg.PayerID,
+		arg.SplitMode,
+		arg.TransportationID,
+		arg.ActivityID,
+		arg.AccommodationID,
+		arg.AttachmentID,
+		arg.Category,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertExpenseParticipant = `-- name: InsertExpenseParticipant :exec
+INSERT INTO expense_participant (expense_id, user_id, share)
+VALUES ($1, $2, $3)
+`
+
+type InsertExpenseParticipantParams struct {
+	ExpenseID int32
+	UserID    int32
+	Share     *float64
+}
+
+func (q *Queries) InsertExpenseParticipant(ctx context.Context, arg InsertExpenseParticipantParams) error {
+	_, err := q.db.Exec(ctx, insertExpenseParticipant, arg.ExpenseID, arg.UserID, arg.Share)
+	return err
+}
+
+const trashExpenseByID = `-- name: TrashExpenseByID :one
+UPDATE expense
+SET deleted_at = now()
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NULL
+  AND ($3::bigint IS NULL OR revision = $3)
+RETURNING id
+`
+
+type TrashExpenseByIDParams struct {
+	TripID   int32
+	ID       int32
+	Revision *int64
+}
+
+func (q *Queries) TrashExpenseByID(ctx context.Context, arg TrashExpenseByIDParams) (int32, error) {
+	row := q.db.QueryRow(ctx, trashExpenseByID, arg.TripID, arg.ID, arg.Revision)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateExpense = `-- name: UpdateExpense :execrows
+UPDATE expense
+SET name              = $3,
+    date              = $4,
+    amount            = $5,
+    currency          = $6,
+    payer_id          = $7,
+    split_mode        = $8,
+    transportation_id = $9,
+    activity_id       = $10,
+    accommodation_id  = $11,
+    attachment_id     = $12,
+    category          = $13
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NULL
+  AND ($14::bigint IS NULL OR revision = $14)
+`
+
+type UpdateExpenseParams struct {
+	TripID           int32
+	ID               int32
+	Name             string
+	Date             civil.Date
+	Amount           float64
+	Currency         string
+	PayerID          int32
+	SplitMode        string
+	TransportationID *int32
+	ActivityID       *int32
+	AccommodationID  *int32
+	AttachmentID     *int32
+	Category         string
+	Revision         *int64
+}
+
+func (q *Queries) UpdateExpense(ctx context.Context, arg UpdateExpenseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateExpense,
+		arg.TripID,
+		arg.ID,
+		arg.Name,
+		arg.Date,
+		arg.Amount,
+		arg.Currency,
+		arg.PayerID,
+		arg.SplitMode,
+		arg.TransportationID,
+		arg.ActivityID,
+		arg.AccommodationID,
+		arg.AttachmentID,
+		arg.Category,
+		arg.Revision,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
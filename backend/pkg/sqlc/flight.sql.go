@@ -27,11 +27,11 @@ func (q *Queries) AirportExists(ctx context.Context, iata string) (bool, error)
 }
 
 const getFlightLegsByTransportationID = `-- name: GetFlightLegsByTransportationID :many
-SELECT flight_leg.id, flight_leg.transportation_id, flight_leg.origin, flight_leg.destination, flight_leg.airline, flight_leg.flight_number, flight_leg.departure_time, flight_leg.arrival_time, flight_leg.duration_in_minutes, flight_leg.aircraft, flight_leg.amadeus_date,
+SELECT flight_leg.id, flight_leg.transportation_id, flight_leg.origin, flight_leg.destination, flight_leg.airline, flight_leg.flight_number, flight_leg.departure_time, flight_leg.arrival_time, flight_leg.duration_in_minutes, flight_leg.aircraft, flight_leg.amadeus_date, flight_leg.cabin_class,
        origin.iata, origin.name, origin.municipality, origin.location_id,
        destination.iata, destination.name, destination.municipality, destination.location_id,
-       origin_location.id, origin_location.latitude, origin_location.longitude,
-       destination_location.id, destination_location.latitude, destination_location.longitude
+       origin_location.id, origin_location.latitude, origin_location.longitude, origin_location.timezone,
+       destination_location.id, destination_location.latitude, destination_location.longitude, destination_location.timezone
 FROM flight_leg
          JOIN airport origin on flight_leg.origin = origin.iata
          JOIN airport destination on flight_leg.destination = destination.iata
@@ -70,6 +70,7 @@ func (q *Queries) GetFlightLegsByTransportationID(ctx context.Context, transport
 			&i.FlightLeg.DurationInMinutes,
 			&i.FlightLeg.Aircraft,
 			&i.FlightLeg.AmadeusDate,
+			&i.FlightLeg.CabinClass,
 			&i.Airport.Iata,
 			&i.Airport.Name,
 			&i.Airport.Municipality,
@@ -81,9 +82,11 @@ func (q *Queries) GetFlightLegsByTransportationID(ctx context.Context, transport
 			&i.Location.ID,
 			&i.Location.Latitude,
 			&i.Location.Longitude,
+			&i.Location.Timezone,
 			&i.Location_2.ID,
 			&i.Location_2.Latitude,
 			&i.Location_2.Longitude,
+			&i.Location_2.Timezone,
 		); err != nil {
 			return nil, err
 		}
@@ -149,8 +152,8 @@ func (q *Queries) InsertAirport(ctx context.Context, arg InsertAirportParams) er
 }
 
 const insertFlightLeg = `-- name: InsertFlightLeg :one
-INSERT INTO flight_leg (transportation_id, origin, destination, airline, flight_number, departure_time, arrival_time, amadeus_date, duration_in_minutes, aircraft)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+INSERT INTO flight_leg (transportation_id, origin, destination, airline, flight_number, departure_time, arrival_time, amadeus_date, duration_in_minutes, aircraft, cabin_class)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 RETURNING id
 `
 
@@ -165,6 +168,7 @@ type InsertFlightLegParams struct {
 	AmadeusDate       *civil.Date
 	DurationInMinutes int32
 	Aircraft          *string
+	CabinClass        *string
 }
 
 func (q *Queries) InsertFlightLeg(ctx context.Context, arg InsertFlightLegParams) (int32, error) {
@@ -179,6 +183,7 @@ func (q *Queries) InsertFlightLeg(ctx context.Context, arg InsertFlightLegParams
 		arg.AmadeusDate,
 		arg.DurationInMinutes,
 		arg.Aircraft,
+		arg.CabinClass,
 	)
 	var id int32
 	err := row.Scan(&id)
@@ -204,6 +209,25 @@ func (q *Queries) InsertPNR(ctx context.Context, arg InsertPNRParams) (int32, er
 	return id, err
 }
 
+const updateAirportTimezone = `-- name: UpdateAirportTimezone :exec
+UPDATE location
+SET timezone = $2
+FROM airport
+WHERE airport.location_id = location.id
+  AND airport.iata = $1
+  AND location.timezone IS NULL
+`
+
+type UpdateAirportTimezoneParams struct {
+	Iata     string
+	Timezone *string
+}
+
+func (q *Queries) UpdateAirportTimezone(ctx context.Context, arg UpdateAirportTimezoneParams) error {
+	_, err := q.db.Exec(ctx, updateAirportTimezone, arg.Iata, arg.Timezone)
+	return err
+}
+
 const updateFlightLeg = `-- name: UpdateFlightLeg :exec
 UPDATE flight_leg
 SET origin              = $2,
@@ -214,7 +238,8 @@ SET origin              = $2,
     arrival_time        = $7,
     amadeus_date        = $8,
     duration_in_minutes = $9,
-    aircraft            = $10
+    aircraft            = $10,
+    cabin_class         = $11
 WHERE id = $1
 `
 
@@ -229,6 +254,7 @@ type UpdateFlightLegParams struct {
 	AmadeusDate       *civil.Date
 	DurationInMinutes int32
 	Aircraft          *string
+	CabinClass        *string
 }
 
 func (q *Queries) UpdateFlightLeg(ctx context.Context, arg UpdateFlightLegParams) error {
@@ -243,6 +269,7 @@ func (q *Queries) UpdateFlightLeg(ctx context.Context, arg UpdateFlightLegParams
 		arg.AmadeusDate,
 		arg.DurationInMinutes,
 		arg.Aircraft,
+		arg.CabinClass,
 	)
 	return err
 }
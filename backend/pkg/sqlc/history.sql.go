@@ -0,0 +1,125 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: history.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const getHistory = `-- name: GetHistory :many
+SELECT id, trip_id, user_id, kind, entity_id, change, before, after, created_at
+FROM history
+WHERE trip_id = $1
+  AND ($2::varchar IS NULL OR kind = $2)
+  AND ($3::integer IS NULL OR entity_id = $3)
+  AND ($4::integer IS NULL OR user_id = $4)
+  AND ($5::integer IS NULL OR id < $5)
+ORDER BY id DESC
+LIMIT $6
+`
+
+type GetHistoryParams struct {
+	TripID     int32
+	Kind       *string
+	EntityID   *int32
+	UserID     *int32
+	BeforeID   *int32
+	MaxEntries int32
+}
+
+func (q *Queries) GetHistory(ctx context.Context, arg GetHistoryParams) ([]History, error) {
+	rows, err := q.db.Query(ctx, getHistory,
+		arg.TripID,
+		arg.Kind,
+		arg.EntityID,
+		arg.UserID,
+		arg.BeforeID,
+		arg.MaxEntries,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []History{}
+	for rows.Next() {
+		var i History
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.UserID,
+			&i.Kind,
+			&i.EntityID,
+			&i.Change,
+			&i.Before,
+			&i.After,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getHistoryEntry = `-- name: GetHistoryEntry :one
+SELECT id, trip_id, user_id, kind, entity_id, change, before, after, created_at
+FROM history
+WHERE trip_id = $1
+  AND id = $2
+`
+
+type GetHistoryEntryParams struct {
+	TripID int32
+	ID     int32
+}
+
+func (q *Queries) GetHistoryEntry(ctx context.Context, arg GetHistoryEntryParams) (History, error) {
+	row := q.db.QueryRow(ctx, getHistoryEntry, arg.TripID, arg.ID)
+	var i History
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.UserID,
+		&i.Kind,
+		&i.EntityID,
+		&i.Change,
+		&i.Before,
+		&i.After,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const insertHistoryEntry = `-- name: InsertHistoryEntry :exec
+INSERT INTO history (trip_id, user_id, kind, entity_id, change, before, after)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type InsertHistoryEntryParams struct {
+	TripID   int32
+	UserID   *int32
+	Kind     string
+	EntityID int32
+	Change   string
+	Before   *string
+	After    *string
+}
+
+func (q *Queries) InsertHistoryEntry(ctx context.Context, arg InsertHistoryEntryParams) error {
+	_, err := q.db.Exec(ctx, insertHistoryEntry,
+		arg.TripID,
+		arg.UserID,
+		arg.Kind,
+		arg.EntityID,
+		arg.Change,
+		arg.Before,
+		arg.After,
+	)
+	return err
+}
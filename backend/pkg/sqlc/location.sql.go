@@ -48,18 +48,19 @@ func (q *Queries) GetLocationIDByActivityID(ctx context.Context, id int32) (int3
 }
 
 const insertLocation = `-- name: InsertLocation :one
-INSERT INTO location (latitude, longitude)
-VALUES ($1, $2)
+INSERT INTO location (latitude, longitude, timezone)
+VALUES ($1, $2, $3)
 RETURNING id
 `
 
 type InsertLocationParams struct {
 	Latitude  float32
 	Longitude float32
+	Timezone  *string
 }
 
 func (q *Queries) InsertLocation(ctx context.Context, arg InsertLocationParams) (int32, error) {
-	row := q.db.QueryRow(ctx, insertLocation, arg.Latitude, arg.Longitude)
+	row := q.db.QueryRow(ctx, insertLocation, arg.Latitude, arg.Longitude, arg.Timezone)
 	var id int32
 	err := row.Scan(&id)
 	return id, err
@@ -68,7 +69,8 @@ func (q *Queries) InsertLocation(ctx context.Context, arg InsertLocationParams)
 const updateLocation = `-- name: UpdateLocation :exec
 UPDATE location
 SET latitude  = $2,
-    longitude = $3
+    longitude = $3,
+    timezone  = $4
 WHERE id = $1
 `
 
@@ -76,9 +78,15 @@ type UpdateLocationParams struct {
 	ID        int32
 	Latitude  float32
 	Longitude float32
+	Timezone  *string
 }
 
 func (q *Queries) UpdateLocation(ctx context.Context, arg UpdateLocationParams) error {
-	_, err := q.db.Exec(ctx, updateLocation, arg.ID, arg.Latitude, arg.Longitude)
+	_, err := q.db.Exec(ctx, updateLocation,
+		arg.ID,
+		arg.Latitude,
+		arg.Longitude,
+		arg.Timezone,
+	)
 	return err
 }
@@ -5,8 +5,11 @@
 package sqlc
 
 import (
+	"time"
+
 	"cloud.google.com/go/civil"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Accommodation struct {
@@ -21,6 +24,11 @@ type Accommodation struct {
 	Description   *string
 	Address       *string
 	Price         *int32
+	Currency      string
+	Sensitive     bool
+	Revision      int64
+	UpdatedAt     time.Time
+	DeletedAt     pgtype.Timestamptz
 }
 
 type Activity struct {
@@ -33,6 +41,11 @@ type Activity struct {
 	Description *string
 	Address     *string
 	Price       *int32
+	Currency    string
+	Sensitive   bool
+	Revision    int64
+	UpdatedAt   time.Time
+	DeletedAt   pgtype.Timestamptz
 }
 
 type Airport struct {
@@ -43,10 +56,19 @@ type Airport struct {
 }
 
 type Attachment struct {
-	ID     int32
-	TripID int32
-	Name   string
-	Blob   []byte
+	ID          int32
+	TripID      int32
+	Name        string
+	Size        int64
+	ContentType string
+	Sha256      string
+	CreatedAt   time.Time
+	UploadedBy  *int32
+	BlobKey     string
+	KeyVersion  *int32
+	Revision    int64
+	UpdatedAt   time.Time
+	DeletedAt   pgtype.Timestamptz
 }
 
 type AttachmentAccommodation struct {
@@ -59,11 +81,65 @@ type AttachmentActivity struct {
 	ActivityID   int32
 }
 
+type AttachmentBlob struct {
+	BlobKey string
+}
+
+type AttachmentBlobChunk struct {
+	BlobKey string
+	Start   int64
+	Data    []byte
+}
+
+type AttachmentThumbnail struct {
+	AttachmentID int32
+	Size         string
+	Data         []byte
+	KeyVersion   *int32
+}
+
 type AttachmentTransportation struct {
 	AttachmentID     int32
 	TransportationID int32
 }
 
+type CategoryBudget struct {
+	TripID   int32
+	Category string
+	Amount   float64
+}
+
+type ExchangeRate struct {
+	Date     civil.Date
+	Currency string
+	Rate     float64
+}
+
+type Expense struct {
+	ID               int32
+	TripID           int32
+	Name             string
+	Date             civil.Date
+	Amount           float64
+	Currency         string
+	PayerID          int32
+	SplitMode        string
+	TransportationID *int32
+	ActivityID       *int32
+	AccommodationID  *int32
+	AttachmentID     *int32
+	Category         string
+	Revision         int64
+	UpdatedAt        time.Time
+	DeletedAt        pgtype.Timestamptz
+}
+
+type ExpenseParticipant struct {
+	ExpenseID int32
+	UserID    int32
+	Share     *float64
+}
+
 type FlightLeg struct {
 	ID                int32
 	TransportationID  int32
@@ -76,6 +152,7 @@ type FlightLeg struct {
 	DurationInMinutes int32
 	Aircraft          *string
 	AmadeusDate       *civil.Date
+	CabinClass        *string
 }
 
 type FlightPnr struct {
@@ -85,10 +162,23 @@ type FlightPnr struct {
 	Pnr              string
 }
 
+type History struct {
+	ID        int32
+	TripID    int32
+	UserID    *int32
+	Kind      string
+	EntityID  int32
+	Change    string
+	Before    *string
+	After     *string
+	CreatedAt time.Time
+}
+
 type Location struct {
 	ID        int32
 	Latitude  float32
 	Longitude float32
+	Timezone  *string
 }
 
 type Permission struct {
@@ -98,6 +188,22 @@ type Permission struct {
 	Write         bool
 }
 
+type SyncCreation struct {
+	TripID    int32
+	ClientID  string
+	Kind      string
+	EntityID  *int32
+	CreatedAt time.Time
+}
+
+type Tombstone struct {
+	TripID    int32
+	Kind      string
+	EntityID  int32
+	Revision  int64
+	DeletedAt time.Time
+}
+
 type TrainDetail struct {
 	TransportationID int32
 	RefreshToken     string
@@ -130,6 +236,10 @@ type Transportation struct {
 	DepartureTime civil.DateTime
 	ArrivalTime   civil.DateTime
 	Price         *int32
+	Currency      string
+	Revision      int64
+	UpdatedAt     time.Time
+	DeletedAt     pgtype.Timestamptz
 }
 
 type TransportationGeneric struct {
@@ -144,14 +254,43 @@ type TransportationGeojson struct {
 	Geojson          []byte
 }
 
+type TransportationTrack struct {
+	TransportationID      int32
+	Display               string
+	DistanceInMeters      float64
+	ElevationGainInMeters *float64
+	StartTime             *civil.DateTime
+	EndTime               *civil.DateTime
+	Geojson               []byte
+}
+
 type Trip struct {
-	ID          int32
-	Name        string
-	StartDate   civil.Date
-	EndDate     civil.Date
-	Description *string
-	ImageUrl    *string
-	OwnerID     int32
+	ID           int32
+	Name         string
+	StartDate    civil.Date
+	EndDate      civil.Date
+	Description  *string
+	ImageUrl     *string
+	OwnerID      int32
+	HomeCurrency string
+	Budget       *float64
+	Revision     int64
+	UpdatedAt    time.Time
+	DeletedAt    pgtype.Timestamptz
+	Template     bool
+}
+
+type TripKey struct {
+	TripID      int32
+	Version     int32
+	MasterKeyID string
+	WrappedKey  []byte
+	CreatedAt   time.Time
+}
+
+type TripRevision struct {
+	TripID   int32
+	Revision int64
 }
 
 type User struct {
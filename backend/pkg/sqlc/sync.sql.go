@@ -0,0 +1,141 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: sync.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const claimClientID = `-- name: ClaimClientID :execrows
+INSERT INTO sync_creation (trip_id, client_id, kind)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type ClaimClientIDParams struct {
+	TripID   int32
+	ClientID string
+	Kind     string
+}
+
+func (q *Queries) ClaimClientID(ctx context.Context, arg ClaimClientIDParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimClientID, arg.TripID, arg.ClientID, arg.Kind)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getSyncCreation = `-- name: GetSyncCreation :one
+SELECT kind, entity_id
+FROM sync_creation
+WHERE trip_id = $1
+  AND client_id = $2
+`
+
+type GetSyncCreationParams struct {
+	TripID   int32
+	ClientID string
+}
+
+type GetSyncCreationRow struct {
+	Kind     string
+	EntityID *int32
+}
+
+func (q *Queries) GetSyncCreation(ctx context.Context, arg GetSyncCreationParams) (GetSyncCreationRow, error) {
+	row := q.db.QueryRow(ctx, getSyncCreation, arg.TripID, arg.ClientID)
+	var i GetSyncCreationRow
+	err := row.Scan(&i.Kind, &i.EntityID)
+	return i, err
+}
+
+const getTombstones = `-- name: GetTombstones :many
+SELECT kind, entity_id, revision
+FROM tombstone
+WHERE trip_id = $1
+  AND revision > $2
+ORDER BY revision
+`
+
+type GetTombstonesParams struct {
+	TripID   int32
+	Revision int64
+}
+
+type GetTombstonesRow struct {
+	Kind     string
+	EntityID int32
+	Revision int64
+}
+
+func (q *Queries) GetTombstones(ctx context.Context, arg GetTombstonesParams) ([]GetTombstonesRow, error) {
+	rows, err := q.db.Query(ctx, getTombstones, arg.TripID, arg.Revision)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTombstonesRow{}
+	for rows.Next() {
+		var i GetTombstonesRow
+		if err := rows.Scan(&i.Kind, &i.EntityID, &i.Revision); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripRevision = `-- name: GetTripRevision :one
+SELECT revision
+FROM trip_revision
+WHERE trip_id = $1
+`
+
+func (q *Queries) GetTripRevision(ctx context.Context, tripID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, getTripRevision, tripID)
+	var revision int64
+	err := row.Scan(&revision)
+	return revision, err
+}
+
+const releaseClientID = `-- name: ReleaseClientID :exec
+DELETE FROM sync_creation
+WHERE trip_id = $1
+  AND client_id = $2
+  AND entity_id IS NULL
+`
+
+type ReleaseClientIDParams struct {
+	TripID   int32
+	ClientID string
+}
+
+func (q *Queries) ReleaseClientID(ctx context.Context, arg ReleaseClientIDParams) error {
+	_, err := q.db.Exec(ctx, releaseClientID, arg.TripID, arg.ClientID)
+	return err
+}
+
+const setSyncCreationEntity = `-- name: SetSyncCreationEntity :exec
+UPDATE sync_creation
+SET entity_id = $3
+WHERE trip_id = $1
+  AND client_id = $2
+`
+
+type SetSyncCreationEntityParams struct {
+	TripID   int32
+	ClientID string
+	EntityID *int32
+}
+
+func (q *Queries) SetSyncCreationEntity(ctx context.Context, arg SetSyncCreationEntityParams) error {
+	_, err := q.db.Exec(ctx, setSyncCreationEntity, arg.TripID, arg.ClientID, arg.EntityID)
+	return err
+}
@@ -28,8 +28,8 @@ const getTrainLegsByTransportationID = `-- name: GetTrainLegsByTransportationID
 SELECT train_leg.id, train_leg.transportation_id, train_leg.origin, train_leg.destination, train_leg.departure_time, train_leg.arrival_time, train_leg.line_name, train_leg.duration_in_minutes, train_leg.operator_name,
        origin.id, origin.name, origin.location_id,
        destination.id, destination.name, destination.location_id,
-       origin_location.id, origin_location.latitude, origin_location.longitude,
-       destination_location.id, destination_location.latitude, destination_location.longitude
+       origin_location.id, origin_location.latitude, origin_location.longitude, origin_location.timezone,
+       destination_location.id, destination_location.latitude, destination_location.longitude, destination_location.timezone
 FROM train_leg
          JOIN train_station origin on train_leg.origin = origin.id
          JOIN train_station destination on train_leg.destination = destination.id
@@ -75,9 +75,11 @@ func (q *Queries) GetTrainLegsByTransportationID(ctx context.Context, transporta
 			&i.Location.ID,
 			&i.Location.Latitude,
 			&i.Location.Longitude,
+			&i.Location.Timezone,
 			&i.Location_2.ID,
 			&i.Location_2.Latitude,
 			&i.Location_2.Longitude,
+			&i.Location_2.Timezone,
 		); err != nil {
 			return nil, err
 		}
@@ -167,3 +169,22 @@ func (q *Queries) TrainStationExists(ctx context.Context, id string) (bool, erro
 	err := row.Scan(&exists)
 	return exists, err
 }
+
+const updateTrainStationTimezone = `-- name: UpdateTrainStationTimezone :exec
+UPDATE location
+SET timezone = $2
+FROM train_station
+WHERE train_station.location_id = location.id
+  AND train_station.id = $1
+  AND location.timezone IS NULL
+`
+
+type UpdateTrainStationTimezoneParams struct {
+	ID       string
+	Timezone *string
+}
+
+func (q *Queries) UpdateTrainStationTimezone(ctx context.Context, arg UpdateTrainStationTimezoneParams) error {
+	_, err := q.db.Exec(ctx, updateTrainStationTimezone, arg.ID, arg.Timezone)
+	return err
+}
@@ -11,31 +11,46 @@ import (
 	"cloud.google.com/go/civil"
 )
 
-const deleteTransportationByID = `-- name: DeleteTransportationByID :one
+const deleteTrack = `-- name: DeleteTrack :one
 DELETE
-FROM transportation
-WHERE trip_id = $1
-  AND id = $2
-RETURNING id
+FROM transportation_track
+USING transportation
+WHERE transportation_track.transportation_id = transportation.id
+  AND transportation.trip_id = $1
+  AND transportation.id = $2
+  AND transportation.deleted_at IS NULL
+  AND ($3::bigint IS NULL OR transportation.revision = $3)
+RETURNING transportation_track.transportation_id
 `
 
-type DeleteTransportationByIDParams struct {
-	TripID int32
-	ID     int32
+type DeleteTrackParams struct {
+	TripID   int32
+	ID       int32
+	Revision *int64
 }
 
-func (q *Queries) DeleteTransportationByID(ctx context.Context, arg DeleteTransportationByIDParams) (int32, error) {
-	row := q.db.QueryRow(ctx, deleteTransportationByID, arg.TripID, arg.ID)
-	var id int32
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) DeleteTrack(ctx context.Context, arg DeleteTrackParams) (int32, error) {
+	row := q.db.QueryRow(ctx, deleteTrack, arg.TripID, arg.ID, arg.Revision)
+	var transportation_id int32
+	err := row.Scan(&transportation_id)
+	return transportation_id, err
 }
 
 const getAllGeoJson = `-- name: GetAllGeoJson :many
 SELECT transportation_geojson.geojson
 FROM transportation_geojson
          JOIN transportation on transportation_geojson.transportation_id = transportation.id
+         LEFT JOIN transportation_track on transportation_track.transportation_id = transportation.id
+WHERE transportation.trip_id = $1
+  AND transportation.deleted_at IS NULL
+  AND (transportation_track.display IS NULL OR transportation_track.display != 'ACTUAL')
+UNION ALL
+SELECT transportation_track.geojson
+FROM transportation_track
+         JOIN transportation on transportation_track.transportation_id = transportation.id
 WHERE transportation.trip_id = $1
+  AND transportation.deleted_at IS NULL
+  AND transportation_track.display != 'PLANNED'
 `
 
 func (q *Queries) GetAllGeoJson(ctx context.Context, tripID int32) ([][]byte, error) {
@@ -59,23 +74,30 @@ func (q *Queries) GetAllGeoJson(ctx context.Context, tripID int32) ([][]byte, er
 }
 
 const getAllTransportation = `-- name: GetAllTransportation :many
-SELECT transportation.id, transportation.trip_id, transportation.type, transportation.origin_id, transportation.destination_id, transportation.departure_time, transportation.arrival_time, transportation.price,
-       origin.id, origin.latitude, origin.longitude,
-       destination.id, destination.latitude, destination.longitude
+SELECT transportation.id, transportation.trip_id, transportation.type, transportation.origin_id, transportation.destination_id, transportation.departure_time, transportation.arrival_time, transportation.price, transportation.currency, transportation.revision, transportation.updated_at, transportation.deleted_at,
+       origin.id, origin.latitude, origin.longitude, origin.timezone,
+       destination.id, destination.latitude, destination.longitude, destination.timezone
 FROM transportation
          JOIN location origin on transportation.origin_id = origin.id
          JOIN location destination on transportation.destination_id = destination.id
 WHERE transportation.trip_id = $1
+  AND transportation.deleted_at IS NULL
+  AND transportation.revision > $2
 `
 
+type GetAllTransportationParams struct {
+	TripID int32
+	Since  int64
+}
+
 type GetAllTransportationRow struct {
 	Transportation Transportation
 	Location       Location
 	Location_2     Location
 }
 
-func (q *Queries) GetAllTransportation(ctx context.Context, tripID int32) ([]GetAllTransportationRow, error) {
-	rows, err := q.db.Query(ctx, getAllTransportation, tripID)
+func (q *Queries) GetAllTransportation(ctx context.Context, arg GetAllTransportationParams) ([]GetAllTransportationRow, error) {
+	rows, err := q.db.Query(ctx, getAllTransportation, arg.TripID, arg.Since)
 	if err != nil {
 		return nil, err
 	}
@@ -92,12 +114,18 @@ func (q *Queries) GetAllTransportation(ctx context.Context, tripID int32) ([]Get
 			&i.Transportation.DepartureTime,
 			&i.Transportation.ArrivalTime,
 			&i.Transportation.Price,
+			&i.Transportation.Currency,
+			&i.Transportation.Revision,
+			&i.Transportation.UpdatedAt,
+			&i.Transportation.DeletedAt,
 			&i.Location.ID,
 			&i.Location.Latitude,
 			&i.Location.Longitude,
+			&i.Location.Timezone,
 			&i.Location_2.ID,
 			&i.Location_2.Latitude,
 			&i.Location_2.Longitude,
+			&i.Location_2.Timezone,
 		); err != nil {
 			return nil, err
 		}
@@ -127,15 +155,79 @@ func (q *Queries) GetGenericDetailByTransportationID(ctx context.Context, transp
 	return i, err
 }
 
+const getRouteGeoJson = `-- name: GetRouteGeoJson :many
+SELECT transportation_geojson.transportation_id, transportation_geojson.geojson
+FROM transportation_geojson
+         JOIN transportation on transportation_geojson.transportation_id = transportation.id
+WHERE transportation.trip_id = $1
+  AND transportation.id = ANY ($2::integer[])
+  AND transportation.deleted_at IS NULL
+`
+
+type GetRouteGeoJsonParams struct {
+	TripID int32
+	Ids    []int32
+}
+
+func (q *Queries) GetRouteGeoJson(ctx context.Context, arg GetRouteGeoJsonParams) ([]TransportationGeojson, error) {
+	rows, err := q.db.Query(ctx, getRouteGeoJson, arg.TripID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TransportationGeojson{}
+	for rows.Next() {
+		var i TransportationGeojson
+		if err := rows.Scan(&i.TransportationID, &i.Geojson); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTrackByTransportationID = `-- name: GetTrackByTransportationID :one
+SELECT transportation_id, display, distance_in_meters, elevation_gain_in_meters, start_time, end_time
+FROM transportation_track
+WHERE transportation_id = $1
+`
+
+type GetTrackByTransportationIDRow struct {
+	TransportationID      int32
+	Display               string
+	DistanceInMeters      float64
+	ElevationGainInMeters *float64
+	StartTime             *civil.DateTime
+	EndTime               *civil.DateTime
+}
+
+func (q *Queries) GetTrackByTransportationID(ctx context.Context, transportationID int32) (GetTrackByTransportationIDRow, error) {
+	row := q.db.QueryRow(ctx, getTrackByTransportationID, transportationID)
+	var i GetTrackByTransportationIDRow
+	err := row.Scan(
+		&i.TransportationID,
+		&i.Display,
+		&i.DistanceInMeters,
+		&i.ElevationGainInMeters,
+		&i.StartTime,
+		&i.EndTime,
+	)
+	return i, err
+}
+
 const getTransportationByID = `-- name: GetTransportationByID :one
-SELECT transportation.id, transportation.trip_id, transportation.type, transportation.origin_id, transportation.destination_id, transportation.departure_time, transportation.arrival_time, transportation.price,
-       origin.id, origin.latitude, origin.longitude,
-       destination.id, destination.latitude, destination.longitude
+SELECT transportation.id, transportation.trip_id, transportation.type, transportation.origin_id, transportation.destination_id, transportation.departure_time, transportation.arrival_time, transportation.price, transportation.currency, transportation.revision, transportation.updated_at, transportation.deleted_at,
+       origin.id, origin.latitude, origin.longitude, origin.timezone,
+       destination.id, destination.latitude, destination.longitude, destination.timezone
 FROM transportation
          JOIN location origin on transportation.origin_id = origin.id
          JOIN location destination on transportation.destination_id = destination.id
 WHERE transportation.trip_id = $1
   AND transportation.id = $2
+  AND transportation.deleted_at IS NULL
 `
 
 type GetTransportationByIDParams struct {
@@ -161,19 +253,26 @@ func (q *Queries) GetTransportationByID(ctx context.Context, arg GetTransportati
 		&i.Transportation.DepartureTime,
 		&i.Transportation.ArrivalTime,
 		&i.Transportation.Price,
+		&i.Transportation.Currency,
+		&i.Transportation.Revision,
+		&i.Transportation.UpdatedAt,
+		&i.Transportation.DeletedAt,
 		&i.Location.ID,
 		&i.Location.Latitude,
 		&i.Location.Longitude,
+		&i.Location.Timezone,
 		&i.Location_2.ID,
 		&i.Location_2.Latitude,
 		&i.Location_2.Longitude,
+		&i.Location_2.Timezone,
 	)
 	return i, err
 }
 
 const insertTransportation = `-- name: InsertTransportation :one
-INSERT INTO transportation (trip_id, type, origin_id, destination_id, departure_time, arrival_time, price)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO transportation (trip_id, type, origin_id, destination_id, departure_time, arrival_time, price, currency)
+VALUES ($1, $2, $3, $4, $5, $6, $7,
+        coalesce($8::varchar, (SELECT home_currency FROM trip WHERE trip.id = $1)))
 RETURNING id
 `
 
@@ -185,6 +284,7 @@ type InsertTransportationParams struct {
 	DepartureTime civil.DateTime
 	ArrivalTime   civil.DateTime
 	Price         *int32
+	Currency      *string
 }
 
 func (q *Queries) InsertTransportation(ctx context.Context, arg InsertTransportationParams) (int32, error) {
@@ -196,21 +296,80 @@ func (q *Queries) InsertTransportation(ctx context.Context, arg InsertTransporta
 		arg.DepartureTime,
 		arg.ArrivalTime,
 		arg.Price,
+		arg.Currency,
 	)
 	var id int32
 	err := row.Scan(&id)
 	return id, err
 }
 
-const updateTransportation = `-- name: UpdateTransportation :exec
+const trashTransportationByID = `-- name: TrashTransportationByID :one
+UPDATE transportation
+SET deleted_at = now()
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NULL
+  AND ($3::bigint IS NULL OR revision = $3)
+RETURNING id
+`
+
+type TrashTransportationByIDParams struct {
+	TripID   int32
+	ID       int32
+	Revision *int64
+}
+
+func (q *Queries) TrashTransportationByID(ctx context.Context, arg TrashTransportationByIDParams) (int32, error) {
+	row := q.db.QueryRow(ctx, trashTransportationByID, arg.TripID, arg.ID, arg.Revision)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateTrackDisplay = `-- name: UpdateTrackDisplay :one
+UPDATE transportation_track
+SET display = $3
+FROM transportation
+WHERE transportation_track.transportation_id = transportation.id
+  AND transportation.trip_id = $1
+  AND transportation.id = $2
+  AND transportation.deleted_at IS NULL
+  AND ($4::bigint IS NULL OR transportation.revision = $4)
+RETURNING transportation_track.transportation_id
+`
+
+type UpdateTrackDisplayParams struct {
+	TripID   int32
+	ID       int32
+	Display  string
+	Revision *int64
+}
+
+func (q *Queries) UpdateTrackDisplay(ctx context.Context, arg UpdateTrackDisplayParams) (int32, error) {
+	row := q.db.QueryRow(ctx, updateTrackDisplay,
+		arg.TripID,
+		arg.ID,
+		arg.Display,
+		arg.Revision,
+	)
+	var transportation_id int32
+	err := row.Scan(&transportation_id)
+	return transportation_id, err
+}
+
+const updateTransportation = `-- name: UpdateTransportation :execrows
 UPDATE transportation
 SET type           = $2,
     origin_id      = $3,
     destination_id = $4,
     departure_time = $5,
     arrival_time   = $6,
-    price          = $7
+    price          = $7,
+    currency       = coalesce($8::varchar, currency)
 WHERE id = $1
+  AND trip_id = $9
+  AND deleted_at IS NULL
+  AND ($10::bigint IS NULL OR revision = $10)
 `
 
 type UpdateTransportationParams struct {
@@ -221,11 +380,13 @@ type UpdateTransportationParams struct {
 	DepartureTime civil.DateTime
 	ArrivalTime   civil.DateTime
 	Price         *int32
+	Currency      *string
+	TripID        int32
+	Revision      *int64
 }
 
-// TODO: check tripID!
-func (q *Queries) UpdateTransportation(ctx context.Context, arg UpdateTransportationParams) error {
-	_, err := q.db.Exec(ctx, updateTransportation,
+func (q *Queries) UpdateTransportation(ctx context.Context, arg UpdateTransportationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTransportation,
 		arg.ID,
 		arg.Type,
 		arg.OriginID,
@@ -233,8 +394,14 @@ func (q *Queries) UpdateTransportation(ctx context.Context, arg UpdateTransporta
 		arg.DepartureTime,
 		arg.ArrivalTime,
 		arg.Price,
+		arg.Currency,
+		arg.TripID,
+		arg.Revision,
 	)
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const upsertGenericTransportationDetail = `-- name: UpsertGenericTransportationDetail :exec
@@ -279,3 +446,38 @@ func (q *Queries) UpsertGeoJson(ctx context.Context, arg UpsertGeoJsonParams) er
 	_, err := q.db.Exec(ctx, upsertGeoJson, arg.TransportationID, arg.Geojson)
 	return err
 }
+
+const upsertTrack = `-- name: UpsertTrack :exec
+INSERT INTO transportation_track (transportation_id, display, distance_in_meters, elevation_gain_in_meters, start_time, end_time, geojson)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT(transportation_id) DO UPDATE SET
+    display = $2,
+    distance_in_meters = $3,
+    elevation_gain_in_meters = $4,
+    start_time = $5,
+    end_time = $6,
+    geojson = $7
+`
+
+type UpsertTrackParams struct {
+	TransportationID      int32
+	Display               string
+	DistanceInMeters      float64
+	ElevationGainInMeters *float64
+	StartTime             *civil.DateTime
+	EndTime               *civil.DateTime
+	Geojson               []byte
+}
+
+func (q *Queries) UpsertTrack(ctx context.Context, arg UpsertTrackParams) error {
+	_, err := q.db.Exec(ctx, upsertTrack,
+		arg.TransportationID,
+		arg.Display,
+		arg.DistanceInMeters,
+		arg.ElevationGainInMeters,
+		arg.StartTime,
+		arg.EndTime,
+		arg.Geojson,
+	)
+	return err
+}
@@ -0,0 +1,348 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: trash.sql
+
+package sqlc
+
+import (
+	"context"
+	"time"
+)
+
+const getBlobKeysOfTrashedTrips = `-- name: GetBlobKeysOfTrashedTrips :many
+SELECT DISTINCT attachment.blob_key
+FROM attachment
+         JOIN trip ON trip.id = attachment.trip_id
+WHERE trip.deleted_at < $1::timestamptz
+`
+
+func (q *Queries) GetBlobKeysOfTrashedTrips(ctx context.Context, before time.Time) ([]string, error) {
+	rows, err := q.db.Query(ctx, getBlobKeysOfTrashedTrips, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var blob_key string
+		if err := rows.Scan(&blob_key); err != nil {
+			return nil, err
+		}
+		items = append(items, blob_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTrash = `-- name: GetTrash :many
+WITH accessible AS (SELECT DISTINCT trip.id, trip.name, trip.deleted_at
+                    FROM trip
+                             LEFT JOIN permissions p ON trip.id = p.trip_id
+                    WHERE (trip.owner_id = $1 OR p.user_id = $1)
+                      AND ($2::integer IS NULL OR trip.id = $2))
+SELECT 'trip'::varchar AS kind, id, id AS trip_id, name, deleted_at::timestamptz AS deleted_at
+FROM accessible
+WHERE deleted_at IS NOT NULL
+UNION ALL
+SELECT 'transportation', transportation.id, transportation.trip_id,
+       coalesce(transportation_generic.name, transportation.type), transportation.deleted_at
+FROM transportation
+         JOIN accessible ON accessible.id = transportation.trip_id
+         LEFT JOIN transportation_generic ON transportation_generic.transportation_id = transportation.id
+WHERE accessible.deleted_at IS NULL
+  AND transportation.deleted_at IS NOT NULL
+UNION ALL
+SELECT 'activity', activity.id, activity.trip_id, activity.name, activity.deleted_at
+FROM activity
+         JOIN accessible ON accessible.id = activity.trip_id
+WHERE accessible.deleted_at IS NULL
+  AND activity.deleted_at IS NOT NULL
+UNION ALL
+SELECT 'accommodation', accommodation.id, accommodation.trip_id, accommodation.name, accommodation.deleted_at
+FROM accommodation
+         JOIN accessible ON accessible.id = accommodation.trip_id
+WHERE accessible.deleted_at IS NULL
+  AND accommodation.deleted_at IS NOT NULL
+UNION ALL
+SELECT 'expense', expense.id, expense.trip_id, expense.name, expense.deleted_at
+FROM expense
+         JOIN accessible ON accessible.id = expense.trip_id
+WHERE accessible.deleted_at IS NULL
+  AND expense.deleted_at IS NOT NULL
+UNION ALL
+SELECT 'attachment', attachment.id, attachment.trip_id, attachment.name, attachment.deleted_at
+FROM attachment
+         JOIN accessible ON accessible.id = attachment.trip_id
+WHERE accessible.deleted_at IS NULL
+  AND attachment.deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, kind, id
+`
+
+type GetTrashParams struct {
+	UserID int32
+	TripID *int32
+}
+
+type GetTrashRow struct {
+	Kind      string
+	ID        int32
+	TripID    int32
+	Name      string
+	DeletedAt time.Time
+}
+
+func (q *Queries) GetTrash(ctx context.Context, arg GetTrashParams) ([]GetTrashRow, error) {
+	rows, err := q.db.Query(ctx, getTrash, arg.UserID, arg.TripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTrashRow{}
+	for rows.Next() {
+		var i GetTrashRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.ID,
+			&i.TripID,
+			&i.Name,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeTrashedAccommodation = `-- name: PurgeTrashedAccommodation :execrows
+DELETE
+FROM accommodation
+WHERE deleted_at < $1::timestamptz
+`
+
+func (q *Queries) PurgeTrashedAccommodation(ctx context.Context, before time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeTrashedAccommodation, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const purgeTrashedActivities = `-- name: PurgeTrashedActivities :execrows
+DELETE
+FROM activity
+WHERE deleted_at < $1::timestamptz
+`
+
+func (q *Queries) PurgeTrashedActivities(ctx context.Context, before time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeTrashedActivities, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const purgeTrashedAttachments = `-- name: PurgeTrashedAttachments :many
+DELETE
+FROM attachment
+WHERE deleted_at < $1::timestamptz
+RETURNING blob_key
+`
+
+func (q *Queries) PurgeTrashedAttachments(ctx context.Context, before time.Time) ([]string, error) {
+	rows, err := q.db.Query(ctx, purgeTrashedAttachments, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var blob_key string
+		if err := rows.Scan(&blob_key); err != nil {
+			return nil, err
+		}
+		items = append(items, blob_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeTrashedExpenses = `-- name: PurgeTrashedExpenses :execrows
+DELETE
+FROM expense
+WHERE deleted_at < $1::timestamptz
+`
+
+func (q *Queries) PurgeTrashedExpenses(ctx context.Context, before time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeTrashedExpenses, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const purgeTrashedTransportation = `-- name: PurgeTrashedTransportation :execrows
+DELETE
+FROM transportation
+WHERE deleted_at < $1::timestamptz
+`
+
+func (q *Queries) PurgeTrashedTransportation(ctx context.Context, before time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeTrashedTransportation, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const purgeTrashedTrips = `-- name: PurgeTrashedTrips :execrows
+DELETE
+FROM trip
+WHERE deleted_at < $1::timestamptz
+`
+
+func (q *Queries) PurgeTrashedTrips(ctx context.Context, before time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeTrashedTrips, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreAccommodationByID = `-- name: RestoreAccommodationByID :one
+UPDATE accommodation
+SET deleted_at = NULL
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NOT NULL
+RETURNING id
+`
+
+type RestoreAccommodationByIDParams struct {
+	TripID int32
+	ID     int32
+}
+
+func (q *Queries) RestoreAccommodationByID(ctx context.Context, arg RestoreAccommodationByIDParams) (int32, error) {
+	row := q.db.QueryRow(ctx, restoreAccommodationByID, arg.TripID, arg.ID)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const restoreActivityByID = `-- name: RestoreActivityByID :one
+UPDATE activity
+SET deleted_at = NULL
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NOT NULL
+RETURNING id
+`
+
+type RestoreActivityByIDParams struct {
+	TripID int32
+	ID     int32
+}
+
+func (q *Queries) RestoreActivityByID(ctx context.Context, arg RestoreActivityByIDParams) (int32, error) {
+	row := q.db.QueryRow(ctx, restoreActivityByID, arg.TripID, arg.ID)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const restoreAttachmentByID = `-- name: RestoreAttachmentByID :one
+UPDATE attachment
+SET deleted_at = NULL
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NOT NULL
+RETURNING id
+`
+
+type RestoreAttachmentByIDParams struct {
+	TripID int32
+	ID     int32
+}
+
+func (q *Queries) RestoreAttachmentByID(ctx context.Context, arg RestoreAttachmentByIDParams) (int32, error) {
+	row := q.db.QueryRow(ctx, restoreAttachmentByID, arg.TripID, arg.ID)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const restoreExpenseByID = `-- name: RestoreExpenseByID :one
+UPDATE expense
+SET deleted_at = NULL
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NOT NULL
+RETURNING id
+`
+
+type RestoreExpenseByIDParams struct {
+	TripID int32
+	ID     int32
+}
+
+func (q *Queries) RestoreExpenseByID(ctx context.Context, arg RestoreExpenseByIDParams) (int32, error) {
+	row := q.db.QueryRow(ctx, restoreExpenseByID, arg.TripID, arg.ID)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const restoreTransportationByID = `-- name: RestoreTransportationByID :one
+UPDATE transportation
+SET deleted_at = NULL
+WHERE trip_id = $1
+  AND id = $2
+  AND deleted_at IS NOT NULL
+RETURNING id
+`
+
+type RestoreTransportationByIDParams struct {
+	TripID int32
+	ID     int32
+}
+
+func (q *Queries) RestoreTransportationByID(ctx context.Context, arg RestoreTransportationByIDParams) (int32, error) {
+	row := q.db.QueryRow(ctx, restoreTransportationByID, arg.TripID, arg.ID)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const restoreTripByID = `-- name: RestoreTripByID :one
+UPDATE trip
+SET deleted_at = NULL
+WHERE id = $1
+  AND deleted_at IS NOT NULL
+  AND (owner_id = $2 OR EXISTS (SELECT 1
+                                               FROM permissions p
+                                               WHERE p.trip_id = trip.id
+                                                 AND p.user_id = $2
+                                                 AND p.write is true))
+RETURNING id
+`
+
+type RestoreTripByIDParams struct {
+	TripID int32
+	UserID int32
+}
+
+func (q *Queries) RestoreTripByID(ctx context.Context, arg RestoreTripByIDParams) (int32, error) {
+	row := q.db.QueryRow(ctx, restoreTripByID, arg.TripID, arg.UserID)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
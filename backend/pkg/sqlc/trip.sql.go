@@ -11,23 +11,171 @@ import (
 	"cloud.google.com/go/civil"
 )
 
-const deleteTripByID = `-- name: DeleteTripByID :one
-DELETE
+const getDatedEntityIDs = `-- name: GetDatedEntityIDs :many
+SELECT 'transportation'::varchar AS kind, id
+FROM transportation
+WHERE trip_id = $1::integer
+  AND deleted_at IS NULL
+UNION ALL
+SELECT 'activity', id
+FROM activity
+WHERE trip_id = $1::integer
+  AND deleted_at IS NULL
+UNION ALL
+SELECT 'accommodation', id
+FROM accommodation
+WHERE trip_id = $1::integer
+  AND deleted_at IS NULL
+ORDER BY kind, id
+`
+
+type GetDatedEntityIDsRow struct {
+	Kind string
+	ID   int32
+}
+
+func (q *Queries) GetDatedEntityIDs(ctx context.Context, tripID int32) ([]GetDatedEntityIDsRow, error) {
+	rows, err := q.db.Query(ctx, getDatedEntityIDs, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetDatedEntityIDsRow{}
+	for rows.Next() {
+		var i GetDatedEntityIDsRow
+		if err := rows.Scan(&i.Kind, &i.ID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEntitiesOutOfRange = `-- name: GetEntitiesOutOfRange :many
+SELECT 'transportation'::varchar AS kind, transportation.id,
+       coalesce(transportation_generic.name, transportation.type)::varchar AS name,
+       (transportation.departure_time::date + $1::integer)::date AS start_date,
+       (transportation.arrival_time::date + $1::integer)::date AS end_date
+FROM transportation
+         LEFT JOIN transportation_generic ON transportation_generic.transportation_id = transportation.id
+WHERE transportation.trip_id = $2
+  AND transportation.deleted_at IS NULL
+  AND (transportation.departure_time::date + $1::integer < $3::date
+    OR transportation.arrival_time::date + $1::integer > $4::date)
+UNION ALL
+SELECT 'activity', id, name, date + $1::integer, date + $1::integer
+FROM activity
+WHERE trip_id = $2
+  AND deleted_at IS NULL
+  AND (date + $1::integer < $3::date
+    OR date + $1::integer > $4::date)
+UNION ALL
+SELECT 'accommodation', id, name, arrival_date + $1::integer, departure_date + $1::integer
+FROM accommodation
+WHERE trip_id = $2
+  AND deleted_at IS NULL
+  AND (arrival_date + $1::integer < $3::date
+    OR departure_date + $1::integer > $4::date)
+ORDER BY start_date, kind, id
+`
+
+type GetEntitiesOutOfRangeParams struct {
+	Days      int32
+	TripID    int32
+	StartDate civil.Date
+	EndDate   civil.Date
+}
+
+type GetEntitiesOutOfRangeRow struct {
+	Kind      string
+	ID        int32
+	Name      string
+	StartDate civil.Date
+	EndDate   civil.Date
+}
+
+// the dates are shifted by the given days before they are compared with the trip dates
+func (q *Queries) GetEntitiesOutOfRange(ctx context.Context, arg GetEntitiesOutOfRangeParams) ([]GetEntitiesOutOfRangeRow, error) {
+	rows, err := q.db.Query(ctx, getEntitiesOutOfRange,
+		arg.Days,
+		arg.TripID,
+		arg.StartDate,
+		arg.EndDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetEntitiesOutOfRangeRow{}
+	for rows.Next() {
+		var i GetEntitiesOutOfRangeRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.ID,
+			&i.Name,
+			&i.StartDate,
+			&i.EndDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTemplates = `-- name: GetTemplates :many
+SELECT id, name, start_date, end_date, description, image_url, owner_id, home_currency, budget, revision, updated_at, deleted_at, template
 FROM trip
-WHERE id = $1
-RETURNING id
+WHERE template
+  AND deleted_at IS NULL
+ORDER BY name
 `
 
-func (q *Queries) DeleteTripByID(ctx context.Context, id int32) (int32, error) {
-	row := q.db.QueryRow(ctx, deleteTripByID, id)
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) GetTemplates(ctx context.Context) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, getTemplates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Trip{}
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.StartDate,
+			&i.EndDate,
+			&i.Description,
+			&i.ImageUrl,
+			&i.OwnerID,
+			&i.HomeCurrency,
+			&i.Budget,
+			&i.Revision,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Template,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const getTripByID = `-- name: GetTripByID :one
-SELECT id, name, start_date, end_date, description, image_url, owner_id
+SELECT id, name, start_date, end_date, description, image_url, owner_id, home_currency, budget, revision, updated_at, deleted_at, template
 FROM trip
 WHERE id = $1
+  AND deleted_at IS NULL
 `
 
 func (q *Queries) GetTripByID(ctx context.Context, id int32) (Trip, error) {
@@ -41,15 +189,81 @@ func (q *Queries) GetTripByID(ctx context.Context, id int32) (Trip, error) {
 		&i.Description,
 		&i.ImageUrl,
 		&i.OwnerID,
+		&i.HomeCurrency,
+		&i.Budget,
+		&i.Revision,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Template,
 	)
 	return i, err
 }
 
+const getTripRouteGeoJson = `-- name: GetTripRouteGeoJson :many
+SELECT transportation_geojson.transportation_id, transportation_geojson.geojson
+FROM transportation_geojson
+         JOIN transportation on transportation_geojson.transportation_id = transportation.id
+WHERE transportation.trip_id = $1
+`
+
+func (q *Queries) GetTripRouteGeoJson(ctx context.Context, tripID int32) ([]TransportationGeojson, error) {
+	rows, err := q.db.Query(ctx, getTripRouteGeoJson, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TransportationGeojson{}
+	for rows.Next() {
+		var i TransportationGeojson
+		if err := rows.Scan(&i.TransportationID, &i.Geojson); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripTrackGeoJson = `-- name: GetTripTrackGeoJson :many
+SELECT transportation_track.transportation_id, transportation_track.geojson
+FROM transportation_track
+         JOIN transportation on transportation_track.transportation_id = transportation.id
+WHERE transportation.trip_id = $1
+`
+
+type GetTripTrackGeoJsonRow struct {
+	TransportationID int32
+	Geojson          []byte
+}
+
+func (q *Queries) GetTripTrackGeoJson(ctx context.Context, tripID int32) ([]GetTripTrackGeoJsonRow, error) {
+	rows, err := q.db.Query(ctx, getTripTrackGeoJson, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTripTrackGeoJsonRow{}
+	for rows.Next() {
+		var i GetTripTrackGeoJsonRow
+		if err := rows.Scan(&i.TransportationID, &i.Geojson); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTrips = `-- name: GetTrips :many
-SELECT trip.id, trip.name, trip.start_date, trip.end_date, trip.description, trip.image_url, trip.owner_id
+SELECT trip.id, trip.name, trip.start_date, trip.end_date, trip.description, trip.image_url, trip.owner_id, trip.home_currency, trip.budget, trip.revision, trip.updated_at, trip.deleted_at, trip.template
 FROM trip
 LEFT JOIN permissions p ON trip.id = p.trip_id
-WHERE trip.owner_id = $1 OR p.user_id = $1
+WHERE (trip.owner_id = $1 OR p.user_id = $1)
+  AND trip.deleted_at IS NULL
 `
 
 func (q *Queries) GetTrips(ctx context.Context, userID int32) ([]Trip, error) {
@@ -69,6 +283,12 @@ func (q *Queries) GetTrips(ctx context.Context, userID int32) ([]Trip, error) {
 			&i.Description,
 			&i.ImageUrl,
 			&i.OwnerID,
+			&i.HomeCurrency,
+			&i.Budget,
+			&i.Revision,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Template,
 		); err != nil {
 			return nil, err
 		}
@@ -81,18 +301,20 @@ func (q *Queries) GetTrips(ctx context.Context, userID int32) ([]Trip, error) {
 }
 
 const insertTrip = `-- name: InsertTrip :one
-INSERT INTO trip (owner_id, name, start_date, end_date, description, image_url)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO trip (owner_id, name, start_date, end_date, description, image_url, home_currency, template)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING id
 `
 
 type InsertTripParams struct {
-	OwnerID     int32
-	Name        string
-	StartDate   civil.Date
-	EndDate     civil.Date
-	Description *string
-	ImageUrl    *string
+	OwnerID      int32
+	Name         string
+	StartDate    civil.Date
+	EndDate      civil.Date
+	Description  *string
+	ImageUrl     *string
+	HomeCurrency string
+	Template     bool
 }
 
 func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (int32, error) {
@@ -103,39 +325,247 @@ func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (int32,
 		arg.EndDate,
 		arg.Description,
 		arg.ImageUrl,
+		arg.HomeCurrency,
+		arg.Template,
 	)
 	var id int32
 	err := row.Scan(&id)
 	return id, err
 }
 
-const updateTrip = `-- name: UpdateTrip :exec
+const shiftAccommodation = `-- name: ShiftAccommodation :many
+UPDATE accommodation
+SET arrival_date   = arrival_date + $1::integer,
+    departure_date = departure_date + $1::integer
+WHERE trip_id = $2
+RETURNING id, (deleted_at IS NOT NULL)::boolean AS trashed
+`
+
+type ShiftAccommodationParams struct {
+	Days   int32
+	TripID int32
+}
+
+type ShiftAccommodationRow struct {
+	ID      int32
+	Trashed bool
+}
+
+func (q *Queries) ShiftAccommodation(ctx context.Context, arg ShiftAccommodationParams) ([]ShiftAccommodationRow, error) {
+	rows, err := q.db.Query(ctx, shiftAccommodation, arg.Days, arg.TripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ShiftAccommodationRow{}
+	for rows.Next() {
+		var i ShiftAccommodationRow
+		if err := rows.Scan(&i.ID, &i.Trashed); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const shiftActivities = `-- name: ShiftActivities :many
+UPDATE activity
+SET date = date + $1::integer
+WHERE trip_id = $2
+RETURNING id, (deleted_at IS NOT NULL)::boolean AS trashed
+`
+
+type ShiftActivitiesParams struct {
+	Days   int32
+	TripID int32
+}
+
+type ShiftActivitiesRow struct {
+	ID      int32
+	Trashed bool
+}
+
+func (q *Queries) ShiftActivities(ctx context.Context, arg ShiftActivitiesParams) ([]ShiftActivitiesRow, error) {
+	rows, err := q.db.Query(ctx, shiftActivities, arg.Days, arg.TripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ShiftActivitiesRow{}
+	for rows.Next() {
+		var i ShiftActivitiesRow
+		if err := rows.Scan(&i.ID, &i.Trashed); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const shiftFlightLegs = `-- name: ShiftFlightLegs :exec
+UPDATE flight_leg
+SET departure_time = departure_time + make_interval(days => $1::integer),
+    arrival_time   = arrival_time + make_interval(days => $1::integer),
+    amadeus_date   = amadeus_date + $1::integer
+FROM transportation
+WHERE transportation.id = flight_leg.transportation_id
+  AND transportation.trip_id = $2
+`
+
+type ShiftFlightLegsParams struct {
+	Days   int32
+	TripID int32
+}
+
+func (q *Queries) ShiftFlightLegs(ctx context.Context, arg ShiftFlightLegsParams) error {
+	_, err := q.db.Exec(ctx, shiftFlightLegs, arg.Days, arg.TripID)
+	return err
+}
+
+const shiftTrack = `-- name: ShiftTrack :exec
+UPDATE transportation_track
+SET start_time = start_time + make_interval(days => $1::integer),
+    end_time   = end_time + make_interval(days => $1::integer),
+    geojson    = $2
+WHERE transportation_id = $3
+`
+
+type ShiftTrackParams struct {
+	Days             int32
+	Geojson          []byte
+	TransportationID int32
+}
+
+func (q *Queries) ShiftTrack(ctx context.Context, arg ShiftTrackParams) error {
+	_, err := q.db.Exec(ctx, shiftTrack, arg.Days, arg.Geojson, arg.TransportationID)
+	return err
+}
+
+const shiftTrainLegs = `-- name: ShiftTrainLegs :exec
+UPDATE train_leg
+SET departure_time = departure_time + make_interval(days => $1::integer),
+    arrival_time   = arrival_time + make_interval(days => $1::integer)
+FROM transportation
+WHERE transportation.id = train_leg.transportation_id
+  AND transportation.trip_id = $2
+`
+
+type ShiftTrainLegsParams struct {
+	Days   int32
+	TripID int32
+}
+
+func (q *Queries) ShiftTrainLegs(ctx context.Context, arg ShiftTrainLegsParams) error {
+	_, err := q.db.Exec(ctx, shiftTrainLegs, arg.Days, arg.TripID)
+	return err
+}
+
+const shiftTransportation = `-- name: ShiftTransportation :many
+UPDATE transportation
+SET departure_time = departure_time + make_interval(days => $1::integer),
+    arrival_time   = arrival_time + make_interval(days => $1::integer)
+WHERE trip_id = $2
+RETURNING id, (deleted_at IS NOT NULL)::boolean AS trashed
+`
+
+type ShiftTransportationParams struct {
+	Days   int32
+	TripID int32
+}
+
+type ShiftTransportationRow struct {
+	ID      int32
+	Trashed bool
+}
+
+// trashed entities are shifted as well, so they are still within the trip dates if they are restored
+func (q *Queries) ShiftTransportation(ctx context.Context, arg ShiftTransportationParams) ([]ShiftTransportationRow, error) {
+	rows, err := q.db.Query(ctx, shiftTransportation, arg.Days, arg.TripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ShiftTransportationRow{}
+	for rows.Next() {
+		var i ShiftTransportationRow
+		if err := rows.Scan(&i.ID, &i.Trashed); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const trashTripByID = `-- name: TrashTripByID :one
+UPDATE trip
+SET deleted_at = now()
+WHERE id = $1
+  AND deleted_at IS NULL
+  AND ($2::bigint IS NULL OR revision = $2)
+RETURNING id
+`
+
+type TrashTripByIDParams struct {
+	ID       int32
+	Revision *int64
+}
+
+func (q *Queries) TrashTripByID(ctx context.Context, arg TrashTripByIDParams) (int32, error) {
+	row := q.db.QueryRow(ctx, trashTripByID, arg.ID, arg.Revision)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateTrip = `-- name: UpdateTrip :execrows
 UPDATE trip
 SET name        = $2,
     start_date  = $3,
     end_date    = $4,
     description = $5,
-    image_url   = $6
+    image_url     = $6,
+    template      = $7,
+    home_currency = coalesce($8::varchar, home_currency)
 WHERE id = $1
+  AND deleted_at IS NULL
+  AND ($9::bigint IS NULL OR revision = $9)
 `
 
 type UpdateTripParams struct {
-	ID          int32
-	Name        string
-	StartDate   civil.Date
-	EndDate     civil.Date
-	Description *string
-	ImageUrl    *string
+	ID           int32
+	Name         string
+	StartDate    civil.Date
+	EndDate      civil.Date
+	Description  *string
+	ImageUrl     *string
+	Template     bool
+	HomeCurrency *string
+	Revision     *int64
 }
 
-func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
-	_, err := q.db.Exec(ctx, updateTrip,
+func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTrip,
 		arg.ID,
 		arg.Name,
 		arg.StartDate,
 		arg.EndDate,
 		arg.Description,
 		arg.ImageUrl,
+		arg.Template,
+		arg.HomeCurrency,
+		arg.Revision,
 	)
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
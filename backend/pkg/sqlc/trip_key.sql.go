@@ -0,0 +1,379 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: trip_key.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const deletePreviousTripKeys = `-- name: DeletePreviousTripKeys :exec
+DELETE
+FROM trip_key
+WHERE trip_id = $1
+  AND version < $2
+`
+
+type DeletePreviousTripKeysParams struct {
+	TripID  int32
+	Version int32
+}
+
+func (q *Queries) DeletePreviousTripKeys(ctx context.Context, arg DeletePreviousTripKeysParams) error {
+	_, err := q.db.Exec(ctx, deletePreviousTripKeys, arg.TripID, arg.Version)
+	return err
+}
+
+const getAllTripKeys = `-- name: GetAllTripKeys :many
+SELECT trip_id, version, master_key_id, wrapped_key, created_at
+FROM trip_key
+ORDER BY trip_id, version
+`
+
+func (q *Queries) GetAllTripKeys(ctx context.Context) ([]TripKey, error) {
+	rows, err := q.db.Query(ctx, getAllTripKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TripKey{}
+	for rows.Next() {
+		var i TripKey
+		if err := rows.Scan(
+			&i.TripID,
+			&i.Version,
+			&i.MasterKeyID,
+			&i.WrappedKey,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCurrentTripKey = `-- name: GetCurrentTripKey :one
+SELECT trip_id, version, master_key_id, wrapped_key, created_at
+FROM trip_key
+WHERE trip_id = $1
+ORDER BY version DESC
+LIMIT 1
+`
+
+func (q *Queries) GetCurrentTripKey(ctx context.Context, tripID int32) (TripKey, error) {
+	row := q.db.QueryRow(ctx, getCurrentTripKey, tripID)
+	var i TripKey
+	err := row.Scan(
+		&i.TripID,
+		&i.Version,
+		&i.MasterKeyID,
+		&i.WrappedKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getHistorySnapshots = `-- name: GetHistorySnapshots :many
+SELECT id, before, after
+FROM history
+WHERE trip_id = $1
+  AND (before IS NOT NULL OR after IS NOT NULL)
+`
+
+type GetHistorySnapshotsRow struct {
+	ID     int32
+	Before *string
+	After  *string
+}
+
+func (q *Queries) GetHistorySnapshots(ctx context.Context, tripID int32) ([]GetHistorySnapshotsRow, error) {
+	rows, err := q.db.Query(ctx, getHistorySnapshots, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetHistorySnapshotsRow{}
+	for rows.Next() {
+		var i GetHistorySnapshotsRow
+		if err := rows.Scan(&i.ID, &i.Before, &i.After); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSensitiveAccommodationDescriptions = `-- name: GetSensitiveAccommodationDescriptions :many
+SELECT id, description
+FROM accommodation
+WHERE trip_id = $1
+  AND sensitive
+  AND description IS NOT NULL
+`
+
+type GetSensitiveAccommodationDescriptionsRow struct {
+	ID          int32
+	Description *string
+}
+
+func (q *Queries) GetSensitiveAccommodationDescriptions(ctx context.Context, tripID int32) ([]GetSensitiveAccommodationDescriptionsRow, error) {
+	rows, err := q.db.Query(ctx, getSensitiveAccommodationDescriptions, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSensitiveAccommodationDescriptionsRow{}
+	for rows.Next() {
+		var i GetSensitiveAccommodationDescriptionsRow
+		if err := rows.Scan(&i.ID, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSensitiveActivityDescriptions = `-- name: GetSensitiveActivityDescriptions :many
+SELECT id, description
+FROM activity
+WHERE trip_id = $1
+  AND sensitive
+  AND description IS NOT NULL
+`
+
+type GetSensitiveActivityDescriptionsRow struct {
+	ID          int32
+	Description *string
+}
+
+func (q *Queries) GetSensitiveActivityDescriptions(ctx context.Context, tripID int32) ([]GetSensitiveActivityDescriptionsRow, error) {
+	rows, err := q.db.Query(ctx, getSensitiveActivityDescriptions, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSensitiveActivityDescriptionsRow{}
+	for rows.Next() {
+		var i GetSensitiveActivityDescriptionsRow
+		if err := rows.Scan(&i.ID, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripIDs = `-- name: GetTripIDs :many
+SELECT id
+FROM trip
+ORDER BY id
+`
+
+func (q *Queries) GetTripIDs(ctx context.Context) ([]int32, error) {
+	rows, err := q.db.Query(ctx, getTripIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int32{}
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripKey = `-- name: GetTripKey :one
+SELECT trip_id, version, master_key_id, wrapped_key, created_at
+FROM trip_key
+WHERE trip_id = $1
+  AND version = $2
+`
+
+type GetTripKeyParams struct {
+	TripID  int32
+	Version int32
+}
+
+func (q *Queries) GetTripKey(ctx context.Context, arg GetTripKeyParams) (TripKey, error) {
+	row := q.db.QueryRow(ctx, getTripKey, arg.TripID, arg.Version)
+	var i TripKey
+	err := row.Scan(
+		&i.TripID,
+		&i.Version,
+		&i.MasterKeyID,
+		&i.WrappedKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getTripPnrs = `-- name: GetTripPnrs :many
+SELECT flight_pnr.id, flight_pnr.transportation_id, flight_pnr.airline, flight_pnr.pnr
+FROM flight_pnr
+         JOIN transportation ON transportation.id = flight_pnr.transportation_id
+WHERE transportation.trip_id = $1
+`
+
+func (q *Queries) GetTripPnrs(ctx context.Context, tripID int32) ([]FlightPnr, error) {
+	rows, err := q.db.Query(ctx, getTripPnrs, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FlightPnr{}
+	for rows.Next() {
+		var i FlightPnr
+		if err := rows.Scan(
+			&i.ID,
+			&i.TransportationID,
+			&i.Airline,
+			&i.Pnr,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertTripKey = `-- name: InsertTripKey :execrows
+INSERT INTO trip_key (trip_id, version, master_key_id, wrapped_key)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (trip_id, version) DO NOTHING
+`
+
+type InsertTripKeyParams struct {
+	TripID      int32
+	Version     int32
+	MasterKeyID string
+	WrappedKey  []byte
+}
+
+func (q *Queries) InsertTripKey(ctx context.Context, arg InsertTripKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertTripKey,
+		arg.TripID,
+		arg.Version,
+		arg.MasterKeyID,
+		arg.WrappedKey,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateAccommodationDescription = `-- name: UpdateAccommodationDescription :exec
+UPDATE accommodation
+SET description = $2
+WHERE id = $1
+`
+
+type UpdateAccommodationDescriptionParams struct {
+	ID          int32
+	Description *string
+}
+
+func (q *Queries) UpdateAccommodationDescription(ctx context.Context, arg UpdateAccommodationDescriptionParams) error {
+	_, err := q.db.Exec(ctx, updateAccommodationDescription, arg.ID, arg.Description)
+	return err
+}
+
+const updateActivityDescription = `-- name: UpdateActivityDescription :exec
+UPDATE activity
+SET description = $2
+WHERE id = $1
+`
+
+type UpdateActivityDescriptionParams struct {
+	ID          int32
+	Description *string
+}
+
+func (q *Queries) UpdateActivityDescription(ctx context.Context, arg UpdateActivityDescriptionParams) error {
+	_, err := q.db.Exec(ctx, updateActivityDescription, arg.ID, arg.Description)
+	return err
+}
+
+const updateHistorySnapshots = `-- name: UpdateHistorySnapshots :exec
+UPDATE history
+SET before = $2,
+    after  = $3
+WHERE id = $1
+`
+
+type UpdateHistorySnapshotsParams struct {
+	ID     int32
+	Before *string
+	After  *string
+}
+
+func (q *Queries) UpdateHistorySnapshots(ctx context.Context, arg UpdateHistorySnapshotsParams) error {
+	_, err := q.db.Exec(ctx, updateHistorySnapshots, arg.ID, arg.Before, arg.After)
+	return err
+}
+
+const updatePnr = `-- name: UpdatePnr :exec
+UPDATE flight_pnr
+SET pnr = $2
+WHERE id = $1
+`
+
+type UpdatePnrParams struct {
+	ID  int32
+	Pnr string
+}
+
+func (q *Queries) UpdatePnr(ctx context.Context, arg UpdatePnrParams) error {
+	_, err := q.db.Exec(ctx, updatePnr, arg.ID, arg.Pnr)
+	return err
+}
+
+const updateTripKeyWrapping = `-- name: UpdateTripKeyWrapping :exec
+UPDATE trip_key
+SET master_key_id = $3,
+    wrapped_key   = $4
+WHERE trip_id = $1
+  AND version = $2
+`
+
+type UpdateTripKeyWrappingParams struct {
+	TripID      int32
+	Version     int32
+	MasterKeyID string
+	WrappedKey  []byte
+}
+
+func (q *Queries) UpdateTripKeyWrapping(ctx context.Context, arg UpdateTripKeyWrappingParams) error {
+	_, err := q.db.Exec(ctx, updateTripKeyWrapping,
+		arg.TripID,
+		arg.Version,
+		arg.MasterKeyID,
+		arg.WrappedKey,
+	)
+	return err
+}
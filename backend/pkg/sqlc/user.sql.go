@@ -11,6 +11,34 @@ import (
 	"github.com/google/uuid"
 )
 
+const getTripMembers = `-- name: GetTripMembers :many
+SELECT id, name, jwt_sub
+FROM "user"
+WHERE id = (SELECT owner_id FROM trip WHERE trip.id = $1)
+   OR id IN (SELECT user_id FROM permissions WHERE permissions.trip_id = $1)
+ORDER BY id
+`
+
+func (q *Queries) GetTripMembers(ctx context.Context, tripID int32) ([]User, error) {
+	rows, err := q.db.Query(ctx, getTripMembers, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(&i.ID, &i.Name, &i.JwtSub); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserByID = `-- name: GetUserByID :one
 SELECT id, name, jwt_sub
 FROM "user"
@@ -69,6 +97,7 @@ SELECT EXISTS (
              LEFT JOIN permissions p ON trip.id = p.trip_id
     WHERE (trip.owner_id = $1 OR p.user_id = $1)
       AND id = $2
+      AND deleted_at IS NULL
 )
 `
 
@@ -84,6 +113,29 @@ func (q *Queries) HasReadPermission(ctx context.Context, arg HasReadPermissionPa
 	return exists, err
 }
 
+const hasReadSensitivePermission = `-- name: HasReadSensitivePermission :one
+SELECT EXISTS (
+    SELECT 1
+    FROM trip
+             LEFT JOIN permissions p ON trip.id = p.trip_id
+    WHERE (trip.owner_id = $1 OR (p.user_id = $1 AND p.read_sensitive is true))
+      AND id = $2
+      AND deleted_at IS NULL
+)
+`
+
+type HasReadSensitivePermissionParams struct {
+	UserID int32
+	TripID int32
+}
+
+func (q *Queries) HasReadSensitivePermission(ctx context.Context, arg HasReadSensitivePermissionParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasReadSensitivePermission, arg.UserID, arg.TripID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const hasWritePermission = `-- name: HasWritePermission :one
 SELECT EXISTS (
     SELECT 1
@@ -91,6 +143,7 @@ SELECT EXISTS (
              LEFT JOIN permissions p ON trip.id = p.trip_id
     WHERE (trip.owner_id = $1 OR (p.user_id = $1 AND p.write is true))
       AND id = $2
+      AND deleted_at IS NULL
 )
 `
 
@@ -130,6 +183,7 @@ SELECT EXISTS (
     FROM trip
     WHERE trip.owner_id = $1
       AND id = $2
+      AND deleted_at IS NULL
 )
 `
 
@@ -0,0 +1,133 @@
+// Package thumbnail renders downscaled JPEG previews of images.
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"kompass/pkg/exif"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// maxPixels protects against images that are small files but decode to huge bitmaps.
+const maxPixels = 50_000_000
+
+const quality = 80
+
+var ErrTooLarge = errors.New("image has too many pixels")
+
+// Supported reports whether previews can be rendered for a content type. There is no pure-Go PDF renderer, so PDFs
+// do not get a preview.
+func Supported(contentType string) bool {
+	switch contentType {
+	case "image/jpeg", "image/png", "image/webp":
+		return true
+	default:
+		return false
+	}
+}
+
+// Source is a decoded image. Its Exif orientation is only applied to the thumbnails, which is much cheaper than
+// turning the whole image.
+type Source struct {
+	image       image.Image
+	orientation int
+}
+
+// Decode reads an image and its Exif orientation.
+func Decode(r io.ReadSeeker) (Source, error) {
+	orientation := 1
+	if metadata, err := exif.Decode(r); err == nil {
+		orientation = metadata.Orientation
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return Source{}, fmt.Errorf("rewind image: %w", err)
+	}
+
+	config, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return Source{}, fmt.Errorf("decode image config: %w", err)
+	}
+	if config.Width*config.Height > maxPixels {
+		return Source{}, fmt.Errorf("%w: %dx%d", ErrTooLarge, config.Width, config.Height)
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return Source{}, fmt.Errorf("rewind image: %w", err)
+	}
+
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return Source{}, fmt.Errorf("decode image: %w", err)
+	}
+	return Source{img, orientation}, nil
+}
+
+// Render scales the image down to fit into a square of size pixels, turns it upright and encodes it as JPEG. Smaller
+// images are not scaled up. Transparent areas become white.
+func (s Source) Render(size int) ([]byte, error) {
+	bounds := s.image.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > size || height > size {
+		if width >= height {
+			width, height = size, max(1, height*size/width)
+		} else {
+			width, height = max(1, width*size/height), size
+		}
+	}
+
+	thumbnail := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(thumbnail, thumbnail.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(thumbnail, thumbnail.Bounds(), s.image, bounds, draw.Over, nil)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, orient(thumbnail, s.orientation), &jpeg.Options{Quality: quality}); err != nil {
+		return nil, fmt.Errorf("encode thumbnail: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// orient applies one of the eight Exif orientations, which are combinations of mirroring and rotating.
+func orient(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	transposed := orientation >= 5
+	result := image.NewRGBA(image.Rect(0, 0, width, height))
+	if transposed {
+		result = image.NewRGBA(image.Rect(0, 0, height, width))
+	}
+
+	for y := range height {
+		for x := range width {
+			dx, dy := x, y
+			switch orientation {
+			case 2:
+				dx = width - 1 - x
+			case 3:
+				dx, dy = width-1-x, height-1-y
+			case 4:
+				dy = height - 1 - y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = height-1-y, x
+			case 7:
+				dx, dy = height-1-y, width-1-x
+			case 8:
+				dx, dy = y, width-1-x
+			}
+			result.Set(dx, dy, img.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+	return result
+}
@@ -0,0 +1,212 @@
+package track
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/paulmach/orb"
+)
+
+// Only the parts of the FIT protocol needed to read the positions of record messages are implemented.
+// See https://developer.garmin.com/fit/protocol/ for the full specification.
+const (
+	fitRecordMessage = 20
+
+	fitFieldTimestamp        = 253
+	fitFieldPositionLat      = 0
+	fitFieldPositionLong     = 1
+	fitFieldAltitude         = 2
+	fitFieldEnhancedAltitude = 78
+)
+
+// fitEpoch is the start of the FIT time scale (1989-12-31T00:00:00Z).
+var fitEpoch = time.Unix(631065600, 0).UTC()
+
+var errTruncatedFit = errors.New("truncated fit file")
+
+type fitField struct {
+	number uint8
+	size   uint8
+}
+
+type fitDefinition struct {
+	globalMessage uint16
+	byteOrder     binary.ByteOrder
+	fields        []fitField
+	size          int
+}
+
+func isFit(data []byte) bool {
+	return len(data) >= 12 && string(data[8:12]) == ".FIT"
+}
+
+// ParseFit reads the position, altitude and timestamp of all record messages of a FIT activity file.
+func ParseFit(data []byte) (Track, error) {
+	if !isFit(data) {
+		return Track{}, ErrUnsupportedFormat
+	}
+
+	headerSize := int(data[0])
+	dataSize := int(binary.LittleEndian.Uint32(data[4:8]))
+	if headerSize < 12 || len(data) < headerSize+dataSize {
+		return Track{}, errTruncatedFit
+	}
+
+	records := data[headerSize : headerSize+dataSize]
+	definitions := map[uint8]fitDefinition{}
+	var lastTimestamp uint32
+	points := []Point{}
+
+	for offset := 0; offset < len(records); {
+		header := records[offset]
+		offset++
+
+		var localMessage uint8
+		var compressedTimestamp *uint32
+		if header&0x80 != 0 {
+			// compressed timestamp header: the lower five bits are an offset to the last full timestamp
+			localMessage = (header >> 5) & 0x03
+			timeOffset := uint32(header & 0x1f)
+			timestamp := (lastTimestamp &^ 0x1f) + timeOffset
+			if timeOffset < lastTimestamp&0x1f {
+				timestamp += 0x20
+			}
+			compressedTimestamp = &timestamp
+		} else {
+			localMessage = header & 0x0f
+			if header&0x40 != 0 {
+				definition, size, err := parseFitDefinition(records[offset:], header&0x20 != 0)
+				if err != nil {
+					return Track{}, err
+				}
+				definitions[localMessage] = definition
+				offset += size
+				continue
+			}
+		}
+
+		definition, ok := definitions[localMessage]
+		if !ok {
+			return Track{}, fmt.Errorf("missing definition for local message %d", localMessage)
+		}
+		if len(records) < offset+definition.size {
+			return Track{}, errTruncatedFit
+		}
+
+		values := readFitFields(records[offset:offset+definition.size], definition)
+		offset += definition.size
+
+		if timestamp, ok := values[fitFieldTimestamp]; ok && timestamp != math.MaxUint32 {
+			lastTimestamp = uint32(timestamp)
+		} else if compressedTimestamp != nil {
+			lastTimestamp = *compressedTimestamp
+			values[fitFieldTimestamp] = uint64(lastTimestamp)
+		}
+
+		if definition.globalMessage != fitRecordMessage {
+			continue
+		}
+		if point, ok := convertFitRecord(values); ok {
+			points = append(points, point)
+		}
+	}
+
+	return Track{Segments: [][]Point{points}}, nil
+}
+
+func parseFitDefinition(data []byte, hasDeveloperFields bool) (fitDefinition, int, error) {
+	if len(data) < 5 {
+		return fitDefinition{}, 0, errTruncatedFit
+	}
+
+	definition := fitDefinition{byteOrder: binary.LittleEndian}
+	if data[1] == 1 {
+		definition.byteOrder = binary.BigEndian
+	}
+	definition.globalMessage = definition.byteOrder.Uint16(data[2:4])
+
+	fieldCount := int(data[4])
+	size := 5 + fieldCount*3
+	if len(data) < size {
+		return fitDefinition{}, 0, errTruncatedFit
+	}
+	for i := 0; i < fieldCount; i++ {
+		field := fitField{number: data[5+i*3], size: data[6+i*3]}
+		definition.fields = append(definition.fields, field)
+		definition.size += int(field.size)
+	}
+
+	if hasDeveloperFields {
+		if len(data) < size+1 {
+			return fitDefinition{}, 0, errTruncatedFit
+		}
+		developerFieldCount := int(data[size])
+		size++
+		if len(data) < size+developerFieldCount*3 {
+			return fitDefinition{}, 0, errTruncatedFit
+		}
+		// developer fields are skipped, so they are added as anonymous padding
+		for i := 0; i < developerFieldCount; i++ {
+			field := fitField{number: math.MaxUint8, size: data[size+i*3+1]}
+			definition.fields = append(definition.fields, field)
+			definition.size += int(field.size)
+		}
+		size += developerFieldCount * 3
+	}
+
+	return definition, size, nil
+}
+
+// readFitFields returns the raw values of all fields with a size of up to four bytes.
+func readFitFields(data []byte, definition fitDefinition) map[uint8]uint64 {
+	values := map[uint8]uint64{}
+	offset := 0
+	for _, field := range definition.fields {
+		raw := data[offset : offset+int(field.size)]
+		offset += int(field.size)
+
+		switch field.size {
+		case 1:
+			values[field.number] = uint64(raw[0])
+		case 2:
+			values[field.number] = uint64(definition.byteOrder.Uint16(raw))
+		case 4:
+			values[field.number] = uint64(definition.byteOrder.Uint32(raw))
+		}
+	}
+	return values
+}
+
+func convertFitRecord(values map[uint8]uint64) (Point, bool) {
+	lat, hasLat := values[fitFieldPositionLat]
+	long, hasLong := values[fitFieldPositionLong]
+	if !hasLat || !hasLong || lat == math.MaxInt32 || long == math.MaxInt32 {
+		return Point{}, false
+	}
+
+	point := Point{
+		Location: orb.Point{semicirclesToDegrees(long), semicirclesToDegrees(lat)},
+	}
+
+	if altitude, ok := values[fitFieldEnhancedAltitude]; ok && altitude != math.MaxUint32 {
+		elevation := float64(altitude)/5 - 500
+		point.Elevation = &elevation
+	} else if altitude, ok := values[fitFieldAltitude]; ok && altitude != math.MaxUint16 {
+		elevation := float64(altitude)/5 - 500
+		point.Elevation = &elevation
+	}
+
+	if timestamp, ok := values[fitFieldTimestamp]; ok && timestamp != math.MaxUint32 {
+		recorded := fitEpoch.Add(time.Duration(timestamp) * time.Second)
+		point.Time = &recorded
+	}
+
+	return point, true
+}
+
+func semicirclesToDegrees(semicircles uint64) float64 {
+	return float64(int32(uint32(semicircles))) * 180 / (1 << 31)
+}
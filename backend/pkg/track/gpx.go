@@ -0,0 +1,79 @@
+package track
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/paulmach/orb"
+)
+
+type gpx struct {
+	Tracks []struct {
+		Segments []struct {
+			Points []gpxPoint `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+	Routes []struct {
+		Points []gpxPoint `xml:"rtept"`
+	} `xml:"rte"`
+}
+
+type gpxPoint struct {
+	Latitude  float64  `xml:"lat,attr"`
+	Longitude float64  `xml:"lon,attr"`
+	Elevation *float64 `xml:"ele"`
+	Time      *string  `xml:"time"`
+}
+
+// ParseGpx reads all track segments of a GPX file. Routes are only used if the file contains no tracks.
+func ParseGpx(data []byte) (Track, error) {
+	var document gpx
+	if err := xml.Unmarshal(data, &document); err != nil {
+		return Track{}, fmt.Errorf("unmarshal gpx: %w", err)
+	}
+
+	track := Track{}
+	for _, trk := range document.Tracks {
+		for _, segment := range trk.Segments {
+			points, err := convertGpxPoints(segment.Points)
+			if err != nil {
+				return Track{}, err
+			}
+			track.Segments = append(track.Segments, points)
+		}
+	}
+
+	if track.IsEmpty() {
+		for _, route := range document.Routes {
+			points, err := convertGpxPoints(route.Points)
+			if err != nil {
+				return Track{}, err
+			}
+			track.Segments = append(track.Segments, points)
+		}
+	}
+
+	return track, nil
+}
+
+func convertGpxPoints(gpxPoints []gpxPoint) ([]Point, error) {
+	points := []Point{}
+	for _, gpxPoint := range gpxPoints {
+		point := Point{
+			Location:  orb.Point{gpxPoint.Longitude, gpxPoint.Latitude},
+			Elevation: gpxPoint.Elevation,
+		}
+
+		if gpxPoint.Time != nil {
+			parsed, err := time.Parse(time.RFC3339, *gpxPoint.Time)
+			if err != nil {
+				return nil, fmt.Errorf("parse time of track point: %w", err)
+			}
+			point.Time = &parsed
+		}
+
+		points = append(points, point)
+	}
+	return points, nil
+}
@@ -0,0 +1,123 @@
+// Package track parses recorded GPS tracks (GPX and FIT files).
+package track
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported track format")
+
+type Point struct {
+	Location  orb.Point
+	Elevation *float64
+	Time      *time.Time
+}
+
+// Track holds the recorded points, split into segments wherever the recording was paused.
+type Track struct {
+	Segments [][]Point
+}
+
+// Parse detects the format of the given file and parses it. FIT files are recognized by their header,
+// everything else is expected to be GPX.
+func Parse(data []byte) (Track, error) {
+	if isFit(data) {
+		return ParseFit(data)
+	}
+	if bytes.Contains(data, []byte("<gpx")) {
+		return ParseGpx(data)
+	}
+	return Track{}, ErrUnsupportedFormat
+}
+
+func (t Track) IsEmpty() bool {
+	for _, segment := range t.Segments {
+		if len(segment) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (t Track) Lines() orb.MultiLineString {
+	lines := orb.MultiLineString{}
+	for _, segment := range t.Segments {
+		if len(segment) < 2 {
+			continue
+		}
+		line := orb.LineString{}
+		for _, point := range segment {
+			line = append(line, point.Location)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Distance returns the length of the track in meters. Gaps between segments are not counted.
+func (t Track) Distance() float64 {
+	distance := 0.0
+	for _, line := range t.Lines() {
+		distance += geo.LengthHaversine(line)
+	}
+	return distance
+}
+
+// ElevationGain returns the accumulated ascent in meters or nil if the track contains no elevation data.
+// Changes below the threshold are ignored to not sum up the noise of barometric and GPS altitude readings.
+func (t Track) ElevationGain() *float64 {
+	const threshold = 3.0
+
+	found := false
+	gain := 0.0
+	for _, segment := range t.Segments {
+		var reference *float64
+		for _, point := range segment {
+			if point.Elevation == nil {
+				continue
+			}
+			found = true
+			if reference == nil {
+				reference = point.Elevation
+				continue
+			}
+
+			delta := *point.Elevation - *reference
+			if delta >= threshold {
+				gain += delta
+				reference = point.Elevation
+			} else if delta <= -threshold {
+				reference = point.Elevation
+			}
+		}
+	}
+
+	if !found {
+		return nil
+	}
+	return &gain
+}
+
+// TimeRange returns the first and last timestamp of the track or nil if the track is not timed.
+func (t Track) TimeRange() (*time.Time, *time.Time) {
+	var start, end *time.Time
+	for _, segment := range t.Segments {
+		for _, point := range segment {
+			if point.Time == nil {
+				continue
+			}
+			if start == nil || point.Time.Before(*start) {
+				start = point.Time
+			}
+			if end == nil || point.Time.After(*end) {
+				end = point.Time
+			}
+		}
+	}
+	return start, end
+}